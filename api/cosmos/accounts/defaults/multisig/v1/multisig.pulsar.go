@@ -2,6 +2,7 @@
 package multisigv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	_ "cosmossdk.io/api/cosmos/msg/v1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
@@ -3489,79 +3490,26 @@ func (x *fastReflection_MsgExecuteProposalResponse) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_MsgUpdateConfig_1_list)(nil)
-
-type _MsgUpdateConfig_1_list struct {
-	list *[]*Member
-}
-
-func (x *_MsgUpdateConfig_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgUpdateConfig_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgUpdateConfig_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Member)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgUpdateConfig_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Member)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgUpdateConfig_1_list) AppendMutable() protoreflect.Value {
-	v := new(Member)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgUpdateConfig_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgUpdateConfig_1_list) NewElement() protoreflect.Value {
-	v := new(Member)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgUpdateConfig_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgUpdateConfig                protoreflect.MessageDescriptor
-	fd_MsgUpdateConfig_update_members protoreflect.FieldDescriptor
-	fd_MsgUpdateConfig_Config         protoreflect.FieldDescriptor
+	md_MsgVetoProposal             protoreflect.MessageDescriptor
+	fd_MsgVetoProposal_proposal_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_MsgUpdateConfig = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("MsgUpdateConfig")
-	fd_MsgUpdateConfig_update_members = md_MsgUpdateConfig.Fields().ByName("update_members")
-	fd_MsgUpdateConfig_Config = md_MsgUpdateConfig.Fields().ByName("Config")
+	md_MsgVetoProposal = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("MsgVetoProposal")
+	fd_MsgVetoProposal_proposal_id = md_MsgVetoProposal.Fields().ByName("proposal_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgVetoProposal)(nil)
 
-type fastReflection_MsgUpdateConfig MsgUpdateConfig
+type fastReflection_MsgVetoProposal MsgVetoProposal
 
-func (x *MsgUpdateConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateConfig)(x)
+func (x *MsgVetoProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVetoProposal)(x)
 }
 
-func (x *MsgUpdateConfig) slowProtoReflect() protoreflect.Message {
+func (x *MsgVetoProposal) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3573,43 +3521,43 @@ func (x *MsgUpdateConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateConfig_messageType fastReflection_MsgUpdateConfig_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateConfig_messageType{}
+var _fastReflection_MsgVetoProposal_messageType fastReflection_MsgVetoProposal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVetoProposal_messageType{}
 
-type fastReflection_MsgUpdateConfig_messageType struct{}
+type fastReflection_MsgVetoProposal_messageType struct{}
 
-func (x fastReflection_MsgUpdateConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateConfig)(nil)
+func (x fastReflection_MsgVetoProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVetoProposal)(nil)
 }
-func (x fastReflection_MsgUpdateConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateConfig)
+func (x fastReflection_MsgVetoProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVetoProposal)
 }
-func (x fastReflection_MsgUpdateConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateConfig
+func (x fastReflection_MsgVetoProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVetoProposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateConfig
+func (x *fastReflection_MsgVetoProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVetoProposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateConfig) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateConfig_messageType
+func (x *fastReflection_MsgVetoProposal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVetoProposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateConfig) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateConfig)
+func (x *fastReflection_MsgVetoProposal) New() protoreflect.Message {
+	return new(fastReflection_MsgVetoProposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateConfig) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateConfig)(x)
+func (x *fastReflection_MsgVetoProposal) Interface() protoreflect.ProtoMessage {
+	return (*MsgVetoProposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3617,16 +3565,10 @@ func (x *fastReflection_MsgUpdateConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.UpdateMembers) != 0 {
-		value := protoreflect.ValueOfList(&_MsgUpdateConfig_1_list{list: &x.UpdateMembers})
-		if !f(fd_MsgUpdateConfig_update_members, value) {
-			return
-		}
-	}
-	if x.Config != nil {
-		value := protoreflect.ValueOfMessage(x.Config.ProtoReflect())
-		if !f(fd_MsgUpdateConfig_Config, value) {
+func (x *fastReflection_MsgVetoProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_MsgVetoProposal_proposal_id, value) {
 			return
 		}
 	}
@@ -3643,17 +3585,15 @@ func (x *fastReflection_MsgUpdateConfig) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgVetoProposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
-		return len(x.UpdateMembers) != 0
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
-		return x.Config != nil
+	case "cosmos.accounts.defaults.multisig.v1.MsgVetoProposal.proposal_id":
+		return x.ProposalId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3663,17 +3603,15 @@ func (x *fastReflection_MsgUpdateConfig) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgVetoProposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
-		x.UpdateMembers = nil
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
-		x.Config = nil
+	case "cosmos.accounts.defaults.multisig.v1.MsgVetoProposal.proposal_id":
+		x.ProposalId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3683,22 +3621,16 @@ func (x *fastReflection_MsgUpdateConfig) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
-		if len(x.UpdateMembers) == 0 {
-			return protoreflect.ValueOfList(&_MsgUpdateConfig_1_list{})
-		}
-		listValue := &_MsgUpdateConfig_1_list{list: &x.UpdateMembers}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
-		value := x.Config
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.accounts.defaults.multisig.v1.MsgVetoProposal.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3712,19 +3644,15 @@ func (x *fastReflection_MsgUpdateConfig) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgVetoProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
-		lv := value.List()
-		clv := lv.(*_MsgUpdateConfig_1_list)
-		x.UpdateMembers = *clv.list
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
-		x.Config = value.Message().Interface().(*Config)
+	case "cosmos.accounts.defaults.multisig.v1.MsgVetoProposal.proposal_id":
+		x.ProposalId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3738,53 +3666,40 @@ func (x *fastReflection_MsgUpdateConfig) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
-		if x.UpdateMembers == nil {
-			x.UpdateMembers = []*Member{}
-		}
-		value := &_MsgUpdateConfig_1_list{list: &x.UpdateMembers}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
-		if x.Config == nil {
-			x.Config = new(Config)
-		}
-		return protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+	case "cosmos.accounts.defaults.multisig.v1.MsgVetoProposal.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.accounts.defaults.multisig.v1.MsgVetoProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
-		list := []*Member{}
-		return protoreflect.ValueOfList(&_MsgUpdateConfig_1_list{list: &list})
-	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
-		m := new(Config)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.accounts.defaults.multisig.v1.MsgVetoProposal.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgVetoProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.MsgVetoProposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3792,7 +3707,7 @@ func (x *fastReflection_MsgUpdateConfig) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgVetoProposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3803,7 +3718,7 @@ func (x *fastReflection_MsgUpdateConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgVetoProposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3815,7 +3730,7 @@ func (x *fastReflection_MsgUpdateConfig) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateConfig) IsValid() bool {
+func (x *fastReflection_MsgVetoProposal) IsValid() bool {
 	return x != nil
 }
 
@@ -3825,9 +3740,9 @@ func (x *fastReflection_MsgUpdateConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgVetoProposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateConfig)
+		x := input.Message.Interface().(*MsgVetoProposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3839,15 +3754,8 @@ func (x *fastReflection_MsgUpdateConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.UpdateMembers) > 0 {
-			for _, e := range x.UpdateMembers {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Config != nil {
-			l = options.Size(x.Config)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3859,7 +3767,7 @@ func (x *fastReflection_MsgUpdateConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateConfig)
+		x := input.Message.Interface().(*MsgVetoProposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3878,35 +3786,10 @@ func (x *fastReflection_MsgUpdateConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Config != nil {
-			encoded, err := options.Marshal(x.Config)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.UpdateMembers) > 0 {
-			for iNdEx := len(x.UpdateMembers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.UpdateMembers[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3919,7 +3802,7 @@ func (x *fastReflection_MsgUpdateConfig) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateConfig)
+		x := input.Message.Interface().(*MsgVetoProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3951,17 +3834,17 @@ func (x *fastReflection_MsgUpdateConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateMembers", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				var msglen int
+				x.ProposalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3971,76 +3854,25 @@ func (x *fastReflection_MsgUpdateConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.ProposalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UpdateMembers = append(x.UpdateMembers, &Member{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdateMembers[len(x.UpdateMembers)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Config == nil {
-					x.Config = &Config{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -4063,23 +3895,23 @@ func (x *fastReflection_MsgUpdateConfig) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateConfigResponse protoreflect.MessageDescriptor
+	md_MsgVetoProposalResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_MsgUpdateConfigResponse = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("MsgUpdateConfigResponse")
+	md_MsgVetoProposalResponse = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("MsgVetoProposalResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateConfigResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgVetoProposalResponse)(nil)
 
-type fastReflection_MsgUpdateConfigResponse MsgUpdateConfigResponse
+type fastReflection_MsgVetoProposalResponse MsgVetoProposalResponse
 
-func (x *MsgUpdateConfigResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateConfigResponse)(x)
+func (x *MsgVetoProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVetoProposalResponse)(x)
 }
 
-func (x *MsgUpdateConfigResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgVetoProposalResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4091,43 +3923,43 @@ func (x *MsgUpdateConfigResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateConfigResponse_messageType fastReflection_MsgUpdateConfigResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateConfigResponse_messageType{}
+var _fastReflection_MsgVetoProposalResponse_messageType fastReflection_MsgVetoProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVetoProposalResponse_messageType{}
 
-type fastReflection_MsgUpdateConfigResponse_messageType struct{}
+type fastReflection_MsgVetoProposalResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateConfigResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateConfigResponse)(nil)
+func (x fastReflection_MsgVetoProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVetoProposalResponse)(nil)
 }
-func (x fastReflection_MsgUpdateConfigResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateConfigResponse)
+func (x fastReflection_MsgVetoProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVetoProposalResponse)
 }
-func (x fastReflection_MsgUpdateConfigResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateConfigResponse
+func (x fastReflection_MsgVetoProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVetoProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateConfigResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateConfigResponse
+func (x *fastReflection_MsgVetoProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVetoProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateConfigResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateConfigResponse_messageType
+func (x *fastReflection_MsgVetoProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVetoProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateConfigResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateConfigResponse)
+func (x *fastReflection_MsgVetoProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgVetoProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateConfigResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateConfigResponse)(x)
+func (x *fastReflection_MsgVetoProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgVetoProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4135,7 +3967,7 @@ func (x *fastReflection_MsgUpdateConfigResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateConfigResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgVetoProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4149,13 +3981,13 @@ func (x *fastReflection_MsgUpdateConfigResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateConfigResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgVetoProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4165,13 +3997,13 @@ func (x *fastReflection_MsgUpdateConfigResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateConfigResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgVetoProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4181,13 +4013,13 @@ func (x *fastReflection_MsgUpdateConfigResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateConfigResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4201,13 +4033,13 @@ func (x *fastReflection_MsgUpdateConfigResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateConfigResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgVetoProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4221,36 +4053,36 @@ func (x *fastReflection_MsgUpdateConfigResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateConfigResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateConfigResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateConfigResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgVetoProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4258,7 +4090,7 @@ func (x *fastReflection_MsgUpdateConfigResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateConfigResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgVetoProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4269,7 +4101,7 @@ func (x *fastReflection_MsgUpdateConfigResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateConfigResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgVetoProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4281,7 +4113,7 @@ func (x *fastReflection_MsgUpdateConfigResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateConfigResponse) IsValid() bool {
+func (x *fastReflection_MsgVetoProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4291,9 +4123,9 @@ func (x *fastReflection_MsgUpdateConfigResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateConfigResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgVetoProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateConfigResponse)
+		x := input.Message.Interface().(*MsgVetoProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4315,7 +4147,7 @@ func (x *fastReflection_MsgUpdateConfigResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateConfigResponse)
+		x := input.Message.Interface().(*MsgVetoProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4345,7 +4177,7 @@ func (x *fastReflection_MsgUpdateConfigResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateConfigResponse)
+		x := input.Message.Interface().(*MsgVetoProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4377,10 +4209,10 @@ func (x *fastReflection_MsgUpdateConfigResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateConfigResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -4418,28 +4250,79 @@ func (x *fastReflection_MsgUpdateConfigResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var _ protoreflect.List = (*_MsgUpdateConfig_1_list)(nil)
+
+type _MsgUpdateConfig_1_list struct {
+	list *[]*Member
+}
+
+func (x *_MsgUpdateConfig_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateConfig_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateConfig_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Member)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateConfig_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Member)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateConfig_1_list) AppendMutable() protoreflect.Value {
+	v := new(Member)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateConfig_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateConfig_1_list) NewElement() protoreflect.Value {
+	v := new(Member)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateConfig_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Member         protoreflect.MessageDescriptor
-	fd_Member_address protoreflect.FieldDescriptor
-	fd_Member_weight  protoreflect.FieldDescriptor
+	md_MsgUpdateConfig                protoreflect.MessageDescriptor
+	fd_MsgUpdateConfig_update_members protoreflect.FieldDescriptor
+	fd_MsgUpdateConfig_Config         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_Member = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("Member")
-	fd_Member_address = md_Member.Fields().ByName("address")
-	fd_Member_weight = md_Member.Fields().ByName("weight")
+	md_MsgUpdateConfig = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("MsgUpdateConfig")
+	fd_MsgUpdateConfig_update_members = md_MsgUpdateConfig.Fields().ByName("update_members")
+	fd_MsgUpdateConfig_Config = md_MsgUpdateConfig.Fields().ByName("Config")
 }
 
-var _ protoreflect.Message = (*fastReflection_Member)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateConfig)(nil)
 
-type fastReflection_Member Member
+type fastReflection_MsgUpdateConfig MsgUpdateConfig
 
-func (x *Member) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Member)(x)
+func (x *MsgUpdateConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateConfig)(x)
 }
 
-func (x *Member) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateConfig) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4451,43 +4334,43 @@ func (x *Member) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Member_messageType fastReflection_Member_messageType
-var _ protoreflect.MessageType = fastReflection_Member_messageType{}
+var _fastReflection_MsgUpdateConfig_messageType fastReflection_MsgUpdateConfig_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateConfig_messageType{}
 
-type fastReflection_Member_messageType struct{}
+type fastReflection_MsgUpdateConfig_messageType struct{}
 
-func (x fastReflection_Member_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Member)(nil)
+func (x fastReflection_MsgUpdateConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateConfig)(nil)
 }
-func (x fastReflection_Member_messageType) New() protoreflect.Message {
-	return new(fastReflection_Member)
+func (x fastReflection_MsgUpdateConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateConfig)
 }
-func (x fastReflection_Member_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Member
+func (x fastReflection_MsgUpdateConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateConfig
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Member) Descriptor() protoreflect.MessageDescriptor {
-	return md_Member
+func (x *fastReflection_MsgUpdateConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateConfig
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Member) Type() protoreflect.MessageType {
-	return _fastReflection_Member_messageType
+func (x *fastReflection_MsgUpdateConfig) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateConfig_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Member) New() protoreflect.Message {
-	return new(fastReflection_Member)
+func (x *fastReflection_MsgUpdateConfig) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateConfig)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Member) Interface() protoreflect.ProtoMessage {
-	return (*Member)(x)
+func (x *fastReflection_MsgUpdateConfig) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateConfig)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4495,16 +4378,16 @@ func (x *fastReflection_Member) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Member) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Member_address, value) {
+func (x *fastReflection_MsgUpdateConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.UpdateMembers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateConfig_1_list{list: &x.UpdateMembers})
+		if !f(fd_MsgUpdateConfig_update_members, value) {
 			return
 		}
 	}
-	if x.Weight != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Weight)
-		if !f(fd_Member_weight, value) {
+	if x.Config != nil {
+		value := protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+		if !f(fd_MsgUpdateConfig_Config, value) {
 			return
 		}
 	}
@@ -4521,17 +4404,17 @@ func (x *fastReflection_Member) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Member) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateConfig) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Member.address":
-		return x.Address != ""
-	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
-		return x.Weight != uint64(0)
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
+		return len(x.UpdateMembers) != 0
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
+		return x.Config != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4541,17 +4424,17 @@ func (x *fastReflection_Member) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Member) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateConfig) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Member.address":
-		x.Address = ""
-	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
-		x.Weight = uint64(0)
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
+		x.UpdateMembers = nil
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
+		x.Config = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4561,19 +4444,22 @@ func (x *fastReflection_Member) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Member) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Member.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
-		value := x.Weight
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
+		if len(x.UpdateMembers) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateConfig_1_list{})
+		}
+		listValue := &_MsgUpdateConfig_1_list{list: &x.UpdateMembers}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
+		value := x.Config
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4587,17 +4473,19 @@ func (x *fastReflection_Member) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Member) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Member.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
-		x.Weight = value.Uint()
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateConfig_1_list)
+		x.UpdateMembers = *clv.list
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
+		x.Config = value.Message().Interface().(*Config)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4611,44 +4499,53 @@ func (x *fastReflection_Member) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Member) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Member.address":
-		panic(fmt.Errorf("field address of message cosmos.accounts.defaults.multisig.v1.Member is not mutable"))
-	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
-		panic(fmt.Errorf("field weight of message cosmos.accounts.defaults.multisig.v1.Member is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
+		if x.UpdateMembers == nil {
+			x.UpdateMembers = []*Member{}
+		}
+		value := &_MsgUpdateConfig_1_list{list: &x.UpdateMembers}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
+		if x.Config == nil {
+			x.Config = new(Config)
+		}
+		return protoreflect.ValueOfMessage(x.Config.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Member) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Member.address":
-		return protoreflect.ValueOfString("")
-	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members":
+		list := []*Member{}
+		return protoreflect.ValueOfList(&_MsgUpdateConfig_1_list{list: &list})
+	case "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config":
+		m := new(Config)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Member) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.Member", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4656,7 +4553,7 @@ func (x *fastReflection_Member) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Member) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateConfig) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4667,7 +4564,7 @@ func (x *fastReflection_Member) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Member) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateConfig) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4679,7 +4576,7 @@ func (x *fastReflection_Member) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Member) IsValid() bool {
+func (x *fastReflection_MsgUpdateConfig) IsValid() bool {
 	return x != nil
 }
 
@@ -4689,9 +4586,9 @@ func (x *fastReflection_Member) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Member) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateConfig) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Member)
+		x := input.Message.Interface().(*MsgUpdateConfig)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4703,12 +4600,15 @@ func (x *fastReflection_Member) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.UpdateMembers) > 0 {
+			for _, e := range x.UpdateMembers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.Weight != 0 {
-			n += 1 + runtime.Sov(uint64(x.Weight))
+		if x.Config != nil {
+			l = options.Size(x.Config)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4720,7 +4620,7 @@ func (x *fastReflection_Member) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Member)
+		x := input.Message.Interface().(*MsgUpdateConfig)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4739,17 +4639,35 @@ func (x *fastReflection_Member) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Weight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Weight))
+		if x.Config != nil {
+			encoded, err := options.Marshal(x.Config)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.UpdateMembers) > 0 {
+			for iNdEx := len(x.UpdateMembers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UpdateMembers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4762,7 +4680,7 @@ func (x *fastReflection_Member) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Member)
+		x := input.Message.Interface().(*MsgUpdateConfig)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4794,17 +4712,17 @@ func (x *fastReflection_Member) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Member: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateConfig: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateMembers", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4814,29 +4732,31 @@ func (x *fastReflection_Member) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.UpdateMembers = append(x.UpdateMembers, &Member{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdateMembers[len(x.UpdateMembers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 				}
-				x.Weight = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4846,11 +4766,28 @@ func (x *fastReflection_Member) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Weight |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Config == nil {
+					x.Config = &Config{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4887,33 +4824,23 @@ func (x *fastReflection_Member) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Config                 protoreflect.MessageDescriptor
-	fd_Config_threshold       protoreflect.FieldDescriptor
-	fd_Config_quorum          protoreflect.FieldDescriptor
-	fd_Config_voting_period   protoreflect.FieldDescriptor
-	fd_Config_revote          protoreflect.FieldDescriptor
-	fd_Config_early_execution protoreflect.FieldDescriptor
+	md_MsgUpdateConfigResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_Config = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("Config")
-	fd_Config_threshold = md_Config.Fields().ByName("threshold")
-	fd_Config_quorum = md_Config.Fields().ByName("quorum")
-	fd_Config_voting_period = md_Config.Fields().ByName("voting_period")
-	fd_Config_revote = md_Config.Fields().ByName("revote")
-	fd_Config_early_execution = md_Config.Fields().ByName("early_execution")
+	md_MsgUpdateConfigResponse = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("MsgUpdateConfigResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_Config)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateConfigResponse)(nil)
 
-type fastReflection_Config Config
+type fastReflection_MsgUpdateConfigResponse MsgUpdateConfigResponse
 
-func (x *Config) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Config)(x)
+func (x *MsgUpdateConfigResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateConfigResponse)(x)
 }
 
-func (x *Config) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateConfigResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4925,43 +4852,43 @@ func (x *Config) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Config_messageType fastReflection_Config_messageType
-var _ protoreflect.MessageType = fastReflection_Config_messageType{}
+var _fastReflection_MsgUpdateConfigResponse_messageType fastReflection_MsgUpdateConfigResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateConfigResponse_messageType{}
 
-type fastReflection_Config_messageType struct{}
+type fastReflection_MsgUpdateConfigResponse_messageType struct{}
 
-func (x fastReflection_Config_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Config)(nil)
+func (x fastReflection_MsgUpdateConfigResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateConfigResponse)(nil)
 }
-func (x fastReflection_Config_messageType) New() protoreflect.Message {
-	return new(fastReflection_Config)
+func (x fastReflection_MsgUpdateConfigResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateConfigResponse)
 }
-func (x fastReflection_Config_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Config
+func (x fastReflection_MsgUpdateConfigResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateConfigResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Config) Descriptor() protoreflect.MessageDescriptor {
-	return md_Config
+func (x *fastReflection_MsgUpdateConfigResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateConfigResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Config) Type() protoreflect.MessageType {
-	return _fastReflection_Config_messageType
+func (x *fastReflection_MsgUpdateConfigResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateConfigResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Config) New() protoreflect.Message {
-	return new(fastReflection_Config)
+func (x *fastReflection_MsgUpdateConfigResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateConfigResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Config) Interface() protoreflect.ProtoMessage {
-	return (*Config)(x)
+func (x *fastReflection_MsgUpdateConfigResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateConfigResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4969,37 +4896,7 @@ func (x *fastReflection_Config) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Config) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Threshold != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Threshold)
-		if !f(fd_Config_threshold, value) {
-			return
-		}
-	}
-	if x.Quorum != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Quorum)
-		if !f(fd_Config_quorum, value) {
-			return
-		}
-	}
-	if x.VotingPeriod != int64(0) {
-		value := protoreflect.ValueOfInt64(x.VotingPeriod)
-		if !f(fd_Config_voting_period, value) {
-			return
-		}
-	}
-	if x.Revote != false {
-		value := protoreflect.ValueOfBool(x.Revote)
-		if !f(fd_Config_revote, value) {
-			return
-		}
-	}
-	if x.EarlyExecution != false {
-		value := protoreflect.ValueOfBool(x.EarlyExecution)
-		if !f(fd_Config_early_execution, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgUpdateConfigResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5013,23 +4910,13 @@ func (x *fastReflection_Config) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Config) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateConfigResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
-		return x.Threshold != int64(0)
-	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
-		return x.Quorum != int64(0)
-	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
-		return x.VotingPeriod != int64(0)
-	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
-		return x.Revote != false
-	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
-		return x.EarlyExecution != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5039,23 +4926,13 @@ func (x *fastReflection_Config) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Config) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateConfigResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
-		x.Threshold = int64(0)
-	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
-		x.Quorum = int64(0)
-	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
-		x.VotingPeriod = int64(0)
-	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
-		x.Revote = false
-	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
-		x.EarlyExecution = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5065,28 +4942,13 @@ func (x *fastReflection_Config) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Config) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateConfigResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
-		value := x.Threshold
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
-		value := x.Quorum
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
-		value := x.VotingPeriod
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
-		value := x.Revote
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
-		value := x.EarlyExecution
-		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5100,23 +4962,13 @@ func (x *fastReflection_Config) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Config) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateConfigResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
-		x.Threshold = value.Int()
-	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
-		x.Quorum = value.Int()
-	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
-		x.VotingPeriod = value.Int()
-	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
-		x.Revote = value.Bool()
-	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
-		x.EarlyExecution = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5130,56 +4982,36 @@ func (x *fastReflection_Config) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Config) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateConfigResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
-		panic(fmt.Errorf("field threshold of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
-	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
-		panic(fmt.Errorf("field quorum of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
-	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
-		panic(fmt.Errorf("field voting_period of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
-	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
-		panic(fmt.Errorf("field revote of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
-	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
-		panic(fmt.Errorf("field early_execution of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Config) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateConfigResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
-		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Config) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateConfigResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.Config", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5187,7 +5019,7 @@ func (x *fastReflection_Config) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Config) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateConfigResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5198,7 +5030,7 @@ func (x *fastReflection_Config) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Config) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateConfigResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5210,7 +5042,7 @@ func (x *fastReflection_Config) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Config) IsValid() bool {
+func (x *fastReflection_MsgUpdateConfigResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5220,9 +5052,9 @@ func (x *fastReflection_Config) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateConfigResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Config)
+		x := input.Message.Interface().(*MsgUpdateConfigResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5234,21 +5066,6 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Threshold != 0 {
-			n += 1 + runtime.Sov(uint64(x.Threshold))
-		}
-		if x.Quorum != 0 {
-			n += 1 + runtime.Sov(uint64(x.Quorum))
-		}
-		if x.VotingPeriod != 0 {
-			n += 1 + runtime.Sov(uint64(x.VotingPeriod))
-		}
-		if x.Revote {
-			n += 2
-		}
-		if x.EarlyExecution {
-			n += 2
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5259,7 +5076,7 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Config)
+		x := input.Message.Interface().(*MsgUpdateConfigResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5278,41 +5095,6 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.EarlyExecution {
-			i--
-			if x.EarlyExecution {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.Revote {
-			i--
-			if x.Revote {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.VotingPeriod != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.VotingPeriod))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.Quorum != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quorum))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.Threshold != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5324,7 +5106,7 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Config)
+		x := input.Message.Interface().(*MsgUpdateConfigResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5356,123 +5138,26 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Config: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateConfigResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-				}
-				x.Threshold = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Threshold |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
-				}
-				x.Quorum = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Quorum |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.VotingPeriod = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.VotingPeriod |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revote", wireType)
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Revote = bool(v != 0)
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarlyExecution", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.EarlyExecution = bool(v != 0)
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -5494,85 +5179,28 @@ func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Proposal_3_list)(nil)
-
-type _Proposal_3_list struct {
-	list *[]*anypb.Any
-}
-
-func (x *_Proposal_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Proposal_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Proposal_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Proposal_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Proposal_3_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Proposal_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Proposal_3_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Proposal_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Proposal                   protoreflect.MessageDescriptor
-	fd_Proposal_title             protoreflect.FieldDescriptor
-	fd_Proposal_summary           protoreflect.FieldDescriptor
-	fd_Proposal_messages          protoreflect.FieldDescriptor
-	fd_Proposal_voting_period_end protoreflect.FieldDescriptor
-	fd_Proposal_status            protoreflect.FieldDescriptor
+	md_Member         protoreflect.MessageDescriptor
+	fd_Member_address protoreflect.FieldDescriptor
+	fd_Member_weight  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_Proposal = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("Proposal")
-	fd_Proposal_title = md_Proposal.Fields().ByName("title")
-	fd_Proposal_summary = md_Proposal.Fields().ByName("summary")
-	fd_Proposal_messages = md_Proposal.Fields().ByName("messages")
-	fd_Proposal_voting_period_end = md_Proposal.Fields().ByName("voting_period_end")
-	fd_Proposal_status = md_Proposal.Fields().ByName("status")
+	md_Member = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("Member")
+	fd_Member_address = md_Member.Fields().ByName("address")
+	fd_Member_weight = md_Member.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
+var _ protoreflect.Message = (*fastReflection_Member)(nil)
 
-type fastReflection_Proposal Proposal
+type fastReflection_Member Member
 
-func (x *Proposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Proposal)(x)
+func (x *Member) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Member)(x)
 }
 
-func (x *Proposal) slowProtoReflect() protoreflect.Message {
+func (x *Member) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5584,43 +5212,43 @@ func (x *Proposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Proposal_messageType fastReflection_Proposal_messageType
-var _ protoreflect.MessageType = fastReflection_Proposal_messageType{}
+var _fastReflection_Member_messageType fastReflection_Member_messageType
+var _ protoreflect.MessageType = fastReflection_Member_messageType{}
 
-type fastReflection_Proposal_messageType struct{}
+type fastReflection_Member_messageType struct{}
 
-func (x fastReflection_Proposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Proposal)(nil)
+func (x fastReflection_Member_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Member)(nil)
 }
-func (x fastReflection_Proposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_Proposal)
+func (x fastReflection_Member_messageType) New() protoreflect.Message {
+	return new(fastReflection_Member)
 }
-func (x fastReflection_Proposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Proposal
+func (x fastReflection_Member_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Member
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Proposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_Proposal
+func (x *fastReflection_Member) Descriptor() protoreflect.MessageDescriptor {
+	return md_Member
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Proposal) Type() protoreflect.MessageType {
-	return _fastReflection_Proposal_messageType
+func (x *fastReflection_Member) Type() protoreflect.MessageType {
+	return _fastReflection_Member_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Proposal) New() protoreflect.Message {
-	return new(fastReflection_Proposal)
+func (x *fastReflection_Member) New() protoreflect.Message {
+	return new(fastReflection_Member)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
-	return (*Proposal)(x)
+func (x *fastReflection_Member) Interface() protoreflect.ProtoMessage {
+	return (*Member)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5628,34 +5256,16 @@ func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Title != "" {
-		value := protoreflect.ValueOfString(x.Title)
-		if !f(fd_Proposal_title, value) {
-			return
-		}
-	}
-	if x.Summary != "" {
-		value := protoreflect.ValueOfString(x.Summary)
-		if !f(fd_Proposal_summary, value) {
-			return
-		}
-	}
-	if len(x.Messages) != 0 {
-		value := protoreflect.ValueOfList(&_Proposal_3_list{list: &x.Messages})
-		if !f(fd_Proposal_messages, value) {
-			return
-		}
-	}
-	if x.VotingPeriodEnd != int64(0) {
-		value := protoreflect.ValueOfInt64(x.VotingPeriodEnd)
-		if !f(fd_Proposal_voting_period_end, value) {
+func (x *fastReflection_Member) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Member_address, value) {
 			return
 		}
 	}
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_Proposal_status, value) {
+	if x.Weight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Weight)
+		if !f(fd_Member_weight, value) {
 			return
 		}
 	}
@@ -5672,23 +5282,17 @@ func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Member) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
-		return x.Title != ""
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
-		return x.Summary != ""
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
-		return len(x.Messages) != 0
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
-		return x.VotingPeriodEnd != int64(0)
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
-		return x.Status != 0
+	case "cosmos.accounts.defaults.multisig.v1.Member.address":
+		return x.Address != ""
+	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
+		return x.Weight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5698,23 +5302,17 @@ func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Member) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
-		x.Title = ""
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
-		x.Summary = ""
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
-		x.Messages = nil
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
-		x.VotingPeriodEnd = int64(0)
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
-		x.Status = 0
+	case "cosmos.accounts.defaults.multisig.v1.Member.address":
+		x.Address = ""
+	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
+		x.Weight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5724,31 +5322,19 @@ func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Member) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
-		value := x.Title
-		return protoreflect.ValueOfString(value)
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
-		value := x.Summary
+	case "cosmos.accounts.defaults.multisig.v1.Member.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
-		if len(x.Messages) == 0 {
-			return protoreflect.ValueOfList(&_Proposal_3_list{})
-		}
-		listValue := &_Proposal_3_list{list: &x.Messages}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
-		value := x.VotingPeriodEnd
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
+		value := x.Weight
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5762,25 +5348,17 @@ func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Member) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
-		x.Title = value.Interface().(string)
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
-		x.Summary = value.Interface().(string)
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
-		lv := value.List()
-		clv := lv.(*_Proposal_3_list)
-		x.Messages = *clv.list
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
-		x.VotingPeriodEnd = value.Int()
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
-		x.Status = (ProposalStatus)(value.Enum())
+	case "cosmos.accounts.defaults.multisig.v1.Member.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
+		x.Weight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5794,61 +5372,44 @@ func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Member) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
-		if x.Messages == nil {
-			x.Messages = []*anypb.Any{}
-		}
-		value := &_Proposal_3_list{list: &x.Messages}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
-		panic(fmt.Errorf("field title of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
-		panic(fmt.Errorf("field summary of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
-		panic(fmt.Errorf("field voting_period_end of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
-		panic(fmt.Errorf("field status of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Member.address":
+		panic(fmt.Errorf("field address of message cosmos.accounts.defaults.multisig.v1.Member is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
+		panic(fmt.Errorf("field weight of message cosmos.accounts.defaults.multisig.v1.Member is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Member) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
-		return protoreflect.ValueOfString("")
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
+	case "cosmos.accounts.defaults.multisig.v1.Member.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_Proposal_3_list{list: &list})
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
-		return protoreflect.ValueOfEnum(0)
+	case "cosmos.accounts.defaults.multisig.v1.Member.weight":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Member"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Member does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Member) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.Proposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.Member", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5856,7 +5417,7 @@ func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Member) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5867,7 +5428,7 @@ func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Member) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5879,7 +5440,7 @@ func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Proposal) IsValid() bool {
+func (x *fastReflection_Member) IsValid() bool {
 	return x != nil
 }
 
@@ -5889,9 +5450,9 @@ func (x *fastReflection_Proposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Member) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*Member)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5903,25 +5464,12 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Title)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Summary)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Messages) > 0 {
-			for _, e := range x.Messages {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.VotingPeriodEnd != 0 {
-			n += 1 + runtime.Sov(uint64(x.VotingPeriodEnd))
-		}
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
+		if x.Weight != 0 {
+			n += 1 + runtime.Sov(uint64(x.Weight))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5933,7 +5481,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*Member)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5952,43 +5500,15 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.VotingPeriodEnd != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.VotingPeriodEnd))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.Messages) > 0 {
-			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Messages[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Summary) > 0 {
-			i -= len(x.Summary)
-			copy(dAtA[i:], x.Summary)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
+		if x.Weight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Weight))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Title) > 0 {
-			i -= len(x.Title)
-			copy(dAtA[i:], x.Title)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6003,7 +5523,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*Member)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6035,15 +5555,15 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Member: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6071,13 +5591,13 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Title = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 				}
-				var stringLen uint64
+				x.Weight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6087,82 +5607,2447 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Weight |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Summary = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Config_8_list)(nil)
+
+type _Config_8_list struct {
+	list *[]string
+}
+
+func (x *_Config_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Config_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Config_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Config_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Config_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Config at list field Vetoers as it is not of Message kind"))
+}
+
+func (x *_Config_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Config_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Config_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Config                 protoreflect.MessageDescriptor
+	fd_Config_threshold       protoreflect.FieldDescriptor
+	fd_Config_quorum          protoreflect.FieldDescriptor
+	fd_Config_voting_period   protoreflect.FieldDescriptor
+	fd_Config_revote          protoreflect.FieldDescriptor
+	fd_Config_early_execution protoreflect.FieldDescriptor
+	fd_Config_execution_delay protoreflect.FieldDescriptor
+	fd_Config_expiry          protoreflect.FieldDescriptor
+	fd_Config_vetoers         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
+	md_Config = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("Config")
+	fd_Config_threshold = md_Config.Fields().ByName("threshold")
+	fd_Config_quorum = md_Config.Fields().ByName("quorum")
+	fd_Config_voting_period = md_Config.Fields().ByName("voting_period")
+	fd_Config_revote = md_Config.Fields().ByName("revote")
+	fd_Config_early_execution = md_Config.Fields().ByName("early_execution")
+	fd_Config_execution_delay = md_Config.Fields().ByName("execution_delay")
+	fd_Config_expiry = md_Config.Fields().ByName("expiry")
+	fd_Config_vetoers = md_Config.Fields().ByName("vetoers")
+}
+
+var _ protoreflect.Message = (*fastReflection_Config)(nil)
+
+type fastReflection_Config Config
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Config)(x)
+}
+
+func (x *Config) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Config_messageType fastReflection_Config_messageType
+var _ protoreflect.MessageType = fastReflection_Config_messageType{}
+
+type fastReflection_Config_messageType struct{}
+
+func (x fastReflection_Config_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Config)(nil)
+}
+func (x fastReflection_Config_messageType) New() protoreflect.Message {
+	return new(fastReflection_Config)
+}
+func (x fastReflection_Config_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Config
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Config) Descriptor() protoreflect.MessageDescriptor {
+	return md_Config
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Config) Type() protoreflect.MessageType {
+	return _fastReflection_Config_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Config) New() protoreflect.Message {
+	return new(fastReflection_Config)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Config) Interface() protoreflect.ProtoMessage {
+	return (*Config)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Config) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Threshold != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Threshold)
+		if !f(fd_Config_threshold, value) {
+			return
+		}
+	}
+	if x.Quorum != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Quorum)
+		if !f(fd_Config_quorum, value) {
+			return
+		}
+	}
+	if x.VotingPeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.VotingPeriod)
+		if !f(fd_Config_voting_period, value) {
+			return
+		}
+	}
+	if x.Revote != false {
+		value := protoreflect.ValueOfBool(x.Revote)
+		if !f(fd_Config_revote, value) {
+			return
+		}
+	}
+	if x.EarlyExecution != false {
+		value := protoreflect.ValueOfBool(x.EarlyExecution)
+		if !f(fd_Config_early_execution, value) {
+			return
+		}
+	}
+	if x.ExecutionDelay != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecutionDelay)
+		if !f(fd_Config_execution_delay, value) {
+			return
+		}
+	}
+	if x.Expiry != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Expiry)
+		if !f(fd_Config_expiry, value) {
+			return
+		}
+	}
+	if len(x.Vetoers) != 0 {
+		value := protoreflect.ValueOfList(&_Config_8_list{list: &x.Vetoers})
+		if !f(fd_Config_vetoers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Config) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
+		return x.Threshold != int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
+		return x.Quorum != int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
+		return x.VotingPeriod != int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
+		return x.Revote != false
+	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
+		return x.EarlyExecution != false
+	case "cosmos.accounts.defaults.multisig.v1.Config.execution_delay":
+		return x.ExecutionDelay != int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Config.expiry":
+		return x.Expiry != int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Config.vetoers":
+		return len(x.Vetoers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Config) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
+		x.Threshold = int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
+		x.Quorum = int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
+		x.VotingPeriod = int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
+		x.Revote = false
+	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
+		x.EarlyExecution = false
+	case "cosmos.accounts.defaults.multisig.v1.Config.execution_delay":
+		x.ExecutionDelay = int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Config.expiry":
+		x.Expiry = int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Config.vetoers":
+		x.Vetoers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Config) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
+		value := x.Quorum
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
+		value := x.VotingPeriod
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
+		value := x.Revote
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
+		value := x.EarlyExecution
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.accounts.defaults.multisig.v1.Config.execution_delay":
+		value := x.ExecutionDelay
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.accounts.defaults.multisig.v1.Config.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.accounts.defaults.multisig.v1.Config.vetoers":
+		if len(x.Vetoers) == 0 {
+			return protoreflect.ValueOfList(&_Config_8_list{})
+		}
+		listValue := &_Config_8_list{list: &x.Vetoers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Config) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
+		x.Threshold = value.Int()
+	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
+		x.Quorum = value.Int()
+	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
+		x.VotingPeriod = value.Int()
+	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
+		x.Revote = value.Bool()
+	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
+		x.EarlyExecution = value.Bool()
+	case "cosmos.accounts.defaults.multisig.v1.Config.execution_delay":
+		x.ExecutionDelay = value.Int()
+	case "cosmos.accounts.defaults.multisig.v1.Config.expiry":
+		x.Expiry = value.Int()
+	case "cosmos.accounts.defaults.multisig.v1.Config.vetoers":
+		lv := value.List()
+		clv := lv.(*_Config_8_list)
+		x.Vetoers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Config) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Config.vetoers":
+		if x.Vetoers == nil {
+			x.Vetoers = []string{}
+		}
+		value := &_Config_8_list{list: &x.Vetoers}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
+		panic(fmt.Errorf("field threshold of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
+		panic(fmt.Errorf("field quorum of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
+		panic(fmt.Errorf("field voting_period of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
+		panic(fmt.Errorf("field revote of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
+		panic(fmt.Errorf("field early_execution of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Config.execution_delay":
+		panic(fmt.Errorf("field execution_delay of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Config.expiry":
+		panic(fmt.Errorf("field expiry of message cosmos.accounts.defaults.multisig.v1.Config is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Config) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Config.threshold":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.accounts.defaults.multisig.v1.Config.quorum":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.accounts.defaults.multisig.v1.Config.voting_period":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.accounts.defaults.multisig.v1.Config.revote":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.accounts.defaults.multisig.v1.Config.early_execution":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.accounts.defaults.multisig.v1.Config.execution_delay":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.accounts.defaults.multisig.v1.Config.expiry":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.accounts.defaults.multisig.v1.Config.vetoers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Config_8_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Config"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Config does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Config) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.Config", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Config) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Config) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Config) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Config) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Config)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if x.Quorum != 0 {
+			n += 1 + runtime.Sov(uint64(x.Quorum))
+		}
+		if x.VotingPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.VotingPeriod))
+		}
+		if x.Revote {
+			n += 2
+		}
+		if x.EarlyExecution {
+			n += 2
+		}
+		if x.ExecutionDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionDelay))
+		}
+		if x.Expiry != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiry))
+		}
+		if len(x.Vetoers) > 0 {
+			for _, s := range x.Vetoers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Config)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Vetoers) > 0 {
+			for iNdEx := len(x.Vetoers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Vetoers[iNdEx])
+				copy(dAtA[i:], x.Vetoers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Vetoers[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.Expiry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ExecutionDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionDelay))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.EarlyExecution {
+			i--
+			if x.EarlyExecution {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Revote {
+			i--
+			if x.Revote {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.VotingPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VotingPeriod))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Quorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quorum))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Config)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Config: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				}
+				x.Quorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Quorum |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				}
+				x.VotingPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VotingPeriod |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revote", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Revote = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarlyExecution", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EarlyExecution = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+				}
+				x.ExecutionDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionDelay |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				x.Expiry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiry |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vetoers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Vetoers = append(x.Vetoers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Proposal_3_list)(nil)
+
+type _Proposal_3_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_Proposal_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Proposal_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Proposal                   protoreflect.MessageDescriptor
+	fd_Proposal_title             protoreflect.FieldDescriptor
+	fd_Proposal_summary           protoreflect.FieldDescriptor
+	fd_Proposal_messages          protoreflect.FieldDescriptor
+	fd_Proposal_voting_period_end protoreflect.FieldDescriptor
+	fd_Proposal_status            protoreflect.FieldDescriptor
+	fd_Proposal_executable_at     protoreflect.FieldDescriptor
+	fd_Proposal_expires_at        protoreflect.FieldDescriptor
+	fd_Proposal_id                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
+	md_Proposal = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("Proposal")
+	fd_Proposal_title = md_Proposal.Fields().ByName("title")
+	fd_Proposal_summary = md_Proposal.Fields().ByName("summary")
+	fd_Proposal_messages = md_Proposal.Fields().ByName("messages")
+	fd_Proposal_voting_period_end = md_Proposal.Fields().ByName("voting_period_end")
+	fd_Proposal_status = md_Proposal.Fields().ByName("status")
+	fd_Proposal_executable_at = md_Proposal.Fields().ByName("executable_at")
+	fd_Proposal_expires_at = md_Proposal.Fields().ByName("expires_at")
+	fd_Proposal_id = md_Proposal.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
+
+type fastReflection_Proposal Proposal
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Proposal)(x)
+}
+
+func (x *Proposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Proposal_messageType fastReflection_Proposal_messageType
+var _ protoreflect.MessageType = fastReflection_Proposal_messageType{}
+
+type fastReflection_Proposal_messageType struct{}
+
+func (x fastReflection_Proposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Proposal)(nil)
+}
+func (x fastReflection_Proposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_Proposal)
+}
+func (x fastReflection_Proposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Proposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Proposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_Proposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Proposal) Type() protoreflect.MessageType {
+	return _fastReflection_Proposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Proposal) New() protoreflect.Message {
+	return new(fastReflection_Proposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
+	return (*Proposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_Proposal_title, value) {
+			return
+		}
+	}
+	if x.Summary != "" {
+		value := protoreflect.ValueOfString(x.Summary)
+		if !f(fd_Proposal_summary, value) {
+			return
+		}
+	}
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_3_list{list: &x.Messages})
+		if !f(fd_Proposal_messages, value) {
+			return
+		}
+	}
+	if x.VotingPeriodEnd != int64(0) {
+		value := protoreflect.ValueOfInt64(x.VotingPeriodEnd)
+		if !f(fd_Proposal_voting_period_end, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Proposal_status, value) {
+			return
+		}
+	}
+	if x.ExecutableAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecutableAt)
+		if !f(fd_Proposal_executable_at, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAt)
+		if !f(fd_Proposal_expires_at, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
+		return x.Title != ""
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
+		return x.Summary != ""
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
+		return len(x.Messages) != 0
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
+		return x.VotingPeriodEnd != int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
+		return x.Status != 0
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.executable_at":
+		return x.ExecutableAt != int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.expires_at":
+		return x.ExpiresAt != int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
+		x.Title = ""
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
+		x.Summary = ""
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
+		x.Messages = nil
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
+		x.VotingPeriodEnd = int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
+		x.Status = 0
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.executable_at":
+		x.ExecutableAt = int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.expires_at":
+		x.ExpiresAt = int64(0)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
+		value := x.Summary
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_3_list{})
+		}
+		listValue := &_Proposal_3_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
+		value := x.VotingPeriodEnd
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.executable_at":
+		value := x.ExecutableAt
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
+		x.Title = value.Interface().(string)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
+		x.Summary = value.Interface().(string)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
+		lv := value.List()
+		clv := lv.(*_Proposal_3_list)
+		x.Messages = *clv.list
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
+		x.VotingPeriodEnd = value.Int()
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
+		x.Status = (ProposalStatus)(value.Enum())
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.executable_at":
+		x.ExecutableAt = value.Int()
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.expires_at":
+		x.ExpiresAt = value.Int()
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
+		}
+		value := &_Proposal_3_list{list: &x.Messages}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
+		panic(fmt.Errorf("field title of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
+		panic(fmt.Errorf("field summary of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
+		panic(fmt.Errorf("field voting_period_end of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
+		panic(fmt.Errorf("field status of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.executable_at":
+		panic(fmt.Errorf("field executable_at of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.expires_at":
+		panic(fmt.Errorf("field expires_at of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.id":
+		panic(fmt.Errorf("field id of message cosmos.accounts.defaults.multisig.v1.Proposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.title":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.summary":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_Proposal_3_list{list: &list})
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.voting_period_end":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.status":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.executable_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.expires_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.accounts.defaults.multisig.v1.Proposal.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.Proposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Proposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Summary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.VotingPeriodEnd != 0 {
+			n += 1 + runtime.Sov(uint64(x.VotingPeriodEnd))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.ExecutableAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutableAt))
+		}
+		if x.ExpiresAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAt))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ExpiresAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAt))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ExecutableAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutableAt))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.VotingPeriodEnd != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VotingPeriodEnd))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Summary) > 0 {
+			i -= len(x.Summary)
+			copy(dAtA[i:], x.Summary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Summary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodEnd", wireType)
+				}
+				x.VotingPeriodEnd = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VotingPeriodEnd |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ProposalStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutableAt", wireType)
+				}
+				x.ExecutableAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutableAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				x.ExpiresAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySequence protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
+	md_QuerySequence = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QuerySequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySequence)(nil)
+
+type fastReflection_QuerySequence QuerySequence
+
+func (x *QuerySequence) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySequence)(x)
+}
+
+func (x *QuerySequence) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySequence_messageType fastReflection_QuerySequence_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySequence_messageType{}
+
+type fastReflection_QuerySequence_messageType struct{}
+
+func (x fastReflection_QuerySequence_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySequence)(nil)
+}
+func (x fastReflection_QuerySequence_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySequence)
+}
+func (x fastReflection_QuerySequence_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySequence
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySequence) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySequence
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySequence) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySequence_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySequence) New() protoreflect.Message {
+	return new(fastReflection_QuerySequence)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySequence) Interface() protoreflect.ProtoMessage {
+	return (*QuerySequence)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySequence) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySequence) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySequence) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySequence) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySequence) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySequence) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySequence) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySequence) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QuerySequence", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySequence) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySequence) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySequence) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySequence) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySequence)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySequence)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySequence)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySequence: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySequenceResponse          protoreflect.MessageDescriptor
+	fd_QuerySequenceResponse_sequence protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
+	md_QuerySequenceResponse = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QuerySequenceResponse")
+	fd_QuerySequenceResponse_sequence = md_QuerySequenceResponse.Fields().ByName("sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySequenceResponse)(nil)
+
+type fastReflection_QuerySequenceResponse QuerySequenceResponse
+
+func (x *QuerySequenceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySequenceResponse)(x)
+}
+
+func (x *QuerySequenceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySequenceResponse_messageType fastReflection_QuerySequenceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySequenceResponse_messageType{}
+
+type fastReflection_QuerySequenceResponse_messageType struct{}
+
+func (x fastReflection_QuerySequenceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySequenceResponse)(nil)
+}
+func (x fastReflection_QuerySequenceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySequenceResponse)
+}
+func (x fastReflection_QuerySequenceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySequenceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySequenceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySequenceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySequenceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySequenceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySequenceResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySequenceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySequenceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySequenceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySequenceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_QuerySequenceResponse_sequence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySequenceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
+		return x.Sequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySequenceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
+		x.Sequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySequenceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySequenceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
+		x.Sequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySequenceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
+		panic(fmt.Errorf("field sequence of message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySequenceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySequenceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySequenceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySequenceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySequenceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySequenceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySequenceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySequenceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySequenceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Messages = append(x.Messages, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodEnd", wireType)
-				}
-				x.VotingPeriodEnd = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.VotingPeriodEnd |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 5:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySequenceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 				}
-				x.Status = 0
+				x.Sequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6172,7 +8057,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Status |= ProposalStatus(b&0x7F) << shift
+					x.Sequence |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -6213,24 +8098,24 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QuerySequence protoreflect.MessageDescriptor
+	md_QueryConfig protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_QuerySequence = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QuerySequence")
+	md_QueryConfig = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QueryConfig")
 }
 
-var _ protoreflect.Message = (*fastReflection_QuerySequence)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryConfig)(nil)
 
-type fastReflection_QuerySequence QuerySequence
+type fastReflection_QueryConfig QueryConfig
 
-func (x *QuerySequence) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuerySequence)(x)
+func (x *QueryConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryConfig)(x)
 }
 
-func (x *QuerySequence) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[13]
+func (x *QueryConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6241,43 +8126,43 @@ func (x *QuerySequence) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuerySequence_messageType fastReflection_QuerySequence_messageType
-var _ protoreflect.MessageType = fastReflection_QuerySequence_messageType{}
+var _fastReflection_QueryConfig_messageType fastReflection_QueryConfig_messageType
+var _ protoreflect.MessageType = fastReflection_QueryConfig_messageType{}
 
-type fastReflection_QuerySequence_messageType struct{}
+type fastReflection_QueryConfig_messageType struct{}
 
-func (x fastReflection_QuerySequence_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuerySequence)(nil)
+func (x fastReflection_QueryConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryConfig)(nil)
 }
-func (x fastReflection_QuerySequence_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuerySequence)
+func (x fastReflection_QueryConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryConfig)
 }
-func (x fastReflection_QuerySequence_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySequence
+func (x fastReflection_QueryConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryConfig
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuerySequence) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySequence
+func (x *fastReflection_QueryConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryConfig
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuerySequence) Type() protoreflect.MessageType {
-	return _fastReflection_QuerySequence_messageType
+func (x *fastReflection_QueryConfig) Type() protoreflect.MessageType {
+	return _fastReflection_QueryConfig_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuerySequence) New() protoreflect.Message {
-	return new(fastReflection_QuerySequence)
+func (x *fastReflection_QueryConfig) New() protoreflect.Message {
+	return new(fastReflection_QueryConfig)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuerySequence) Interface() protoreflect.ProtoMessage {
-	return (*QuerySequence)(x)
+func (x *fastReflection_QueryConfig) Interface() protoreflect.ProtoMessage {
+	return (*QueryConfig)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6285,7 +8170,7 @@ func (x *fastReflection_QuerySequence) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuerySequence) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6299,13 +8184,13 @@ func (x *fastReflection_QuerySequence) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuerySequence) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryConfig) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6315,13 +8200,13 @@ func (x *fastReflection_QuerySequence) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySequence) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryConfig) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6331,13 +8216,13 @@ func (x *fastReflection_QuerySequence) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuerySequence) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6351,13 +8236,13 @@ func (x *fastReflection_QuerySequence) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySequence) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6371,36 +8256,36 @@ func (x *fastReflection_QuerySequence) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySequence) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QuerySequence) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequence does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QuerySequence) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QuerySequence", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QueryConfig", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6408,7 +8293,7 @@ func (x *fastReflection_QuerySequence) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QuerySequence) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryConfig) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6419,7 +8304,7 @@ func (x *fastReflection_QuerySequence) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySequence) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryConfig) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6431,7 +8316,7 @@ func (x *fastReflection_QuerySequence) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QuerySequence) IsValid() bool {
+func (x *fastReflection_QueryConfig) IsValid() bool {
 	return x != nil
 }
 
@@ -6441,9 +8326,9 @@ func (x *fastReflection_QuerySequence) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QuerySequence) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryConfig) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QuerySequence)
+		x := input.Message.Interface().(*QueryConfig)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6465,7 +8350,7 @@ func (x *fastReflection_QuerySequence) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySequence)
+		x := input.Message.Interface().(*QueryConfig)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6495,7 +8380,7 @@ func (x *fastReflection_QuerySequence) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySequence)
+		x := input.Message.Interface().(*QueryConfig)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6527,10 +8412,10 @@ func (x *fastReflection_QuerySequence) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySequence: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConfig: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySequence: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -6568,27 +8453,80 @@ func (x *fastReflection_QuerySequence) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_QueryConfigResponse_1_list)(nil)
+
+type _QueryConfigResponse_1_list struct {
+	list *[]*Member
+}
+
+func (x *_QueryConfigResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryConfigResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryConfigResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Member)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryConfigResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Member)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryConfigResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Member)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryConfigResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryConfigResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Member)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryConfigResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QuerySequenceResponse          protoreflect.MessageDescriptor
-	fd_QuerySequenceResponse_sequence protoreflect.FieldDescriptor
+	md_QueryConfigResponse         protoreflect.MessageDescriptor
+	fd_QueryConfigResponse_members protoreflect.FieldDescriptor
+	fd_QueryConfigResponse_Config  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_QuerySequenceResponse = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QuerySequenceResponse")
-	fd_QuerySequenceResponse_sequence = md_QuerySequenceResponse.Fields().ByName("sequence")
+	md_QueryConfigResponse = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QueryConfigResponse")
+	fd_QueryConfigResponse_members = md_QueryConfigResponse.Fields().ByName("members")
+	fd_QueryConfigResponse_Config = md_QueryConfigResponse.Fields().ByName("Config")
 }
 
-var _ protoreflect.Message = (*fastReflection_QuerySequenceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryConfigResponse)(nil)
 
-type fastReflection_QuerySequenceResponse QuerySequenceResponse
+type fastReflection_QueryConfigResponse QueryConfigResponse
 
-func (x *QuerySequenceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuerySequenceResponse)(x)
+func (x *QueryConfigResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryConfigResponse)(x)
 }
 
-func (x *QuerySequenceResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[14]
+func (x *QueryConfigResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6599,43 +8537,43 @@ func (x *QuerySequenceResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuerySequenceResponse_messageType fastReflection_QuerySequenceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QuerySequenceResponse_messageType{}
+var _fastReflection_QueryConfigResponse_messageType fastReflection_QueryConfigResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryConfigResponse_messageType{}
 
-type fastReflection_QuerySequenceResponse_messageType struct{}
+type fastReflection_QueryConfigResponse_messageType struct{}
 
-func (x fastReflection_QuerySequenceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuerySequenceResponse)(nil)
+func (x fastReflection_QueryConfigResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryConfigResponse)(nil)
 }
-func (x fastReflection_QuerySequenceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuerySequenceResponse)
+func (x fastReflection_QueryConfigResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryConfigResponse)
 }
-func (x fastReflection_QuerySequenceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySequenceResponse
+func (x fastReflection_QueryConfigResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryConfigResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuerySequenceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySequenceResponse
+func (x *fastReflection_QueryConfigResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryConfigResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuerySequenceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QuerySequenceResponse_messageType
+func (x *fastReflection_QueryConfigResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryConfigResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuerySequenceResponse) New() protoreflect.Message {
-	return new(fastReflection_QuerySequenceResponse)
+func (x *fastReflection_QueryConfigResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryConfigResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuerySequenceResponse) Interface() protoreflect.ProtoMessage {
-	return (*QuerySequenceResponse)(x)
+func (x *fastReflection_QueryConfigResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryConfigResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6643,10 +8581,16 @@ func (x *fastReflection_QuerySequenceResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuerySequenceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_QuerySequenceResponse_sequence, value) {
+func (x *fastReflection_QueryConfigResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Members) != 0 {
+		value := protoreflect.ValueOfList(&_QueryConfigResponse_1_list{list: &x.Members})
+		if !f(fd_QueryConfigResponse_members, value) {
+			return
+		}
+	}
+	if x.Config != nil {
+		value := protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+		if !f(fd_QueryConfigResponse_Config, value) {
 			return
 		}
 	}
@@ -6663,15 +8607,17 @@ func (x *fastReflection_QuerySequenceResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuerySequenceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryConfigResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
-		return x.Sequence != uint64(0)
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
+		return len(x.Members) != 0
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
+		return x.Config != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6681,15 +8627,17 @@ func (x *fastReflection_QuerySequenceResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySequenceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryConfigResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
-		x.Sequence = uint64(0)
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
+		x.Members = nil
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
+		x.Config = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6699,16 +8647,22 @@ func (x *fastReflection_QuerySequenceResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuerySequenceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConfigResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
-		value := x.Sequence
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
+		if len(x.Members) == 0 {
+			return protoreflect.ValueOfList(&_QueryConfigResponse_1_list{})
+		}
+		listValue := &_QueryConfigResponse_1_list{list: &x.Members}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
+		value := x.Config
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6722,15 +8676,19 @@ func (x *fastReflection_QuerySequenceResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySequenceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryConfigResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
-		x.Sequence = value.Uint()
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
+		lv := value.List()
+		clv := lv.(*_QueryConfigResponse_1_list)
+		x.Members = *clv.list
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
+		x.Config = value.Message().Interface().(*Config)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6744,40 +8702,53 @@ func (x *fastReflection_QuerySequenceResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySequenceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConfigResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
-		panic(fmt.Errorf("field sequence of message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
+		if x.Members == nil {
+			x.Members = []*Member{}
+		}
+		value := &_QueryConfigResponse_1_list{list: &x.Members}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
+		if x.Config == nil {
+			x.Config = new(Config)
+		}
+		return protoreflect.ValueOfMessage(x.Config.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QuerySequenceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryConfigResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse.sequence":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
+		list := []*Member{}
+		return protoreflect.ValueOfList(&_QueryConfigResponse_1_list{list: &list})
+	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
+		m := new(Config)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QuerySequenceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryConfigResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QueryConfigResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6785,7 +8756,7 @@ func (x *fastReflection_QuerySequenceResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QuerySequenceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryConfigResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6796,7 +8767,7 @@ func (x *fastReflection_QuerySequenceResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySequenceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryConfigResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6808,7 +8779,7 @@ func (x *fastReflection_QuerySequenceResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QuerySequenceResponse) IsValid() bool {
+func (x *fastReflection_QueryConfigResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6818,9 +8789,9 @@ func (x *fastReflection_QuerySequenceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QuerySequenceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryConfigResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QuerySequenceResponse)
+		x := input.Message.Interface().(*QueryConfigResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6832,8 +8803,15 @@ func (x *fastReflection_QuerySequenceResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
+		if len(x.Members) > 0 {
+			for _, e := range x.Members {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Config != nil {
+			l = options.Size(x.Config)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6845,7 +8823,7 @@ func (x *fastReflection_QuerySequenceResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySequenceResponse)
+		x := input.Message.Interface().(*QueryConfigResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6864,10 +8842,35 @@ func (x *fastReflection_QuerySequenceResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+		if x.Config != nil {
+			encoded, err := options.Marshal(x.Config)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x12
+		}
+		if len(x.Members) > 0 {
+			for iNdEx := len(x.Members) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Members[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6880,7 +8883,7 @@ func (x *fastReflection_QuerySequenceResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySequenceResponse)
+		x := input.Message.Interface().(*QueryConfigResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6912,17 +8915,17 @@ func (x *fastReflection_QuerySequenceResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySequenceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 				}
-				x.Sequence = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6932,11 +8935,62 @@ func (x *fastReflection_QuerySequenceResponse) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Members = append(x.Members, &Member{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Members[len(x.Members)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Config == nil {
+					x.Config = &Config{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6973,24 +9027,26 @@ func (x *fastReflection_QuerySequenceResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryConfig protoreflect.MessageDescriptor
+	md_QueryProposal             protoreflect.MessageDescriptor
+	fd_QueryProposal_proposal_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_QueryConfig = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QueryConfig")
+	md_QueryProposal = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QueryProposal")
+	fd_QueryProposal_proposal_id = md_QueryProposal.Fields().ByName("proposal_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProposal)(nil)
 
-type fastReflection_QueryConfig QueryConfig
+type fastReflection_QueryProposal QueryProposal
 
-func (x *QueryConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryConfig)(x)
+func (x *QueryProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposal)(x)
 }
 
-func (x *QueryConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[15]
+func (x *QueryProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7001,43 +9057,43 @@ func (x *QueryConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryConfig_messageType fastReflection_QueryConfig_messageType
-var _ protoreflect.MessageType = fastReflection_QueryConfig_messageType{}
+var _fastReflection_QueryProposal_messageType fastReflection_QueryProposal_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposal_messageType{}
 
-type fastReflection_QueryConfig_messageType struct{}
+type fastReflection_QueryProposal_messageType struct{}
 
-func (x fastReflection_QueryConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryConfig)(nil)
+func (x fastReflection_QueryProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposal)(nil)
 }
-func (x fastReflection_QueryConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryConfig)
+func (x fastReflection_QueryProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposal)
 }
-func (x fastReflection_QueryConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryConfig
+func (x fastReflection_QueryProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryConfig
+func (x *fastReflection_QueryProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryConfig) Type() protoreflect.MessageType {
-	return _fastReflection_QueryConfig_messageType
+func (x *fastReflection_QueryProposal) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryConfig) New() protoreflect.Message {
-	return new(fastReflection_QueryConfig)
+func (x *fastReflection_QueryProposal) New() protoreflect.Message {
+	return new(fastReflection_QueryProposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryConfig) Interface() protoreflect.ProtoMessage {
-	return (*QueryConfig)(x)
+func (x *fastReflection_QueryProposal) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7045,7 +9101,13 @@ func (x *fastReflection_QueryConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryProposal_proposal_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7059,13 +9121,15 @@ func (x *fastReflection_QueryConfig) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
+		return x.ProposalId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7075,13 +9139,15 @@ func (x *fastReflection_QueryConfig) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
+		x.ProposalId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7091,13 +9157,16 @@ func (x *fastReflection_QueryConfig) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7111,13 +9180,15 @@ func (x *fastReflection_QueryConfig) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
+		x.ProposalId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7131,36 +9202,40 @@ func (x *fastReflection_QueryConfig) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.accounts.defaults.multisig.v1.QueryProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QueryConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QueryProposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7168,7 +9243,7 @@ func (x *fastReflection_QueryConfig) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7179,7 +9254,7 @@ func (x *fastReflection_QueryConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7191,7 +9266,7 @@ func (x *fastReflection_QueryConfig) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryConfig) IsValid() bool {
+func (x *fastReflection_QueryProposal) IsValid() bool {
 	return x != nil
 }
 
@@ -7201,9 +9276,9 @@ func (x *fastReflection_QueryConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryConfig)
+		x := input.Message.Interface().(*QueryProposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7215,6 +9290,9 @@ func (x *fastReflection_QueryConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7225,7 +9303,7 @@ func (x *fastReflection_QueryConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryConfig)
+		x := input.Message.Interface().(*QueryProposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7244,6 +9322,11 @@ func (x *fastReflection_QueryConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7255,7 +9338,7 @@ func (x *fastReflection_QueryConfig) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryConfig)
+		x := input.Message.Interface().(*QueryProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7287,12 +9370,31 @@ func (x *fastReflection_QueryConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposal: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7328,80 +9430,27 @@ func (x *fastReflection_QueryConfig) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_QueryConfigResponse_1_list)(nil)
-
-type _QueryConfigResponse_1_list struct {
-	list *[]*Member
-}
-
-func (x *_QueryConfigResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryConfigResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryConfigResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Member)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryConfigResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Member)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryConfigResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Member)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryConfigResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryConfigResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Member)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryConfigResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryConfigResponse         protoreflect.MessageDescriptor
-	fd_QueryConfigResponse_members protoreflect.FieldDescriptor
-	fd_QueryConfigResponse_Config  protoreflect.FieldDescriptor
+	md_QueryProposalResponse          protoreflect.MessageDescriptor
+	fd_QueryProposalResponse_proposal protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_QueryConfigResponse = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QueryConfigResponse")
-	fd_QueryConfigResponse_members = md_QueryConfigResponse.Fields().ByName("members")
-	fd_QueryConfigResponse_Config = md_QueryConfigResponse.Fields().ByName("Config")
+	md_QueryProposalResponse = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QueryProposalResponse")
+	fd_QueryProposalResponse_proposal = md_QueryProposalResponse.Fields().ByName("proposal")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryConfigResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProposalResponse)(nil)
 
-type fastReflection_QueryConfigResponse QueryConfigResponse
+type fastReflection_QueryProposalResponse QueryProposalResponse
 
-func (x *QueryConfigResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryConfigResponse)(x)
+func (x *QueryProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalResponse)(x)
 }
 
-func (x *QueryConfigResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[16]
+func (x *QueryProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7412,43 +9461,43 @@ func (x *QueryConfigResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryConfigResponse_messageType fastReflection_QueryConfigResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryConfigResponse_messageType{}
+var _fastReflection_QueryProposalResponse_messageType fastReflection_QueryProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalResponse_messageType{}
 
-type fastReflection_QueryConfigResponse_messageType struct{}
+type fastReflection_QueryProposalResponse_messageType struct{}
 
-func (x fastReflection_QueryConfigResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryConfigResponse)(nil)
+func (x fastReflection_QueryProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalResponse)(nil)
 }
-func (x fastReflection_QueryConfigResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryConfigResponse)
+func (x fastReflection_QueryProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalResponse)
 }
-func (x fastReflection_QueryConfigResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryConfigResponse
+func (x fastReflection_QueryProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryConfigResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryConfigResponse
+func (x *fastReflection_QueryProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryConfigResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryConfigResponse_messageType
+func (x *fastReflection_QueryProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryConfigResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryConfigResponse)
+func (x *fastReflection_QueryProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryConfigResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryConfigResponse)(x)
+func (x *fastReflection_QueryProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7456,16 +9505,10 @@ func (x *fastReflection_QueryConfigResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryConfigResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Members) != 0 {
-		value := protoreflect.ValueOfList(&_QueryConfigResponse_1_list{list: &x.Members})
-		if !f(fd_QueryConfigResponse_members, value) {
-			return
-		}
-	}
-	if x.Config != nil {
-		value := protoreflect.ValueOfMessage(x.Config.ProtoReflect())
-		if !f(fd_QueryConfigResponse_Config, value) {
+func (x *fastReflection_QueryProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proposal != nil {
+		value := protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
+		if !f(fd_QueryProposalResponse_proposal, value) {
 			return
 		}
 	}
@@ -7482,17 +9525,15 @@ func (x *fastReflection_QueryConfigResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryConfigResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
-		return len(x.Members) != 0
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
-		return x.Config != nil
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
+		return x.Proposal != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7502,17 +9543,15 @@ func (x *fastReflection_QueryConfigResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryConfigResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
-		x.Members = nil
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
-		x.Config = nil
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
+		x.Proposal = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7522,22 +9561,16 @@ func (x *fastReflection_QueryConfigResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryConfigResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
-		if len(x.Members) == 0 {
-			return protoreflect.ValueOfList(&_QueryConfigResponse_1_list{})
-		}
-		listValue := &_QueryConfigResponse_1_list{list: &x.Members}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
-		value := x.Config
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
+		value := x.Proposal
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7551,19 +9584,15 @@ func (x *fastReflection_QueryConfigResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryConfigResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
-		lv := value.List()
-		clv := lv.(*_QueryConfigResponse_1_list)
-		x.Members = *clv.list
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
-		x.Config = value.Message().Interface().(*Config)
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
+		x.Proposal = value.Message().Interface().(*Proposal)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7577,53 +9606,44 @@ func (x *fastReflection_QueryConfigResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryConfigResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
-		if x.Members == nil {
-			x.Members = []*Member{}
-		}
-		value := &_QueryConfigResponse_1_list{list: &x.Members}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
-		if x.Config == nil {
-			x.Config = new(Config)
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
+		if x.Proposal == nil {
+			x.Proposal = new(Proposal)
 		}
-		return protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryConfigResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members":
-		list := []*Member{}
-		return protoreflect.ValueOfList(&_QueryConfigResponse_1_list{list: &list})
-	case "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config":
-		m := new(Config)
+	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
+		m := new(Proposal)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryConfigResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryConfigResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QueryConfigResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QueryProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7631,7 +9651,7 @@ func (x *fastReflection_QueryConfigResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryConfigResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7642,7 +9662,7 @@ func (x *fastReflection_QueryConfigResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryConfigResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7654,7 +9674,7 @@ func (x *fastReflection_QueryConfigResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryConfigResponse) IsValid() bool {
+func (x *fastReflection_QueryProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7664,9 +9684,9 @@ func (x *fastReflection_QueryConfigResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryConfigResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryConfigResponse)
+		x := input.Message.Interface().(*QueryProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7678,14 +9698,8 @@ func (x *fastReflection_QueryConfigResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.Members) > 0 {
-			for _, e := range x.Members {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Config != nil {
-			l = options.Size(x.Config)
+		if x.Proposal != nil {
+			l = options.Size(x.Proposal)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7698,7 +9712,7 @@ func (x *fastReflection_QueryConfigResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryConfigResponse)
+		x := input.Message.Interface().(*QueryProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7717,8 +9731,8 @@ func (x *fastReflection_QueryConfigResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Config != nil {
-			encoded, err := options.Marshal(x.Config)
+		if x.Proposal != nil {
+			encoded, err := options.Marshal(x.Proposal)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7729,23 +9743,7 @@ func (x *fastReflection_QueryConfigResponse) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Members) > 0 {
-			for iNdEx := len(x.Members) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Members[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7758,7 +9756,7 @@ func (x *fastReflection_QueryConfigResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryConfigResponse)
+		x := input.Message.Interface().(*QueryProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7790,49 +9788,15 @@ func (x *fastReflection_QueryConfigResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Members = append(x.Members, &Member{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Members[len(x.Members)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7859,10 +9823,10 @@ func (x *fastReflection_QueryConfigResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Config == nil {
-					x.Config = &Config{}
+				if x.Proposal == nil {
+					x.Proposal = &Proposal{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposal); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7902,26 +9866,26 @@ func (x *fastReflection_QueryConfigResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryProposal             protoreflect.MessageDescriptor
-	fd_QueryProposal_proposal_id protoreflect.FieldDescriptor
+	md_QueryOpenProposals            protoreflect.MessageDescriptor
+	fd_QueryOpenProposals_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_QueryProposal = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QueryProposal")
-	fd_QueryProposal_proposal_id = md_QueryProposal.Fields().ByName("proposal_id")
+	md_QueryOpenProposals = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QueryOpenProposals")
+	fd_QueryOpenProposals_pagination = md_QueryOpenProposals.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposal)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOpenProposals)(nil)
 
-type fastReflection_QueryProposal QueryProposal
+type fastReflection_QueryOpenProposals QueryOpenProposals
 
-func (x *QueryProposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposal)(x)
+func (x *QueryOpenProposals) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOpenProposals)(x)
 }
 
-func (x *QueryProposal) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[17]
+func (x *QueryOpenProposals) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7932,54 +9896,54 @@ func (x *QueryProposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposal_messageType fastReflection_QueryProposal_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposal_messageType{}
+var _fastReflection_QueryOpenProposals_messageType fastReflection_QueryOpenProposals_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOpenProposals_messageType{}
 
-type fastReflection_QueryProposal_messageType struct{}
+type fastReflection_QueryOpenProposals_messageType struct{}
 
-func (x fastReflection_QueryProposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposal)(nil)
+func (x fastReflection_QueryOpenProposals_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOpenProposals)(nil)
 }
-func (x fastReflection_QueryProposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposal)
+func (x fastReflection_QueryOpenProposals_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOpenProposals)
 }
-func (x fastReflection_QueryProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposal
+func (x fastReflection_QueryOpenProposals_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOpenProposals
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposal
+func (x *fastReflection_QueryOpenProposals) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOpenProposals
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposal) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposal_messageType
+func (x *fastReflection_QueryOpenProposals) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOpenProposals_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposal) New() protoreflect.Message {
-	return new(fastReflection_QueryProposal)
+func (x *fastReflection_QueryOpenProposals) New() protoreflect.Message {
+	return new(fastReflection_QueryOpenProposals)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposal) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposal)(x)
+func (x *fastReflection_QueryOpenProposals) Interface() protoreflect.ProtoMessage {
+	return (*QueryOpenProposals)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
 // calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_QueryProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryProposal_proposal_id, value) {
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryOpenProposals) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOpenProposals_pagination, value) {
 			return
 		}
 	}
@@ -7996,15 +9960,15 @@ func (x *fastReflection_QueryProposal) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOpenProposals) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
-		return x.ProposalId != uint64(0)
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposals.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposals"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposals does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8014,15 +9978,15 @@ func (x *fastReflection_QueryProposal) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOpenProposals) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
-		x.ProposalId = uint64(0)
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposals.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposals"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposals does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8032,16 +9996,16 @@ func (x *fastReflection_QueryProposal) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOpenProposals) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposals.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposals"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposals does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8055,15 +10019,15 @@ func (x *fastReflection_QueryProposal) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOpenProposals) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
-		x.ProposalId = value.Uint()
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposals.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposals"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposals does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8077,40 +10041,44 @@ func (x *fastReflection_QueryProposal) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOpenProposals) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.accounts.defaults.multisig.v1.QueryProposal is not mutable"))
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposals.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposals"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposals does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOpenProposals) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposal.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposals.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposals"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposals does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOpenProposals) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QueryProposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QueryOpenProposals", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8118,7 +10086,7 @@ func (x *fastReflection_QueryProposal) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOpenProposals) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8129,7 +10097,7 @@ func (x *fastReflection_QueryProposal) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOpenProposals) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8141,7 +10109,7 @@ func (x *fastReflection_QueryProposal) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposal) IsValid() bool {
+func (x *fastReflection_QueryOpenProposals) IsValid() bool {
 	return x != nil
 }
 
@@ -8151,9 +10119,9 @@ func (x *fastReflection_QueryProposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOpenProposals) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposal)
+		x := input.Message.Interface().(*QueryOpenProposals)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8165,8 +10133,9 @@ func (x *fastReflection_QueryProposal) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8178,7 +10147,7 @@ func (x *fastReflection_QueryProposal) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposal)
+		x := input.Message.Interface().(*QueryOpenProposals)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8197,10 +10166,19 @@ func (x *fastReflection_QueryProposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8213,7 +10191,7 @@ func (x *fastReflection_QueryProposal) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposal)
+		x := input.Message.Interface().(*QueryOpenProposals)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8245,17 +10223,17 @@ func (x *fastReflection_QueryProposal) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposal: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOpenProposals: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOpenProposals: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				x.ProposalId = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8265,11 +10243,28 @@ func (x *fastReflection_QueryProposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8305,27 +10300,80 @@ func (x *fastReflection_QueryProposal) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_QueryOpenProposalsResponse_1_list)(nil)
+
+type _QueryOpenProposalsResponse_1_list struct {
+	list *[]*Proposal
+}
+
+func (x *_QueryOpenProposalsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryOpenProposalsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryOpenProposalsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryOpenProposalsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryOpenProposalsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Proposal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOpenProposalsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryOpenProposalsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Proposal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryOpenProposalsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryProposalResponse          protoreflect.MessageDescriptor
-	fd_QueryProposalResponse_proposal protoreflect.FieldDescriptor
+	md_QueryOpenProposalsResponse            protoreflect.MessageDescriptor
+	fd_QueryOpenProposalsResponse_proposals  protoreflect.FieldDescriptor
+	fd_QueryOpenProposalsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init()
-	md_QueryProposalResponse = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QueryProposalResponse")
-	fd_QueryProposalResponse_proposal = md_QueryProposalResponse.Fields().ByName("proposal")
+	md_QueryOpenProposalsResponse = File_cosmos_accounts_defaults_multisig_v1_multisig_proto.Messages().ByName("QueryOpenProposalsResponse")
+	fd_QueryOpenProposalsResponse_proposals = md_QueryOpenProposalsResponse.Fields().ByName("proposals")
+	fd_QueryOpenProposalsResponse_pagination = md_QueryOpenProposalsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOpenProposalsResponse)(nil)
 
-type fastReflection_QueryProposalResponse QueryProposalResponse
+type fastReflection_QueryOpenProposalsResponse QueryOpenProposalsResponse
 
-func (x *QueryProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalResponse)(x)
+func (x *QueryOpenProposalsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOpenProposalsResponse)(x)
 }
 
-func (x *QueryProposalResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[18]
+func (x *QueryOpenProposalsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8336,43 +10384,43 @@ func (x *QueryProposalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalResponse_messageType fastReflection_QueryProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalResponse_messageType{}
+var _fastReflection_QueryOpenProposalsResponse_messageType fastReflection_QueryOpenProposalsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOpenProposalsResponse_messageType{}
 
-type fastReflection_QueryProposalResponse_messageType struct{}
+type fastReflection_QueryOpenProposalsResponse_messageType struct{}
 
-func (x fastReflection_QueryProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalResponse)(nil)
+func (x fastReflection_QueryOpenProposalsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOpenProposalsResponse)(nil)
 }
-func (x fastReflection_QueryProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalResponse)
+func (x fastReflection_QueryOpenProposalsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOpenProposalsResponse)
 }
-func (x fastReflection_QueryProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalResponse
+func (x fastReflection_QueryOpenProposalsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOpenProposalsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalResponse
+func (x *fastReflection_QueryOpenProposalsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOpenProposalsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalResponse_messageType
+func (x *fastReflection_QueryOpenProposalsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOpenProposalsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalResponse)
+func (x *fastReflection_QueryOpenProposalsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOpenProposalsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalResponse)(x)
+func (x *fastReflection_QueryOpenProposalsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOpenProposalsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8380,10 +10428,16 @@ func (x *fastReflection_QueryProposalResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Proposal != nil {
-		value := protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
-		if !f(fd_QueryProposalResponse_proposal, value) {
+func (x *fastReflection_QueryOpenProposalsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Proposals) != 0 {
+		value := protoreflect.ValueOfList(&_QueryOpenProposalsResponse_1_list{list: &x.Proposals})
+		if !f(fd_QueryOpenProposalsResponse_proposals, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryOpenProposalsResponse_pagination, value) {
 			return
 		}
 	}
@@ -8400,15 +10454,17 @@ func (x *fastReflection_QueryProposalResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOpenProposalsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
-		return x.Proposal != nil
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.proposals":
+		return len(x.Proposals) != 0
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8418,15 +10474,17 @@ func (x *fastReflection_QueryProposalResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOpenProposalsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
-		x.Proposal = nil
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.proposals":
+		x.Proposals = nil
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8436,16 +10494,22 @@ func (x *fastReflection_QueryProposalResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOpenProposalsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
-		value := x.Proposal
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.proposals":
+		if len(x.Proposals) == 0 {
+			return protoreflect.ValueOfList(&_QueryOpenProposalsResponse_1_list{})
+		}
+		listValue := &_QueryOpenProposalsResponse_1_list{list: &x.Proposals}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8459,15 +10523,19 @@ func (x *fastReflection_QueryProposalResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOpenProposalsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
-		x.Proposal = value.Message().Interface().(*Proposal)
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.proposals":
+		lv := value.List()
+		clv := lv.(*_QueryOpenProposalsResponse_1_list)
+		x.Proposals = *clv.list
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8481,44 +10549,53 @@ func (x *fastReflection_QueryProposalResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOpenProposalsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
-		if x.Proposal == nil {
-			x.Proposal = new(Proposal)
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.proposals":
+		if x.Proposals == nil {
+			x.Proposals = []*Proposal{}
 		}
-		return protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
+		value := &_QueryOpenProposalsResponse_1_list{list: &x.Proposals}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOpenProposalsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal":
-		m := new(Proposal)
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.proposals":
+		list := []*Proposal{}
+		return protoreflect.ValueOfList(&_QueryOpenProposalsResponse_1_list{list: &list})
+	case "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOpenProposalsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QueryProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8526,7 +10603,7 @@ func (x *fastReflection_QueryProposalResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOpenProposalsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8537,7 +10614,7 @@ func (x *fastReflection_QueryProposalResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOpenProposalsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8549,7 +10626,7 @@ func (x *fastReflection_QueryProposalResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalResponse) IsValid() bool {
+func (x *fastReflection_QueryOpenProposalsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8559,9 +10636,9 @@ func (x *fastReflection_QueryProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOpenProposalsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalResponse)
+		x := input.Message.Interface().(*QueryOpenProposalsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8573,8 +10650,14 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.Proposal != nil {
-			l = options.Size(x.Proposal)
+		if len(x.Proposals) > 0 {
+			for _, e := range x.Proposals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8587,7 +10670,7 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalResponse)
+		x := input.Message.Interface().(*QueryOpenProposalsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8606,8 +10689,8 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Proposal != nil {
-			encoded, err := options.Marshal(x.Proposal)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8618,7 +10701,23 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Proposals) > 0 {
+			for iNdEx := len(x.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Proposals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8631,7 +10730,7 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalResponse)
+		x := input.Message.Interface().(*QueryOpenProposalsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8663,15 +10762,49 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOpenProposalsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOpenProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposals = append(x.Proposals, &Proposal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposals[len(x.Proposals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8698,10 +10831,10 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Proposal == nil {
-					x.Proposal = &Proposal{}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposal); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8765,6 +10898,14 @@ const (
 	ProposalStatus_PROPOSAL_STATUS_PASSED ProposalStatus = 2
 	// PROPOSAL_STATUS_REJECTED defines the proposal status when the proposal was rejected.
 	ProposalStatus_PROPOSAL_STATUS_REJECTED ProposalStatus = 3
+	// PROPOSAL_STATUS_QUEUED defines the proposal status when the proposal passed and
+	// waits for the execution delay to elapse.
+	ProposalStatus_PROPOSAL_STATUS_QUEUED ProposalStatus = 4
+	// PROPOSAL_STATUS_VETOED defines the proposal status when the proposal was vetoed.
+	ProposalStatus_PROPOSAL_STATUS_VETOED ProposalStatus = 5
+	// PROPOSAL_STATUS_EXPIRED defines the proposal status when the proposal expired
+	// before being executed.
+	ProposalStatus_PROPOSAL_STATUS_EXPIRED ProposalStatus = 6
 )
 
 // Enum value maps for ProposalStatus.
@@ -8774,12 +10915,18 @@ var (
 		1: "PROPOSAL_STATUS_VOTING_PERIOD",
 		2: "PROPOSAL_STATUS_PASSED",
 		3: "PROPOSAL_STATUS_REJECTED",
+		4: "PROPOSAL_STATUS_QUEUED",
+		5: "PROPOSAL_STATUS_VETOED",
+		6: "PROPOSAL_STATUS_EXPIRED",
 	}
 	ProposalStatus_value = map[string]int32{
 		"PROPOSAL_STATUS_UNSPECIFIED":   0,
 		"PROPOSAL_STATUS_VOTING_PERIOD": 1,
 		"PROPOSAL_STATUS_PASSED":        2,
 		"PROPOSAL_STATUS_REJECTED":      3,
+		"PROPOSAL_STATUS_QUEUED":        4,
+		"PROPOSAL_STATUS_VETOED":        5,
+		"PROPOSAL_STATUS_EXPIRED":       6,
 	}
 )
 
@@ -9147,6 +11294,69 @@ func (x *MsgExecuteProposalResponse) GetResponses() []*anypb.Any {
 	return nil
 }
 
+// MsgVetoProposal is used by a vetoer to cancel a proposal which is in its voting
+// period or queued for execution.
+type MsgVetoProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (x *MsgVetoProposal) Reset() {
+	*x = MsgVetoProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgVetoProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgVetoProposal) ProtoMessage() {}
+
+// Deprecated: Use MsgVetoProposal.ProtoReflect.Descriptor instead.
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgVetoProposal) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+type MsgVetoProposalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgVetoProposalResponse) Reset() {
+	*x = MsgVetoProposalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgVetoProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgVetoProposalResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgVetoProposalResponse.ProtoReflect.Descriptor instead.
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{9}
+}
+
 // MsgUpdateConfig is used to change the config or members.
 type MsgUpdateConfig struct {
 	state         protoimpl.MessageState
@@ -9162,7 +11372,7 @@ type MsgUpdateConfig struct {
 func (x *MsgUpdateConfig) Reset() {
 	*x = MsgUpdateConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[8]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9176,7 +11386,7 @@ func (*MsgUpdateConfig) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateConfig.ProtoReflect.Descriptor instead.
 func (*MsgUpdateConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MsgUpdateConfig) GetUpdateMembers() []*Member {
@@ -9202,7 +11412,7 @@ type MsgUpdateConfigResponse struct {
 func (x *MsgUpdateConfigResponse) Reset() {
 	*x = MsgUpdateConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[9]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9216,7 +11426,7 @@ func (*MsgUpdateConfigResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateConfigResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateConfigResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{11}
 }
 
 type Member struct {
@@ -9231,7 +11441,7 @@ type Member struct {
 func (x *Member) Reset() {
 	*x = Member{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[10]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9245,7 +11455,7 @@ func (*Member) ProtoMessage() {}
 
 // Deprecated: Use Member.ProtoReflect.Descriptor instead.
 func (*Member) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Member) GetAddress() string {
@@ -9275,12 +11485,22 @@ type Config struct {
 	Revote bool `protobuf:"varint,4,opt,name=revote,proto3" json:"revote,omitempty"`
 	// early_execution defines if the multisig can be executed before the voting period ends.
 	EarlyExecution bool `protobuf:"varint,5,opt,name=early_execution,json=earlyExecution,proto3" json:"early_execution,omitempty"`
+	// execution_delay is the minimum duration in seconds between the moment a
+	// proposal passes and its execution, during which it can be vetoed.
+	ExecutionDelay int64 `protobuf:"varint,6,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay,omitempty"`
+	// expiry is the duration in seconds after the end of the voting period after
+	// which a proposal which wasn't executed expires and its votes are void. If 0,
+	// proposals never expire.
+	Expiry int64 `protobuf:"varint,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// vetoers are the addresses allowed to veto proposals, they don't need to be
+	// members.
+	Vetoers []string `protobuf:"bytes,8,rep,name=vetoers,proto3" json:"vetoers,omitempty"`
 }
 
 func (x *Config) Reset() {
 	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[11]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9294,7 +11514,7 @@ func (*Config) ProtoMessage() {}
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{11}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Config) GetThreshold() int64 {
@@ -9332,6 +11552,27 @@ func (x *Config) GetEarlyExecution() bool {
 	return false
 }
 
+func (x *Config) GetExecutionDelay() int64 {
+	if x != nil {
+		return x.ExecutionDelay
+	}
+	return 0
+}
+
+func (x *Config) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *Config) GetVetoers() []string {
+	if x != nil {
+		return x.Vetoers
+	}
+	return nil
+}
+
 type Proposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -9343,12 +11584,21 @@ type Proposal struct {
 	// voting_period_end will be set by the account when the proposal is created.
 	VotingPeriodEnd int64          `protobuf:"varint,4,opt,name=voting_period_end,json=votingPeriodEnd,proto3" json:"voting_period_end,omitempty"`
 	Status          ProposalStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.accounts.defaults.multisig.v1.ProposalStatus" json:"status,omitempty"`
+	// executable_at will be set by the account when the proposal passes, it is the
+	// unix time in seconds after which the proposal can be executed.
+	ExecutableAt int64 `protobuf:"varint,6,opt,name=executable_at,json=executableAt,proto3" json:"executable_at,omitempty"`
+	// expires_at will be set by the account when the proposal is created if the
+	// config has an expiry, it is the unix time in seconds after which the proposal
+	// can no longer be executed.
+	ExpiresAt int64 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// id will be set by the account when the proposal is created.
+	Id uint64 `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *Proposal) Reset() {
 	*x = Proposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[12]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9362,7 +11612,7 @@ func (*Proposal) ProtoMessage() {}
 
 // Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{12}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Proposal) GetTitle() string {
@@ -9400,6 +11650,27 @@ func (x *Proposal) GetStatus() ProposalStatus {
 	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
 }
 
+func (x *Proposal) GetExecutableAt() int64 {
+	if x != nil {
+		return x.ExecutableAt
+	}
+	return 0
+}
+
+func (x *Proposal) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Proposal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 // QuerySequence is the request for the account sequence.
 type QuerySequence struct {
 	state         protoimpl.MessageState
@@ -9410,7 +11681,7 @@ type QuerySequence struct {
 func (x *QuerySequence) Reset() {
 	*x = QuerySequence{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[13]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9424,7 +11695,7 @@ func (*QuerySequence) ProtoMessage() {}
 
 // Deprecated: Use QuerySequence.ProtoReflect.Descriptor instead.
 func (*QuerySequence) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{13}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{15}
 }
 
 // QuerySequenceResponse returns the sequence of the account.
@@ -9440,7 +11711,7 @@ type QuerySequenceResponse struct {
 func (x *QuerySequenceResponse) Reset() {
 	*x = QuerySequenceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[14]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9454,7 +11725,7 @@ func (*QuerySequenceResponse) ProtoMessage() {}
 
 // Deprecated: Use QuerySequenceResponse.ProtoReflect.Descriptor instead.
 func (*QuerySequenceResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{14}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QuerySequenceResponse) GetSequence() uint64 {
@@ -9473,7 +11744,7 @@ type QueryConfig struct {
 func (x *QueryConfig) Reset() {
 	*x = QueryConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[15]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9487,7 +11758,7 @@ func (*QueryConfig) ProtoMessage() {}
 
 // Deprecated: Use QueryConfig.ProtoReflect.Descriptor instead.
 func (*QueryConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{15}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{17}
 }
 
 // QuerySequenceResponse returns the sequence of the account.
@@ -9503,7 +11774,7 @@ type QueryConfigResponse struct {
 func (x *QueryConfigResponse) Reset() {
 	*x = QueryConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[16]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9517,7 +11788,7 @@ func (*QueryConfigResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryConfigResponse.ProtoReflect.Descriptor instead.
 func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{16}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QueryConfigResponse) GetMembers() []*Member {
@@ -9545,7 +11816,7 @@ type QueryProposal struct {
 func (x *QueryProposal) Reset() {
 	*x = QueryProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[17]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9559,7 +11830,7 @@ func (*QueryProposal) ProtoMessage() {}
 
 // Deprecated: Use QueryProposal.ProtoReflect.Descriptor instead.
 func (*QueryProposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{17}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryProposal) GetProposalId() uint64 {
@@ -9580,7 +11851,7 @@ type QueryProposalResponse struct {
 func (x *QueryProposalResponse) Reset() {
 	*x = QueryProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[18]
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9594,7 +11865,7 @@ func (*QueryProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryProposalResponse.ProtoReflect.Descriptor instead.
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{18}
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryProposalResponse) GetProposal() *Proposal {
@@ -9604,6 +11875,86 @@ func (x *QueryProposalResponse) GetProposal() *Proposal {
 	return nil
 }
 
+// QueryOpenProposals is the request for the proposals which are in their voting
+// period or queued for execution.
+type QueryOpenProposals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryOpenProposals) Reset() {
+	*x = QueryOpenProposals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOpenProposals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOpenProposals) ProtoMessage() {}
+
+// Deprecated: Use QueryOpenProposals.ProtoReflect.Descriptor instead.
+func (*QueryOpenProposals) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryOpenProposals) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryOpenProposalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proposals  []*Proposal           `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryOpenProposalsResponse) Reset() {
+	*x = QueryOpenProposalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOpenProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOpenProposalsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryOpenProposalsResponse.ProtoReflect.Descriptor instead.
+func (*QueryOpenProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueryOpenProposalsResponse) GetProposals() []*Proposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+func (x *QueryOpenProposalsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 var File_cosmos_accounts_defaults_multisig_v1_multisig_proto protoreflect.FileDescriptor
 
 var file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDesc = []byte{
@@ -9614,76 +11965,91 @@ var file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e,
 	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d,
-	0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x07, 0x4d,
-	0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x44,
-	0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
-	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
-	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x3c, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x49, 0x6e,
+	0x69, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x22, 0x3c, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x49, 0x64, 0x12, 0x44, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x56,
-	0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x4d,
-	0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x49, 0x64, 0x22, 0x50, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x53, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0d,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a,
-	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54,
-	0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x71,
-	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x76, 0x6f,
-	0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x76, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f,
-	0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x61, 0x72,
-	0x6c, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe6, 0x01, 0x0a, 0x08,
+	0x49, 0x64, 0x22, 0x70, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x44,
+	0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x76, 0x6f, 0x74, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x50,
+	0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x22, 0x32, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x56, 0x65, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x56, 0x65, 0x74, 0x6f, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xac, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x53, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x19,
+	0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x06, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x99, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x76, 0x65, 0x74, 0x6f, 0x65,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x76, 0x65, 0x74, 0x6f, 0x65, 0x72, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x08,
 	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18,
 	0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -9698,67 +12064,95 @@ var file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDesc = []byte{
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
 	0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
 	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x33, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x0d, 0x0a, 0x0b, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xa3, 0x01, 0x0a, 0x13, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x30, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
-	0x64, 0x22, 0x63, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2a, 0x8e, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f,
-	0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52,
-	0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f,
-	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a,
-	0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f,
-	0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a,
-	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x6b, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x59, 0x45, 0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f, 0x54, 0x45, 0x5f,
-	0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x02,
-	0x12, 0x12, 0x0a, 0x0e, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4e, 0x4f, 0x10, 0x03, 0x42, 0xb0, 0x02, 0x0a, 0x28, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x33, 0x0a, 0x15, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x0d,
+	0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xa3, 0x01,
+	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a,
+	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0x30, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x5c, 0x0a, 0x12, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66,
 	0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76,
-	0x31, 0x42, 0x0d, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x40, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
-	0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x04, 0x43, 0x41, 0x44, 0x4d, 0xaa, 0x02, 0x24, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x5c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x5c, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x30, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x73, 0x5c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x28, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a,
-	0x3a, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x3a, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x73, 0x69, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0xe3,
+	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52,
+	0x49, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41,
+	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x50,
+	0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56,
+	0x45, 0x54, 0x4f, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x50, 0x4f,
+	0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x06, 0x2a, 0x6b, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x59,
+	0x45, 0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x10,
+	0x03, 0x42, 0xb0, 0x02, 0x0a, 0x28, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0d,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x40, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x76,
+	0x31, 0xa2, 0x02, 0x04, 0x43, 0x41, 0x44, 0x4d, 0xaa, 0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x5c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x5c, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x30, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x73, 0x5c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x28, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x3a, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x3a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9774,7 +12168,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDescGZIP() []by
 }
 
 var file_cosmos_accounts_defaults_multisig_v1_multisig_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_cosmos_accounts_defaults_multisig_v1_multisig_proto_goTypes = []interface{}{
 	(ProposalStatus)(0),                // 0: cosmos.accounts.defaults.multisig.v1.ProposalStatus
 	(VoteOption)(0),                    // 1: cosmos.accounts.defaults.multisig.v1.VoteOption
@@ -9786,37 +12180,46 @@ var file_cosmos_accounts_defaults_multisig_v1_multisig_proto_goTypes = []interfa
 	(*MsgVoteResponse)(nil),            // 7: cosmos.accounts.defaults.multisig.v1.MsgVoteResponse
 	(*MsgExecuteProposal)(nil),         // 8: cosmos.accounts.defaults.multisig.v1.MsgExecuteProposal
 	(*MsgExecuteProposalResponse)(nil), // 9: cosmos.accounts.defaults.multisig.v1.MsgExecuteProposalResponse
-	(*MsgUpdateConfig)(nil),            // 10: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig
-	(*MsgUpdateConfigResponse)(nil),    // 11: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse
-	(*Member)(nil),                     // 12: cosmos.accounts.defaults.multisig.v1.Member
-	(*Config)(nil),                     // 13: cosmos.accounts.defaults.multisig.v1.Config
-	(*Proposal)(nil),                   // 14: cosmos.accounts.defaults.multisig.v1.Proposal
-	(*QuerySequence)(nil),              // 15: cosmos.accounts.defaults.multisig.v1.QuerySequence
-	(*QuerySequenceResponse)(nil),      // 16: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse
-	(*QueryConfig)(nil),                // 17: cosmos.accounts.defaults.multisig.v1.QueryConfig
-	(*QueryConfigResponse)(nil),        // 18: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse
-	(*QueryProposal)(nil),              // 19: cosmos.accounts.defaults.multisig.v1.QueryProposal
-	(*QueryProposalResponse)(nil),      // 20: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse
-	(*anypb.Any)(nil),                  // 21: google.protobuf.Any
+	(*MsgVetoProposal)(nil),            // 10: cosmos.accounts.defaults.multisig.v1.MsgVetoProposal
+	(*MsgVetoProposalResponse)(nil),    // 11: cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse
+	(*MsgUpdateConfig)(nil),            // 12: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig
+	(*MsgUpdateConfigResponse)(nil),    // 13: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse
+	(*Member)(nil),                     // 14: cosmos.accounts.defaults.multisig.v1.Member
+	(*Config)(nil),                     // 15: cosmos.accounts.defaults.multisig.v1.Config
+	(*Proposal)(nil),                   // 16: cosmos.accounts.defaults.multisig.v1.Proposal
+	(*QuerySequence)(nil),              // 17: cosmos.accounts.defaults.multisig.v1.QuerySequence
+	(*QuerySequenceResponse)(nil),      // 18: cosmos.accounts.defaults.multisig.v1.QuerySequenceResponse
+	(*QueryConfig)(nil),                // 19: cosmos.accounts.defaults.multisig.v1.QueryConfig
+	(*QueryConfigResponse)(nil),        // 20: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse
+	(*QueryProposal)(nil),              // 21: cosmos.accounts.defaults.multisig.v1.QueryProposal
+	(*QueryProposalResponse)(nil),      // 22: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse
+	(*QueryOpenProposals)(nil),         // 23: cosmos.accounts.defaults.multisig.v1.QueryOpenProposals
+	(*QueryOpenProposalsResponse)(nil), // 24: cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse
+	(*anypb.Any)(nil),                  // 25: google.protobuf.Any
+	(*v1beta1.PageRequest)(nil),        // 26: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),       // 27: cosmos.base.query.v1beta1.PageResponse
 }
 var file_cosmos_accounts_defaults_multisig_v1_multisig_proto_depIdxs = []int32{
-	12, // 0: cosmos.accounts.defaults.multisig.v1.MsgInit.members:type_name -> cosmos.accounts.defaults.multisig.v1.Member
-	13, // 1: cosmos.accounts.defaults.multisig.v1.MsgInit.Config:type_name -> cosmos.accounts.defaults.multisig.v1.Config
-	14, // 2: cosmos.accounts.defaults.multisig.v1.MsgCreateProposal.proposal:type_name -> cosmos.accounts.defaults.multisig.v1.Proposal
+	14, // 0: cosmos.accounts.defaults.multisig.v1.MsgInit.members:type_name -> cosmos.accounts.defaults.multisig.v1.Member
+	15, // 1: cosmos.accounts.defaults.multisig.v1.MsgInit.Config:type_name -> cosmos.accounts.defaults.multisig.v1.Config
+	16, // 2: cosmos.accounts.defaults.multisig.v1.MsgCreateProposal.proposal:type_name -> cosmos.accounts.defaults.multisig.v1.Proposal
 	1,  // 3: cosmos.accounts.defaults.multisig.v1.MsgVote.vote:type_name -> cosmos.accounts.defaults.multisig.v1.VoteOption
-	21, // 4: cosmos.accounts.defaults.multisig.v1.MsgExecuteProposalResponse.responses:type_name -> google.protobuf.Any
-	12, // 5: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members:type_name -> cosmos.accounts.defaults.multisig.v1.Member
-	13, // 6: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config:type_name -> cosmos.accounts.defaults.multisig.v1.Config
-	21, // 7: cosmos.accounts.defaults.multisig.v1.Proposal.messages:type_name -> google.protobuf.Any
+	25, // 4: cosmos.accounts.defaults.multisig.v1.MsgExecuteProposalResponse.responses:type_name -> google.protobuf.Any
+	14, // 5: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.update_members:type_name -> cosmos.accounts.defaults.multisig.v1.Member
+	15, // 6: cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig.Config:type_name -> cosmos.accounts.defaults.multisig.v1.Config
+	25, // 7: cosmos.accounts.defaults.multisig.v1.Proposal.messages:type_name -> google.protobuf.Any
 	0,  // 8: cosmos.accounts.defaults.multisig.v1.Proposal.status:type_name -> cosmos.accounts.defaults.multisig.v1.ProposalStatus
-	12, // 9: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members:type_name -> cosmos.accounts.defaults.multisig.v1.Member
-	13, // 10: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config:type_name -> cosmos.accounts.defaults.multisig.v1.Config
-	14, // 11: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal:type_name -> cosmos.accounts.defaults.multisig.v1.Proposal
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	14, // 9: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.members:type_name -> cosmos.accounts.defaults.multisig.v1.Member
+	15, // 10: cosmos.accounts.defaults.multisig.v1.QueryConfigResponse.Config:type_name -> cosmos.accounts.defaults.multisig.v1.Config
+	16, // 11: cosmos.accounts.defaults.multisig.v1.QueryProposalResponse.proposal:type_name -> cosmos.accounts.defaults.multisig.v1.Proposal
+	26, // 12: cosmos.accounts.defaults.multisig.v1.QueryOpenProposals.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	16, // 13: cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.proposals:type_name -> cosmos.accounts.defaults.multisig.v1.Proposal
+	27, // 14: cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() }
@@ -9922,7 +12325,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateConfig); i {
+			switch v := v.(*MsgVetoProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9934,7 +12337,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateConfigResponse); i {
+			switch v := v.(*MsgVetoProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9946,7 +12349,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Member); i {
+			switch v := v.(*MsgUpdateConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9958,7 +12361,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+			switch v := v.(*MsgUpdateConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9970,7 +12373,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Proposal); i {
+			switch v := v.(*Member); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9982,7 +12385,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySequence); i {
+			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9994,7 +12397,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySequenceResponse); i {
+			switch v := v.(*Proposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10006,7 +12409,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryConfig); i {
+			switch v := v.(*QuerySequence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10018,7 +12421,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryConfigResponse); i {
+			switch v := v.(*QuerySequenceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10030,7 +12433,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposal); i {
+			switch v := v.(*QueryConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10042,6 +12445,30 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			}
 		}
 		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryProposalResponse); i {
 			case 0:
 				return &v.state
@@ -10053,6 +12480,30 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOpenProposals); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_accounts_defaults_multisig_v1_multisig_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOpenProposalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10060,7 +12511,7 @@ func file_cosmos_accounts_defaults_multisig_v1_multisig_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_accounts_defaults_multisig_v1_multisig_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   19,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName  = "/cosmos.auth.v1beta1.Msg/UpdateParams"
-	Msg_NonAtomicExec_FullMethodName = "/cosmos.auth.v1beta1.Msg/NonAtomicExec"
+	Msg_UpdateParams_FullMethodName          = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_NonAtomicExec_FullMethodName         = "/cosmos.auth.v1beta1.Msg/NonAtomicExec"
+	Msg_MigrateAccount_FullMethodName        = "/cosmos.auth.v1beta1.Msg/MigrateAccount"
+	Msg_UpdateFeeMarketParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateFeeMarketParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +34,12 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// NonAtomicExec allows users to submit multiple messages for non-atomic execution.
 	NonAtomicExec(ctx context.Context, in *MsgNonAtomicExec, opts ...grpc.CallOption) (*MsgNonAtomicExecResponse, error)
+	// MigrateAccount migrates a BaseAccount or a vesting account to an x/accounts
+	// account type, keeping its address, account number and balances.
+	MigrateAccount(ctx context.Context, in *MsgMigrateAccount, opts ...grpc.CallOption) (*MsgMigrateAccountResponse, error)
+	// UpdateFeeMarketParams defines a (governance) operation for updating the
+	// fee market parameters. The authority defaults to the x/gov module account.
+	UpdateFeeMarketParams(ctx context.Context, in *MsgUpdateFeeMarketParams, opts ...grpc.CallOption) (*MsgUpdateFeeMarketParamsResponse, error)
 }
 
 type msgClient struct {
@@ -60,6 +68,24 @@ func (c *msgClient) NonAtomicExec(ctx context.Context, in *MsgNonAtomicExec, opt
 	return out, nil
 }
 
+func (c *msgClient) MigrateAccount(ctx context.Context, in *MsgMigrateAccount, opts ...grpc.CallOption) (*MsgMigrateAccountResponse, error) {
+	out := new(MsgMigrateAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_MigrateAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeMarketParams(ctx context.Context, in *MsgUpdateFeeMarketParams, opts ...grpc.CallOption) (*MsgUpdateFeeMarketParamsResponse, error) {
+	out := new(MsgUpdateFeeMarketParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeeMarketParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -69,6 +95,12 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// NonAtomicExec allows users to submit multiple messages for non-atomic execution.
 	NonAtomicExec(context.Context, *MsgNonAtomicExec) (*MsgNonAtomicExecResponse, error)
+	// MigrateAccount migrates a BaseAccount or a vesting account to an x/accounts
+	// account type, keeping its address, account number and balances.
+	MigrateAccount(context.Context, *MsgMigrateAccount) (*MsgMigrateAccountResponse, error)
+	// UpdateFeeMarketParams defines a (governance) operation for updating the
+	// fee market parameters. The authority defaults to the x/gov module account.
+	UpdateFeeMarketParams(context.Context, *MsgUpdateFeeMarketParams) (*MsgUpdateFeeMarketParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -82,6 +114,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) NonAtomicExec(context.Context, *MsgNonAtomicExec) (*MsgNonAtomicExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NonAtomicExec not implemented")
 }
+func (UnimplementedMsgServer) MigrateAccount(context.Context, *MsgMigrateAccount) (*MsgMigrateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAccount not implemented")
+}
+func (UnimplementedMsgServer) UpdateFeeMarketParams(context.Context, *MsgUpdateFeeMarketParams) (*MsgUpdateFeeMarketParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeMarketParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -131,6 +169,42 @@ func _Msg_NonAtomicExec_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MigrateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAccount(ctx, req.(*MsgMigrateAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeMarketParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeMarketParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeMarketParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeeMarketParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeMarketParams(ctx, req.(*MsgUpdateFeeMarketParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -146,6 +220,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NonAtomicExec",
 			Handler:    _Msg_NonAtomicExec_Handler,
 		},
+		{
+			MethodName: "MigrateAccount",
+			Handler:    _Msg_MigrateAccount_Handler,
+		},
+		{
+			MethodName: "UpdateFeeMarketParams",
+			Handler:    _Msg_UpdateFeeMarketParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
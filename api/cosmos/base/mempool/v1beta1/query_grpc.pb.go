@@ -0,0 +1,262 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/base/mempool/v1beta1/query.proto
+
+package mempoolv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Service_Count_FullMethodName      = "/cosmos.base.mempool.v1beta1.Service/Count"
+	Service_PendingTxs_FullMethodName = "/cosmos.base.mempool.v1beta1.Service/PendingTxs"
+	Service_SenderTxs_FullMethodName  = "/cosmos.base.mempool.v1beta1.Service/SenderTxs"
+	Service_Subscribe_FullMethodName  = "/cosmos.base.mempool.v1beta1.Service/Subscribe"
+)
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceClient interface {
+	// Count queries the number of transactions and senders in the mempool.
+	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	// PendingTxs queries the transactions pending in the mempool, ordered by
+	// sender then nonce.
+	PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error)
+	// SenderTxs queries the transactions of a sender pending in the mempool,
+	// ordered by nonce.
+	SenderTxs(ctx context.Context, in *SenderTxsRequest, opts ...grpc.CallOption) (*SenderTxsResponse, error)
+	// Subscribe streams the transactions inserted into or removed from the mempool.
+	// It is only available through gRPC.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Service_SubscribeClient, error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, Service_Count_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error) {
+	out := new(PendingTxsResponse)
+	err := c.cc.Invoke(ctx, Service_PendingTxs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SenderTxs(ctx context.Context, in *SenderTxsRequest, opts ...grpc.CallOption) (*SenderTxsResponse, error) {
+	out := new(SenderTxsResponse)
+	err := c.cc.Invoke(ctx, Service_SenderTxs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Service_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[0], Service_Subscribe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_SubscribeClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type serviceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceSubscribeClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility
+type ServiceServer interface {
+	// Count queries the number of transactions and senders in the mempool.
+	Count(context.Context, *CountRequest) (*CountResponse, error)
+	// PendingTxs queries the transactions pending in the mempool, ordered by
+	// sender then nonce.
+	PendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error)
+	// SenderTxs queries the transactions of a sender pending in the mempool,
+	// ordered by nonce.
+	SenderTxs(context.Context, *SenderTxsRequest) (*SenderTxsResponse, error)
+	// Subscribe streams the transactions inserted into or removed from the mempool.
+	// It is only available through gRPC.
+	Subscribe(*SubscribeRequest, Service_SubscribeServer) error
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) Count(context.Context, *CountRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedServiceServer) PendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTxs not implemented")
+}
+func (UnimplementedServiceServer) SenderTxs(context.Context, *SenderTxsRequest) (*SenderTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderTxs not implemented")
+}
+func (UnimplementedServiceServer) Subscribe(*SubscribeRequest, Service_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Count_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Count(ctx, req.(*CountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PendingTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PendingTxs(ctx, req.(*PendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SenderTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SenderTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SenderTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SenderTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SenderTxs(ctx, req.(*SenderTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).Subscribe(m, &serviceSubscribeServer{stream})
+}
+
+type Service_SubscribeServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type serviceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceSubscribeServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.mempool.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Count",
+			Handler:    _Service_Count_Handler,
+		},
+		{
+			MethodName: "PendingTxs",
+			Handler:    _Service_PendingTxs_Handler,
+		},
+		{
+			MethodName: "SenderTxs",
+			Handler:    _Service_SenderTxs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Service_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/mempool/v1beta1/query.proto",
+}
@@ -708,12 +708,16 @@ func (x *_Metadata_1_list) IsValid() bool {
 var (
 	md_Metadata              protoreflect.MessageDescriptor
 	fd_Metadata_chunk_hashes protoreflect.FieldDescriptor
+	fd_Metadata_base_height  protoreflect.FieldDescriptor
+	fd_Metadata_base_format  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
 	md_Metadata = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("Metadata")
 	fd_Metadata_chunk_hashes = md_Metadata.Fields().ByName("chunk_hashes")
+	fd_Metadata_base_height = md_Metadata.Fields().ByName("base_height")
+	fd_Metadata_base_format = md_Metadata.Fields().ByName("base_format")
 }
 
 var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
@@ -787,6 +791,18 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.BaseHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseHeight)
+		if !f(fd_Metadata_base_height, value) {
+			return
+		}
+	}
+	if x.BaseFormat != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BaseFormat)
+		if !f(fd_Metadata_base_format, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -804,6 +820,10 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		return len(x.ChunkHashes) != 0
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		return x.BaseHeight != uint64(0)
+	case "cosmos.store.snapshots.v1.Metadata.base_format":
+		return x.BaseFormat != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -822,6 +842,10 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		x.ChunkHashes = nil
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		x.BaseHeight = uint64(0)
+	case "cosmos.store.snapshots.v1.Metadata.base_format":
+		x.BaseFormat = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -844,6 +868,12 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 		}
 		listValue := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		value := x.BaseHeight
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.snapshots.v1.Metadata.base_format":
+		value := x.BaseFormat
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -868,6 +898,10 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 		lv := value.List()
 		clv := lv.(*_Metadata_1_list)
 		x.ChunkHashes = *clv.list
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		x.BaseHeight = value.Uint()
+	case "cosmos.store.snapshots.v1.Metadata.base_format":
+		x.BaseFormat = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -894,6 +928,10 @@ func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) proto
 		}
 		value := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		panic(fmt.Errorf("field base_height of message cosmos.store.snapshots.v1.Metadata is not mutable"))
+	case "cosmos.store.snapshots.v1.Metadata.base_format":
+		panic(fmt.Errorf("field base_format of message cosmos.store.snapshots.v1.Metadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -910,6 +948,10 @@ func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) prot
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		list := [][]byte{}
 		return protoreflect.ValueOfList(&_Metadata_1_list{list: &list})
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.snapshots.v1.Metadata.base_format":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -985,6 +1027,12 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.BaseHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseHeight))
+		}
+		if x.BaseFormat != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFormat))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1014,6 +1062,16 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BaseFormat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFormat))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BaseHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseHeight))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.ChunkHashes) > 0 {
 			for iNdEx := len(x.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ChunkHashes[iNdEx])
@@ -1104,6 +1162,44 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				x.ChunkHashes = append(x.ChunkHashes, make([]byte, postIndex-iNdEx))
 				copy(x.ChunkHashes[len(x.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+				}
+				x.BaseHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFormat", wireType)
+				}
+				x.BaseFormat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFormat |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1145,6 +1241,7 @@ var (
 	fd_SnapshotItem_iavl              protoreflect.FieldDescriptor
 	fd_SnapshotItem_extension         protoreflect.FieldDescriptor
 	fd_SnapshotItem_extension_payload protoreflect.FieldDescriptor
+	fd_SnapshotItem_iavl_base         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1154,6 +1251,7 @@ func init() {
 	fd_SnapshotItem_iavl = md_SnapshotItem.Fields().ByName("iavl")
 	fd_SnapshotItem_extension = md_SnapshotItem.Fields().ByName("extension")
 	fd_SnapshotItem_extension_payload = md_SnapshotItem.Fields().ByName("extension_payload")
+	fd_SnapshotItem_iavl_base = md_SnapshotItem.Fields().ByName("iavl_base")
 }
 
 var _ protoreflect.Message = (*fastReflection_SnapshotItem)(nil)
@@ -1247,6 +1345,12 @@ func (x *fastReflection_SnapshotItem) Range(f func(protoreflect.FieldDescriptor,
 			if !f(fd_SnapshotItem_extension_payload, value) {
 				return
 			}
+		case *SnapshotItem_IavlBase:
+			v := o.IavlBase
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_iavl_base, value) {
+				return
+			}
 		}
 	}
 }
@@ -1296,6 +1400,14 @@ func (x *fastReflection_SnapshotItem) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_base":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_IavlBase); ok {
+			return true
+		} else {
+			return false
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1320,6 +1432,8 @@ func (x *fastReflection_SnapshotItem) Clear(fd protoreflect.FieldDescriptor) {
 		x.Item = nil
 	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
 		x.Item = nil
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_base":
+		x.Item = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1368,6 +1482,14 @@ func (x *fastReflection_SnapshotItem) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
 		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_base":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLBaseItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_IavlBase); ok {
+			return protoreflect.ValueOfMessage(v.IavlBase.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLBaseItem)(nil).ProtoReflect())
+		}
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1400,6 +1522,9 @@ func (x *fastReflection_SnapshotItem) Set(fd protoreflect.FieldDescriptor, value
 	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
 		cv := value.Message().Interface().(*SnapshotExtensionPayload)
 		x.Item = &SnapshotItem_ExtensionPayload{ExtensionPayload: cv}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_base":
+		cv := value.Message().Interface().(*SnapshotIAVLBaseItem)
+		x.Item = &SnapshotItem_IavlBase{IavlBase: cv}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1484,6 +1609,22 @@ func (x *fastReflection_SnapshotItem) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Item = oneofValue
 			return protoreflect.ValueOfMessage(value.ProtoReflect())
 		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_base":
+		if x.Item == nil {
+			value := &SnapshotIAVLBaseItem{}
+			oneofValue := &SnapshotItem_IavlBase{IavlBase: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_IavlBase:
+			return protoreflect.ValueOfMessage(m.IavlBase.ProtoReflect())
+		default:
+			value := &SnapshotIAVLBaseItem{}
+			oneofValue := &SnapshotItem_IavlBase{IavlBase: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1509,6 +1650,9 @@ func (x *fastReflection_SnapshotItem) NewField(fd protoreflect.FieldDescriptor)
 	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
 		value := &SnapshotExtensionPayload{}
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_base":
+		value := &SnapshotIAVLBaseItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1535,6 +1679,8 @@ func (x *fastReflection_SnapshotItem) WhichOneof(d protoreflect.OneofDescriptor)
 			return x.Descriptor().Fields().ByName("extension")
 		case *SnapshotItem_ExtensionPayload:
 			return x.Descriptor().Fields().ByName("extension_payload")
+		case *SnapshotItem_IavlBase:
+			return x.Descriptor().Fields().ByName("iavl_base")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotItem", d.FullName()))
@@ -1617,6 +1763,12 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			}
 			l = options.Size(x.ExtensionPayload)
 			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_IavlBase:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.IavlBase)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1700,6 +1852,19 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x22
+		case *SnapshotItem_IavlBase:
+			encoded, err := options.Marshal(x.IavlBase)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1890,6 +2055,41 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 				}
 				x.Item = &SnapshotItem_ExtensionPayload{v}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IavlBase", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotIAVLBaseItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_IavlBase{v}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2930,27 +3130,27 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SnapshotExtensionMeta        protoreflect.MessageDescriptor
-	fd_SnapshotExtensionMeta_name   protoreflect.FieldDescriptor
-	fd_SnapshotExtensionMeta_format protoreflect.FieldDescriptor
+	md_SnapshotIAVLBaseItem       protoreflect.MessageDescriptor
+	fd_SnapshotIAVLBaseItem_skip  protoreflect.FieldDescriptor
+	fd_SnapshotIAVLBaseItem_count protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
-	md_SnapshotExtensionMeta = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotExtensionMeta")
-	fd_SnapshotExtensionMeta_name = md_SnapshotExtensionMeta.Fields().ByName("name")
-	fd_SnapshotExtensionMeta_format = md_SnapshotExtensionMeta.Fields().ByName("format")
+	md_SnapshotIAVLBaseItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotIAVLBaseItem")
+	fd_SnapshotIAVLBaseItem_skip = md_SnapshotIAVLBaseItem.Fields().ByName("skip")
+	fd_SnapshotIAVLBaseItem_count = md_SnapshotIAVLBaseItem.Fields().ByName("count")
 }
 
-var _ protoreflect.Message = (*fastReflection_SnapshotExtensionMeta)(nil)
+var _ protoreflect.Message = (*fastReflection_SnapshotIAVLBaseItem)(nil)
 
-type fastReflection_SnapshotExtensionMeta SnapshotExtensionMeta
+type fastReflection_SnapshotIAVLBaseItem SnapshotIAVLBaseItem
 
-func (x *SnapshotExtensionMeta) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SnapshotExtensionMeta)(x)
+func (x *SnapshotIAVLBaseItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLBaseItem)(x)
 }
 
-func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
+func (x *SnapshotIAVLBaseItem) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2962,43 +3162,43 @@ func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SnapshotExtensionMeta_messageType fastReflection_SnapshotExtensionMeta_messageType
-var _ protoreflect.MessageType = fastReflection_SnapshotExtensionMeta_messageType{}
+var _fastReflection_SnapshotIAVLBaseItem_messageType fastReflection_SnapshotIAVLBaseItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotIAVLBaseItem_messageType{}
 
-type fastReflection_SnapshotExtensionMeta_messageType struct{}
+type fastReflection_SnapshotIAVLBaseItem_messageType struct{}
 
-func (x fastReflection_SnapshotExtensionMeta_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SnapshotExtensionMeta)(nil)
+func (x fastReflection_SnapshotIAVLBaseItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLBaseItem)(nil)
 }
-func (x fastReflection_SnapshotExtensionMeta_messageType) New() protoreflect.Message {
-	return new(fastReflection_SnapshotExtensionMeta)
+func (x fastReflection_SnapshotIAVLBaseItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLBaseItem)
 }
-func (x fastReflection_SnapshotExtensionMeta_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotExtensionMeta
+func (x fastReflection_SnapshotIAVLBaseItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLBaseItem
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SnapshotExtensionMeta) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotExtensionMeta
+func (x *fastReflection_SnapshotIAVLBaseItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLBaseItem
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SnapshotExtensionMeta) Type() protoreflect.MessageType {
-	return _fastReflection_SnapshotExtensionMeta_messageType
+func (x *fastReflection_SnapshotIAVLBaseItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotIAVLBaseItem_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SnapshotExtensionMeta) New() protoreflect.Message {
-	return new(fastReflection_SnapshotExtensionMeta)
+func (x *fastReflection_SnapshotIAVLBaseItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLBaseItem)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SnapshotExtensionMeta) Interface() protoreflect.ProtoMessage {
-	return (*SnapshotExtensionMeta)(x)
+func (x *fastReflection_SnapshotIAVLBaseItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotIAVLBaseItem)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3006,16 +3206,16 @@ func (x *fastReflection_SnapshotExtensionMeta) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SnapshotExtensionMeta) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_SnapshotExtensionMeta_name, value) {
+func (x *fastReflection_SnapshotIAVLBaseItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Skip != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Skip)
+		if !f(fd_SnapshotIAVLBaseItem_skip, value) {
 			return
 		}
 	}
-	if x.Format != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Format)
-		if !f(fd_SnapshotExtensionMeta_format, value) {
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_SnapshotIAVLBaseItem_count, value) {
 			return
 		}
 	}
@@ -3032,17 +3232,17 @@ func (x *fastReflection_SnapshotExtensionMeta) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SnapshotExtensionMeta) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SnapshotIAVLBaseItem) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		return x.Name != ""
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		return x.Format != uint32(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.skip":
+		return x.Skip != uint64(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.count":
+		return x.Count != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLBaseItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLBaseItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3052,17 +3252,17 @@ func (x *fastReflection_SnapshotExtensionMeta) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotExtensionMeta) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SnapshotIAVLBaseItem) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		x.Name = ""
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		x.Format = uint32(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.skip":
+		x.Skip = uint64(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.count":
+		x.Count = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLBaseItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLBaseItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3072,19 +3272,19 @@ func (x *fastReflection_SnapshotExtensionMeta) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SnapshotExtensionMeta) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLBaseItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		value := x.Format
-		return protoreflect.ValueOfUint32(value)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.skip":
+		value := x.Skip
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLBaseItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLBaseItem does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3098,17 +3298,17 @@ func (x *fastReflection_SnapshotExtensionMeta) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotExtensionMeta) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SnapshotIAVLBaseItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		x.Name = value.Interface().(string)
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		x.Format = uint32(value.Uint())
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.skip":
+		x.Skip = value.Uint()
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.count":
+		x.Count = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLBaseItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLBaseItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3122,44 +3322,44 @@ func (x *fastReflection_SnapshotExtensionMeta) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotExtensionMeta) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLBaseItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		panic(fmt.Errorf("field name of message cosmos.store.snapshots.v1.SnapshotExtensionMeta is not mutable"))
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		panic(fmt.Errorf("field format of message cosmos.store.snapshots.v1.SnapshotExtensionMeta is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.skip":
+		panic(fmt.Errorf("field skip of message cosmos.store.snapshots.v1.SnapshotIAVLBaseItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.count":
+		panic(fmt.Errorf("field count of message cosmos.store.snapshots.v1.SnapshotIAVLBaseItem is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLBaseItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLBaseItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SnapshotExtensionMeta) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLBaseItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		return protoreflect.ValueOfString("")
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.skip":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem.count":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLBaseItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLBaseItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SnapshotExtensionMeta) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SnapshotIAVLBaseItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotExtensionMeta", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotIAVLBaseItem", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3167,7 +3367,7 @@ func (x *fastReflection_SnapshotExtensionMeta) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SnapshotExtensionMeta) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SnapshotIAVLBaseItem) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3178,7 +3378,7 @@ func (x *fastReflection_SnapshotExtensionMeta) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotExtensionMeta) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SnapshotIAVLBaseItem) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3190,7 +3390,7 @@ func (x *fastReflection_SnapshotExtensionMeta) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SnapshotExtensionMeta) IsValid() bool {
+func (x *fastReflection_SnapshotIAVLBaseItem) IsValid() bool {
 	return x != nil
 }
 
@@ -3200,9 +3400,9 @@ func (x *fastReflection_SnapshotExtensionMeta) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SnapshotIAVLBaseItem) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SnapshotExtensionMeta)
+		x := input.Message.Interface().(*SnapshotIAVLBaseItem)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3214,12 +3414,11 @@ func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Skip != 0 {
+			n += 1 + runtime.Sov(uint64(x.Skip))
 		}
-		if x.Format != 0 {
-			n += 1 + runtime.Sov(uint64(x.Format))
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3231,7 +3430,7 @@ func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotExtensionMeta)
+		x := input.Message.Interface().(*SnapshotIAVLBaseItem)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3250,17 +3449,15 @@ func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Format != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Format))
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if x.Skip != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Skip))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3273,7 +3470,7 @@ func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotExtensionMeta)
+		x := input.Message.Interface().(*SnapshotIAVLBaseItem)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3305,17 +3502,17 @@ func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotExtensionMeta: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLBaseItem: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotExtensionMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLBaseItem: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
 				}
-				var stringLen uint64
+				x.Skip = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3325,29 +3522,16 @@ func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Skip |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 				}
-				x.Format = 0
+				x.Count = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3357,7 +3541,7 @@ func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Format |= uint32(b&0x7F) << shift
+					x.Count |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -3398,15 +3582,483 @@ func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_SnapshotExtensionPayload         protoreflect.MessageDescriptor
-	fd_SnapshotExtensionPayload_payload protoreflect.FieldDescriptor
+	md_SnapshotExtensionMeta        protoreflect.MessageDescriptor
+	fd_SnapshotExtensionMeta_name   protoreflect.FieldDescriptor
+	fd_SnapshotExtensionMeta_format protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
-	md_SnapshotExtensionPayload = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotExtensionPayload")
-	fd_SnapshotExtensionPayload_payload = md_SnapshotExtensionPayload.Fields().ByName("payload")
-}
+	md_SnapshotExtensionMeta = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotExtensionMeta")
+	fd_SnapshotExtensionMeta_name = md_SnapshotExtensionMeta.Fields().ByName("name")
+	fd_SnapshotExtensionMeta_format = md_SnapshotExtensionMeta.Fields().ByName("format")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotExtensionMeta)(nil)
+
+type fastReflection_SnapshotExtensionMeta SnapshotExtensionMeta
+
+func (x *SnapshotExtensionMeta) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotExtensionMeta)(x)
+}
+
+func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotExtensionMeta_messageType fastReflection_SnapshotExtensionMeta_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotExtensionMeta_messageType{}
+
+type fastReflection_SnapshotExtensionMeta_messageType struct{}
+
+func (x fastReflection_SnapshotExtensionMeta_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotExtensionMeta)(nil)
+}
+func (x fastReflection_SnapshotExtensionMeta_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotExtensionMeta)
+}
+func (x fastReflection_SnapshotExtensionMeta_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotExtensionMeta
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotExtensionMeta) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotExtensionMeta
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotExtensionMeta) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotExtensionMeta_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotExtensionMeta) New() protoreflect.Message {
+	return new(fastReflection_SnapshotExtensionMeta)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotExtensionMeta) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotExtensionMeta)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotExtensionMeta) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SnapshotExtensionMeta_name, value) {
+			return
+		}
+	}
+	if x.Format != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Format)
+		if !f(fd_SnapshotExtensionMeta_format, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotExtensionMeta) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		return x.Name != ""
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		return x.Format != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotExtensionMeta) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		x.Name = ""
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		x.Format = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotExtensionMeta) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		value := x.Format
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotExtensionMeta) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		x.Format = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotExtensionMeta) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		panic(fmt.Errorf("field name of message cosmos.store.snapshots.v1.SnapshotExtensionMeta is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		panic(fmt.Errorf("field format of message cosmos.store.snapshots.v1.SnapshotExtensionMeta is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotExtensionMeta) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotExtensionMeta) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotExtensionMeta", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotExtensionMeta) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotExtensionMeta) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotExtensionMeta) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotExtensionMeta)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Format != 0 {
+			n += 1 + runtime.Sov(uint64(x.Format))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotExtensionMeta)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Format != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Format))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotExtensionMeta)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotExtensionMeta: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotExtensionMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				}
+				x.Format = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Format |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotExtensionPayload         protoreflect.MessageDescriptor
+	fd_SnapshotExtensionPayload_payload protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_snapshots_v1_snapshot_proto_init()
+	md_SnapshotExtensionPayload = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotExtensionPayload")
+	fd_SnapshotExtensionPayload_payload = md_SnapshotExtensionPayload.Fields().ByName("payload")
+}
 
 var _ protoreflect.Message = (*fastReflection_SnapshotExtensionPayload)(nil)
 
@@ -3417,7 +4069,7 @@ func (x *SnapshotExtensionPayload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionPayload) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3907,6 +4559,11 @@ type Metadata struct {
 	unknownFields protoimpl.UnknownFields
 
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"` // SHA-256 chunk hashes
+	// base_height is the height of the snapshot an incremental snapshot is based
+	// on, zero for a full snapshot.
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
+	// base_format is the format of the snapshot an incremental snapshot is based on.
+	BaseFormat uint32 `protobuf:"varint,3,opt,name=base_format,json=baseFormat,proto3" json:"base_format,omitempty"`
 }
 
 func (x *Metadata) Reset() {
@@ -3936,6 +4593,20 @@ func (x *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (x *Metadata) GetBaseHeight() uint64 {
+	if x != nil {
+		return x.BaseHeight
+	}
+	return 0
+}
+
+func (x *Metadata) GetBaseFormat() uint32 {
+	if x != nil {
+		return x.BaseFormat
+	}
+	return 0
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	state         protoimpl.MessageState
@@ -3945,11 +4616,11 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are assignable to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_Iavl
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_IavlBase
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -4008,6 +4679,13 @@ func (x *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (x *SnapshotItem) GetIavlBase() *SnapshotIAVLBaseItem {
+	if x, ok := x.GetItem().(*SnapshotItem_IavlBase); ok {
+		return x.IavlBase
+	}
+	return nil
+}
+
 type isSnapshotItem_Item interface {
 	isSnapshotItem_Item()
 }
@@ -4028,6 +4706,10 @@ type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof"`
 }
 
+type SnapshotItem_IavlBase struct {
+	IavlBase *SnapshotIAVLBaseItem `protobuf:"bytes,5,opt,name=iavl_base,json=iavlBase,proto3,oneof"`
+}
+
 func (*SnapshotItem_Store) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_Iavl) isSnapshotItem_Item() {}
@@ -4036,6 +4718,8 @@ func (*SnapshotItem_Extension) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
 
+func (*SnapshotItem_IavlBase) isSnapshotItem_Item() {}
+
 // SnapshotStoreItem contains metadata about a snapshotted store.
 type SnapshotStoreItem struct {
 	state         protoimpl.MessageState
@@ -4134,6 +4818,52 @@ func (x *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLBaseItem references exported IAVL nodes of the base snapshot of an
+// incremental snapshot. The nodes of the current store in the base snapshot are
+// read in order, skipping the first skip nodes and copying the next count nodes.
+type SnapshotIAVLBaseItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Skip  uint64 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SnapshotIAVLBaseItem) Reset() {
+	*x = SnapshotIAVLBaseItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotIAVLBaseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotIAVLBaseItem) ProtoMessage() {}
+
+// Deprecated: Use SnapshotIAVLBaseItem.ProtoReflect.Descriptor instead.
+func (*SnapshotIAVLBaseItem) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SnapshotIAVLBaseItem) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *SnapshotIAVLBaseItem) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 type SnapshotExtensionMeta struct {
 	state         protoimpl.MessageState
@@ -4147,7 +4877,7 @@ type SnapshotExtensionMeta struct {
 func (x *SnapshotExtensionMeta) Reset() {
 	*x = SnapshotExtensionMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4161,7 +4891,7 @@ func (*SnapshotExtensionMeta) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionMeta.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SnapshotExtensionMeta) GetName() string {
@@ -4190,7 +4920,7 @@ type SnapshotExtensionPayload struct {
 func (x *SnapshotExtensionPayload) Reset() {
 	*x = SnapshotExtensionPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4204,7 +4934,7 @@ func (*SnapshotExtensionPayload) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionPayload.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SnapshotExtensionPayload) GetPayload() []byte {
@@ -4235,71 +4965,89 @@ var file_cosmos_store_snapshots_v1_snapshot_proto_rawDesc = []byte{
 	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
 	0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
 	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x48,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xf4, 0x02, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x44, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49,
-	0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x4b, 0x0a, 0x04,
-	0x69, 0x61, 0x76, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
-	0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x08, 0xe2, 0xde, 0x1f, 0x04, 0x49, 0x41, 0x56,
-	0x4c, 0x48, 0x00, 0x52, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x12, 0x50, 0x0a, 0x09, 0x65, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00,
-	0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x11, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a,
-	0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20,
-	0x30, 0x2e, 0x34, 0x36, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3c, 0x0a, 0x11,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x58,
-	0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x49, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x13,
-	0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30,
-	0x2e, 0x34, 0x36, 0x42, 0xed, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x99, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xda, 0xb4, 0x2d,
+	0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35, 0x32,
+	0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x0b,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x13, 0xda, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x20, 0x30, 0x2e, 0x35, 0x32, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x22, 0xd2, 0x03, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x44, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x4b, 0x0a, 0x04, 0x69, 0x61, 0x76,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c,
+	0x49, 0x74, 0x65, 0x6d, 0x42, 0x08, 0xe2, 0xde, 0x1f, 0x04, 0x49, 0x41, 0x56, 0x4c, 0x48, 0x00,
+	0x52, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x12, 0x50, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f,
-	0x76, 0x31, 0x3b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x43, 0x53, 0x53, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x5c, 0x0a, 0x09,
+	0x69, 0x61, 0x76, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x42, 0x61, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x49, 0x41, 0x56, 0x4c, 0x42, 0x61, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x08, 0x69, 0x61, 0x76, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x42,
+	0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3c, 0x0a, 0x11, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x55, 0x0a, 0x14, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x42, 0x61, 0x73, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d,
+	0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35, 0x32,
+	0x22, 0x58, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x49, 0x0a, 0x18, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x20, 0x30, 0x2e, 0x34, 0x36, 0x42, 0xed, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x43, 0x53, 0x53, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
+	0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4314,27 +5062,29 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP() []byte {
 	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescData
 }
 
-var file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_cosmos_store_snapshots_v1_snapshot_proto_goTypes = []interface{}{
 	(*Snapshot)(nil),                 // 0: cosmos.store.snapshots.v1.Snapshot
 	(*Metadata)(nil),                 // 1: cosmos.store.snapshots.v1.Metadata
 	(*SnapshotItem)(nil),             // 2: cosmos.store.snapshots.v1.SnapshotItem
 	(*SnapshotStoreItem)(nil),        // 3: cosmos.store.snapshots.v1.SnapshotStoreItem
 	(*SnapshotIAVLItem)(nil),         // 4: cosmos.store.snapshots.v1.SnapshotIAVLItem
-	(*SnapshotExtensionMeta)(nil),    // 5: cosmos.store.snapshots.v1.SnapshotExtensionMeta
-	(*SnapshotExtensionPayload)(nil), // 6: cosmos.store.snapshots.v1.SnapshotExtensionPayload
+	(*SnapshotIAVLBaseItem)(nil),     // 5: cosmos.store.snapshots.v1.SnapshotIAVLBaseItem
+	(*SnapshotExtensionMeta)(nil),    // 6: cosmos.store.snapshots.v1.SnapshotExtensionMeta
+	(*SnapshotExtensionPayload)(nil), // 7: cosmos.store.snapshots.v1.SnapshotExtensionPayload
 }
 var file_cosmos_store_snapshots_v1_snapshot_proto_depIdxs = []int32{
 	1, // 0: cosmos.store.snapshots.v1.Snapshot.metadata:type_name -> cosmos.store.snapshots.v1.Metadata
 	3, // 1: cosmos.store.snapshots.v1.SnapshotItem.store:type_name -> cosmos.store.snapshots.v1.SnapshotStoreItem
 	4, // 2: cosmos.store.snapshots.v1.SnapshotItem.iavl:type_name -> cosmos.store.snapshots.v1.SnapshotIAVLItem
-	5, // 3: cosmos.store.snapshots.v1.SnapshotItem.extension:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionMeta
-	6, // 4: cosmos.store.snapshots.v1.SnapshotItem.extension_payload:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionPayload
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 3: cosmos.store.snapshots.v1.SnapshotItem.extension:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionMeta
+	7, // 4: cosmos.store.snapshots.v1.SnapshotItem.extension_payload:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionPayload
+	5, // 5: cosmos.store.snapshots.v1.SnapshotItem.iavl_base:type_name -> cosmos.store.snapshots.v1.SnapshotIAVLBaseItem
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_store_snapshots_v1_snapshot_proto_init() }
@@ -4404,7 +5154,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotExtensionMeta); i {
+			switch v := v.(*SnapshotIAVLBaseItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4416,6 +5166,18 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotExtensionMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SnapshotExtensionPayload); i {
 			case 0:
 				return &v.state
@@ -4433,6 +5195,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 		(*SnapshotItem_Iavl)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_IavlBase)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4440,7 +5203,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_store_snapshots_v1_snapshot_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -729,6 +729,10 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 		return nil, err
 	}
 
+	if app.gasProfiler != nil {
+		app.gasProfiler.resetBlock()
+	}
+
 	if app.cms.TracingEnabled() {
 		app.cms.SetTracingContext(storetypes.TraceContext(
 			map[string]any{"blockHeight": req.Height},
@@ -958,6 +962,12 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 
 	app.cms.Commit()
 
+	if app.gasProfiler != nil {
+		if err := app.gasProfiler.commitBlock(header.Height); err != nil {
+			app.logger.Error("failed to report gas profile", "height", header.Height, "err", err)
+		}
+	}
+
 	resp := &abci.CommitResponse{
 		RetainHeight: retainHeight,
 	}
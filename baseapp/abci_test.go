@@ -810,6 +810,9 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 		var simRes sdk.SimulationResponse
 		require.NoError(t, jsonpb.Unmarshal(strings.NewReader(string(queryResult.Value)), &simRes))
 
+		// the JSON response holds an empty gas profile when profiling is disabled.
+		require.Nil(t, gInfo.GasProfile)
+		gInfo.GasProfile = []sdk.GasProfileEntry{}
 		require.Equal(t, gInfo, simRes.GasInfo)
 		require.Equal(t, result.Log, simRes.Result.Log)
 		require.Equal(t, result.Events, simRes.Result.Events)
@@ -60,10 +60,7 @@ func ValidateVoteExtensions(
 	// Start checking vote extensions only **after** the vote extensions enable
 	// height, because when `currentHeight == VoteExtensionsEnableHeight`
 	// PrepareProposal doesn't get any vote extensions in its request.
-	extsEnabled := cp.Feature != nil && cp.Feature.VoteExtensionsEnableHeight != nil && currentHeight > cp.Feature.VoteExtensionsEnableHeight.Value && cp.Feature.VoteExtensionsEnableHeight.Value != 0
-	if !extsEnabled {
-		extsEnabled = cp.Abci != nil && currentHeight > cp.Abci.VoteExtensionsEnableHeight && cp.Abci.VoteExtensionsEnableHeight != 0
-	}
+	extsEnabled := voteExtensionsEnabled(cp, currentHeight)
 	marshalDelimitedFn := func(msg proto.Message) ([]byte, error) {
 		var buf bytes.Buffer
 		if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
@@ -255,6 +252,10 @@ func (h *DefaultProposalHandler) SetTxSelector(ts TxSelector) {
 // - If no mempool is set or if the mempool is a no-op mempool, the transactions
 // requested from CometBFT will simply be returned, which, by default, are in
 // FIFO order.
+//
+// - If the mempool is a mempool.LaneMempool, the lanes are enumerated in turn,
+// the transactions of each lane using at most its share of the block bytes and
+// gas.
 func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
 		var maxBlockGas uint64
@@ -285,80 +286,127 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
 		}
 
-		iterator := h.mempool.Select(ctx, req.Txs)
 		selectedTxsSignersSeqs := make(map[string]uint64)
-		var selectedTxsNums int
-		for iterator != nil {
-			memTx := iterator.Tx()
-			signerData, err := h.signerExtAdapter.GetSigners(memTx)
+		lanes, isLaned := h.mempool.(*mempool.LaneMempool)
+		if !isLaned {
+			if _, err := h.selectTxs(ctx, h.mempool, req, maxBlockGas, nil, selectedTxsSignersSeqs); err != nil {
+				return nil, err
+			}
+
+			return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+		}
+
+		// The txs of each lane are selected in turn, each lane being capped to its
+		// share of the block space. The whole selection is still capped by
+		// req.MaxTxBytes.
+		maxBlockBytes := laneMaxBlockBytes(ctx)
+		for _, lane := range lanes.Lanes() {
+			quota := newLaneQuota(lane, maxBlockBytes, maxBlockGas)
+			stop, err := h.selectTxs(ctx, lane.Mempool, req, maxBlockGas, quota, selectedTxsSignersSeqs)
 			if err != nil {
 				return nil, err
 			}
+			if stop {
+				break
+			}
+		}
 
-			// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
-			// so we add them and continue given that we don't need to check the sequence.
-			shouldAdd := true
-			txSignersSeqs := make(map[string]uint64)
-			for _, signer := range signerData {
-				seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
-				if !ok {
-					txSignersSeqs[signer.Signer.String()] = signer.Sequence
-					continue
-				}
+		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+	}
+}
 
-				// If we have seen this signer before in this block, we must make
-				// sure that the current sequence is seq+1; otherwise is invalid
-				// and we skip it.
-				if seq+1 != signer.Sequence {
-					shouldAdd = false
-					break
-				}
+// selectTxs selects the valid txs of mp for the proposal with the TxSelector,
+// up to the quota if it isn't nil. It returns true if the proposal is full.
+func (h *DefaultProposalHandler) selectTxs(
+	ctx sdk.Context,
+	mp mempool.Mempool,
+	req *abci.PrepareProposalRequest,
+	maxBlockGas uint64,
+	quota *laneQuota,
+	selectedTxsSignersSeqs map[string]uint64,
+) (bool, error) {
+	iterator := mp.Select(ctx, req.Txs)
+	selectedTxsNums := len(h.txSelector.SelectedTxs(ctx))
+	for iterator != nil {
+		memTx := iterator.Tx()
+		signerData, err := h.signerExtAdapter.GetSigners(memTx)
+		if err != nil {
+			return false, err
+		}
+
+		// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
+		// so we add them and continue given that we don't need to check the sequence.
+		shouldAdd := true
+		txSignersSeqs := make(map[string]uint64)
+		for _, signer := range signerData {
+			seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
+			if !ok {
 				txSignersSeqs[signer.Signer.String()] = signer.Sequence
-			}
-			if !shouldAdd {
-				iterator = iterator.Next()
 				continue
 			}
 
-			// NOTE: Since transaction verification was already executed in CheckTx,
-			// which calls mempool.Insert, in theory everything in the pool should be
-			// valid. But some mempool implementations may insert invalid txs, so we
-			// check again.
-			txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
-			if err != nil {
-				err := h.mempool.Remove(memTx)
-				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-					return nil, err
-				}
-			} else {
+			// If we have seen this signer before in this block, we must make
+			// sure that the current sequence is seq+1; otherwise is invalid
+			// and we skip it.
+			if seq+1 != signer.Sequence {
+				shouldAdd = false
+				break
+			}
+			txSignersSeqs[signer.Signer.String()] = signer.Sequence
+		}
+		if !shouldAdd {
+			iterator = iterator.Next()
+			continue
+		}
+
+		// NOTE: Since transaction verification was already executed in CheckTx,
+		// which calls mempool.Insert, in theory everything in the pool should be
+		// valid. But some mempool implementations may insert invalid txs, so we
+		// check again.
+		txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+		if err != nil {
+			err := mp.Remove(memTx)
+			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return false, err
+			}
+		} else {
+			// The txs exceeding the quota of the lane are skipped, smaller ones
+			// may still fit.
+			if quota == nil || quota.fits(memTx, txBz) {
 				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
 				if stop {
-					break
+					return true, nil
 				}
+			}
 
-				txsLen := len(h.txSelector.SelectedTxs(ctx))
-				for sender, seq := range txSignersSeqs {
-					// If txsLen != selectedTxsNums is true, it means that we've
-					// added a new tx to the selected txs, so we need to update
-					// the sequence of the sender.
-					if txsLen != selectedTxsNums {
-						selectedTxsSignersSeqs[sender] = seq
-					} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
-						// The transaction hasn't been added but it passed the
-						// verification, so we know that the sequence is correct.
-						// So we set this sender's sequence to seq-1, in order
-						// to avoid unnecessary calls to PrepareProposalVerifyTx.
-						selectedTxsSignersSeqs[sender] = seq - 1
-					}
+			txsLen := len(h.txSelector.SelectedTxs(ctx))
+			for sender, seq := range txSignersSeqs {
+				// If txsLen != selectedTxsNums is true, it means that we've
+				// added a new tx to the selected txs, so we need to update
+				// the sequence of the sender.
+				if txsLen != selectedTxsNums {
+					selectedTxsSignersSeqs[sender] = seq
+				} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
+					// The transaction hasn't been added but it passed the
+					// verification, so we know that the sequence is correct.
+					// So we set this sender's sequence to seq-1, in order
+					// to avoid unnecessary calls to PrepareProposalVerifyTx.
+					selectedTxsSignersSeqs[sender] = seq - 1
 				}
-				selectedTxsNums = txsLen
 			}
-
-			iterator = iterator.Next()
+			if quota != nil && txsLen != selectedTxsNums {
+				quota.add(memTx, txBz)
+				if quota.full() {
+					return false, nil
+				}
+			}
+			selectedTxsNums = txsLen
 		}
 
-		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+		iterator = iterator.Next()
 	}
+
+	return false, nil
 }
 
 // ProcessProposalHandler returns the default implementation for processing an
@@ -368,6 +416,9 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 // 2. The transaction must be valid (i.e. pass runTx, AnteHandler only)
 //
 // If any transaction fails to pass either condition, the proposal is rejected.
+// If the mempool is a mempool.LaneMempool, the proposal is also rejected if its
+// transactions aren't ordered by lane or if a lane exceeds its share of the
+// block space.
 // Note that step (2) is identical to the validation step performed in
 // DefaultPrepareProposal. It is very important that the same validation logic
 // is used in both steps, and applications must ensure that this is the case in
@@ -380,6 +431,8 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 		return NoOpProcessProposal()
 	}
 
+	lanes, isLaned := h.mempool.(*mempool.LaneMempool)
+
 	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
 		var totalTxGas uint64
 
@@ -388,12 +441,37 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 			maxBlockGas = b.MaxGas
 		}
 
+		var quotas []*laneQuota
+		if isLaned {
+			quotas = make([]*laneQuota, len(lanes.Lanes()))
+			maxBlockBytes := laneMaxBlockBytes(ctx)
+			for i, lane := range lanes.Lanes() {
+				quotas[i] = newLaneQuota(lane, maxBlockBytes, uint64(max(maxBlockGas, 0)))
+			}
+		}
+
+		currentLane := 0
 		for _, txBytes := range req.Txs {
 			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
 			if err != nil {
 				return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
 			}
 
+			// The txs must be ordered by lane, and each lane must not exceed its
+			// share of the block space.
+			if isLaned {
+				i, ok := lanes.LaneIndex(ctx, tx)
+				if !ok || i < currentLane {
+					return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+				}
+				currentLane = i
+
+				if !quotas[i].fits(tx, txBytes) {
+					return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+				}
+				quotas[i].add(tx, txBytes)
+			}
+
 			if maxBlockGas > 0 {
 				gasTx, ok := tx.(GasTx)
 				if ok {
@@ -410,6 +488,63 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 	}
 }
 
+// laneQuota tracks the block space used by the txs of a mempool.Lane.
+type laneQuota struct {
+	maxTxBytes, maxGas     uint64
+	limitTxBytes, limitGas bool
+	txBytes, gas           uint64
+}
+
+// newLaneQuota returns the quota of lane given the block max tx bytes and max gas,
+// where 0 means there is no limit.
+func newLaneQuota(lane mempool.Lane, maxTxBytes, maxBlockGas uint64) *laneQuota {
+	return &laneQuota{
+		maxTxBytes:   lane.Limit(maxTxBytes),
+		maxGas:       lane.Limit(maxBlockGas),
+		limitTxBytes: maxTxBytes > 0,
+		limitGas:     maxBlockGas > 0,
+	}
+}
+
+// fits reports whether tx fits in the remaining space of the lane.
+func (q *laneQuota) fits(tx sdk.Tx, txBz []byte) bool {
+	txSize, txGas := txSpace(tx, txBz)
+	return (!q.limitTxBytes || q.txBytes+txSize <= q.maxTxBytes) && (!q.limitGas || q.gas+txGas <= q.maxGas)
+}
+
+func (q *laneQuota) add(tx sdk.Tx, txBz []byte) {
+	txSize, txGas := txSpace(tx, txBz)
+	q.txBytes += txSize
+	q.gas += txGas
+}
+
+// full reports whether the lane has used all its space.
+func (q *laneQuota) full() bool {
+	return (q.limitTxBytes && q.txBytes >= q.maxTxBytes) || (q.limitGas && q.gas >= q.maxGas)
+}
+
+// txSpace returns the block bytes and gas used by a tx.
+func txSpace(tx sdk.Tx, txBz []byte) (txSize, txGas uint64) {
+	txSize = uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+	if gasTx, ok := tx.(GasTx); ok {
+		txGas = gasTx.GetGas()
+	}
+
+	return txSize, txGas
+}
+
+// laneMaxBlockBytes returns the block bytes the lane shares apply to, which is
+// the consensus max block bytes, or 0 if there is no limit. PrepareProposal and
+// ProcessProposal must apply the shares to the same limit so that the proposals
+// of an honest proposer are never rejected.
+func laneMaxBlockBytes(ctx sdk.Context) uint64 {
+	if b := ctx.ConsensusParams().Block; b != nil && b.MaxBytes > 0 { // nolint:staticcheck // ignore linting error
+		return uint64(b.MaxBytes)
+	}
+
+	return 0
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"bytes"
+	"context"
 	"sort"
 	"testing"
 
@@ -21,6 +22,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	authtx "cosmossdk.io/x/auth/tx"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -709,6 +711,114 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 	}
 }
 
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_LaneMempool() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	// the relayer txs have a lower priority than the default ones, but are
+	// proposed first, within half of the block space.
+	testTxs := []struct {
+		tx       sdk.Tx
+		priority int64
+		bz       []byte
+	}{
+		{tx: buildMsg(s.T(), txConfig, []byte(`r0`), [][]byte{[]byte("secret1")}, []uint64{1}), priority: 1},
+		{tx: buildMsg(s.T(), txConfig, []byte(`r1`), [][]byte{[]byte("secret2")}, []uint64{1}), priority: 2},
+		{tx: buildMsg(s.T(), txConfig, []byte(`r2`), [][]byte{[]byte("secret3")}, []uint64{1}), priority: 3},
+		{tx: buildMsg(s.T(), txConfig, []byte(`d3`), [][]byte{[]byte("secret4")}, []uint64{1}), priority: 10},
+		{tx: buildMsg(s.T(), txConfig, []byte(`d4`), [][]byte{[]byte("secret5")}, []uint64{1}), priority: 20},
+		{tx: buildMsg(s.T(), txConfig, []byte(`d5`), [][]byte{[]byte("secret6")}, []uint64{1}), priority: 30},
+	}
+	for i := range testTxs {
+		bz, err := txConfig.TxEncoder()(testTxs[i].tx)
+		s.Require().NoError(err)
+		testTxs[i].bz = bz
+	}
+	txSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{testTxs[0].bz})
+	for _, tx := range testTxs {
+		s.Require().Equal(txSize, cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx.bz}))
+	}
+
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 4 * txSize, MaxGas: -1},
+	})
+	newMempool := func() *mempool.LaneMempool {
+		newLane := func() mempool.Mempool {
+			return mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+				TxPriority:      mempool.NewDefaultTxPriority(),
+				SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+			})
+		}
+		return mempool.NewLaneMempool(
+			mempool.Lane{
+				Name: "relayer",
+				Match: func(_ context.Context, tx sdk.Tx) bool {
+					return tx.GetMsgs()[0].(*baseapptestutil.MsgKeyValue).Value[0] == 'r'
+				},
+				Mempool:       newLane(),
+				MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+			},
+			mempool.Lane{Name: "default", Mempool: newLane()},
+		)
+	}
+
+	ctrl := gomock.NewController(s.T())
+	app := mock.NewMockProposalTxVerifier(ctrl)
+	mp := newMempool()
+	for _, v := range testTxs {
+		app.EXPECT().PrepareProposalVerifyTx(v.tx).Return(v.bz, nil).AnyTimes()
+		app.EXPECT().ProcessProposalVerifyTx(v.bz).Return(v.tx, nil).AnyTimes()
+		s.Require().NoError(mp.Insert(ctx.WithPriority(v.priority), v.tx))
+	}
+	s.Require().Equal(6, mp.CountTx())
+	ph := baseapp.NewDefaultProposalHandler(mp, app)
+
+	resp, err := ph.PrepareProposalHandler()(ctx, &abci.PrepareProposalRequest{MaxTxBytes: 4 * txSize})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{testTxs[2].bz, testTxs[1].bz, testTxs[5].bz, testTxs[4].bz}, resp.Txs)
+
+	// the shares apply to the consensus max block bytes, as in ProcessProposal,
+	// even if the proposal has less space for txs.
+	resp, err = ph.PrepareProposalHandler()(ctx, &abci.PrepareProposalRequest{MaxTxBytes: 3 * txSize})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{testTxs[2].bz, testTxs[1].bz, testTxs[5].bz}, resp.Txs)
+
+	testCases := map[string]struct {
+		txs    []int
+		status abci.ProcessProposalStatus
+	}{
+		"prepared proposal": {
+			txs:    []int{2, 1, 5, 4},
+			status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"default lane only": {
+			txs:    []int{3, 4, 5},
+			status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"lanes out of order": {
+			txs:    []int{0, 3, 1},
+			status: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"lane exceeds its share": {
+			txs:    []int{0, 1, 2},
+			status: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &abci.ProcessProposalRequest{}
+			for _, i := range tc.txs {
+				req.Txs = append(req.Txs, testTxs[i].bz)
+			}
+			resp, err := ph.ProcessProposalHandler()(ctx, req)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.status, resp.Status)
+		})
+	}
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
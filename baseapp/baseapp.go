@@ -3,6 +3,7 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 	"strconv"
@@ -130,6 +131,10 @@ type BaseApp struct {
 	// application parameter store.
 	paramStore ParamStore
 
+	// gasConfigStore is used to query for the KVStores gas costs, see
+	// SetGasConfigStore.
+	gasConfigStore GasConfigStore
+
 	// queryGasLimit defines the maximum gas for queries; unbounded if 0.
 	queryGasLimit uint64
 
@@ -185,6 +190,9 @@ type BaseApp struct {
 	// including the goroutine handling.This is experimental and must be enabled
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
+
+	// gasProfiler profiles the gas consumed by transactions, if set.
+	gasProfiler *GasProfiler
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -343,6 +351,20 @@ func (app *BaseApp) MountMemoryStores(keys map[string]*storetypes.MemoryStoreKey
 	}
 }
 
+// HasStore reports whether a store of the given key name is mounted in the
+// BaseApp multistore.
+func (app *BaseApp) HasStore(name string) bool {
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return false
+	}
+
+	_, ok = cms.StoreKeysByName()[name]
+	return ok
+}
+
 // MountStore mounts a store to the provided key in the BaseApp multistore,
 // using the default DB.
 func (app *BaseApp) MountStore(key storetypes.StoreKey, typ storetypes.StoreType) {
@@ -488,10 +510,10 @@ func (app *BaseApp) setState(mode execMode, h cmtproto.Header) {
 	}
 	baseState := &state{
 		ms: ms,
-		ctx: sdk.NewContext(ms, false, app.logger).
+		ctx: app.withGasConfigs(sdk.NewContext(ms, false, app.logger).
 			WithStreamingManager(app.streamingManager).
 			WithBlockHeader(h).
-			WithHeaderInfo(headerInfo),
+			WithHeaderInfo(headerInfo)),
 	}
 
 	switch mode {
@@ -513,6 +535,23 @@ func (app *BaseApp) setState(mode execMode, h cmtproto.Header) {
 	}
 }
 
+// withGasConfigs returns ctx with the KVStores gas costs of the BaseApp's
+// GasConfigStore, read from the state of ctx without consuming gas. The default
+// costs are kept if the BaseApp has no GasConfigStore.
+func (app *BaseApp) withGasConfigs(ctx sdk.Context) sdk.Context {
+	if app.gasConfigStore == nil {
+		return ctx
+	}
+
+	defaultConfig, storeConfigs, err := app.gasConfigStore.GasConfigs(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	if err != nil {
+		app.logger.Error("failed to get gas configs", "err", err)
+		return ctx
+	}
+
+	return ctx.WithKVGasConfig(defaultConfig).WithStoreKVGasConfigs(storeConfigs)
+}
+
 // SetCircuitBreaker sets the circuit breaker for the BaseApp.
 // The circuit breaker is checked on every message execution to verify if a transaction should be executed or not.
 func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
@@ -768,7 +807,7 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 			err,
 			gInfo.GasWanted,
 			gInfo.GasUsed,
-			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
+			sdk.MarkEventsToIndex(append(anteEvents, gasProfileEvents(gInfo.GasProfile)...), app.indexEvents),
 			app.trace,
 		)
 		return resp
@@ -779,7 +818,7 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		GasUsed:   int64(gInfo.GasUsed),
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		Events:    sdk.MarkEventsToIndex(append(result.Events, gasProfileEvents(gInfo.GasProfile)...), app.indexEvents),
 	}
 
 	return resp
@@ -827,6 +866,10 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
+	if app.gasProfiler != nil {
+		ctx = ctx.WithGasProfile(sdk.NewGasProfile())
+	}
+
 	// only run the tx if there is block gas remaining
 	if mode == execModeFinalize && ctx.BlockGasMeter().IsOutOfGas() {
 		return gInfo, nil, nil, errorsmod.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
@@ -840,6 +883,12 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		}
 
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
+		if profile := ctx.GasProfile(); profile != nil {
+			gInfo.GasProfile = profile.Entries()
+			if mode == execModeFinalize {
+				app.gasProfiler.block.Merge(gInfo.GasProfile)
+			}
+		}
 	}()
 
 	blockGasConsumed := false
@@ -898,6 +947,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		if mode == execModeSimulate {
 			anteCtx = anteCtx.WithExecMode(sdk.ExecMode(execModeSimulate))
 		}
+		anteCtx.GasProfile().Begin("", anteCtx.GasMeter().GasConsumed())
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
 
 		if !newCtx.IsZero() {
@@ -914,6 +964,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 
 		// GasMeter expected to be set in AnteHandler
 		gasWanted = ctx.GasMeter().Limit()
+		ctx.GasProfile().End(ctx.GasMeter().GasConsumed())
 
 		if err != nil {
 			if mode == execModeReCheck {
@@ -964,7 +1015,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		// Note that the state is still preserved.
 		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
 
+		postCtx.GasProfile().Begin("", postCtx.GasMeter().GasConsumed())
 		newCtx, errPostHandler := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
+		postCtx.GasProfile().End(postCtx.GasMeter().GasConsumed())
 		if errPostHandler != nil {
 			return gInfo, nil, anteEvents, errors.Join(err, errPostHandler)
 		}
@@ -1014,7 +1067,9 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 		}
 
 		// ADR 031 request type routing
+		ctx.GasProfile().Begin(sdk.MsgTypeURL(msg), ctx.GasMeter().GasConsumed())
 		msgResult, err := handler(ctx, msg)
+		ctx.GasProfile().End(ctx.GasMeter().GasConsumed())
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -1163,5 +1218,14 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	// Close the streaming listeners writing to local resources, e.g. files
+	for _, listener := range app.streamingManager.ABCIListeners {
+		if closer, ok := listener.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	return errors.Join(errs...)
 }
@@ -496,6 +496,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		suite.baseApp.SetFauxMerkleMode()
 	})
+	require.Panics(t, func() {
+		suite.baseApp.SetGasConfigStore(nil)
+	})
 }
 
 func TestTxDecoder(t *testing.T) {
@@ -837,6 +840,48 @@ func TestGetEmptyConsensusParams(t *testing.T) {
 	require.Equal(t, uint64(0), suite.baseApp.GetMaximumBlockGas(ctx))
 }
 
+type mockGasConfigStore struct {
+	defaultConfig storetypes.GasConfig
+	storeConfigs  map[string]storetypes.GasConfig
+}
+
+func (m *mockGasConfigStore) GasConfigs(context.Context) (storetypes.GasConfig, map[string]storetypes.GasConfig, error) {
+	return m.defaultConfig, m.storeConfigs, nil
+}
+
+func TestGasConfigStore(t *testing.T) {
+	gasConfigs := &mockGasConfigStore{
+		defaultConfig: storetypes.GasConfig{ReadCostFlat: 10},
+		storeConfigs:  map[string]storetypes.GasConfig{capKey1.Name(): {ReadCostFlat: 1}},
+	}
+	suite := NewBaseAppSuite(t, func(bapp *baseapp.BaseApp) {
+		bapp.SetGasConfigStore(gasConfigs)
+	})
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	ctx := getFinalizeBlockStateCtx(suite.baseApp)
+	require.True(t, suite.baseApp.HasStore(capKey1.Name()))
+	require.False(t, suite.baseApp.HasStore("unknown"))
+	require.Equal(t, gasConfigs.defaultConfig, ctx.KVGasConfig())
+	require.Equal(t, gasConfigs.storeConfigs, ctx.StoreKVGasConfigs())
+
+	meter := storetypes.NewInfiniteGasMeter()
+	ctx.WithGasMeter(meter).KVStore(capKey1).Get([]byte("key"))
+	require.Equal(t, storetypes.Gas(1), meter.GasConsumed())
+
+	// the costs are read again once the block is committed.
+	gasConfigs.storeConfigs = nil
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+	require.Empty(t, getCheckStateCtx(suite.baseApp).StoreKVGasConfigs())
+	require.Equal(t, gasConfigs.defaultConfig, getCheckStateCtx(suite.baseApp).KVGasConfig())
+}
+
 func TestLoadVersionPruning(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOptions := pruningtypes.NewCustomPruningOptions(10, 15)
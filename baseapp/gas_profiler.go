@@ -0,0 +1,80 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EventTypeGasProfile is the type of the events reporting the gas profile of a
+// transaction in its result.
+const EventTypeGasProfile = "gas_profile"
+
+// GasReport defines the gas profile of all the transactions of a block.
+type GasReport struct {
+	Height  int64                 `json:"height"`
+	Entries []sdk.GasProfileEntry `json:"entries"`
+}
+
+// GasProfiler profiles the gas consumed by transactions. The profile of a
+// transaction is returned in its GasInfo, e.g. when simulating it, and as
+// EventTypeGasProfile events in its result. The profiles of the transactions of
+// a block are aggregated and reported once the block is committed.
+type GasProfiler struct {
+	report func(GasReport) error
+	block  *sdk.GasProfile
+}
+
+// NewGasProfiler returns a new GasProfiler calling report with the gas report
+// of each committed block. report may be nil.
+func NewGasProfiler(report func(GasReport) error) *GasProfiler {
+	return &GasProfiler{report: report, block: sdk.NewGasProfile()}
+}
+
+// NewGasReportWriter returns a gas report function writing the reports to w as
+// JSON, one line per block.
+func NewGasReportWriter(w io.Writer) func(GasReport) error {
+	enc := json.NewEncoder(w)
+	return func(report GasReport) error {
+		return enc.Encode(report)
+	}
+}
+
+// resetBlock discards the profiles of the transactions of the block, which is
+// executed again, e.g. after an aborted optimistic execution.
+func (p *GasProfiler) resetBlock() {
+	p.block = sdk.NewGasProfile()
+}
+
+// commitBlock reports the profile of the committed block.
+func (p *GasProfiler) commitBlock(height int64) error {
+	entries := p.block.Entries()
+	p.resetBlock()
+	if p.report == nil {
+		return nil
+	}
+
+	return p.report(GasReport{Height: height, Entries: entries})
+}
+
+// gasProfileEvents returns the events reporting the gas profile of a transaction.
+func gasProfileEvents(entries []sdk.GasProfileEntry) []abci.Event {
+	events := make([]abci.Event, len(entries))
+	for i, e := range entries {
+		events[i] = abci.Event{
+			Type: EventTypeGasProfile,
+			Attributes: []abci.EventAttribute{
+				{Key: "msg_type_url", Value: e.MsgTypeURL},
+				{Key: "store", Value: e.Store},
+				{Key: "operation", Value: e.Operation},
+				{Key: "gas", Value: strconv.FormatUint(e.Gas, 10)},
+			},
+		}
+	}
+
+	return events
+}
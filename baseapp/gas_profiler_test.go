@@ -0,0 +1,94 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasProfiler(t *testing.T) {
+	var reports []baseapp.GasReport
+	profiler := baseapp.NewGasProfiler(func(report baseapp.GasReport) error {
+		reports = append(reports, report)
+		return nil
+	})
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key")))
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetGasProfiler(profiler))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+	require.True(t, res.TxResults[0].IsOK())
+
+	msgTypeURL := sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})
+	var (
+		entries []sdk.GasProfileEntry
+		total   uint64
+	)
+	for _, ev := range res.TxResults[0].Events {
+		if ev.Type != baseapp.EventTypeGasProfile {
+			continue
+		}
+		entry := sdk.GasProfileEntry{}
+		for _, attr := range ev.Attributes {
+			switch attr.Key {
+			case "msg_type_url":
+				entry.MsgTypeURL = attr.Value
+			case "store":
+				entry.Store = attr.Value
+			case "operation":
+				entry.Operation = attr.Value
+			}
+		}
+		entries = append(entries, entry)
+	}
+	require.Contains(t, entries, sdk.GasProfileEntry{Store: capKey1.Name(), Operation: sdk.GasOperationRead})
+	require.Contains(t, entries, sdk.GasProfileEntry{MsgTypeURL: msgTypeURL, Store: capKey1.Name(), Operation: sdk.GasOperationWrite})
+	require.Contains(t, entries, sdk.GasProfileEntry{MsgTypeURL: msgTypeURL, Operation: sdk.GasOperationOther})
+
+	// the block is only reported once committed.
+	require.Empty(t, reports)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	require.Equal(t, int64(1), reports[0].Height)
+	require.Len(t, reports[0].Entries, len(entries))
+	for _, e := range reports[0].Entries {
+		total += e.Gas
+	}
+	require.Equal(t, uint64(res.TxResults[0].GasUsed), total)
+}
+
+func TestGasReportWriter(t *testing.T) {
+	var buf bytes.Buffer
+	report := baseapp.NewGasReportWriter(&buf)
+	entries := []sdk.GasProfileEntry{{MsgTypeURL: "/test", Store: "bank", Operation: sdk.GasOperationRead, Gas: 10}}
+	require.NoError(t, report(baseapp.GasReport{Height: 1, Entries: entries}))
+	require.NoError(t, report(baseapp.GasReport{Height: 2}))
+
+	dec := json.NewDecoder(&buf)
+	var got baseapp.GasReport
+	require.NoError(t, dec.Decode(&got))
+	require.Equal(t, baseapp.GasReport{Height: 1, Entries: entries}, got)
+	require.NoError(t, dec.Decode(&got))
+	require.Equal(t, int64(2), got.Height)
+}
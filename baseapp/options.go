@@ -119,6 +119,11 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetGasProfiler enables gas profiling with the given GasProfiler.
+func SetGasProfiler(profiler *GasProfiler) func(*BaseApp) {
+	return func(app *BaseApp) { app.gasProfiler = profiler }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -136,6 +141,17 @@ func (app *BaseApp) SetParamStore(ps ParamStore) {
 	app.paramStore = ps
 }
 
+// SetGasConfigStore sets the store of the KVStores gas costs on the BaseApp.
+// The costs are read from the state each block starts from, so that updates
+// take effect from the next block.
+func (app *BaseApp) SetGasConfigStore(gs GasConfigStore) {
+	if app.sealed {
+		panic("SetGasConfigStore() on sealed BaseApp")
+	}
+
+	app.gasConfigStore = gs
+}
+
 // SetVersion sets the application's version string.
 func (app *BaseApp) SetVersion(v string) {
 	if app.sealed {
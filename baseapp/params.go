@@ -4,6 +4,8 @@ import (
 	"context"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+
+	storetypes "cosmossdk.io/store/types"
 )
 
 const InitialAppVersion uint64 = 0
@@ -15,3 +17,11 @@ type ParamStore interface {
 	Has(ctx context.Context) (bool, error)
 	Set(ctx context.Context, cp cmtproto.ConsensusParams) error
 }
+
+// GasConfigStore defines the interface the store of the KVStores gas costs used
+// by the BaseApp must fulfill.
+type GasConfigStore interface {
+	// GasConfigs returns the gas costs of the KVStores without costs of their
+	// own and the gas costs of the KVStores by store key name.
+	GasConfigs(ctx context.Context) (storetypes.GasConfig, map[string]storetypes.GasConfig, error)
+}
@@ -1,15 +1,20 @@
 package baseapp
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"path/filepath"
 	"sort"
 	"strings"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/spf13/cast"
 
 	"cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/streaming/file"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
@@ -20,10 +25,20 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingFileTomlKey              = "file"
+	StreamingFileDirTomlKey           = "dir"
+	StreamingFileKeysTomlKey          = "keys"
+	StreamingFileMaxBlocksTomlKey     = "max-blocks"
+	StreamingFileMaxSizeTomlKey       = "max-size"
+	StreamingFileFsyncTomlKey         = "fsync"
+	StreamingFileStopNodeOnErrTomlKey = "stop-node-on-err"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
 func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	var listeners []streamingListener
+
 	// register streaming services
 	streamingCfg := cast.ToStringMap(appOpts.Get(StreamingTomlKey))
 	for service := range streamingCfg {
@@ -35,48 +50,140 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 			if err != nil {
 				return fmt.Errorf("failed to load streaming plugin: %w", err)
 			}
-			if err := app.registerStreamingPlugin(appOpts, keys, plugin); err != nil {
+			listener, err := streamingPluginListener(appOpts, keys, plugin)
+			if err != nil {
 				return fmt.Errorf("failed to register streaming plugin %w", err)
 			}
+			listeners = append(listeners, listener)
 		}
 	}
 
+	// register the file streaming service
+	listener, err := fileStreamingListener(appOpts, keys)
+	if err != nil {
+		return fmt.Errorf("failed to register file streaming service: %w", err)
+	}
+	if listener != nil {
+		listeners = append(listeners, *listener)
+	}
+
+	app.registerABCIListeners(listeners)
 	return nil
 }
 
-// registerStreamingPlugin registers streaming plugins with the BaseApp.
-func (app *BaseApp) registerStreamingPlugin(
+// streamingListener is an ABCIListener with the store keys exposed to it.
+type streamingListener struct {
+	listener      storetypes.ABCIListener
+	storeKeys     []storetypes.StoreKey
+	stopNodeOnErr bool
+}
+
+// streamingPluginListener returns the listener of a streaming plugin.
+func streamingPluginListener(
 	appOpts servertypes.AppOptions,
 	keys map[string]*storetypes.KVStoreKey,
 	streamingPlugin interface{},
-) error {
+) (streamingListener, error) {
 	v, ok := streamingPlugin.(storetypes.ABCIListener)
 	if !ok {
-		return fmt.Errorf("unexpected plugin type %T", v)
+		return streamingListener{}, fmt.Errorf("unexpected plugin type %T", v)
 	}
 
-	app.registerABCIListenerPlugin(appOpts, keys, v)
-	return nil
-}
-
-// registerABCIListenerPlugin registers plugins that implement the ABCIListener interface.
-func (app *BaseApp) registerABCIListenerPlugin(
-	appOpts servertypes.AppOptions,
-	keys map[string]*storetypes.KVStoreKey,
-	abciListener storetypes.ABCIListener,
-) {
 	stopNodeOnErrKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIStopNodeOnErrTomlKey)
-	stopNodeOnErr := cast.ToBool(appOpts.Get(stopNodeOnErrKey))
 	keysKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIKeysTomlKey)
-	exposeKeysStr := cast.ToStringSlice(appOpts.Get(keysKey))
-	exposedKeys := exposeStoreKeysSorted(exposeKeysStr, keys)
-	app.cms.AddListeners(exposedKeys)
-	app.SetStreamingManager(
-		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
-			StopNodeOnErr: stopNodeOnErr,
-		},
-	)
+	return streamingListener{
+		listener:      v,
+		storeKeys:     exposeStoreKeysSorted(cast.ToStringSlice(appOpts.Get(keysKey)), keys),
+		stopNodeOnErr: cast.ToBool(appOpts.Get(stopNodeOnErrKey)),
+	}, nil
+}
+
+// fileStreamingListener returns the listener writing to local files, or nil if
+// no directory is configured. A relative directory is relative to the home
+// directory of the node.
+func fileStreamingListener(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) (*streamingListener, error) {
+	fileKey := func(key string) string {
+		return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingFileTomlKey, key)
+	}
+
+	dir := strings.TrimSpace(cast.ToString(appOpts.Get(fileKey(StreamingFileDirTomlKey))))
+	if dir == "" {
+		return nil, nil
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+	}
+
+	listener, err := file.NewListener(dir, file.Options{
+		MaxBlocks: cast.ToInt64(appOpts.Get(fileKey(StreamingFileMaxBlocksTomlKey))),
+		MaxSize:   cast.ToInt64(appOpts.Get(fileKey(StreamingFileMaxSizeTomlKey))),
+		Fsync:     cast.ToBool(appOpts.Get(fileKey(StreamingFileFsyncTomlKey))),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &streamingListener{
+		listener:      listener,
+		storeKeys:     exposeStoreKeysSorted(cast.ToStringSlice(appOpts.Get(fileKey(StreamingFileKeysTomlKey))), keys),
+		stopNodeOnErr: cast.ToBool(appOpts.Get(fileKey(StreamingFileStopNodeOnErrTomlKey))),
+	}, nil
+}
+
+// registerABCIListeners registers the streaming listeners with the BaseApp. The
+// multi-store listens to the stores exposed to any of them, so the change sets
+// are filtered by the stores exposed to each listener when there are several.
+func (app *BaseApp) registerABCIListeners(listeners []streamingListener) {
+	if len(listeners) == 0 {
+		return
+	}
+
+	manager := storetypes.StreamingManager{}
+	for _, l := range listeners {
+		app.cms.AddListeners(l.storeKeys)
+		listener := l.listener
+		if len(listeners) > 1 {
+			listener = newStoreKeysListener(listener, l.storeKeys)
+		}
+		manager.ABCIListeners = append(manager.ABCIListeners, listener)
+		manager.StopNodeOnErr = manager.StopNodeOnErr || l.stopNodeOnErr
+	}
+	app.SetStreamingManager(manager)
+}
+
+var _ storetypes.ABCIListener = storeKeysListener{}
+
+// storeKeysListener is an ABCIListener only receiving the changes of the given
+// stores.
+type storeKeysListener struct {
+	storetypes.ABCIListener
+	storeKeys map[string]struct{}
+}
+
+func newStoreKeysListener(listener storetypes.ABCIListener, storeKeys []storetypes.StoreKey) storeKeysListener {
+	l := storeKeysListener{ABCIListener: listener, storeKeys: make(map[string]struct{}, len(storeKeys))}
+	for _, key := range storeKeys {
+		l.storeKeys[key.Name()] = struct{}{}
+	}
+	return l
+}
+
+func (l storeKeysListener) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	filtered := make([]*storetypes.StoreKVPair, 0, len(changeSet))
+	for _, pair := range changeSet {
+		if _, ok := l.storeKeys[pair.StoreKey]; ok {
+			filtered = append(filtered, pair)
+		}
+	}
+	return l.ABCIListener.ListenCommit(ctx, res, filtered)
+}
+
+// Close closes the listener if it's an io.Closer.
+func (l storeKeysListener) Close() error {
+	if closer, ok := l.ABCIListener.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
 }
 
 func exposeAll(list []string) bool {
@@ -0,0 +1,166 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+func writeBlocks(t *testing.T, l *Listener, from, to int64) {
+	t.Helper()
+	for h := from; h <= to; h++ {
+		require.NoError(t, l.ListenFinalizeBlock(context.Background(),
+			abci.FinalizeBlockRequest{Height: h, Txs: [][]byte{[]byte(fmt.Sprintf("tx%d", h))}},
+			abci.FinalizeBlockResponse{AppHash: []byte{byte(h)}},
+		))
+		require.NoError(t, l.ListenCommit(context.Background(), abci.CommitResponse{RetainHeight: h - 1}, []*storetypes.StoreKVPair{
+			{StoreKey: "bank", Key: []byte("key"), Value: []byte(fmt.Sprintf("value%d", h))},
+			{StoreKey: "staking", Key: []byte("deleted"), Delete: true},
+		}))
+	}
+}
+
+func readHeights(t *testing.T, dir string, from int64) []int64 {
+	t.Helper()
+	var heights []int64
+	require.NoError(t, Replay(dir, from, func(b *Block) error {
+		heights = append(heights, b.Height())
+		return nil
+	}))
+	return heights
+}
+
+func TestListener(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(dir, Options{MaxBlocks: 3, Fsync: true})
+	require.NoError(t, err)
+
+	require.ErrorContains(t, l.ListenCommit(context.Background(), abci.CommitResponse{}, nil), "no FinalizeBlock")
+
+	writeBlocks(t, l, 1, 7)
+	require.NoError(t, l.Close())
+
+	files, err := listFiles(dir)
+	require.NoError(t, err)
+	require.Equal(t, []blockFile{
+		{path: filepath.Join(dir, "block-00000000000000000001.pb"), height: 1},
+		{path: filepath.Join(dir, "block-00000000000000000004.pb"), height: 4},
+		{path: filepath.Join(dir, "block-00000000000000000007.pb"), height: 7},
+	}, files)
+
+	r, err := NewReader(dir, 0)
+	require.NoError(t, err)
+	block, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), block.Height())
+	require.Equal(t, [][]byte{[]byte("tx1")}, block.FinalizeBlockRequest.Txs)
+	require.Equal(t, []byte{1}, block.FinalizeBlockResponse.AppHash)
+	require.Equal(t, int64(0), block.CommitResponse.RetainHeight)
+	require.Equal(t, []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte("key"), Value: []byte("value1")},
+		{StoreKey: "staking", Key: []byte("deleted"), Delete: true},
+	}, block.ChangeSet)
+	require.NoError(t, r.Close())
+
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7}, readHeights(t, dir, 0))
+	require.Equal(t, []int64{5, 6, 7}, readHeights(t, dir, 5))
+	require.Empty(t, readHeights(t, dir, 8))
+
+	// a restarted listener starts a new file
+	l, err = NewListener(dir, Options{MaxBlocks: 3})
+	require.NoError(t, err)
+	writeBlocks(t, l, 8, 8)
+	require.NoError(t, l.Close())
+	require.Equal(t, []int64{6, 7, 8}, readHeights(t, dir, 6))
+}
+
+func TestListenerMaxSize(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(dir, Options{MaxSize: 1})
+	require.NoError(t, err)
+	writeBlocks(t, l, 1, 3)
+	require.NoError(t, l.Close())
+
+	files, err := listFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, []int64{1, 2, 3}, readHeights(t, dir, 0))
+
+	_, err = NewListener(dir, Options{MaxSize: -1})
+	require.Error(t, err)
+}
+
+func TestReaderPartialBlock(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(dir, Options{})
+	require.NoError(t, err)
+	writeBlocks(t, l, 1, 2)
+	require.NoError(t, l.Close())
+
+	// truncate the last block, e.g. on a crash
+	path := filepath.Join(dir, fileName(1))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-3))
+
+	// the partial block of the last file can't be read yet
+	r, err := NewReader(dir, 0)
+	require.NoError(t, err)
+	block, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), block.Height())
+	_, err = r.Next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.NoError(t, r.Close())
+
+	// the restarted listener writes the block again in a new file, the partial
+	// block is then skipped
+	l, err = NewListener(dir, Options{})
+	require.NoError(t, err)
+	writeBlocks(t, l, 2, 3)
+	require.NoError(t, l.Close())
+	require.Equal(t, []int64{1, 2, 3}, readHeights(t, dir, 0))
+	require.Equal(t, []int64{2, 3}, readHeights(t, dir, 2))
+}
+
+func TestReaderRollback(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewListener(dir, Options{MaxBlocks: 3})
+	require.NoError(t, err)
+	writeBlocks(t, l, 1, 7)
+	require.NoError(t, l.Close())
+
+	// the state is rolled back to height 4, the blocks written after it are
+	// superseded by the ones written again
+	l, err = NewListener(dir, Options{MaxBlocks: 3})
+	require.NoError(t, err)
+	writeBlocks(t, l, 5, 6)
+	require.NoError(t, l.Close())
+
+	files, err := listFiles(dir)
+	require.NoError(t, err)
+	require.Equal(t, []blockFile{
+		{path: filepath.Join(dir, fileName(1)), height: 1},
+		{path: filepath.Join(dir, fileName(4)), height: 4},
+		{path: filepath.Join(dir, fileName(5)), height: 5},
+	}, files)
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6}, readHeights(t, dir, 0))
+	require.Equal(t, []int64{5, 6}, readHeights(t, dir, 5))
+
+	// the blocks of the new file are read
+	r, err := NewReader(dir, 5)
+	require.NoError(t, err)
+	defer r.Close()
+	block, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, fileName(5)), r.file.Name())
+	require.Equal(t, int64(5), block.Height())
+}
@@ -0,0 +1,195 @@
+package file
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Options defines the rotation and durability options of a Listener.
+type Options struct {
+	// MaxBlocks is the number of blocks after which the file is rotated,
+	// unbounded if 0.
+	MaxBlocks int64
+	// MaxSize is the size in bytes after which the file is rotated, unbounded
+	// if 0. A file may exceed it by one block, as blocks aren't split.
+	MaxSize int64
+	// Fsync syncs the file to disk after each block.
+	Fsync bool
+}
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// Listener is an ABCIListener writing the FinalizeBlock request and response
+// and the state changes of each committed block to rotating files of a local
+// directory, to be read back with a Reader.
+//
+// NOTE: Each block is written as two length-prefixed (uvarint) protobuf records:
+// - a ListenFinalizeBlockRequest with the FinalizeBlock request and response
+// - a ListenCommitRequest with the Commit response and the StoreKVPair change set
+// A file holds consecutive blocks and is named after the height of its first block.
+type Listener struct {
+	dir  string
+	opts Options
+
+	mtx           sync.Mutex
+	file          *os.File
+	size          *countingWriter
+	w             *bufio.Writer
+	blocks        int64
+	finalizeBlock *streamingabci.ListenFinalizeBlockRequest
+}
+
+// NewListener returns a new Listener writing to the given directory, created if
+// it doesn't exist.
+func NewListener(dir string, opts Options) (*Listener, error) {
+	if opts.MaxBlocks < 0 || opts.MaxSize < 0 {
+		return nil, errors.New("max blocks and max size must not be negative")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create streaming directory: %w", err)
+	}
+
+	return &Listener{dir: dir, opts: opts}, nil
+}
+
+// ListenFinalizeBlock implements ABCIListener. The FinalizeBlock request and
+// response are kept until the block is committed.
+func (l *Listener) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.finalizeBlock = &streamingabci.ListenFinalizeBlockRequest{Req: &req, Res: &res}
+	return nil
+}
+
+// ListenCommit implements ABCIListener. It writes the committed block, after
+// rotating the file if needed.
+func (l *Listener) ListenCommit(_ context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	finalizeBlock := l.finalizeBlock
+	if finalizeBlock == nil {
+		return errors.New("no FinalizeBlock request for the committed block")
+	}
+	l.finalizeBlock = nil
+	height := finalizeBlock.Req.Height
+
+	if l.file == nil ||
+		(l.opts.MaxBlocks > 0 && l.blocks >= l.opts.MaxBlocks) ||
+		(l.opts.MaxSize > 0 && l.size.n >= l.opts.MaxSize) {
+		if err := l.rotate(height); err != nil {
+			return err
+		}
+	}
+
+	if err := l.writeBlock(finalizeBlock, &streamingabci.ListenCommitRequest{
+		BlockHeight: height,
+		Res:         &res,
+		ChangeSet:   changeSet,
+	}); err != nil {
+		// the file may end with a partial block, the next block starts a new one
+		_ = l.closeFile()
+		return fmt.Errorf("failed to write block %d: %w", height, err)
+	}
+
+	return nil
+}
+
+// writeBlock writes the records of a block to the current file and flushes it.
+func (l *Listener) writeBlock(records ...proto.Message) error {
+	w := protoio.NewDelimitedWriter(l.w)
+	for _, record := range records {
+		if err := w.WriteMsg(record); err != nil {
+			return err
+		}
+	}
+	if err := l.w.Flush(); err != nil {
+		return err
+	}
+	if l.opts.Fsync {
+		if err := l.file.Sync(); err != nil {
+			return err
+		}
+	}
+
+	l.blocks++
+	return nil
+}
+
+// rotate closes the current file, if any, and opens a new one starting at the
+// given height. A file starting at the same height, e.g. written before a
+// rollback of the state, is overwritten, and the files starting after it are
+// removed.
+func (l *Listener) rotate(height int64) error {
+	if err := l.closeFile(); err != nil {
+		return err
+	}
+
+	files, err := listFiles(l.dir)
+	if err != nil {
+		return fmt.Errorf("failed to list streaming files: %w", err)
+	}
+	for _, f := range files {
+		if f.height > height {
+			if err := os.Remove(f.path); err != nil {
+				return fmt.Errorf("failed to remove streaming file: %w", err)
+			}
+		}
+	}
+
+	f, err := os.OpenFile(filepath.Join(l.dir, fileName(height)), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create streaming file: %w", err)
+	}
+	l.file, l.size, l.blocks = f, &countingWriter{w: f}, 0
+	l.w = bufio.NewWriter(l.size)
+	return nil
+}
+
+// closeFile flushes and closes the current file, if any.
+func (l *Listener) closeFile() error {
+	if l.file == nil {
+		return nil
+	}
+
+	f, w := l.file, l.w
+	l.file, l.size, l.w = nil, nil, nil
+	return errors.Join(w.Flush(), f.Sync(), f.Close())
+}
+
+// Close closes the current file. The next committed block, if any, starts a
+// new one.
+func (l *Listener) Close() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.closeFile()
+}
+
+var _ io.Writer = (*countingWriter)(nil)
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,253 @@
+package file
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	filePrefix = "block-"
+	fileSuffix = ".pb"
+
+	// maxRecordSize is the maximum size of a record read from a file.
+	maxRecordSize = 1 << 30
+)
+
+// fileName returns the name of the file starting at the given height.
+func fileName(height int64) string {
+	return fmt.Sprintf("%s%020d%s", filePrefix, height, fileSuffix)
+}
+
+// blockFile is a file written by a Listener.
+type blockFile struct {
+	path   string
+	height int64
+}
+
+// listFiles returns the files written by a Listener in the given directory,
+// sorted by height.
+func listFiles(dir string) ([]blockFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []blockFile
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, fileSuffix) {
+			continue
+		}
+		height, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, filePrefix), fileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		files = append(files, blockFile{path: filepath.Join(dir, name), height: height})
+	}
+	slices.SortFunc(files, func(a, b blockFile) int { return cmp.Compare(a.height, b.height) })
+
+	return files, nil
+}
+
+// Block is a committed block read from the files of a Listener.
+type Block struct {
+	FinalizeBlockRequest  *abci.FinalizeBlockRequest
+	FinalizeBlockResponse *abci.FinalizeBlockResponse
+	CommitResponse        *abci.CommitResponse
+	ChangeSet             []*storetypes.StoreKVPair
+}
+
+// Height returns the height of the block.
+func (b *Block) Height() int64 {
+	return b.FinalizeBlockRequest.Height
+}
+
+// Reader reads the blocks written by a Listener, in order.
+type Reader struct {
+	files      []blockFile
+	fromHeight int64
+
+	file *os.File
+	r    *bufio.Reader
+	// end is the height the current file ends at, the one the next file starts
+	// at, 0 for the last file.
+	end int64
+}
+
+// NewReader returns a new Reader of the blocks written in the given directory,
+// starting at the given height, or the first block if 0. The files before the
+// one holding the starting height aren't read.
+func NewReader(dir string, fromHeight int64) (*Reader, error) {
+	files, err := listFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// skip the files ending before the starting height
+	start := 0
+	for i, f := range files {
+		if f.height <= fromHeight {
+			start = i
+		}
+	}
+
+	return &Reader{files: files[start:], fromHeight: fromHeight}, nil
+}
+
+// Next returns the next block, or io.EOF if there are no more blocks.
+//
+// A file ending with a partial block, e.g. written when the node crashed, is
+// followed by the file the restarted listener wrote, which holds the block
+// again, so the partial block is skipped. Only the last file ending with a
+// partial block returns an error wrapping io.ErrUnexpectedEOF. The blocks of a
+// file from the height the next file starts at are skipped as well, as they
+// were written before a rollback of the state and are superseded by the blocks
+// of the next file.
+func (r *Reader) Next() (*Block, error) {
+	for {
+		if r.r == nil {
+			if len(r.files) == 0 {
+				return nil, io.EOF
+			}
+			if err := r.open(r.files[0]); err != nil {
+				return nil, err
+			}
+			r.files = r.files[1:]
+			r.end = 0
+			if len(r.files) > 0 {
+				r.end = r.files[0].height
+			}
+		}
+
+		block, err := r.readBlock()
+		if errors.Is(err, io.EOF) || (errors.Is(err, io.ErrUnexpectedEOF) && r.end > 0) {
+			if err := r.closeFile(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read block from %s: %w", r.file.Name(), err)
+		}
+		if r.end > 0 && block.Height() >= r.end {
+			if err := r.closeFile(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if block.Height() < r.fromHeight {
+			continue
+		}
+
+		return block, nil
+	}
+}
+
+// readBlock reads the records of the next block of the current file, returning
+// io.EOF at the end of the file.
+func (r *Reader) readBlock() (*Block, error) {
+	var finalizeBlock streamingabci.ListenFinalizeBlockRequest
+	if err := readRecord(r.r, &finalizeBlock); err != nil {
+		return nil, err
+	}
+	var commit streamingabci.ListenCommitRequest
+	if err := readRecord(r.r, &commit); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if finalizeBlock.Req == nil || finalizeBlock.Req.Height != commit.BlockHeight {
+		return nil, fmt.Errorf("mismatched FinalizeBlock and Commit records at height %d", commit.BlockHeight)
+	}
+
+	return &Block{
+		FinalizeBlockRequest:  finalizeBlock.Req,
+		FinalizeBlockResponse: finalizeBlock.Res,
+		CommitResponse:        commit.Res,
+		ChangeSet:             commit.ChangeSet,
+	}, nil
+}
+
+func (r *Reader) open(f blockFile) error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return err
+	}
+	r.file = file
+	r.r = bufio.NewReader(file)
+	return nil
+}
+
+func (r *Reader) closeFile() error {
+	if r.r == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file, r.r = nil, nil
+	return err
+}
+
+// Close closes the file being read.
+func (r *Reader) Close() error {
+	return r.closeFile()
+}
+
+// Replay calls fn with the blocks written in the given directory, in order,
+// starting at the given height, or the first block if 0.
+func Replay(dir string, fromHeight int64, fn func(*Block) error) error {
+	r, err := NewReader(dir, fromHeight)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	for {
+		block, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(block); err != nil {
+			return err
+		}
+	}
+}
+
+// readRecord reads a length-prefixed record into msg. It returns io.EOF only if
+// there are no more records, and io.ErrUnexpectedEOF for a partial record.
+func readRecord(r *bufio.Reader, msg proto.Message) error {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if size > maxRecordSize {
+		return fmt.Errorf("record size %d exceeds the maximum of %d bytes", size, maxRecordSize)
+	}
+
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return proto.Unmarshal(bz, msg)
+}
@@ -3,6 +3,7 @@ package baseapp_test
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -12,7 +13,11 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/streaming/file"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ storetypes.ABCIListener = (*MockABCIListener)(nil)
@@ -146,3 +151,56 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestRegisterStreamingServices_File(t *testing.T) {
+	dir := t.TempDir()
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.file.dir":        "streaming",
+		"streaming.file.keys":       []string{distKey1.Name()},
+		"streaming.file.max-blocks": 2,
+		flags.FlagHome:              dir,
+	}
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	endBlockerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+			ctx.KVStore(distKey1).Set([]byte("distKey"), []byte(fmt.Sprintf("distVal%d", ctx.BlockHeight())))
+			ctx.KVStore(capKey1).Set([]byte("capKey"), []byte("capVal"))
+			return sdk.EndBlock{}, nil
+		})
+	}
+	streamingOpt := func(bapp *baseapp.BaseApp) {
+		require.NoError(t, bapp.RegisterStreamingServices(appOpts, map[string]*storetypes.KVStoreKey{
+			capKey1.Name():  capKey1,
+			distKey1.Name(): distKey1,
+		}))
+	}
+	suite := NewBaseAppSuite(t, distOpt, endBlockerOpt, streamingOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	nBlocks := 3
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: int64(blockN) + 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+	require.NoError(t, suite.baseApp.Close())
+
+	height := int64(0)
+	err = file.Replay(filepath.Join(dir, "streaming"), 0, func(block *file.Block) error {
+		height++
+		require.Equal(t, height, block.Height())
+		require.Equal(t, []*storetypes.StoreKVPair{{
+			StoreKey: distKey1.Name(),
+			Key:      []byte("distKey"),
+			Value:    []byte(fmt.Sprintf("distVal%d", height)),
+		}}, block.ChangeSet)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(nBlocks), height)
+}
@@ -0,0 +1,335 @@
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// VotePayload defines the payload of a module in the vote extension of a
+	// validator, along with the voting power of the validator.
+	VotePayload struct {
+		Validator sdk.ConsAddress
+		Power     int64
+		Data      []byte
+	}
+
+	// VoteExtensionHandlers defines the handlers of a module using vote
+	// extensions, registered in a VoteExtensionRegistry under the key of the
+	// module payload. All the handlers are optional.
+	VoteExtensionHandlers struct {
+		// ExtendVote returns the payload of the module in the vote extension of
+		// the validator. A nil payload is omitted from the vote extension.
+		ExtendVote func(ctx sdk.Context, req *abci.ExtendVoteRequest) ([]byte, error)
+
+		// VerifyVoteExtension verifies the payload of the module in the vote
+		// extension of another validator.
+		VerifyVoteExtension func(ctx sdk.Context, validator sdk.ConsAddress, payload []byte) error
+
+		// Aggregate aggregates the payloads of the validators which committed the
+		// previous block, e.g. by computing their median weighted by voting power.
+		// It must be deterministic as every validator checks the aggregation of the
+		// proposer. A nil result is omitted from the proposal.
+		Aggregate func(ctx sdk.Context, votes []VotePayload) ([]byte, error)
+
+		// PreBlock is called before the block is executed with the aggregation of
+		// the payloads of the module.
+		PreBlock func(ctx sdk.Context, result []byte) error
+	}
+
+	// VoteExtensionRegistry multiplexes the vote extensions of several modules.
+	// The vote extension of a validator carries a payload per module, encoded as
+	// a sdk.MultiplexedVoteExtension. Once vote extensions are enabled, the
+	// proposer aggregates the payloads of the vote extensions of the previous
+	// height per module, and injects the results at the start of the block as a
+	// sdk.InjectedVoteExtensions pseudo transaction. The other validators reject
+	// the proposal if the aggregation doesn't match the vote extensions, and the
+	// results are handed to the modules in PreBlocker.
+	//
+	// Note, FinalizeBlock can't decode the pseudo transaction, so it is reported
+	// in the block results as a failed transaction, with the tx decode error
+	// code. It consumes no gas and doesn't change the state.
+	VoteExtensionRegistry struct {
+		valStore ValidatorStore
+		keys     []string
+		handlers map[string]VoteExtensionHandlers
+	}
+)
+
+// NewVoteExtensionRegistry returns a new VoteExtensionRegistry. The validator
+// store is used to verify the vote extensions signatures.
+func NewVoteExtensionRegistry(valStore ValidatorStore) *VoteExtensionRegistry {
+	return &VoteExtensionRegistry{
+		valStore: valStore,
+		handlers: make(map[string]VoteExtensionHandlers),
+	}
+}
+
+// Register registers the vote extension handlers of a module under the key of
+// its payload. It panics if the key is empty or already registered.
+func (r *VoteExtensionRegistry) Register(key string, handlers VoteExtensionHandlers) {
+	if key == "" {
+		panic("vote extension key cannot be empty")
+	}
+	if _, ok := r.handlers[key]; ok {
+		panic(fmt.Errorf("vote extension handlers already registered for key %s", key))
+	}
+
+	r.handlers[key] = handlers
+	r.keys = append(r.keys, key)
+	slices.Sort(r.keys)
+}
+
+// ExtendVoteHandler returns the ExtendVote handler building the vote extension
+// from the payloads of the modules. A module failing to return its payload is
+// logged and omitted, so that it doesn't prevent the other modules from
+// extending the vote.
+func (r *VoteExtensionRegistry) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+		var ext sdk.MultiplexedVoteExtension
+		for _, key := range r.keys {
+			h := r.handlers[key]
+			if h.ExtendVote == nil {
+				continue
+			}
+
+			payload, err := h.ExtendVote(ctx, req)
+			if err != nil {
+				ctx.Logger().Error("failed to extend vote", "key", key, "height", req.Height, "err", err)
+				continue
+			}
+			if payload != nil {
+				ext.Payloads = append(ext.Payloads, sdk.VoteExtensionPayload{Key: key, Data: payload})
+			}
+		}
+
+		bz, err := ext.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ExtendVoteResponse{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the VerifyVoteExtension handler rejecting
+// the vote extensions which are malformed, which carry the payload of an
+// unknown module or which a module fails to verify.
+func (r *VoteExtensionRegistry) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+		if err := r.verifyVoteExtension(ctx, sdk.ConsAddress(req.ValidatorAddress), req.VoteExtension); err != nil {
+			ctx.Logger().Info("rejected vote extension", "validator", sdk.ConsAddress(req.ValidatorAddress), "height", req.Height, "err", err)
+			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
+		}
+
+		return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
+	}
+}
+
+func (r *VoteExtensionRegistry) verifyVoteExtension(ctx sdk.Context, validator sdk.ConsAddress, voteExt []byte) error {
+	var ext sdk.MultiplexedVoteExtension
+	if err := ext.Unmarshal(voteExt); err != nil {
+		return err
+	}
+
+	for i, payload := range ext.Payloads {
+		if i > 0 && payload.Key <= ext.Payloads[i-1].Key {
+			return fmt.Errorf("vote extension payloads are not sorted by key")
+		}
+
+		h, ok := r.handlers[payload.Key]
+		if !ok {
+			return fmt.Errorf("unknown vote extension key %s", payload.Key)
+		}
+		if h.VerifyVoteExtension == nil {
+			continue
+		}
+		if err := h.VerifyVoteExtension(ctx, validator, payload.Data); err != nil {
+			return fmt.Errorf("invalid %s payload: %w", payload.Key, err)
+		}
+	}
+
+	return nil
+}
+
+// PrepareProposalHandler wraps next to inject the aggregation of the vote
+// extensions at the start of the proposal, once vote extensions are enabled.
+func (r *VoteExtensionRegistry) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+		if !voteExtensionsEnabled(ctx.ConsensusParams(), ctx.HeaderInfo().Height) { // nolint:staticcheck // ignore linting error
+			return next(ctx, req)
+		}
+
+		if err := ValidateVoteExtensions(ctx, r.valStore, req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+
+		extCommit, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		injected := sdk.InjectedVoteExtensions{
+			ExtendedCommitInfo: extCommit,
+			Payloads:           r.aggregate(ctx, req.LocalLastCommit),
+		}
+		injectedTx, err := injected.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		nextReq := *req
+		nextReq.MaxTxBytes -= cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{injectedTx})
+		resp, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{injectedTx}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler wraps next to verify the aggregation of the vote
+// extensions injected at the start of the proposal, once vote extensions are
+// enabled. next processes the rest of the proposal.
+func (r *VoteExtensionRegistry) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		if !voteExtensionsEnabled(ctx.ConsensusParams(), ctx.HeaderInfo().Height) { // nolint:staticcheck // ignore linting error
+			return next(ctx, req)
+		}
+
+		if err := r.verifyInjectedVoteExtensions(ctx, req.Txs); err != nil {
+			ctx.Logger().Error("rejected proposal with invalid vote extensions", "height", req.Height, "err", err)
+			return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[1:]
+		return next(ctx, &nextReq)
+	}
+}
+
+func (r *VoteExtensionRegistry) verifyInjectedVoteExtensions(ctx sdk.Context, txs [][]byte) error {
+	if len(txs) == 0 {
+		return fmt.Errorf("missing injected vote extensions")
+	}
+
+	var injected sdk.InjectedVoteExtensions
+	if err := injected.Unmarshal(txs[0]); err != nil {
+		return err
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(injected.ExtendedCommitInfo); err != nil {
+		return err
+	}
+
+	if err := ValidateVoteExtensions(ctx, r.valStore, extCommit); err != nil {
+		return err
+	}
+
+	payloads := r.aggregate(ctx, extCommit)
+	if len(payloads) != len(injected.Payloads) {
+		return fmt.Errorf("expected %d aggregated payloads, got %d", len(payloads), len(injected.Payloads))
+	}
+	for i, payload := range payloads {
+		if payload.Key != injected.Payloads[i].Key || !bytes.Equal(payload.Data, injected.Payloads[i].Data) {
+			return fmt.Errorf("aggregated %s payload mismatch", payload.Key)
+		}
+	}
+
+	return nil
+}
+
+// aggregate aggregates the payloads of the vote extensions of the validators
+// which committed the previous block, per module. The vote extensions which
+// can't be decoded are ignored, as well as the modules failing to aggregate
+// their payloads.
+func (r *VoteExtensionRegistry) aggregate(ctx sdk.Context, extCommit abci.ExtendedCommitInfo) []sdk.VoteExtensionPayload {
+	votes := make(map[string][]VotePayload, len(r.keys))
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
+		var ext sdk.MultiplexedVoteExtension
+		if err := ext.Unmarshal(vote.VoteExtension); err != nil {
+			continue
+		}
+		for _, payload := range ext.Payloads {
+			votes[payload.Key] = append(votes[payload.Key], VotePayload{
+				Validator: vote.Validator.Address,
+				Power:     vote.Validator.Power,
+				Data:      payload.Data,
+			})
+		}
+	}
+
+	var payloads []sdk.VoteExtensionPayload
+	for _, key := range r.keys {
+		h := r.handlers[key]
+		if h.Aggregate == nil {
+			continue
+		}
+
+		result, err := h.Aggregate(ctx, votes[key])
+		if err != nil {
+			ctx.Logger().Error("failed to aggregate vote extensions", "key", key, "err", err)
+			continue
+		}
+		if result != nil {
+			payloads = append(payloads, sdk.VoteExtensionPayload{Key: key, Data: result})
+		}
+	}
+
+	return payloads
+}
+
+// PreBlocker wraps next to hand the aggregated vote extensions injected in the
+// block to the modules before the block is executed. next may be nil.
+func (r *VoteExtensionRegistry) PreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.FinalizeBlockRequest) error {
+		if voteExtensionsEnabled(ctx.ConsensusParams(), ctx.HeaderInfo().Height) && len(req.Txs) > 0 { // nolint:staticcheck // ignore linting error
+			var injected sdk.InjectedVoteExtensions
+			if err := injected.Unmarshal(req.Txs[0]); err != nil {
+				return fmt.Errorf("failed to decode injected vote extensions: %w", err)
+			}
+
+			for _, payload := range injected.Payloads {
+				h := r.handlers[payload.Key]
+				if h.PreBlock == nil {
+					continue
+				}
+				if err := h.PreBlock(ctx, payload.Data); err != nil {
+					return err
+				}
+			}
+		}
+
+		if next == nil {
+			return nil
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// voteExtensionsEnabled reports whether the proposal at the given height carries
+// the vote extensions of the previous height. When height is equal to
+// VoteExtensionsEnableHeight, PrepareProposal doesn't get any vote extensions
+// in its request.
+func voteExtensionsEnabled(cp cmtproto.ConsensusParams, height int64) bool {
+	if cp.Feature != nil && cp.Feature.VoteExtensionsEnableHeight != nil && height > cp.Feature.VoteExtensionsEnableHeight.Value && cp.Feature.VoteExtensionsEnableHeight.Value != 0 {
+		return true
+	}
+
+	return cp.Abci != nil && height > cp.Abci.VoteExtensionsEnableHeight && cp.Abci.VoteExtensionsEnableHeight != 0 // nolint:staticcheck // ignore linting error
+}
@@ -0,0 +1,193 @@
+package baseapp_test
+
+import (
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+
+	"cosmossdk.io/core/header"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *ABCIUtilsTestSuite) newVoteExtensionRegistry(preBlocked *[]byte) *baseapp.VoteExtensionRegistry {
+	registry := baseapp.NewVoteExtensionRegistry(s.valStore)
+	registry.Register("price", baseapp.VoteExtensionHandlers{
+		ExtendVote: func(sdk.Context, *abci.ExtendVoteRequest) ([]byte, error) {
+			return []byte("10"), nil
+		},
+		VerifyVoteExtension: func(_ sdk.Context, _ sdk.ConsAddress, payload []byte) error {
+			if len(payload) == 0 {
+				return errors.New("empty price")
+			}
+			return nil
+		},
+		// the price of the validator with the most voting power.
+		Aggregate: func(_ sdk.Context, votes []baseapp.VotePayload) ([]byte, error) {
+			var result baseapp.VotePayload
+			for _, vote := range votes {
+				if vote.Power > result.Power {
+					result = vote
+				}
+			}
+			return result.Data, nil
+		},
+		PreBlock: func(_ sdk.Context, result []byte) error {
+			*preBlocked = result
+			return nil
+		},
+	})
+	registry.Register("failing", baseapp.VoteExtensionHandlers{
+		ExtendVote: func(sdk.Context, *abci.ExtendVoteRequest) ([]byte, error) {
+			return nil, errors.New("failure")
+		},
+		Aggregate: func(sdk.Context, []baseapp.VotePayload) ([]byte, error) {
+			return nil, errors.New("failure")
+		},
+	})
+
+	return registry
+}
+
+func (s *ABCIUtilsTestSuite) TestVoteExtensionRegistry_ExtendAndVerifyVote() {
+	registry := s.newVoteExtensionRegistry(new([]byte))
+
+	// the payload of the failing module is omitted.
+	resp, err := registry.ExtendVoteHandler()(s.ctx, &abci.ExtendVoteRequest{Height: 2})
+	s.Require().NoError(err)
+	var ext sdk.MultiplexedVoteExtension
+	s.Require().NoError(ext.Unmarshal(resp.VoteExtension))
+	s.Require().Equal([]sdk.VoteExtensionPayload{{Key: "price", Data: []byte("10")}}, ext.Payloads)
+
+	marshal := func(payloads ...sdk.VoteExtensionPayload) []byte {
+		bz, err := (&sdk.MultiplexedVoteExtension{Payloads: payloads}).Marshal()
+		s.Require().NoError(err)
+		return bz
+	}
+	testCases := map[string]struct {
+		voteExt []byte
+		status  abci.VerifyVoteExtensionStatus
+	}{
+		"valid": {
+			voteExt: resp.VoteExtension,
+			status:  abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+		},
+		"empty": {
+			voteExt: nil,
+			status:  abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+		},
+		"malformed": {
+			voteExt: []byte("malformed"),
+			status:  abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		},
+		"unknown key": {
+			voteExt: marshal(sdk.VoteExtensionPayload{Key: "unknown", Data: []byte("1")}),
+			status:  abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		},
+		"unsorted keys": {
+			voteExt: marshal(sdk.VoteExtensionPayload{Key: "price", Data: []byte("1")}, sdk.VoteExtensionPayload{Key: "failing"}),
+			status:  abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		},
+		"invalid payload": {
+			voteExt: marshal(sdk.VoteExtensionPayload{Key: "price"}),
+			status:  abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			resp, err := registry.VerifyVoteExtensionHandler()(s.ctx, &abci.VerifyVoteExtensionRequest{
+				ValidatorAddress: s.vals[0].consAddr,
+				Height:           2,
+				VoteExtension:    tc.voteExt,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.status, resp.Status)
+		})
+	}
+}
+
+func (s *ABCIUtilsTestSuite) TestVoteExtensionRegistry_Proposal() {
+	var preBlocked []byte
+	registry := s.newVoteExtensionRegistry(&preBlocked)
+
+	// the validators extended their votes of height 2 with different prices.
+	var votes []abci.ExtendedVoteInfo
+	for i, price := range []string{"20", "30", "10"} {
+		ext, err := (&sdk.MultiplexedVoteExtension{Payloads: []sdk.VoteExtensionPayload{{Key: "price", Data: []byte(price)}}}).Marshal()
+		s.Require().NoError(err)
+		bz, err := marshalDelimitedFn(&cmtproto.CanonicalVoteExtension{Extension: ext, Height: 2, ChainId: chainID})
+		s.Require().NoError(err)
+		sig, err := s.vals[i].privKey.Sign(bz)
+		s.Require().NoError(err)
+
+		votes = append(votes, abci.ExtendedVoteInfo{
+			Validator:          s.vals[i].toValidator(int64(333 + i)),
+			VoteExtension:      ext,
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+	}
+	llc, info := extendedCommitToLastCommit(abci.ExtendedCommitInfo{Votes: votes})
+	ctx := s.ctx.WithBlockHeight(3).WithHeaderInfo(header.Info{Height: 3, ChainID: chainID}).WithCometInfo(info)
+
+	tx := []byte("tx")
+	prepare := registry.PrepareProposalHandler(baseapp.NoOpPrepareProposal())
+	resp, err := prepare(ctx, &abci.PrepareProposalRequest{Height: 3, MaxTxBytes: 1000, Txs: [][]byte{tx}, LocalLastCommit: llc})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Txs, 2)
+	s.Require().Equal(tx, resp.Txs[1])
+
+	var injected sdk.InjectedVoteExtensions
+	s.Require().NoError(injected.Unmarshal(resp.Txs[0]))
+	s.Require().Equal([]sdk.VoteExtensionPayload{{Key: "price", Data: []byte("10")}}, injected.Payloads)
+
+	tampered := injected
+	tampered.Payloads = []sdk.VoteExtensionPayload{{Key: "price", Data: []byte("1")}}
+	tamperedTx, err := tampered.Marshal()
+	s.Require().NoError(err)
+
+	process := registry.ProcessProposalHandler(func(_ sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		// the injected vote extensions are stripped from the proposal.
+		s.Require().Equal([][]byte{tx}, req.Txs)
+		return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil
+	})
+	testCases := map[string]struct {
+		txs    [][]byte
+		status abci.ProcessProposalStatus
+	}{
+		"prepared proposal": {
+			txs:    resp.Txs,
+			status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"missing vote extensions": {
+			txs:    nil,
+			status: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"malformed vote extensions": {
+			txs:    [][]byte{tx, tx},
+			status: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"tampered aggregation": {
+			txs:    [][]byte{tamperedTx, tx},
+			status: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			resp, err := process(ctx, &abci.ProcessProposalRequest{Height: 3, Txs: tc.txs})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.status, resp.Status)
+		})
+	}
+
+	s.Require().NoError(registry.PreBlocker(nil)(ctx, &abci.FinalizeBlockRequest{Height: 3, Txs: resp.Txs}))
+	s.Require().Equal([]byte("10"), preBlocked)
+
+	// nothing is injected before vote extensions are enabled.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, ChainID: chainID})
+	resp, err = prepare(ctx, &abci.PrepareProposalRequest{Height: 2, MaxTxBytes: 1000, Txs: [][]byte{tx}})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{tx}, resp.Txs)
+}
@@ -0,0 +1,174 @@
+package debug
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/state"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagStartKey = "start"
+	flagEndKey   = "end"
+)
+
+// StateCmd returns a command browsing the application state decoded through the
+// collections schemas of the modules. It reads the data directory of the node,
+// which must not be running.
+func StateCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Browse the application state decoded through the collections schemas of the modules",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.PersistentFlags().Int64(flags.FlagHeight, 0, "Height of the state to browse, the latest height if 0")
+
+	cmd.AddCommand(
+		stateModulesCmd(appCreator),
+		stateCollectionsCmd(appCreator),
+		stateEntriesCmd(appCreator),
+	)
+
+	return cmd
+}
+
+func stateModulesCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	return &cobra.Command{
+		Use:     "modules",
+		Short:   "List the module stores having a collections schema",
+		Example: fmt.Sprintf("%s debug state modules", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withStateServer(cmd, appCreator, func(ctx sdk.Context, srv state.ServiceServer) error {
+				res, err := srv.Modules(ctx, &state.ModulesRequest{})
+				if err != nil {
+					return err
+				}
+				return printState(cmd, res)
+			})
+		},
+	}
+}
+
+func stateCollectionsCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	return &cobra.Command{
+		Use:     "collections [module]",
+		Short:   "List the collections of a module store",
+		Example: fmt.Sprintf("%s debug state collections bank", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withStateServer(cmd, appCreator, func(ctx sdk.Context, srv state.ServiceServer) error {
+				res, err := srv.Collections(ctx, &state.CollectionsRequest{Module: args[0]})
+				if err != nil {
+					return err
+				}
+				return printState(cmd, res)
+			})
+		},
+	}
+}
+
+func stateEntriesCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entries [module] [collection]",
+		Short: "List the decoded entries of a collection of a module store",
+		Long: `List the decoded entries of a collection of a module store, as JSON.
+The entries can be restricted to a range of keys with --start (inclusive) and --end
+(exclusive), given as JSON encoded keys of the collection.`,
+		Example: fmt.Sprintf(`%s debug state entries bank balances --start '{"k1":"cosmos1...","k2":""}' --limit 10`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startKey, err := cmd.Flags().GetString(flagStartKey)
+			if err != nil {
+				return err
+			}
+			endKey, err := cmd.Flags().GetString(flagEndKey)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return withStateServer(cmd, appCreator, func(ctx sdk.Context, srv state.ServiceServer) error {
+				res, err := srv.Entries(ctx, &state.EntriesRequest{
+					Module:     args[0],
+					Collection: args[1],
+					StartKey:   startKey,
+					EndKey:     endKey,
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+				return printState(cmd, res)
+			})
+		},
+	}
+
+	cmd.Flags().String(flagStartKey, "", "JSON encoded key the entries start from, inclusive")
+	cmd.Flags().String(flagEndKey, "", "JSON encoded key the entries end at, exclusive")
+	flags.AddPaginationFlagsToCmd(cmd, "entries")
+
+	return cmd
+}
+
+// withStateServer loads the application from the data directory of the node and
+// calls fn with a state query server and a context at the height of the command.
+func withStateServer[T servertypes.Application](
+	cmd *cobra.Command,
+	appCreator servertypes.AppCreator[T],
+	fn func(ctx sdk.Context, srv state.ServiceServer) error,
+) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+
+	height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+	if err != nil {
+		return err
+	}
+
+	db, err := server.OpenDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	app := appCreator(log.NewNopLogger(), db, nil, serverCtx.Viper)
+	s, ok := any(app).(HasCollectionsSchemas)
+	if !ok {
+		return errors.New("the application doesn't expose the collections schemas of its modules")
+	}
+
+	cms := app.CommitMultiStore()
+	if height == 0 {
+		height = cms.LastCommitID().Version
+		if height == 0 {
+			return errors.New("the application has no committed state")
+		}
+	}
+	ms, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return fmt.Errorf("failed to load state at height %d: %w", height, err)
+	}
+
+	ctx := sdk.NewContext(ms, false, log.NewNopLogger()).WithBlockHeight(height)
+	return fn(ctx, state.NewQueryServer(kvStoreKeys(cms), s.CollectionsSchemas()))
+}
+
+func printState(cmd *cobra.Command, res proto.Message) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	return clientCtx.WithOutputFormat(flags.OutputFormatJSON).PrintProto(res)
+}
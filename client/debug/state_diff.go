@@ -0,0 +1,314 @@
+package debug
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagFrom  = "from"
+	flagTo    = "to"
+	flagStore = "store"
+)
+
+// HasCollectionsSchemas is implemented by applications exposing the collections
+// schemas of their modules, by store key name. They are used to decode the keys
+// and values of the state diffs.
+type HasCollectionsSchemas interface {
+	CollectionsSchemas() map[string]collections.Schema
+}
+
+// StateDiffCmd returns a command listing the keys added, modified and deleted in
+// the application state between two heights.
+func StateDiffCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff",
+		Short: "List the keys added, modified and deleted in the application state between two heights",
+		Long: `List the keys added, modified and deleted in the application state between two heights.
+The keys and values are decoded through the collections schemas of the modules when
+possible, and printed in hex otherwise. Both heights must not be pruned.`,
+		Example: fmt.Sprintf("%s debug state-diff --from 100 --to 101 --store bank", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			from, err := cmd.Flags().GetInt64(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagTo)
+			if err != nil {
+				return err
+			}
+			if from <= 0 || to <= 0 {
+				return errors.New("both --from and --to heights must be positive")
+			}
+			storeName, err := cmd.Flags().GetString(flagStore)
+			if err != nil {
+				return err
+			}
+
+			db, err := server.OpenDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(log.NewNopLogger(), db, nil, ctx.Viper)
+			var schemas map[string]collections.Schema
+			if s, ok := any(app).(HasCollectionsSchemas); ok {
+				schemas = s.CollectionsSchemas()
+			}
+
+			return StateDiff(cmd.OutOrStdout(), app.CommitMultiStore(), from, to, storeName, schemas)
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "Height of the state to compare from")
+	cmd.Flags().Int64(flagTo, 0, "Height of the state to compare to")
+	cmd.Flags().String(flagStore, "", "Name of the store to compare, all stores if empty")
+	_ = cmd.MarkFlagRequired(flagFrom)
+	_ = cmd.MarkFlagRequired(flagTo)
+
+	return cmd
+}
+
+// StateDiff writes to w the keys added, modified and deleted between the states
+// of the multi-store at the from and to heights, for the given store or all the
+// stores if storeName is empty. The keys of the stores having a schema are
+// decoded through their collections.
+func StateDiff(
+	w io.Writer,
+	cms storetypes.CommitMultiStore,
+	from, to int64,
+	storeName string,
+	schemas map[string]collections.Schema,
+) error {
+	keys := kvStoreKeys(cms)
+	if storeName != "" {
+		keys = slices.DeleteFunc(keys, func(key storetypes.StoreKey) bool { return key.Name() != storeName })
+		if len(keys) == 0 {
+			return fmt.Errorf("store %s not found", storeName)
+		}
+	}
+
+	fromStores, err := kvStoresAt(cms, from, keys)
+	if err != nil {
+		return err
+	}
+	toStores, err := kvStoresAt(cms, to, keys)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		decoder := newStateDecoder(schemas[key.Name()])
+
+		var added, modified, deleted int
+		err := diffKVStores(fromStores[key], toStores[key], func(k, fromValue, toValue []byte) error {
+			coll, keyStr := decoder.decodeKey(k)
+			var err error
+			switch {
+			case fromValue == nil:
+				added++
+				_, err = fmt.Fprintf(w, "+ [%s] %s%s\n    to:   %s\n", key.Name(), coll, keyStr, decoder.decodeValue(k, toValue))
+			case toValue == nil:
+				deleted++
+				_, err = fmt.Fprintf(w, "- [%s] %s%s\n    from: %s\n", key.Name(), coll, keyStr, decoder.decodeValue(k, fromValue))
+			default:
+				modified++
+				_, err = fmt.Fprintf(w, "~ [%s] %s%s\n    from: %s\n    to:   %s\n", key.Name(), coll, keyStr,
+					decoder.decodeValue(k, fromValue), decoder.decodeValue(k, toValue))
+			}
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if added+modified+deleted > 0 {
+			if _, err := fmt.Fprintf(w, "[%s] %d added, %d modified, %d deleted\n", key.Name(), added, modified, deleted); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// kvStoreKeys returns the keys of the persistent stores of the multi-store,
+// sorted by name.
+func kvStoreKeys(cms storetypes.CommitMultiStore) []storetypes.StoreKey {
+	var keys []storetypes.StoreKey
+	if s, ok := cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	}); ok {
+		for _, key := range s.StoreKeysByName() {
+			if _, ok := key.(*storetypes.KVStoreKey); ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.SortFunc(keys, func(a, b storetypes.StoreKey) int { return strings.Compare(a.Name(), b.Name()) })
+	return keys
+}
+
+// kvStoresAt returns the stores of the given keys at the given height, without
+// the stores which don't exist at this height, e.g. added by a later upgrade.
+func kvStoresAt(cms storetypes.CommitMultiStore, height int64, keys []storetypes.StoreKey) (map[storetypes.StoreKey]storetypes.KVStore, error) {
+	ms, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state at height %d: %w", height, err)
+	}
+
+	// the stores missing from the commit info didn't exist at this height
+	var exists map[string]bool
+	if s, ok := cms.(interface {
+		GetCommitInfo(int64) (*storetypes.CommitInfo, error)
+	}); ok {
+		cInfo, err := s.GetCommitInfo(height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load commit info at height %d: %w", height, err)
+		}
+		exists = make(map[string]bool, len(cInfo.StoreInfos))
+		for _, storeInfo := range cInfo.StoreInfos {
+			exists[storeInfo.Name] = true
+		}
+	}
+
+	stores := make(map[storetypes.StoreKey]storetypes.KVStore, len(keys))
+	for _, key := range keys {
+		if exists == nil || exists[key.Name()] {
+			stores[key] = ms.GetKVStore(key)
+		}
+	}
+	return stores, nil
+}
+
+// diffKVStores calls fn for each key which differs between the from and to
+// stores, in key order, with a nil value for the missing side. A nil store is
+// considered empty, e.g. a store which doesn't exist yet at the from height.
+func diffKVStores(from, to storetypes.KVStore, fn func(key, fromValue, toValue []byte) error) error {
+	fromIter, toIter := newIterator(from), newIterator(to)
+	if fromIter != nil {
+		defer fromIter.Close()
+	}
+	if toIter != nil {
+		defer toIter.Close()
+	}
+
+	valid := func(iter storetypes.Iterator) bool { return iter != nil && iter.Valid() }
+	for valid(fromIter) || valid(toIter) {
+		var cmp int
+		switch {
+		case !valid(fromIter):
+			cmp = 1
+		case !valid(toIter):
+			cmp = -1
+		default:
+			cmp = bytes.Compare(fromIter.Key(), toIter.Key())
+		}
+
+		var err error
+		switch {
+		case cmp < 0:
+			err = fn(fromIter.Key(), fromIter.Value(), nil)
+			fromIter.Next()
+		case cmp > 0:
+			err = fn(toIter.Key(), nil, toIter.Value())
+			toIter.Next()
+		default:
+			if !bytes.Equal(fromIter.Value(), toIter.Value()) {
+				err = fn(fromIter.Key(), fromIter.Value(), toIter.Value())
+			}
+			fromIter.Next()
+			toIter.Next()
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newIterator(store storetypes.KVStore) storetypes.Iterator {
+	if store == nil {
+		return nil
+	}
+	return store.Iterator(nil, nil)
+}
+
+// stateDecoder decodes the keys and values of a store through the collections of
+// its schema, falling back to hex.
+type stateDecoder struct {
+	colls []collections.Collection
+}
+
+func newStateDecoder(schema collections.Schema) stateDecoder {
+	return stateDecoder{colls: schema.ListCollections()}
+}
+
+// collection returns the collection the key belongs to, if any.
+func (d stateDecoder) collection(key []byte) (collections.Collection, bool) {
+	for _, coll := range d.colls {
+		if bytes.HasPrefix(key, coll.GetPrefix()) {
+			return coll, true
+		}
+	}
+	return nil, false
+}
+
+// decodeKey returns the name of the collection of the key, followed by a space,
+// and the decoded key, or an empty name and the hex key if it can't be decoded.
+func (d stateDecoder) decodeKey(key []byte) (coll, keyStr string) {
+	c, ok := d.collection(key)
+	if !ok {
+		return "", fmt.Sprintf("%X", key)
+	}
+
+	kc := c.KeyCodec()
+	k, err := kc.Decode(key[len(c.GetPrefix()):])
+	if err != nil {
+		return c.GetName() + " ", fmt.Sprintf("%X", key)
+	}
+	s, err := kc.Stringify(k)
+	if err != nil {
+		return c.GetName() + " ", fmt.Sprintf("%X", key)
+	}
+	return c.GetName() + " ", s
+}
+
+// decodeValue returns the decoded value of the key, or the hex value if it can't
+// be decoded.
+func (d stateDecoder) decodeValue(key, value []byte) string {
+	c, ok := d.collection(key)
+	if !ok {
+		return fmt.Sprintf("%X", value)
+	}
+
+	vc := c.ValueCodec()
+	v, err := vc.Decode(value)
+	if err != nil {
+		return fmt.Sprintf("%X", value)
+	}
+	s, err := vc.Stringify(v)
+	if err != nil {
+		return fmt.Sprintf("%X", value)
+	}
+	return s
+}
@@ -0,0 +1,92 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+func TestStateDiff(t *testing.T) {
+	db := dbm.NewMemDB()
+	bankKey, newKey := storetypes.NewKVStoreKey("bank"), storetypes.NewKVStoreKey("new")
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(bankKey))
+	collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	balance := func(name string, amount uint64) ([]byte, []byte) {
+		return append([]byte{1}, name...), binary.BigEndian.AppendUint64(nil, amount)
+	}
+
+	// height 1, only the bank store exists
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	bank := rs.GetKVStore(bankKey)
+	bank.Set(balance("alice", 10))
+	bank.Set(balance("bob", 20))
+	bank.Set(balance("carol", 30))
+	bank.Set([]byte{2}, []byte{0xab})
+	rs.Commit()
+
+	// height 2, the new store is added
+	rs = rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(newKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersionAndUpgrade(&storetypes.StoreUpgrades{Added: []string{"new"}}))
+	bank = rs.GetKVStore(bankKey)
+	bank.Set(balance("alice", 15))
+	bank.Delete(append([]byte{1}, "bob"...))
+	bank.Set(balance("dave", 40))
+	bank.Set([]byte{2}, []byte{0xcd})
+	rs.GetKVStore(newKey).Set([]byte("key"), []byte("value"))
+	rs.Commit()
+
+	schemas := map[string]collections.Schema{"bank": schema}
+
+	var buf bytes.Buffer
+	require.NoError(t, StateDiff(&buf, rs, 1, 2, "", schemas))
+	require.Equal(t, `~ [bank] balances alice
+    from: 10
+    to:   15
+- [bank] balances bob
+    from: 20
++ [bank] balances dave
+    to:   40
+~ [bank] 02
+    from: AB
+    to:   CD
+[bank] 1 added, 2 modified, 1 deleted
++ [new] 6B6579
+    to:   76616C7565
+[new] 1 added, 0 modified, 0 deleted
+`, buf.String())
+
+	// the diff is reversed between the heights in the other order
+	buf.Reset()
+	require.NoError(t, StateDiff(&buf, rs, 2, 1, "new", schemas))
+	require.Equal(t, `- [new] 6B6579
+    from: 76616C7565
+[new] 0 added, 0 modified, 1 deleted
+`, buf.String())
+
+	// no diff at the same height
+	buf.Reset()
+	require.NoError(t, StateDiff(&buf, rs, 2, 2, "", schemas))
+	require.Empty(t, buf.String())
+
+	require.ErrorContains(t, StateDiff(&buf, rs, 1, 2, "unknown", schemas), "store unknown not found")
+	require.Error(t, StateDiff(&buf, rs, 1, 3, "", schemas))
+}
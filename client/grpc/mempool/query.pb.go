@@ -0,0 +1,2223 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/mempool/v1beta1/query.proto
+
+package mempool
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Type defines the type of an Event.
+type Event_Type int32
+
+const (
+	// TYPE_UNSPECIFIED defines an unspecified event type.
+	Event_TYPE_UNSPECIFIED Event_Type = 0
+	// TYPE_INSERTED is emitted when a transaction is inserted into the mempool.
+	Event_TYPE_INSERTED Event_Type = 1
+	// TYPE_REMOVED is emitted when a transaction is removed from the mempool,
+	// including when it is evicted or replaced by another transaction.
+	Event_TYPE_REMOVED Event_Type = 2
+)
+
+var Event_Type_name = map[int32]string{
+	0: "TYPE_UNSPECIFIED",
+	1: "TYPE_INSERTED",
+	2: "TYPE_REMOVED",
+}
+
+var Event_Type_value = map[string]int32{
+	"TYPE_UNSPECIFIED": 0,
+	"TYPE_INSERTED":    1,
+	"TYPE_REMOVED":     2,
+}
+
+func (x Event_Type) String() string {
+	return proto.EnumName(Event_Type_name, int32(x))
+}
+
+func (Event_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fca092bb163b3669, []int{8, 0}
+}
+
+// PendingTx describes a transaction pending in the mempool.
+type PendingTx struct {
+	// hash is the hex encoded hash of the transaction.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// sender is the address of the first signer of the transaction.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// nonce is the sequence of the first signer of the transaction.
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// priority is the priority of the transaction, it is only set by mempools
+	// ordering transactions by priority.
+	Priority int64 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *PendingTx) Reset()         { *m = PendingTx{} }
+func (m *PendingTx) String() string { return proto.CompactTextString(m) }
+func (*PendingTx) ProtoMessage()    {}
+func (*PendingTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fca092bb163b3669, []int{0}
+}
+func (m *PendingTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTx.Merge(m, src)
+}
+func (m *PendingTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTx proto.InternalMessageInfo
+
+func (m *PendingTx) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *PendingTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingTx) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *PendingTx) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+// CountRequest is the request type for the Service.Count RPC method.
+type CountRequest struct {
+}
+
+func (m *CountRequest) Reset()         { *m = CountRequest{} }
+func (m *CountRequest) String() string { return proto.CompactTextString(m) }
+func (*CountRequest) ProtoMessage()    {}
+func (*CountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fca092bb163b3669, []int{1}
+}
+func (m *CountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountRequest.Merge(m, src)
+}
+func (m *CountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountRequest proto.InternalMessageInfo
+
+// CountResponse is the response type for the Service.Count RPC method.
+type CountResponse struct {
+	// txs is the number of transactions in the mempool.
+	Txs uint64 `protobuf:"varint,1,opt,name=txs,proto3" json:"txs,omitempty"`
+	// senders is the number of senders with transactions in the mempool.
+	Senders uint64 `protobuf:"varint,2,opt,name=senders,proto3" json:"senders,omitempty"`
+}
+
+func (m *CountResponse) Reset()         { *m = CountResponse{} }
+func (m *CountResponse) String() string { return proto.CompactTextString(m) }
+func (*CountResponse) ProtoMessage()    {}
+func (*CountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fca092bb163b3669, []int{2}
+}
+func (m *CountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountResponse.Merge(m, src)
+}
+func (m *CountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountResponse proto.InternalMessageInfo
+
+func (m *CountResponse) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func (m *CountResponse) GetSenders() uint64 {
+	if m != nil {
+		return m.Senders
+	}
+	return 0
+}
+
+// PendingTxsRequest is the request type for the Service.PendingTxs RPC method.
+type PendingTxsRequest struct {
+	// pagination defines an optional pagination for the request, only offset
+	// based pagination is supported.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PendingTxsRequest) Reset()         { *m = PendingTxsRequest{} }
+func (m *PendingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingTxsRequest) ProtoMessage()    {}
+func (*PendingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fca092bb163b3669, []int{3}
+}
+func (m *PendingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxsRequest.Merge(m, src)
+}
+func (m *PendingTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxsRequest proto.InternalMessageInfo
+
+func (m *PendingTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PendingTxsResponse is the response type for the Service.PendingTxs RPC method.
+type PendingTxsResponse struct {
+	Txs []*PendingTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PendingTxsResponse) Reset()         { *m = PendingTxsResponse{} }
+func (m *PendingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingTxsResponse) ProtoMessage()    {}
+func (*PendingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fca092bb163b3669, []int{4}
+}
+func (m *PendingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxsResponse.Merge(m, src)
+}
+func (m *PendingTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxsResponse proto.InternalMessageInfo
+
+func (m *PendingTxsResponse) GetTxs() []*PendingTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *PendingTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// SenderTxsRequest is the request type for the Service.SenderTxs RPC method.
+type SenderTxsRequest struct {
+	// sender is the address of the sender to query the transactions of.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *SenderTxsRequest) Reset()         { *m = SenderTxsRequest{} }
+func (m *SenderTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*SenderTxsRequest) ProtoMessage()    {}
+func (*SenderTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fca092bb163b3669, []int{5}
+}
+func (m *SenderTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SenderTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderTxsRequest.Merge(m, src)
+}
+func (m *SenderTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SenderTxsRequest proto.InternalMessageInfo
+
+func (m *SenderTxsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// SenderTxsResponse is the response type for the Service.SenderTxs RPC method.
+type SenderTxsResponse struct {
+	Txs []*PendingTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *SenderTxsResponse) Reset()         { *m = SenderTxsResponse{} }
+func (m *SenderTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*SenderTxsResponse) ProtoMessage()    {}
+func (*SenderTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fca092bb163b3669, []int{6}
+}
+func (m *SenderTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SenderTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderTxsResponse.Merge(m, src)
+}
+func (m *SenderTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SenderTxsResponse proto.InternalMessageInfo
+
+func (m *SenderTxsResponse) GetTxs() []*PendingTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+// SubscribeRequest is the request type for the Service.Subscribe RPC method.
+type SubscribeRequest struct {
+	// sender optionally restricts the events to the transactions of sender.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fca092bb163b3669, []int{7}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// Event describes a transaction inserted into or removed from the mempool.
+type Event struct {
+	EventType Event_Type `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=cosmos.base.mempool.v1beta1.Event_Type" json:"event_type,omitempty"`
+	Tx        *PendingTx `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fca092bb163b3669, []int{8}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetEventType() Event_Type {
+	if m != nil {
+		return m.EventType
+	}
+	return Event_TYPE_UNSPECIFIED
+}
+
+func (m *Event) GetTx() *PendingTx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.base.mempool.v1beta1.Event_Type", Event_Type_name, Event_Type_value)
+	proto.RegisterType((*PendingTx)(nil), "cosmos.base.mempool.v1beta1.PendingTx")
+	proto.RegisterType((*CountRequest)(nil), "cosmos.base.mempool.v1beta1.CountRequest")
+	proto.RegisterType((*CountResponse)(nil), "cosmos.base.mempool.v1beta1.CountResponse")
+	proto.RegisterType((*PendingTxsRequest)(nil), "cosmos.base.mempool.v1beta1.PendingTxsRequest")
+	proto.RegisterType((*PendingTxsResponse)(nil), "cosmos.base.mempool.v1beta1.PendingTxsResponse")
+	proto.RegisterType((*SenderTxsRequest)(nil), "cosmos.base.mempool.v1beta1.SenderTxsRequest")
+	proto.RegisterType((*SenderTxsResponse)(nil), "cosmos.base.mempool.v1beta1.SenderTxsResponse")
+	proto.RegisterType((*SubscribeRequest)(nil), "cosmos.base.mempool.v1beta1.SubscribeRequest")
+	proto.RegisterType((*Event)(nil), "cosmos.base.mempool.v1beta1.Event")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/mempool/v1beta1/query.proto", fileDescriptor_fca092bb163b3669)
+}
+
+var fileDescriptor_fca092bb163b3669 = []byte{
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xd1, 0x6b, 0xd3, 0x5e,
+	0x14, 0xee, 0x4d, 0xb3, 0xed, 0xd7, 0xf3, 0xdb, 0x46, 0x76, 0x19, 0x52, 0xa2, 0x84, 0x12, 0xc4,
+	0x75, 0x95, 0x25, 0xdb, 0x04, 0x11, 0x7c, 0xd2, 0x2d, 0x93, 0x09, 0x9b, 0x25, 0xad, 0x82, 0xfa,
+	0x30, 0x93, 0xec, 0x92, 0x05, 0xd7, 0x7b, 0xb3, 0xdc, 0xdb, 0xd1, 0x22, 0xbe, 0xf8, 0xe8, 0x93,
+	0xe0, 0xc3, 0x9e, 0xfc, 0x27, 0xfc, 0x2b, 0xc4, 0xa7, 0x81, 0x2f, 0x3e, 0xca, 0xe6, 0x1f, 0x22,
+	0xbd, 0x49, 0xb3, 0xac, 0x42, 0x5b, 0xf0, 0x29, 0xe7, 0x5c, 0xbe, 0x73, 0xbe, 0xef, 0x9c, 0xf3,
+	0x11, 0x58, 0x09, 0x18, 0xef, 0x30, 0x6e, 0xfb, 0x1e, 0x27, 0x76, 0x87, 0x74, 0x62, 0xc6, 0x8e,
+	0xed, 0xd3, 0x0d, 0x9f, 0x08, 0x6f, 0xc3, 0x3e, 0xe9, 0x92, 0xa4, 0x6f, 0xc5, 0x09, 0x13, 0x0c,
+	0xdf, 0x4c, 0x81, 0xd6, 0x00, 0x68, 0x65, 0x40, 0x2b, 0x03, 0xea, 0xb7, 0x42, 0xc6, 0xc2, 0x63,
+	0x62, 0x7b, 0x71, 0x64, 0x7b, 0x94, 0x32, 0xe1, 0x89, 0x88, 0x51, 0x9e, 0x96, 0xea, 0x8d, 0x22,
+	0x87, 0xec, 0x99, 0x33, 0xc4, 0x5e, 0x18, 0x51, 0x09, 0x4e, 0xb1, 0x66, 0x04, 0x95, 0x26, 0xa1,
+	0x87, 0x11, 0x0d, 0xdb, 0x3d, 0x8c, 0x41, 0x3d, 0xf2, 0xf8, 0x51, 0x15, 0xd5, 0x50, 0xbd, 0xe2,
+	0xca, 0x18, 0xdf, 0x80, 0x59, 0x4e, 0xe8, 0x21, 0x49, 0xaa, 0x8a, 0x7c, 0xcd, 0x32, 0xbc, 0x0c,
+	0x33, 0x94, 0xd1, 0x80, 0x54, 0xcb, 0x35, 0x54, 0x57, 0xdd, 0x34, 0xc1, 0x3a, 0xfc, 0x17, 0x27,
+	0x11, 0x4b, 0x22, 0xd1, 0xaf, 0xaa, 0x35, 0x54, 0x2f, 0xbb, 0x79, 0x6e, 0x2e, 0xc2, 0xfc, 0x16,
+	0xeb, 0x52, 0xe1, 0x92, 0x93, 0x2e, 0xe1, 0xc2, 0x7c, 0x08, 0x0b, 0x59, 0xce, 0x63, 0x46, 0x39,
+	0xc1, 0x1a, 0x94, 0x45, 0x8f, 0x4b, 0x76, 0xd5, 0x1d, 0x84, 0xb8, 0x0a, 0x73, 0x29, 0x1d, 0x97,
+	0xec, 0xaa, 0x3b, 0x4c, 0xcd, 0xd7, 0xb0, 0x94, 0xeb, 0xe6, 0x59, 0x47, 0xbc, 0x03, 0x70, 0x35,
+	0xa0, 0xec, 0xf3, 0xff, 0xe6, 0x1d, 0xab, 0xb8, 0xc8, 0x74, 0xc3, 0xd9, 0x36, 0xac, 0xa6, 0x17,
+	0x92, 0xac, 0xd6, 0x2d, 0x54, 0x9a, 0x67, 0x08, 0x70, 0xb1, 0x7b, 0xa6, 0xef, 0xc1, 0x50, 0x5f,
+	0xf9, 0xaf, 0xbe, 0x23, 0x07, 0xb2, 0xf2, 0xea, 0x74, 0x8e, 0x27, 0xd7, 0x84, 0x29, 0x52, 0xd8,
+	0xca, 0x44, 0x61, 0x29, 0xed, 0x35, 0x65, 0x0d, 0xd0, 0x5a, 0x72, 0x03, 0x85, 0xa9, 0xaf, 0x2e,
+	0x84, 0x8a, 0x17, 0x32, 0xf7, 0x60, 0xa9, 0x80, 0xfd, 0xd7, 0x19, 0x24, 0x75, 0xd7, 0xe7, 0x41,
+	0x12, 0xf9, 0x64, 0x12, 0xf5, 0x77, 0x04, 0x33, 0xce, 0x29, 0xa1, 0xf2, 0x24, 0x64, 0x10, 0x1c,
+	0x88, 0x7e, 0x4c, 0x24, 0x6a, 0x71, 0x73, 0x65, 0x2c, 0xad, 0xac, 0xb3, 0xda, 0xfd, 0x98, 0xb8,
+	0x15, 0x59, 0x3a, 0x08, 0xf1, 0x7d, 0x50, 0x44, 0x2f, 0xdb, 0xdc, 0xb4, 0xb2, 0x15, 0xd1, 0x33,
+	0x1f, 0x81, 0x2a, 0xeb, 0x97, 0x41, 0x6b, 0xbf, 0x6c, 0x3a, 0x07, 0xcf, 0xf7, 0x5b, 0x4d, 0x67,
+	0x6b, 0x77, 0x67, 0xd7, 0xd9, 0xd6, 0x4a, 0x78, 0x09, 0x16, 0xe4, 0xeb, 0xee, 0x7e, 0xcb, 0x71,
+	0xdb, 0xce, 0xb6, 0x86, 0xb0, 0x06, 0xf3, 0xf2, 0xc9, 0x75, 0xf6, 0x9e, 0xbd, 0x70, 0xb6, 0x35,
+	0x65, 0xf3, 0xab, 0x0a, 0x73, 0x2d, 0x92, 0x9c, 0x46, 0x01, 0xc1, 0x1f, 0x11, 0xcc, 0x48, 0xd3,
+	0xe2, 0xd5, 0xb1, 0x22, 0x8a, 0x46, 0xd7, 0x1b, 0xd3, 0x40, 0xd3, 0xfb, 0x98, 0x8d, 0x0f, 0x3f,
+	0x7e, 0x7f, 0x56, 0x6e, 0x63, 0xd3, 0x1e, 0xf7, 0xa3, 0x08, 0xa4, 0x84, 0x33, 0x04, 0x70, 0x65,
+	0x53, 0x6c, 0x4d, 0xb7, 0x96, 0xa1, 0x6f, 0x74, 0x7b, 0x6a, 0x7c, 0xa6, 0xad, 0x2e, 0xb5, 0x99,
+	0xb8, 0x36, 0x56, 0xdb, 0xc0, 0xef, 0x5f, 0x10, 0x54, 0x72, 0xef, 0xe1, 0xb5, 0xb1, 0x44, 0xa3,
+	0x7e, 0xd6, 0xad, 0x69, 0xe1, 0x99, 0xac, 0x0d, 0x29, 0xeb, 0x2e, 0x5e, 0x9d, 0x24, 0xcb, 0x7e,
+	0x97, 0xda, 0xf3, 0x3d, 0x7e, 0x03, 0x95, 0xdc, 0xcb, 0x93, 0xe4, 0x8d, 0x78, 0x5e, 0x37, 0x27,
+	0xbb, 0x77, 0x1d, 0x3d, 0x7e, 0xfa, 0xed, 0xc2, 0x40, 0xe7, 0x17, 0x06, 0xfa, 0x75, 0x61, 0xa0,
+	0x4f, 0x97, 0x46, 0xe9, 0xfc, 0xd2, 0x28, 0xfd, 0xbc, 0x34, 0x4a, 0xaf, 0xd6, 0xc3, 0x48, 0x1c,
+	0x75, 0x7d, 0x2b, 0x60, 0x9d, 0xa1, 0xe0, 0xf4, 0xb3, 0xc6, 0x0f, 0xdf, 0xda, 0xc1, 0x71, 0x44,
+	0xa8, 0xb0, 0xc3, 0x24, 0x0e, 0x86, 0x23, 0xf8, 0xb3, 0xf2, 0x57, 0x7d, 0xef, 0xcf, 0x00, 0xe8,
+	0xef, 0x18, 0x35, 0x3c, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// Count queries the number of transactions and senders in the mempool.
+	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	// PendingTxs queries the transactions pending in the mempool, ordered by
+	// sender then nonce.
+	PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error)
+	// SenderTxs queries the transactions of a sender pending in the mempool,
+	// ordered by nonce.
+	SenderTxs(ctx context.Context, in *SenderTxsRequest, opts ...grpc.CallOption) (*SenderTxsResponse, error)
+	// Subscribe streams the transactions inserted into or removed from the mempool.
+	// It is only available through gRPC.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Service_SubscribeClient, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.mempool.v1beta1.Service/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error) {
+	out := new(PendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.mempool.v1beta1.Service/PendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SenderTxs(ctx context.Context, in *SenderTxsRequest, opts ...grpc.CallOption) (*SenderTxsResponse, error) {
+	out := new(SenderTxsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.mempool.v1beta1.Service/SenderTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Service_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Service_serviceDesc.Streams[0], "/cosmos.base.mempool.v1beta1.Service/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_SubscribeClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type serviceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceSubscribeClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// Count queries the number of transactions and senders in the mempool.
+	Count(context.Context, *CountRequest) (*CountResponse, error)
+	// PendingTxs queries the transactions pending in the mempool, ordered by
+	// sender then nonce.
+	PendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error)
+	// SenderTxs queries the transactions of a sender pending in the mempool,
+	// ordered by nonce.
+	SenderTxs(context.Context, *SenderTxsRequest) (*SenderTxsResponse, error)
+	// Subscribe streams the transactions inserted into or removed from the mempool.
+	// It is only available through gRPC.
+	Subscribe(*SubscribeRequest, Service_SubscribeServer) error
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) Count(ctx context.Context, req *CountRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (*UnimplementedServiceServer) PendingTxs(ctx context.Context, req *PendingTxsRequest) (*PendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTxs not implemented")
+}
+func (*UnimplementedServiceServer) SenderTxs(ctx context.Context, req *SenderTxsRequest) (*SenderTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderTxs not implemented")
+}
+func (*UnimplementedServiceServer) Subscribe(req *SubscribeRequest, srv Service_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.mempool.v1beta1.Service/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Count(ctx, req.(*CountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.mempool.v1beta1.Service/PendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PendingTxs(ctx, req.(*PendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SenderTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SenderTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SenderTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.mempool.v1beta1.Service/SenderTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SenderTxs(ctx, req.(*SenderTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).Subscribe(m, &serviceSubscribeServer{stream})
+}
+
+type Service_SubscribeServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type serviceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceSubscribeServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.mempool.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Count",
+			Handler:    _Service_Count_Handler,
+		},
+		{
+			MethodName: "PendingTxs",
+			Handler:    _Service_PendingTxs_Handler,
+		},
+		{
+			MethodName: "SenderTxs",
+			Handler:    _Service_SenderTxs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Service_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/mempool/v1beta1/query.proto",
+}
+
+func (m *PendingTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Senders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Senders))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Txs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQuery(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *CountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Txs != 0 {
+		n += 1 + sovQuery(uint64(m.Txs))
+	}
+	if m.Senders != 0 {
+		n += 1 + sovQuery(uint64(m.Senders))
+	}
+	return n
+}
+
+func (m *PendingTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SenderTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SenderTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventType != 0 {
+		n += 1 + sovQuery(uint64(m.EventType))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			m.Senders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Senders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &PendingTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SenderTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SenderTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SenderTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SenderTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SenderTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SenderTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &PendingTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= Event_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &PendingTx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
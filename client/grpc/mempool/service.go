@@ -0,0 +1,182 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// subscriptionBuffer is the number of events buffered for a subscriber. A subscriber
+// falling further behind is disconnected, as the mempool can't wait for it.
+const subscriptionBuffer = 1024
+
+// RegisterMempoolService registers the mempool gRPC service on the provided gRPC
+// server. It must be a node gRPC server rather than the app query router, as the
+// service streams the mempool events.
+func RegisterMempoolService(server gogogrpc.Server, mp sdkmempool.Mempool) {
+	RegisterServiceServer(server, NewQueryServer(mp))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool gRPC service's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	mempool sdkmempool.Mempool
+}
+
+// NewQueryServer returns the mempool gRPC service. The pending transactions and
+// the events are only available if mp implements mempool.InspectableMempool.
+func NewQueryServer(mp sdkmempool.Mempool) ServiceServer {
+	return queryServer{mempool: mp}
+}
+
+func (s queryServer) Count(_ context.Context, _ *CountRequest) (*CountResponse, error) {
+	res := &CountResponse{Txs: uint64(s.mempool.CountTx())}
+	if mp, ok := s.mempool.(sdkmempool.InspectableMempool); ok {
+		var txs uint64
+		lastSender := ""
+		mp.WalkPendingTxs("", func(info sdkmempool.TxInfo) bool {
+			txs++
+			if info.Sender != lastSender {
+				res.Senders++
+				lastSender = info.Sender
+			}
+			return false
+		})
+		res.Txs = txs
+	}
+
+	return res, nil
+}
+
+func (s queryServer) PendingTxs(_ context.Context, req *PendingTxsRequest) (*PendingTxsResponse, error) {
+	mp, err := s.inspectableMempool()
+	if err != nil {
+		return nil, err
+	}
+
+	var offset, limit uint64 = 0, query.DefaultLimit
+	countTotal := false
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported")
+		}
+		offset = req.Pagination.Offset
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+		countTotal = req.Pagination.CountTotal
+	}
+
+	// walk the mempool without copying it, stopping once the page is full unless
+	// the total is requested.
+	res := &PendingTxsResponse{Pagination: &query.PageResponse{}}
+	var i uint64
+	mp.WalkPendingTxs("", func(info sdkmempool.TxInfo) bool {
+		if i >= offset && uint64(len(res.Txs)) < limit {
+			res.Txs = append(res.Txs, pendingTx(info))
+		}
+		i++
+		return !countTotal && uint64(len(res.Txs)) == limit
+	})
+	if countTotal {
+		res.Pagination.Total = i
+	}
+
+	return res, nil
+}
+
+func (s queryServer) SenderTxs(_ context.Context, req *SenderTxsRequest) (*SenderTxsResponse, error) {
+	if req.Sender == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty sender")
+	}
+
+	mp, err := s.inspectableMempool()
+	if err != nil {
+		return nil, err
+	}
+
+	var txs []*PendingTx
+	mp.WalkPendingTxs(req.Sender, func(info sdkmempool.TxInfo) bool {
+		txs = append(txs, pendingTx(info))
+		return false
+	})
+
+	return &SenderTxsResponse{Txs: txs}, nil
+}
+
+func (s queryServer) Subscribe(req *SubscribeRequest, stream Service_SubscribeServer) error {
+	mp, err := s.inspectableMempool()
+	if err != nil {
+		return err
+	}
+
+	var (
+		events     = make(chan sdkmempool.Event, subscriptionBuffer)
+		overflow   = make(chan struct{})
+		onOverflow sync.Once
+	)
+	unsubscribe := mp.Subscribe(func(event sdkmempool.Event) {
+		if req.Sender != "" && event.Sender != req.Sender {
+			return
+		}
+		select {
+		case events <- event:
+		default:
+			onOverflow.Do(func() { close(overflow) })
+		}
+	})
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-overflow:
+			return status.Error(codes.ResourceExhausted, "subscriber is too slow, mempool events were dropped")
+		case event := <-events:
+			eventType := Event_TYPE_INSERTED
+			if event.Type == sdkmempool.EventTxRemoved {
+				eventType = Event_TYPE_REMOVED
+			}
+			if err := stream.Send(&Event{EventType: eventType, Tx: pendingTx(event.TxInfo)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s queryServer) inspectableMempool() (sdkmempool.InspectableMempool, error) {
+	mp, ok := s.mempool.(sdkmempool.InspectableMempool)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "mempool %T does not support introspection", s.mempool)
+	}
+
+	return mp, nil
+}
+
+func pendingTx(info sdkmempool.TxInfo) *PendingTx {
+	tx := &PendingTx{
+		Sender:   info.Sender,
+		Nonce:    info.Nonce,
+		Priority: info.Priority,
+	}
+	if len(info.Hash) > 0 {
+		tx.Hash = fmt.Sprintf("%X", info.Hash)
+	}
+
+	return tx
+}
@@ -0,0 +1,178 @@
+package mempool
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+type testTx struct {
+	sdk.Tx
+	sender sdk.AccAddress
+	nonce  uint64
+}
+
+type testSignerExtractor struct{}
+
+func (testSignerExtractor) GetSigners(tx sdk.Tx) ([]sdkmempool.SignerData, error) {
+	t := tx.(testTx)
+	return []sdkmempool.SignerData{sdkmempool.NewSignerData(t.sender, t.nonce)}, nil
+}
+
+func testTxBytes(tx testTx) []byte {
+	return []byte(fmt.Sprintf("%s/%d", tx.sender, tx.nonce))
+}
+
+func testTxHash(tx testTx) string {
+	return fmt.Sprintf("%X", sha256.Sum256(testTxBytes(tx)))
+}
+
+func setupQueryServer(t *testing.T) (ServiceServer, sdkmempool.InspectableMempool, []testTx) {
+	t.Helper()
+
+	mp := sdkmempool.NewPriorityMempool(sdkmempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      sdkmempool.NewDefaultTxPriority(),
+		SignerExtractor: testSignerExtractor{},
+	})
+	// the bech32 encoding of sender_b sorts before the one of sender_a.
+	sa, sb := sdk.AccAddress("sender_b"), sdk.AccAddress("sender_a")
+	txs := []testTx{
+		{sender: sa, nonce: 1},
+		{sender: sa, nonce: 2},
+		{sender: sb, nonce: 1},
+	}
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	for i, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(int64(i)).WithTxBytes(testTxBytes(tx)), tx))
+	}
+
+	return NewQueryServer(mp), mp, txs
+}
+
+// opaqueMempool hides the introspection of the mempool it wraps.
+type opaqueMempool struct {
+	sdkmempool.Mempool
+}
+
+func TestQueryServer_Count(t *testing.T) {
+	svr, mp, _ := setupQueryServer(t)
+	res, err := svr.Count(context.Background(), &CountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Txs)
+	require.Equal(t, uint64(2), res.Senders)
+
+	// only the number of txs is known for a mempool which can't be inspected.
+	svr = NewQueryServer(opaqueMempool{mp})
+	res, err = svr.Count(context.Background(), &CountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Txs)
+	require.Equal(t, uint64(0), res.Senders)
+}
+
+func TestQueryServer_PendingTxs(t *testing.T) {
+	svr, _, txs := setupQueryServer(t)
+
+	res, err := svr.PendingTxs(context.Background(), &PendingTxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 3)
+	require.Equal(t, &PendingTx{Hash: testTxHash(txs[0]), Sender: txs[0].sender.String(), Nonce: 1, Priority: 0}, res.Txs[0])
+	require.Equal(t, &PendingTx{Hash: testTxHash(txs[2]), Sender: txs[2].sender.String(), Nonce: 1, Priority: 2}, res.Txs[2])
+
+	res, err = svr.PendingTxs(context.Background(), &PendingTxsRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+	require.Equal(t, testTxHash(txs[1]), res.Txs[0].Hash)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = svr.PendingTxs(context.Background(), &PendingTxsRequest{Pagination: &query.PageRequest{Offset: 5}})
+	require.NoError(t, err)
+	require.Empty(t, res.Txs)
+
+	_, err = svr.PendingTxs(context.Background(), &PendingTxsRequest{Pagination: &query.PageRequest{Key: []byte("key")}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryServer_SenderTxs(t *testing.T) {
+	svr, _, txs := setupQueryServer(t)
+
+	res, err := svr.SenderTxs(context.Background(), &SenderTxsRequest{Sender: txs[0].sender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	require.Equal(t, testTxHash(txs[0]), res.Txs[0].Hash)
+	require.Equal(t, testTxHash(txs[1]), res.Txs[1].Hash)
+
+	_, err = svr.SenderTxs(context.Background(), &SenderTxsRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	svr = NewQueryServer(sdkmempool.NoOpMempool{})
+	_, err = svr.SenderTxs(context.Background(), &SenderTxsRequest{Sender: txs[0].sender.String()})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+type subscribeStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *Event
+}
+
+func (s subscribeStream) Context() context.Context { return s.ctx }
+
+func (s subscribeStream) Send(event *Event) error {
+	s.events <- event
+	return nil
+}
+
+// notifyingMempool signals when a listener subscribes to the mempool.
+type notifyingMempool struct {
+	sdkmempool.InspectableMempool
+	subscribed chan struct{}
+}
+
+func (mp notifyingMempool) Subscribe(listener func(sdkmempool.Event)) func() {
+	defer close(mp.subscribed)
+	return mp.InspectableMempool.Subscribe(listener)
+}
+
+func TestQueryServer_Subscribe(t *testing.T) {
+	_, mp, txs := setupQueryServer(t)
+	nmp := notifyingMempool{InspectableMempool: mp, subscribed: make(chan struct{})}
+	svr := NewQueryServer(nmp)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := subscribeStream{ctx: ctx, events: make(chan *Event, 10)}
+	done := make(chan error)
+	go func() {
+		done <- svr.Subscribe(&SubscribeRequest{Sender: txs[2].sender.String()}, stream)
+	}()
+	<-nmp.subscribed
+
+	// the events of other senders are filtered out.
+	require.NoError(t, mp.Remove(txs[0]))
+	require.NoError(t, mp.Remove(txs[2]))
+	require.NoError(t, mp.Insert(sdk.NewContext(nil, false, log.NewNopLogger()).WithPriority(5).WithTxBytes(testTxBytes(txs[2])), txs[2]))
+
+	event := <-stream.events
+	require.Equal(t, Event_TYPE_REMOVED, event.EventType)
+	require.Equal(t, testTxHash(txs[2]), event.Tx.Hash)
+	event = <-stream.events
+	require.Equal(t, Event_TYPE_INSERTED, event.EventType)
+	require.Equal(t, &PendingTx{Hash: testTxHash(txs[2]), Sender: txs[2].sender.String(), Nonce: 1, Priority: 5}, event.Tx)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+	require.Empty(t, stream.events)
+}
@@ -0,0 +1,249 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	// MaxLimit is the maximum number of entries returned by an Entries query,
+	// larger limits are capped to it.
+	MaxLimit = 1000
+
+	// MaxCountTotal is the maximum number of entries counted by an Entries query
+	// with count_total set. The total isn't returned for the larger collections,
+	// so that a query never iterates over a whole large collection.
+	MaxCountTotal = 10_000
+
+	// MaxOffset is the maximum offset of an Entries query, the later entries are
+	// paginated with the page keys.
+	MaxOffset = 10_000
+)
+
+// RegisterStateService registers the state gRPC service on the provided gRPC router.
+func RegisterStateService(server gogogrpc.Server, storeKeys []storetypes.StoreKey, schemas map[string]collections.Schema) {
+	RegisterServiceServer(server, NewQueryServer(storeKeys, schemas))
+}
+
+// RegisterGRPCGatewayRoutes mounts the state gRPC service's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	storeKeys map[string]storetypes.StoreKey
+	schemas   map[string]collections.Schema
+}
+
+// NewQueryServer returns a state query server reading the stores of the given
+// keys from the multi-store of the query context, and decoding them through
+// the given collections schemas, by store key name.
+func NewQueryServer(storeKeys []storetypes.StoreKey, schemas map[string]collections.Schema) ServiceServer {
+	keys := make(map[string]storetypes.StoreKey, len(storeKeys))
+	for _, key := range storeKeys {
+		if _, ok := schemas[key.Name()]; ok {
+			keys[key.Name()] = key
+		}
+	}
+
+	return queryServer{
+		storeKeys: keys,
+		schemas:   schemas,
+	}
+}
+
+func (s queryServer) Modules(_ context.Context, _ *ModulesRequest) (*ModulesResponse, error) {
+	modules := make([]string, 0, len(s.storeKeys))
+	for name := range s.storeKeys {
+		modules = append(modules, name)
+	}
+	slices.Sort(modules)
+
+	return &ModulesResponse{Modules: modules}, nil
+}
+
+func (s queryServer) Collections(_ context.Context, req *CollectionsRequest) (*CollectionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, ok := s.storeKeys[req.Module]; !ok {
+		return nil, status.Errorf(codes.NotFound, "module %s not found", req.Module)
+	}
+
+	colls := s.schemas[req.Module].ListCollections()
+	res := &CollectionsResponse{Collections: make([]*Collection, len(colls))}
+	for i, coll := range colls {
+		res.Collections[i] = &Collection{
+			Name:      coll.GetName(),
+			Prefix:    coll.GetPrefix(),
+			KeyType:   coll.KeyCodec().KeyType(),
+			ValueType: coll.ValueCodec().ValueType(),
+		}
+	}
+
+	return res, nil
+}
+
+func (s queryServer) Entries(ctx context.Context, req *EntriesRequest) (*EntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	storeKey, ok := s.storeKeys[req.Module]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "module %s not found", req.Module)
+	}
+	idx := slices.IndexFunc(s.schemas[req.Module].ListCollections(), func(c collections.Collection) bool {
+		return c.GetName() == req.Collection
+	})
+	if idx < 0 {
+		return nil, status.Errorf(codes.NotFound, "collection %s not found in module %s", req.Collection, req.Module)
+	}
+	coll := s.schemas[req.Module].ListCollections()[idx]
+	kc, vc := coll.KeyCodec(), coll.ValueCodec()
+
+	start, err := encodeJSONKey(kc, req.StartKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start key: %v", err)
+	}
+	end, err := encodeJSONKey(kc, req.EndKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid end key: %v", err)
+	}
+
+	store := prefix.NewStore(sdk.UnwrapSDKContext(ctx).MultiStore().GetKVStore(storeKey), coll.GetPrefix())
+	var entries []*Entry
+	pageRes, err := paginate(store, start, end, req.Pagination, func(key, value []byte) error {
+		k, err := kc.Decode(key)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to decode key %X: %v", key, err)
+		}
+		keyJSON, err := kc.EncodeJSON(k)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to encode key %X: %v", key, err)
+		}
+		v, err := vc.Decode(value)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to decode value of key %X: %v", key, err)
+		}
+		valueJSON, err := vc.EncodeJSON(v)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to encode value of key %X: %v", key, err)
+		}
+
+		entries = append(entries, &Entry{
+			Key:    string(keyJSON),
+			Value:  string(valueJSON),
+			RawKey: bytes.Clone(key),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntriesResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// encodeJSONKey returns the encoded key of the given JSON key, nil if empty.
+func encodeJSONKey(kc collcodec.UntypedKeyCodec, jsonKey string) ([]byte, error) {
+	if jsonKey == "" {
+		return nil, nil
+	}
+	key, err := kc.DecodeJSON([]byte(jsonKey))
+	if err != nil {
+		return nil, err
+	}
+	return kc.Encode(key)
+}
+
+// paginate calls onResult for the entries of the page of the store between the
+// start and end keys. The page keys are the keys of the store, i.e. the next key
+// is the key of the first entry of the next page.
+func paginate(
+	store storetypes.KVStore,
+	start, end []byte,
+	pageReq *query.PageRequest,
+	onResult func(key, value []byte) error,
+) (*query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "paginate: invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Offset > MaxOffset {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: offset %d exceeds the maximum offset %d, use the page key", pageReq.Offset, MaxOffset)
+	}
+	limit := min(pageReq.Limit, MaxLimit)
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// resume from the page key, which is the first key of the page
+	if len(pageReq.Key) > 0 {
+		if pageReq.Reverse {
+			keyEnd := append(bytes.Clone(pageReq.Key), 0)
+			if end == nil || bytes.Compare(keyEnd, end) < 0 {
+				end = keyEnd
+			}
+		} else if start == nil || bytes.Compare(pageReq.Key, start) > 0 {
+			start = pageReq.Key
+		}
+	}
+
+	var iter storetypes.Iterator
+	if pageReq.Reverse {
+		iter = store.ReverseIterator(start, end)
+	} else {
+		iter = store.Iterator(start, end)
+	}
+	defer iter.Close()
+
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+	res := &query.PageResponse{}
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		if count > pageReq.Offset+limit {
+			if res.NextKey == nil {
+				res.NextKey = bytes.Clone(iter.Key())
+			}
+			// the total of the large collections isn't counted
+			if count > MaxCountTotal {
+				countTotal = false
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+		if err := onResult(iter.Key(), iter.Value()); err != nil {
+			return nil, err
+		}
+	}
+	if countTotal {
+		res.Total = count
+	}
+
+	return res, nil
+}
@@ -0,0 +1,149 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestServiceServer(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	balances := collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.StringKey, collections.Uint64Value)
+	supply := collections.NewItem(sb, collections.NewPrefix(2), "supply", collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, balances.Set(ctx, fmt.Sprintf("addr%d", i), uint64(i)))
+	}
+	require.NoError(t, supply.Set(ctx, 45))
+
+	// stores without a schema aren't browsable
+	srv := NewQueryServer([]storetypes.StoreKey{key, storetypes.NewKVStoreKey("other")}, map[string]collections.Schema{"bank": schema})
+
+	modules, err := srv.Modules(ctx, &ModulesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bank"}, modules.Modules)
+
+	colls, err := srv.Collections(ctx, &CollectionsRequest{Module: "bank"})
+	require.NoError(t, err)
+	require.Equal(t, []*Collection{
+		{Name: "balances", Prefix: []byte{1}, KeyType: "string", ValueType: "uint64"},
+		{Name: "supply", Prefix: []byte{2}, KeyType: "no_key", ValueType: "uint64"},
+	}, colls.Collections)
+
+	_, err = srv.Collections(ctx, &CollectionsRequest{Module: "other"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	entries := func(req *EntriesRequest) ([]string, *query.PageResponse) {
+		t.Helper()
+		req.Module = "bank"
+		res, err := srv.Entries(ctx, req)
+		require.NoError(t, err)
+		var kvs []string
+		for _, e := range res.Entries {
+			kvs = append(kvs, e.Key+"="+e.Value)
+		}
+		return kvs, res.Pagination
+	}
+
+	kvs, _ := entries(&EntriesRequest{Collection: "supply"})
+	require.Equal(t, []string{`"item"="45"`}, kvs)
+
+	// key range
+	kvs, pageRes := entries(&EntriesRequest{Collection: "balances", StartKey: `"addr2"`, EndKey: `"addr5"`})
+	require.Equal(t, []string{`"addr2"="2"`, `"addr3"="3"`, `"addr4"="4"`}, kvs)
+	require.Nil(t, pageRes.NextKey)
+
+	// key pagination within a key range
+	kvs, pageRes = entries(&EntriesRequest{
+		Collection: "balances", StartKey: `"addr2"`, EndKey: `"addr8"`,
+		Pagination: &query.PageRequest{Key: []byte{}, Limit: 4, CountTotal: true},
+	})
+	require.Equal(t, []string{`"addr2"="2"`, `"addr3"="3"`, `"addr4"="4"`, `"addr5"="5"`}, kvs)
+	require.Equal(t, []byte("addr6"), pageRes.NextKey)
+	require.Equal(t, uint64(6), pageRes.Total)
+
+	kvs, pageRes = entries(&EntriesRequest{
+		Collection: "balances", StartKey: `"addr2"`, EndKey: `"addr8"`,
+		Pagination: &query.PageRequest{Limit: 4, Key: pageRes.NextKey},
+	})
+	require.Equal(t, []string{`"addr6"="6"`, `"addr7"="7"`}, kvs)
+	require.Nil(t, pageRes.NextKey)
+
+	// reverse and offset pagination
+	kvs, pageRes = entries(&EntriesRequest{
+		Collection: "balances", EndKey: `"addr8"`,
+		Pagination: &query.PageRequest{Limit: 2, Offset: 1, Reverse: true},
+	})
+	require.Equal(t, []string{`"addr6"="6"`, `"addr5"="5"`}, kvs)
+	require.Equal(t, []byte("addr4"), pageRes.NextKey)
+
+	kvs, _ = entries(&EntriesRequest{
+		Collection: "balances", StartKey: `"addr3"`,
+		Pagination: &query.PageRequest{Limit: 10, Key: pageRes.NextKey, Reverse: true},
+	})
+	require.Equal(t, []string{`"addr4"="4"`, `"addr3"="3"`}, kvs)
+
+	// invalid requests
+	_, err = srv.Entries(ctx, &EntriesRequest{Module: "bank", Collection: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = srv.Entries(ctx, &EntriesRequest{Module: "bank", Collection: "balances", StartKey: "addr"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.Entries(ctx, &EntriesRequest{
+		Module: "bank", Collection: "balances",
+		Pagination: &query.PageRequest{Key: []byte("addr1"), Offset: 1},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceServerLimits(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	balances := collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+	for i := 0; i < MaxCountTotal+1; i++ {
+		require.NoError(t, balances.Set(ctx, fmt.Sprintf("addr%05d", i), uint64(i)))
+	}
+	srv := NewQueryServer([]storetypes.StoreKey{key}, map[string]collections.Schema{"bank": schema})
+
+	// the page size is capped, and the total of a large collection isn't counted
+	res, err := srv.Entries(ctx, &EntriesRequest{
+		Module: "bank", Collection: "balances",
+		Pagination: &query.PageRequest{Limit: MaxLimit + 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, MaxLimit)
+	require.Equal(t, []byte(fmt.Sprintf("addr%05d", MaxLimit)), res.Pagination.NextKey)
+	require.Zero(t, res.Pagination.Total)
+
+	// the total of a collection within the bound is counted
+	res, err = srv.Entries(ctx, &EntriesRequest{
+		Module: "bank", Collection: "balances", StartKey: `"addr00001"`,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(MaxCountTotal), res.Pagination.Total)
+
+	_, err = srv.Entries(ctx, &EntriesRequest{
+		Module: "bank", Collection: "balances",
+		Pagination: &query.PageRequest{Offset: MaxOffset + 1},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
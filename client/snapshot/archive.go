@@ -0,0 +1,486 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	// SnapshotFileName is the name of the archive file containing the snapshot metadata.
+	SnapshotFileName = "_snapshot"
+	// ManifestFileName is the name of the archive file containing the manifest.
+	ManifestFileName = "_manifest"
+	// SignatureFileName is the name of the archive file containing the signature of the manifest.
+	SignatureFileName = "_signature"
+
+	// ArchiveVersion is the version of the archive format described by the manifest.
+	ArchiveVersion uint32 = 1
+)
+
+// An archive is a gzipped tarball containing, in order, the manifest, its
+// optional signature, the snapshot metadata and the snapshot chunks, named by
+// their index. Archives dumped before the manifest was introduced only contain
+// the snapshot metadata and chunks.
+
+// Manifest describes the snapshot contained in an archive, so that it can be
+// verified without restoring it.
+type Manifest struct {
+	Version      uint32              `json:"version"`
+	ChainID      string              `json:"chain_id"`
+	Height       uint64              `json:"height"`
+	Format       uint32              `json:"format"`
+	AppHash      cmtbytes.HexBytes   `json:"app_hash"`
+	SnapshotHash cmtbytes.HexBytes   `json:"snapshot_hash"`
+	ChunkHashes  []cmtbytes.HexBytes `json:"chunk_hashes"`
+}
+
+// NewManifest returns the manifest of a snapshot of the snapshot store, computing
+// the app hash from the snapshot chunks.
+func NewManifest(store *snapshots.Store, snapshot *snapshottypes.Snapshot, chainID string) (*Manifest, error) {
+	_, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	if err != nil {
+		return nil, err
+	}
+	if chunks == nil {
+		return nil, errors.New("snapshot doesn't exist")
+	}
+
+	appHash, err := snapshotAppHash(snapshot.Height, snapshot.Format, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		Version:      ArchiveVersion,
+		ChainID:      chainID,
+		Height:       snapshot.Height,
+		Format:       snapshot.Format,
+		AppHash:      appHash,
+		SnapshotHash: snapshot.Hash,
+		ChunkHashes:  make([]cmtbytes.HexBytes, len(snapshot.Metadata.ChunkHashes)),
+	}
+	for i, hash := range snapshot.Metadata.ChunkHashes {
+		manifest.ChunkHashes[i] = hash
+	}
+
+	return manifest, nil
+}
+
+// validateSnapshot checks that the manifest describes the given snapshot.
+func (m *Manifest) validateSnapshot(snapshot *snapshottypes.Snapshot) error {
+	if m.Version != ArchiveVersion {
+		return fmt.Errorf("unsupported archive version %d", m.Version)
+	}
+	if m.Height != snapshot.Height || m.Format != snapshot.Format {
+		return fmt.Errorf("manifest is for snapshot at height %d format %d, got height %d format %d",
+			m.Height, m.Format, snapshot.Height, snapshot.Format)
+	}
+	if !bytes.Equal(m.SnapshotHash, snapshot.Hash) {
+		return fmt.Errorf("manifest snapshot hash %X doesn't match snapshot hash %X", m.SnapshotHash, snapshot.Hash)
+	}
+	if len(m.ChunkHashes) != int(snapshot.Chunks) || len(snapshot.Metadata.ChunkHashes) != int(snapshot.Chunks) {
+		return fmt.Errorf("manifest has %d chunk hashes, but snapshot has %d chunks", len(m.ChunkHashes), snapshot.Chunks)
+	}
+	for i, hash := range m.ChunkHashes {
+		if !bytes.Equal(hash, snapshot.Metadata.ChunkHashes[i]) {
+			return fmt.Errorf("manifest hash of chunk %d doesn't match snapshot metadata", i)
+		}
+	}
+
+	return nil
+}
+
+// ManifestSignature is the signature of the manifest of an archive by the key of
+// an operator.
+type ManifestSignature struct {
+	// PubKey is the JSON encoded public key of the signer.
+	PubKey    json.RawMessage `json:"pub_key"`
+	Signature []byte          `json:"signature"`
+}
+
+// archiveCodec returns the codec used to encode the public keys of the
+// signatures, independently of the application.
+func archiveCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+// SignManifest signs the encoded manifest with the key uid of the keyring.
+func SignManifest(kr keyring.Keyring, uid string, manifest []byte) (*ManifestSignature, error) {
+	sig, pubKey, err := kr.Sign(uid, manifest, signing.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign manifest: %w", err)
+	}
+
+	pk, err := archiveCodec().MarshalInterfaceJSON(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
+
+	return &ManifestSignature{PubKey: pk, Signature: sig}, nil
+}
+
+// Verify verifies the signature of the encoded manifest, returning the public
+// key of the signer.
+func (s *ManifestSignature) Verify(manifest []byte) (cryptotypes.PubKey, error) {
+	var pubKey cryptotypes.PubKey
+	if err := archiveCodec().UnmarshalInterfaceJSON(s.PubKey, &pubKey); err != nil {
+		return nil, fmt.Errorf("failed to decode public key: %w", err)
+	}
+
+	if !pubKey.VerifySignature(manifest, s.Signature) {
+		return nil, errors.New("invalid manifest signature")
+	}
+
+	return pubKey, nil
+}
+
+// writeArchiveFile writes a file with the given content to the archive.
+func writeArchiveFile(tarWriter *tar.Writer, name string, bz []byte) error {
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(bz)),
+	}); err != nil {
+		return fmt.Errorf("failed to write %s header to tar: %w", name, err)
+	}
+	if _, err := tarWriter.Write(bz); err != nil {
+		return fmt.Errorf("failed to write %s to tar: %w", name, err)
+	}
+
+	return nil
+}
+
+// writeArchive writes the archive of a snapshot of the snapshot store, with its
+// encoded manifest and signature, if not nil.
+func writeArchive(w io.Writer, snapshotStore *snapshots.Store, snapshot *snapshottypes.Snapshot, manifest, signature []byte) error {
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+
+	// since the chunk files are already compressed, we just use fastest compression here
+	gzipWriter, err := gzip.NewWriterLevel(w, gzip.BestSpeed)
+	if err != nil {
+		return err
+	}
+	tarWriter := tar.NewWriter(gzipWriter)
+	if err := writeArchiveFile(tarWriter, ManifestFileName, manifest); err != nil {
+		return err
+	}
+	if signature != nil {
+		if err := writeArchiveFile(tarWriter, SignatureFileName, signature); err != nil {
+			return err
+		}
+	}
+	if err := writeArchiveFile(tarWriter, SnapshotFileName, bz); err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		path := snapshotStore.PathChunk(snapshot.Height, snapshot.Format, i)
+		tarName := strconv.FormatUint(uint64(i), 10)
+		if err := processChunk(tarWriter, path, tarName); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close tar writer: %w", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close gzip writer: %w", err)
+	}
+
+	return nil
+}
+
+func processChunk(tarWriter *tar.Writer, path, tarName string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open chunk file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	st, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat chunk file %s: %w", path, err)
+	}
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name: tarName,
+		Mode: 0o644,
+		Size: st.Size(),
+	}); err != nil {
+		return fmt.Errorf("failed to write chunk header to tar: %w", err)
+	}
+
+	if _, err := io.Copy(tarWriter, file); err != nil {
+		return fmt.Errorf("failed to write chunk to tar: %w", err)
+	}
+
+	return nil
+}
+
+// archiveReader reads the files of an archive.
+type archiveReader struct {
+	tr *tar.Reader
+
+	// manifest and signature are nil for the archives without manifest.
+	manifestBz []byte
+	manifest   *Manifest
+	signature  *ManifestSignature
+	snapshot   snapshottypes.Snapshot
+
+	chunk uint32
+}
+
+// newArchiveReader reads the metadata of an archive, up to its first chunk.
+func newArchiveReader(tr *tar.Reader) (*archiveReader, error) {
+	r := &archiveReader{tr: tr}
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive file header: %w", err)
+	}
+
+	if hdr.Name == ManifestFileName {
+		if r.manifestBz, err = io.ReadAll(tr); err != nil {
+			return nil, fmt.Errorf("failed to read manifest file: %w", err)
+		}
+		r.manifest = &Manifest{}
+		if err := json.Unmarshal(r.manifestBz, r.manifest); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+
+		if hdr, err = tr.Next(); err != nil {
+			return nil, fmt.Errorf("failed to read archive file header: %w", err)
+		}
+	}
+
+	if hdr.Name == SignatureFileName {
+		if r.manifest == nil {
+			return nil, errors.New("invalid archive, signature without manifest")
+		}
+		bz, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read signature file: %w", err)
+		}
+		r.signature = &ManifestSignature{}
+		if err := json.Unmarshal(bz, r.signature); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal signature: %w", err)
+		}
+
+		if hdr, err = tr.Next(); err != nil {
+			return nil, fmt.Errorf("failed to read archive file header: %w", err)
+		}
+	}
+
+	if hdr.Name != SnapshotFileName {
+		return nil, fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
+	}
+	bz, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+	if err := r.snapshot.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+
+	if r.manifest != nil {
+		if err := r.manifest.validateSnapshot(&r.snapshot); err != nil {
+			return nil, fmt.Errorf("invalid archive: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+// nextChunk reads the next chunk of the snapshot, returning io.EOF after the
+// last one.
+func (r *archiveReader) nextChunk() ([]byte, error) {
+	if r.chunk >= r.snapshot.Chunks {
+		return nil, io.EOF
+	}
+
+	hdr, err := r.tr.Next()
+	if err != nil {
+		return nil, err
+	}
+	if hdr.Name != strconv.FormatInt(int64(r.chunk), 10) {
+		return nil, fmt.Errorf("invalid archive, expect file: %d, got: %s", r.chunk, hdr.Name)
+	}
+
+	bz, err := io.ReadAll(r.tr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chunk file: %w", err)
+	}
+	r.chunk++
+
+	return bz, nil
+}
+
+// snapshotAppHash computes the app hash of the state of a snapshot from its
+// chunks, by rebuilding the root hash of each IAVL store from its exported
+// nodes. The chunks are drained.
+func snapshotAppHash(height uint64, format uint32, chunks <-chan io.ReadCloser) ([]byte, error) {
+	defer snapshots.DrainChunks(chunks)
+
+	if format != snapshottypes.CurrentFormat {
+		return nil, fmt.Errorf("cannot compute the app hash of snapshot format %d", format)
+	}
+
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	if err != nil {
+		return nil, err
+	}
+	defer streamReader.Close()
+
+	commitInfo := storetypes.CommitInfo{Version: int64(height)}
+	var tree *iavlHasher
+	endStore := func() error {
+		if tree == nil {
+			return nil
+		}
+		hash, err := tree.rootHash()
+		if err != nil {
+			return err
+		}
+		commitInfo.StoreInfos = append(commitInfo.StoreInfos, storetypes.StoreInfo{
+			Name:     tree.name,
+			CommitId: storetypes.CommitID{Version: int64(height), Hash: hash},
+		})
+		return nil
+	}
+
+loop:
+	for {
+		var item snapshottypes.SnapshotItem
+		err := streamReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		switch item := item.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if err := endStore(); err != nil {
+				return nil, err
+			}
+			tree = &iavlHasher{name: item.Store.Name}
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if tree == nil {
+				return nil, errors.New("received IAVL node item before store item")
+			}
+			if err := tree.add(item.IAVL); err != nil {
+				return nil, fmt.Errorf("store %s: %w", tree.name, err)
+			}
+
+		default:
+			// the stores are followed by the extensions, which aren't part of the app hash
+			break loop
+		}
+	}
+
+	if err := endStore(); err != nil {
+		return nil, err
+	}
+
+	return commitInfo.Hash(), nil
+}
+
+// iavlHasher computes the root hash of an IAVL tree from its nodes, exported in
+// post-order.
+type iavlHasher struct {
+	name  string
+	stack []iavlNodeHash
+}
+
+type iavlNodeHash struct {
+	hash   []byte
+	size   int64
+	height int32
+}
+
+// add adds the next exported node of the tree, computing its hash.
+func (h *iavlHasher) add(node *snapshottypes.SnapshotIAVLItem) error {
+	var buf bytes.Buffer
+	writeVarint := func(i int64) {
+		buf.Write(binary.AppendVarint(nil, i))
+	}
+	writeBytes := func(bz []byte) {
+		buf.Write(binary.AppendUvarint(nil, uint64(len(bz))))
+		buf.Write(bz)
+	}
+
+	var size int64
+	if node.Height == 0 {
+		size = 1
+		writeVarint(0)
+		writeVarint(size)
+		writeVarint(node.Version)
+		writeBytes(node.Key)
+		valueHash := sha256.Sum256(node.Value)
+		writeBytes(valueHash[:])
+	} else {
+		if len(h.stack) < 2 {
+			return fmt.Errorf("inner node at height %d is missing children", node.Height)
+		}
+		left, right := h.stack[len(h.stack)-2], h.stack[len(h.stack)-1]
+		h.stack = h.stack[:len(h.stack)-2]
+		if left.height >= node.Height || right.height >= node.Height {
+			return fmt.Errorf("inner node at height %d has children at height %d and %d", node.Height, left.height, right.height)
+		}
+
+		size = left.size + right.size
+		writeVarint(int64(node.Height))
+		writeVarint(size)
+		writeVarint(node.Version)
+		writeBytes(left.hash)
+		writeBytes(right.hash)
+	}
+
+	hash := sha256.Sum256(buf.Bytes())
+	h.stack = append(h.stack, iavlNodeHash{hash: hash[:], size: size, height: node.Height})
+	return nil
+}
+
+// rootHash returns the root hash of the tree once all its nodes are added.
+func (h *iavlHasher) rootHash() ([]byte, error) {
+	switch len(h.stack) {
+	case 0:
+		// empty tree
+		hash := sha256.Sum256(nil)
+		return hash[:], nil
+	case 1:
+		return h.stack[0].hash, nil
+	default:
+		return nil, fmt.Errorf("store %s has %d dangling nodes", h.name, len(h.stack)-1)
+	}
+}
@@ -0,0 +1,169 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setupSnapshot takes a snapshot of a multistore, returning the snapshot store
+// and the app hash of the snapshot height.
+func setupSnapshot(t *testing.T) (*snapshots.Store, *snapshottypes.Snapshot, []byte) {
+	t.Helper()
+
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := []*storetypes.KVStoreKey{
+		storetypes.NewKVStoreKey("acc"),
+		storetypes.NewKVStoreKey("bank"),
+		storetypes.NewKVStoreKey("empty"),
+	}
+	for _, key := range keys {
+		rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, rs.LoadLatestVersion())
+
+	for version := 1; version <= 2; version++ {
+		for _, key := range keys[:2] {
+			store := rs.GetKVStore(key)
+			for i := 0; i < 100*version; i++ {
+				store.Set([]byte(fmt.Sprintf("key-%03d", i)), []byte(fmt.Sprintf("value-%d-%d", version, i)))
+			}
+		}
+		rs.Commit()
+	}
+	appHash := rs.LastCommitID().Hash
+
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		if err := rs.Snapshot(2, streamWriter); err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
+		_ = streamWriter.Close()
+	}()
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	snapshot, err := snapshotStore.Save(2, snapshottypes.CurrentFormat, chunks)
+	require.NoError(t, err)
+
+	return snapshotStore, snapshot, appHash
+}
+
+func TestVerifyArchive(t *testing.T) {
+	snapshotStore, snapshot, appHash := setupSnapshot(t)
+
+	manifest, err := NewManifest(snapshotStore, snapshot, "test-chain")
+	require.NoError(t, err)
+	require.Equal(t, appHash, []byte(manifest.AppHash))
+	manifestBz, err := json.Marshal(manifest)
+	require.NoError(t, err)
+
+	kr := keyring.NewInMemory(archiveCodec())
+	record, _, err := kr.NewMnemonic("operator", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	operator, err := record.GetPubKey()
+	require.NoError(t, err)
+	signature, err := SignManifest(kr, "operator", manifestBz)
+	require.NoError(t, err)
+	signatureBz, err := json.Marshal(signature)
+	require.NoError(t, err)
+
+	// signed archive
+	var archive bytes.Buffer
+	require.NoError(t, writeArchive(&archive, snapshotStore, snapshot, manifestBz, signatureBz))
+	verified, pubKey, err := VerifyArchive(bytes.NewReader(archive.Bytes()), appHash)
+	require.NoError(t, err)
+	require.Equal(t, manifest, verified)
+	require.True(t, operator.Equals(pubKey))
+
+	_, _, err = VerifyArchive(bytes.NewReader(archive.Bytes()), []byte{1, 2, 3})
+	require.ErrorContains(t, err, "doesn't match trusted app hash")
+
+	// unsigned archive
+	archive.Reset()
+	require.NoError(t, writeArchive(&archive, snapshotStore, snapshot, manifestBz, nil))
+	_, pubKey, err = VerifyArchive(bytes.NewReader(archive.Bytes()), appHash)
+	require.NoError(t, err)
+	require.Nil(t, pubKey)
+
+	// the signature doesn't match a modified manifest
+	forged := *manifest
+	forged.ChainID = "other-chain"
+	forgedBz, err := json.Marshal(&forged)
+	require.NoError(t, err)
+	archive.Reset()
+	require.NoError(t, writeArchive(&archive, snapshotStore, snapshot, forgedBz, signatureBz))
+	_, _, err = VerifyArchive(bytes.NewReader(archive.Bytes()), appHash)
+	require.ErrorContains(t, err, "invalid manifest signature")
+
+	// the app hash of the manifest must be the one of the snapshot state
+	forged = *manifest
+	forged.AppHash = bytes.Repeat([]byte{1}, 32)
+	forgedBz, err = json.Marshal(&forged)
+	require.NoError(t, err)
+	archive.Reset()
+	require.NoError(t, writeArchive(&archive, snapshotStore, snapshot, forgedBz, nil))
+	_, _, err = VerifyArchive(bytes.NewReader(archive.Bytes()), forged.AppHash)
+	require.ErrorContains(t, err, "doesn't match manifest app hash")
+}
+
+func TestVerifyArchiveCmd(t *testing.T) {
+	snapshotStore, snapshot, appHash := setupSnapshot(t)
+
+	manifest, err := NewManifest(snapshotStore, snapshot, "test-chain")
+	require.NoError(t, err)
+	manifestBz, err := json.Marshal(manifest)
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	require.NoError(t, writeArchive(&archive, snapshotStore, snapshot, manifestBz, nil))
+	archiveFile := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	require.NoError(t, os.WriteFile(archiveFile, archive.Bytes(), 0o600))
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr string
+	}{
+		{"app hash missing", nil, "--app-hash is required"},
+		{"app hash mismatch", []string{"--app-hash", "010203"}, "doesn't match trusted app hash"},
+		{"app hash and skip", []string{"--app-hash", hex.EncodeToString(appHash), "--unsafe-skip-app-hash"}, "none of the others can be"},
+		{"trusted app hash", []string{"--app-hash", hex.EncodeToString(appHash)}, ""},
+		{"app hash skipped", []string{"--unsafe-skip-app-hash"}, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := VerifyArchiveCmd()
+			cmd.SetArgs(append([]string{archiveFile}, tc.args...))
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			err := cmd.Execute()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ func Cmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra
 		ExportSnapshotCmd(appCreator),
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
+		VerifyArchiveCmd(),
 		DeleteSnapshotCmd(),
 	)
 	return cmd
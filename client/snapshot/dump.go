@@ -1,20 +1,24 @@
 package snapshot
 
 import (
-	"archive/tar"
-	"compress/gzip"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"os"
 	"strconv"
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-// DumpArchiveCmd returns a command to dump the snapshot as portable archive format
+const flagSigner = "signer"
+
+// DumpArchiveCmd returns a command to dump the snapshot as portable archive format,
+// along with a manifest describing it, optionally signed by an operator key.
 func DumpArchiveCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "dump <height> <format>",
@@ -45,57 +49,68 @@ func DumpArchiveCmd() *cobra.Command {
 				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
 			}
 
-			snapshot, err := snapshotStore.Get(height, uint32(format))
+			chainID, err := cmd.Flags().GetString(flags.FlagChainID)
 			if err != nil {
 				return err
 			}
-
-			if snapshot == nil {
-				return errors.New("snapshot doesn't exist")
+			if chainID == "" {
+				// fallback to genesis chain-id
+				chainID, err = chainIDFromGenesis(ctx.Config.GenesisFile())
+				if err != nil {
+					return err
+				}
 			}
 
-			bz, err := snapshot.Marshal()
+			signer, err := cmd.Flags().GetString(flagSigner)
 			if err != nil {
 				return err
 			}
 
-			fp, err := os.Create(output)
+			snapshot, err := snapshotStore.Get(height, uint32(format))
 			if err != nil {
 				return err
 			}
-			defer fp.Close()
 
-			// since the chunk files are already compressed, we just use fastest compression here
-			gzipWriter, err := gzip.NewWriterLevel(fp, gzip.BestSpeed)
-			if err != nil {
-				return err
+			if snapshot == nil {
+				return errors.New("snapshot doesn't exist")
 			}
-			tarWriter := tar.NewWriter(gzipWriter)
-			if err := tarWriter.WriteHeader(&tar.Header{
-				Name: SnapshotFileName,
-				Mode: 0o644,
-				Size: int64(len(bz)),
-			}); err != nil {
-				return fmt.Errorf("failed to write snapshot header to tar: %w", err)
+
+			manifest, err := NewManifest(snapshotStore, snapshot, chainID)
+			if err != nil {
+				return fmt.Errorf("failed to create manifest: %w", err)
 			}
-			if _, err := tarWriter.Write(bz); err != nil {
-				return fmt.Errorf("failed to write snapshot to tar: %w", err)
+			manifestBz, err := json.Marshal(manifest)
+			if err != nil {
+				return err
 			}
 
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				path := snapshotStore.PathChunk(height, uint32(format), i)
-				tarName := strconv.FormatUint(uint64(i), 10)
-				if err := processChunk(tarWriter, path, tarName); err != nil {
+			var signatureBz []byte
+			if signer != "" {
+				clientCtx, err := client.ReadPersistentCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+				if err != nil {
+					return err
+				}
+				if clientCtx.Keyring == nil {
+					return errors.New("no keyring to sign the manifest")
+				}
+
+				signature, err := SignManifest(clientCtx.Keyring, signer, manifestBz)
+				if err != nil {
+					return err
+				}
+				if signatureBz, err = json.Marshal(signature); err != nil {
 					return err
 				}
 			}
 
-			if err := tarWriter.Close(); err != nil {
-				return fmt.Errorf("failed to close tar writer: %w", err)
+			fp, err := os.Create(output)
+			if err != nil {
+				return err
 			}
+			defer fp.Close()
 
-			if err := gzipWriter.Close(); err != nil {
-				return fmt.Errorf("failed to close gzip writer: %w", err)
+			if err := writeArchive(fp, snapshotStore, snapshot, manifestBz, signatureBz); err != nil {
+				return err
 			}
 
 			return fp.Close()
@@ -103,33 +118,25 @@ func DumpArchiveCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringP("output", "o", "", "output file")
+	cmd.Flags().String(flags.FlagChainID, "", "chain-id recorded in the manifest, default to the genesis chain-id")
+	cmd.Flags().String(flagSigner, "", "name of the keyring key signing the manifest, the manifest isn't signed if empty")
+	flags.AddKeyringFlags(cmd.Flags())
 
 	return cmd
 }
 
-func processChunk(tarWriter *tar.Writer, path, tarName string) error {
-	file, err := os.Open(path)
+// chainIDFromGenesis reads the chain-id of the genesis file.
+func chainIDFromGenesis(genesisFile string) (string, error) {
+	reader, err := os.Open(genesisFile)
 	if err != nil {
-		return fmt.Errorf("failed to open chunk file %s: %w", path, err)
+		return "", fmt.Errorf("failed to open genesis file: %w", err)
 	}
-	defer file.Close()
+	defer reader.Close()
 
-	st, err := file.Stat()
+	chainID, err := genutiltypes.ParseChainIDFromGenesis(reader)
 	if err != nil {
-		return fmt.Errorf("failed to stat chunk file %s: %w", path, err)
-	}
-
-	if err := tarWriter.WriteHeader(&tar.Header{
-		Name: tarName,
-		Mode: 0o644,
-		Size: st.Size(),
-	}); err != nil {
-		return fmt.Errorf("failed to write chunk header to tar: %w", err)
-	}
-
-	if _, err := io.Copy(tarWriter, file); err != nil {
-		return fmt.Errorf("failed to write chunk to tar: %w", err)
+		return "", fmt.Errorf("failed to parse chain-id from genesis file: %w", err)
 	}
 
-	return nil
+	return chainID, nil
 }
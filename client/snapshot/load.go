@@ -9,7 +9,6 @@ import (
 	"io"
 	"os"
 	"reflect"
-	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -18,9 +17,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
-const SnapshotFileName = "_snapshot"
-
-// LoadArchiveCmd load a portable archive format snapshot into snapshot store
+// LoadArchiveCmd load a portable archive format snapshot into snapshot store. The
+// chunks are checked against the manifest of the archive, if any, but its signature
+// isn't verified, see VerifyArchiveCmd.
 func LoadArchiveCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "load <archive-file>",
@@ -38,31 +37,17 @@ func LoadArchiveCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to open archive file: %w", err)
 			}
+			defer fp.Close()
 			reader, err := gzip.NewReader(fp)
 			if err != nil {
 				return fmt.Errorf("failed to create gzip reader: %w", err)
 			}
 
-			var snapshot snapshottypes.Snapshot
-			tr := tar.NewReader(reader)
-			if err != nil {
-				return fmt.Errorf("failed to create tar reader: %w", err)
-			}
-
-			hdr, err := tr.Next()
-			if err != nil {
-				return fmt.Errorf("failed to read snapshot file header: %w", err)
-			}
-			if hdr.Name != SnapshotFileName {
-				return fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
-			}
-			bz, err := io.ReadAll(tr)
+			archive, err := newArchiveReader(tar.NewReader(reader))
 			if err != nil {
-				return fmt.Errorf("failed to read snapshot file: %w", err)
-			}
-			if err := snapshot.Unmarshal(bz); err != nil {
-				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
+				return err
 			}
+			snapshot := archive.snapshot
 
 			// make sure the channel is unbuffered, because the tar reader can't do concurrency
 			chunks := make(chan io.ReadCloser)
@@ -78,23 +63,14 @@ func LoadArchiveCmd() *cobra.Command {
 				quitChan <- savedSnapshot
 			}()
 
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				hdr, err = tr.Next()
+			for {
+				bz, err := archive.nextChunk()
 				if err != nil {
 					if errors.Is(err, io.EOF) {
 						break
 					}
 					return err
 				}
-
-				if hdr.Name != strconv.FormatInt(int64(i), 10) {
-					return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
-				}
-
-				bz, err := io.ReadAll(tr)
-				if err != nil {
-					return fmt.Errorf("failed to read chunk file: %w", err)
-				}
 				chunks <- io.NopCloser(bytes.NewReader(bz))
 			}
 			close(chunks)
@@ -0,0 +1,203 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const (
+	flagAppHash           = "app-hash"
+	flagUnsafeSkipAppHash = "unsafe-skip-app-hash"
+	flagSignerAddress     = "signer-address"
+)
+
+// VerifyArchiveCmd returns a command to verify a snapshot archive without restoring it.
+func VerifyArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <archive-file>",
+		Short: "Verify a snapshot archive file (.tar.gz) against its manifest and a trusted app hash",
+		Long: `Verify a snapshot archive file (.tar.gz) without restoring it.
+
+The chunks of the snapshot are checked against the manifest of the archive, and the app hash of
+the snapshot state is computed from them and checked against the manifest. If the manifest is
+signed, its signature is verified. The app hash of a snapshot taken at height H is the app hash
+found in the header of the block at height H+1.
+
+The trusted app hash is required, the archive is rejected if it doesn't match. Skipping it with
+--unsafe-skip-app-hash only checks that the archive is consistent with its own manifest, which
+an attacker crafting the archive can forge.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appHashStr, err := cmd.Flags().GetString(flagAppHash)
+			if err != nil {
+				return err
+			}
+			skipAppHash, err := cmd.Flags().GetBool(flagUnsafeSkipAppHash)
+			if err != nil {
+				return err
+			}
+			if appHashStr == "" && !skipAppHash {
+				return fmt.Errorf("--%s is required, or --%s to not check the archive against a trusted app hash", flagAppHash, flagUnsafeSkipAppHash)
+			}
+			trustedAppHash, err := hex.DecodeString(appHashStr)
+			if err != nil {
+				return fmt.Errorf("invalid app hash: %w", err)
+			}
+
+			chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+
+			signerAddress, err := cmd.Flags().GetString(flagSignerAddress)
+			if err != nil {
+				return err
+			}
+			var signer []byte
+			if signerAddress != "" {
+				if _, signer, err = bech32.DecodeAndConvert(signerAddress); err != nil {
+					return fmt.Errorf("invalid signer address: %w", err)
+				}
+			}
+
+			fp, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open archive file: %w", err)
+			}
+			defer fp.Close()
+
+			manifest, pubKey, err := VerifyArchive(fp, trustedAppHash)
+			if err != nil {
+				return err
+			}
+
+			if chainID != "" && manifest.ChainID != chainID {
+				return fmt.Errorf("archive is for chain-id %s, expected %s", manifest.ChainID, chainID)
+			}
+			if signer != nil {
+				if pubKey == nil {
+					return errors.New("archive manifest isn't signed")
+				}
+				if !bytes.Equal(pubKey.Address(), signer) {
+					return fmt.Errorf("archive manifest is signed by %s, expected %s", sdk.AccAddress(pubKey.Address()), signerAddress)
+				}
+			}
+
+			cmd.Printf("Snapshot at height %d, format %d, chunks %d of chain %s verified\n",
+				manifest.Height, manifest.Format, len(manifest.ChunkHashes), manifest.ChainID)
+			cmd.Printf("App hash: %s\n", manifest.AppHash)
+			if pubKey != nil {
+				cmd.Printf("Signed by: %s\n", sdk.AccAddress(pubKey.Address()))
+			} else {
+				cmd.Println("Not signed")
+			}
+			if skipAppHash {
+				cmd.Println("WARNING: the app hash wasn't checked against a trusted app hash")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAppHash, "", "trusted app hash (hex) of the snapshot height, i.e. the app hash of the header of the next block")
+	cmd.Flags().Bool(flagUnsafeSkipAppHash, false, "don't check the archive against a trusted app hash, only against its own manifest")
+	cmd.MarkFlagsMutuallyExclusive(flagAppHash, flagUnsafeSkipAppHash)
+	cmd.Flags().String(flags.FlagChainID, "", "expected chain-id of the archive")
+	cmd.Flags().String(flagSignerAddress, "", "expected address of the signer of the archive manifest, the archive must be signed if set")
+
+	return cmd
+}
+
+// VerifyArchive verifies the snapshot archive read from r against its manifest,
+// computing the app hash of the snapshot state from its chunks, and against the
+// trusted app hash. An empty trusted app hash skips the latter check, leaving
+// the archive unauthenticated unless its manifest signer is trusted. It returns
+// the manifest and the public key of its signer, nil if the manifest isn't signed.
+func VerifyArchive(r io.Reader, trustedAppHash []byte) (*Manifest, cryptotypes.PubKey, error) {
+	reader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+
+	archive, err := newArchiveReader(tar.NewReader(reader))
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest := archive.manifest
+	if manifest == nil {
+		return nil, nil, errors.New("archive has no manifest")
+	}
+
+	var pubKey cryptotypes.PubKey
+	if archive.signature != nil {
+		if pubKey, err = archive.signature.Verify(archive.manifestBz); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(trustedAppHash) > 0 && !bytes.Equal(manifest.AppHash, trustedAppHash) {
+		return nil, nil, fmt.Errorf("manifest app hash %X doesn't match trusted app hash %X", manifest.AppHash, trustedAppHash)
+	}
+
+	// the app hash is computed while the chunks are read
+	chunks := make(chan io.ReadCloser)
+	type appHashResult struct {
+		hash []byte
+		err  error
+	}
+	chAppHash := make(chan appHashResult, 1)
+	go func() {
+		hash, err := snapshotAppHash(manifest.Height, manifest.Format, chunks)
+		chAppHash <- appHashResult{hash: hash, err: err}
+	}()
+
+	snapshotHasher := sha256.New()
+	err = func() error {
+		defer close(chunks)
+		for i := 0; ; i++ {
+			bz, err := archive.nextChunk()
+			if errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			hash := sha256.Sum256(bz)
+			if !bytes.Equal(hash[:], manifest.ChunkHashes[i]) {
+				return fmt.Errorf("chunk %d hash %X doesn't match manifest hash %X", i, hash, manifest.ChunkHashes[i])
+			}
+			snapshotHasher.Write(bz)
+			chunks <- io.NopCloser(bytes.NewReader(bz))
+		}
+	}()
+	result := <-chAppHash
+	if err != nil {
+		return nil, nil, err
+	}
+	if result.err != nil {
+		return nil, nil, fmt.Errorf("failed to compute app hash: %w", result.err)
+	}
+
+	if !bytes.Equal(snapshotHasher.Sum(nil), manifest.SnapshotHash) {
+		return nil, nil, errors.New("snapshot hash doesn't match manifest")
+	}
+	if !bytes.Equal(result.hash, manifest.AppHash) {
+		return nil, nil, fmt.Errorf("snapshot app hash %X doesn't match manifest app hash %X", result.hash, manifest.AppHash)
+	}
+
+	return manifest, pubKey, nil
+}
@@ -53,6 +53,10 @@ func (b boolKey[T]) KeyType() string {
 	return "bool"
 }
 
+func (boolKey[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return singleFieldSchemaCodec(BoolKind, func(key T) interface{} { return bool(key) }), nil
+}
+
 func (b boolKey[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return b.Encode(buffer, key)
 }
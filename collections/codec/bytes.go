@@ -45,6 +45,10 @@ func (b bytesKey[T]) KeyType() string {
 	return "bytes"
 }
 
+func (bytesKey[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return singleFieldSchemaCodec(BytesKind, func(key T) interface{} { return []byte(key) }), nil
+}
+
 func (b bytesKey[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	if len(key) > MaxBytesKeyNonTerminalSize {
 		return 0, fmt.Errorf(
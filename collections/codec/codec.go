@@ -111,6 +111,13 @@ func NewUntypedValueCodec[V any](v ValueCodec[V]) UntypedValueCodec {
 			return v.Stringify(concrete), nil
 		},
 		ValueType: func() string { return v.ValueType() },
+		SchemaCodec: func() (UntypedSchemaCodec, error) {
+			c, err := ValueSchemaCodec(v)
+			if err != nil {
+				return UntypedSchemaCodec{}, err
+			}
+			return NewUntypedSchemaCodec(c), nil
+		},
 	}
 }
 
@@ -122,6 +129,35 @@ type UntypedValueCodec struct {
 	EncodeJSON func(value interface{}) ([]byte, error)
 	Stringify  func(value interface{}) (string, error)
 	ValueType  func() string
+	// SchemaCodec returns the schema codec of the values, see ValueSchemaCodec.
+	SchemaCodec func() (UntypedSchemaCodec, error)
+}
+
+// NewUntypedKeyCodec returns an UntypedKeyCodec for the provided KeyCodec.
+func NewUntypedKeyCodec[K any](k KeyCodec[K]) UntypedKeyCodec {
+	vc := NewUntypedValueCodec(KeyToValueCodec(k))
+	return UntypedKeyCodec{
+		Decode:      vc.Decode,
+		Encode:      vc.Encode,
+		DecodeJSON:  vc.DecodeJSON,
+		EncodeJSON:  vc.EncodeJSON,
+		Stringify:   vc.Stringify,
+		KeyType:     vc.ValueType,
+		SchemaCodec: vc.SchemaCodec,
+	}
+}
+
+// UntypedKeyCodec wraps a KeyCodec to expose an untyped API for encoding and decoding keys.
+// Decode expects the provided bytes to be the full encoding of a key.
+type UntypedKeyCodec struct {
+	Decode     func(b []byte) (interface{}, error)
+	Encode     func(key interface{}) ([]byte, error)
+	DecodeJSON func(b []byte) (interface{}, error)
+	EncodeJSON func(key interface{}) ([]byte, error)
+	Stringify  func(key interface{}) (string, error)
+	KeyType    func() string
+	// SchemaCodec returns the schema codec of the keys, see KeySchemaCodec.
+	SchemaCodec func() (UntypedSchemaCodec, error)
 }
 
 // KeyToValueCodec converts a KeyCodec into a ValueCodec.
@@ -167,3 +203,7 @@ func (k keyToValueCodec[K]) Stringify(value K) string {
 func (k keyToValueCodec[K]) ValueType() string {
 	return k.kc.KeyType()
 }
+
+func (k keyToValueCodec[K]) SchemaCodec() (SchemaCodec[K], error) {
+	return KeySchemaCodec(k.kc)
+}
@@ -1,6 +1,7 @@
 package codec
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -37,3 +38,62 @@ func TestUntypedValueCodec(t *testing.T) {
 		require.Equal(t, "hello", s)
 	})
 }
+
+func TestUntypedKeyCodec(t *testing.T) {
+	kc := NewUntypedKeyCodec(NewUint64Key[uint64]())
+
+	_, err := kc.Encode("hello")
+	require.ErrorIs(t, err, ErrEncoding)
+	b, err := kc.Encode(uint64(1))
+	require.NoError(t, err)
+	key, err := kc.Decode(b)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), key)
+	s, err := kc.Stringify(key)
+	require.NoError(t, err)
+	require.Equal(t, "1", s)
+	require.Equal(t, "uint64", kc.KeyType())
+
+	// the key must be fully consumed
+	_, err = kc.Decode(append(b, 0))
+	require.ErrorIs(t, err, ErrEncoding)
+}
+
+func TestSchemaCodec(t *testing.T) {
+	c, err := KeySchemaCodec(NewUint32Key[uint32]())
+	require.NoError(t, err)
+	require.Equal(t, []SchemaField{{Kind: Uint64Kind}}, c.Fields)
+	values, err := c.ToSchemaType(7)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint64(7)}, values)
+
+	// value codecs of keys share their fields
+	vc, err := ValueSchemaCodec(KeyToValueCodec(NewBytesKey[[]byte]()))
+	require.NoError(t, err)
+	require.Equal(t, []SchemaField{{Kind: BytesKind}}, vc.Fields)
+
+	// codecs not describing their fields have a single JSON field
+	alt, err := ValueSchemaCodec(NewAltValueCodec(KeyToValueCodec(NewStringKeyCodec[string]()), nil))
+	require.NoError(t, err)
+	require.Equal(t, []SchemaField{{Kind: StringKind}}, alt.Fields)
+	jc, err := ValueSchemaCodec[uint64](noSchemaValueCodec{KeyToValueCodec(NewUint64Key[uint64]())})
+	require.NoError(t, err)
+	require.Equal(t, []SchemaField{{Kind: JSONKind}}, jc.Fields)
+	values, err = jc.ToSchemaType(7)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{json.RawMessage(`"7"`)}, values)
+
+	uc, err := NewUntypedKeyCodec(NewInt32Key[int32]()).SchemaCodec()
+	require.NoError(t, err)
+	require.Equal(t, []SchemaField{{Kind: Int64Kind}}, uc.Fields)
+	_, err = uc.ToSchemaType("1")
+	require.ErrorIs(t, err, ErrEncoding)
+	values, err = uc.ToSchemaType(int32(-1))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(-1)}, values)
+}
+
+// noSchemaValueCodec hides the schema codec of the wrapped codec.
+type noSchemaValueCodec struct {
+	ValueCodec[uint64]
+}
@@ -52,6 +52,10 @@ func (i int64Key[T]) KeyType() string {
 	return "int64"
 }
 
+func (int64Key[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return singleFieldSchemaCodec(Int64Kind, func(key T) interface{} { return int64(key) }), nil
+}
+
 func (i int64Key[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return i.Encode(buffer, key)
 }
@@ -110,6 +114,10 @@ func (i int32Key[T]) KeyType() string {
 	return "int32"
 }
 
+func (int32Key[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return singleFieldSchemaCodec(Int64Kind, func(key T) interface{} { return int64(key) }), nil
+}
+
 func (i int32Key[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return i.Encode(buffer, key)
 }
@@ -0,0 +1,138 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FieldKind is the kind of the value of a SchemaField.
+type FieldKind int
+
+const (
+	// InvalidKind is the zero value of FieldKind.
+	InvalidKind FieldKind = iota
+	// StringKind is a field of string values.
+	StringKind
+	// BytesKind is a field of []byte values.
+	BytesKind
+	// Int64Kind is a field of int64 values.
+	Int64Kind
+	// Uint64Kind is a field of uint64 values.
+	Uint64Kind
+	// BoolKind is a field of bool values.
+	BoolKind
+	// TimeKind is a field of time.Time values.
+	TimeKind
+	// JSONKind is a field of json.RawMessage values.
+	JSONKind
+)
+
+func (k FieldKind) String() string {
+	switch k {
+	case StringKind:
+		return "string"
+	case BytesKind:
+		return "bytes"
+	case Int64Kind:
+		return "int64"
+	case Uint64Kind:
+		return "uint64"
+	case BoolKind:
+		return "bool"
+	case TimeKind:
+		return "time"
+	case JSONKind:
+		return "json"
+	default:
+		return fmt.Sprintf("invalid(%d)", int(k))
+	}
+}
+
+// SchemaField describes a field of a key or value, e.g. to map it to a column of
+// a relational table.
+type SchemaField struct {
+	// Name is the name of the field. The single field of a key or value may have
+	// an empty name, which is then named after its collection, e.g. "key" or "value".
+	Name string
+	// Kind is the kind of the values of the field.
+	Kind FieldKind
+}
+
+// SchemaCodec describes the fields of a key or value type and converts the
+// values of the type to the values of their fields.
+type SchemaCodec[T any] struct {
+	// Fields are the fields of the type.
+	Fields []SchemaField
+	// ToSchemaType returns the values of the fields of the value, in the order of
+	// Fields, as Go values of their kinds.
+	ToSchemaType func(T) ([]interface{}, error)
+}
+
+// HasSchemaCodec is implemented by key and value codecs describing the fields
+// of their type.
+type HasSchemaCodec[T any] interface {
+	SchemaCodec() (SchemaCodec[T], error)
+}
+
+// KeySchemaCodec returns the SchemaCodec of the key codec, or a single JSON field
+// with the JSON encoding of the keys if it doesn't implement HasSchemaCodec.
+func KeySchemaCodec[K any](keyCodec KeyCodec[K]) (SchemaCodec[K], error) {
+	if c, ok := keyCodec.(HasSchemaCodec[K]); ok {
+		return c.SchemaCodec()
+	}
+	return jsonSchemaCodec(keyCodec.EncodeJSON), nil
+}
+
+// ValueSchemaCodec returns the SchemaCodec of the value codec, or a single JSON
+// field with the JSON encoding of the values if it doesn't implement HasSchemaCodec.
+func ValueSchemaCodec[V any](valueCodec ValueCodec[V]) (SchemaCodec[V], error) {
+	if c, ok := valueCodec.(HasSchemaCodec[V]); ok {
+		return c.SchemaCodec()
+	}
+	return jsonSchemaCodec(valueCodec.EncodeJSON), nil
+}
+
+func jsonSchemaCodec[T any](encodeJSON func(T) ([]byte, error)) SchemaCodec[T] {
+	return SchemaCodec[T]{
+		Fields: []SchemaField{{Kind: JSONKind}},
+		ToSchemaType: func(value T) ([]interface{}, error) {
+			bz, err := encodeJSON(value)
+			if err != nil {
+				return nil, err
+			}
+			return []interface{}{json.RawMessage(bz)}, nil
+		},
+	}
+}
+
+// singleFieldSchemaCodec returns the SchemaCodec of a type with a single field
+// of the given kind.
+func singleFieldSchemaCodec[T any](kind FieldKind, toSchemaType func(T) interface{}) SchemaCodec[T] {
+	return SchemaCodec[T]{
+		Fields: []SchemaField{{Kind: kind}},
+		ToSchemaType: func(value T) ([]interface{}, error) {
+			return []interface{}{toSchemaType(value)}, nil
+		},
+	}
+}
+
+// NewUntypedSchemaCodec returns an UntypedSchemaCodec for the provided SchemaCodec.
+func NewUntypedSchemaCodec[T any](c SchemaCodec[T]) UntypedSchemaCodec {
+	typeName := fmt.Sprintf("%T", *new(T))
+	return UntypedSchemaCodec{
+		Fields: c.Fields,
+		ToSchemaType: func(value interface{}) ([]interface{}, error) {
+			v, ok := value.(T)
+			if !ok {
+				return nil, fmt.Errorf("%w: expected value of type %s, got %T", ErrEncoding, typeName, value)
+			}
+			return c.ToSchemaType(v)
+		},
+	}
+}
+
+// UntypedSchemaCodec wraps a SchemaCodec to expose an untyped API.
+type UntypedSchemaCodec struct {
+	Fields       []SchemaField
+	ToSchemaType func(value interface{}) ([]interface{}, error)
+}
@@ -66,3 +66,7 @@ func (stringKey[T]) Stringify(key T) string {
 func (stringKey[T]) KeyType() string {
 	return "string"
 }
+
+func (stringKey[T]) SchemaCodec() (SchemaCodec[T], error) {
+	return singleFieldSchemaCodec(StringKind, func(key T) interface{} { return string(key) }), nil
+}
@@ -86,6 +86,10 @@ type Collection interface {
 	// GetPrefix is the unique prefix of the collection within a schema.
 	GetPrefix() []byte
 
+	// KeyCodec returns the codec used to encode/decode keys of the collection,
+	// without the collection prefix.
+	KeyCodec() codec.UntypedKeyCodec
+
 	// ValueCodec returns the codec used to encode/decode values of the collection.
 	ValueCodec() codec.UntypedValueCodec
 
@@ -138,6 +142,10 @@ type collectionImpl[K, V any] struct {
 	m Map[K, V]
 }
 
+func (c collectionImpl[K, V]) KeyCodec() codec.UntypedKeyCodec {
+	return codec.NewUntypedKeyCodec(c.m.kc)
+}
+
 func (c collectionImpl[K, V]) ValueCodec() codec.UntypedValueCodec {
 	return codec.NewUntypedValueCodec(c.m.vc)
 }
@@ -62,6 +62,11 @@ func (noKey) DecodeJSON(b []byte) (noKey, error) {
 	}
 	return noKey{}, nil
 }
+func (noKey) SchemaCodec() (codec.SchemaCodec[noKey], error) {
+	return codec.SchemaCodec[noKey]{
+		ToSchemaType: func(noKey) ([]interface{}, error) { return nil, nil },
+	}, nil
+}
 func (k noKey) EncodeNonTerminal(_ []byte, _ noKey) (int, error) { panic("must not be called") }
 func (k noKey) DecodeNonTerminal(_ []byte) (int, noKey, error)   { panic("must not be called") }
 func (k noKey) SizeNonTerminal(_ noKey) int                      { panic("must not be called") }
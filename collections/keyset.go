@@ -140,3 +140,10 @@ func (NoValue) Stringify(_ NoValue) string {
 func (n NoValue) ValueType() string {
 	return noValueValueType
 }
+
+// SchemaCodec implements codec.HasSchemaCodec, NoValue has no fields.
+func (NoValue) SchemaCodec() (codec.SchemaCodec[NoValue], error) {
+	return codec.SchemaCodec[NoValue]{
+		ToSchemaType: func(NoValue) ([]interface{}, error) { return nil, nil },
+	}, nil
+}
@@ -135,6 +135,49 @@ func (p pairKeyCodec[K1, K2]) KeyType() string {
 	return fmt.Sprintf("Pair[%s, %s]", p.keyCodec1.KeyType(), p.keyCodec2.KeyType())
 }
 
+// SchemaCodec implements codec.HasSchemaCodec, with the fields of the parts of
+// the pair named k1 and k2.
+func (p pairKeyCodec[K1, K2]) SchemaCodec() (codec.SchemaCodec[Pair[K1, K2]], error) {
+	c1, err := codec.KeySchemaCodec(p.keyCodec1)
+	if err != nil {
+		return codec.SchemaCodec[Pair[K1, K2]]{}, err
+	}
+	c2, err := codec.KeySchemaCodec(p.keyCodec2)
+	if err != nil {
+		return codec.SchemaCodec[Pair[K1, K2]]{}, err
+	}
+
+	return codec.SchemaCodec[Pair[K1, K2]]{
+		Fields: append(keyPartFields("k1", c1.Fields), keyPartFields("k2", c2.Fields)...),
+		ToSchemaType: func(key Pair[K1, K2]) ([]interface{}, error) {
+			v1, err := c1.ToSchemaType(key.K1())
+			if err != nil {
+				return nil, err
+			}
+			v2, err := c2.ToSchemaType(key.K2())
+			if err != nil {
+				return nil, err
+			}
+			return append(v1, v2...), nil
+		},
+	}, nil
+}
+
+// keyPartFields returns the schema fields of a part of a multipart key, named
+// after the part, or prefixed with its name for parts with several fields.
+func keyPartFields(part string, fields []codec.SchemaField) []codec.SchemaField {
+	res := make([]codec.SchemaField, len(fields))
+	for i, field := range fields {
+		res[i] = field
+		if field.Name == "" {
+			res[i].Name = part
+		} else {
+			res[i].Name = part + "_" + field.Name
+		}
+	}
+	return res
+}
+
 func (p pairKeyCodec[K1, K2]) EncodeNonTerminal(buffer []byte, pair Pair[K1, K2]) (int, error) {
 	writtenTotal := 0
 	if pair.key1 != nil {
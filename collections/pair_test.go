@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/codec"
 )
 
 func TestPair(t *testing.T) {
@@ -67,3 +69,27 @@ func TestPairRange(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []Pair[string, uint64]{Join("A", uint64(2)), Join("A", uint64(1))}, keys)
 }
+
+func TestPairSchemaCodec(t *testing.T) {
+	c, err := codec.KeySchemaCodec(PairKeyCodec(StringKey, PairKeyCodec(Uint64Key, BoolKey)))
+	require.NoError(t, err)
+	require.Equal(t, []codec.SchemaField{
+		{Name: "k1", Kind: codec.StringKind},
+		{Name: "k2_k1", Kind: codec.Uint64Kind},
+		{Name: "k2_k2", Kind: codec.BoolKind},
+	}, c.Fields)
+
+	values, err := c.ToSchemaType(Join("a", Join(uint64(1), true)))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"a", uint64(1), true}, values)
+
+	// items have no key fields
+	storeService, _ := deps()
+	sb := NewSchemaBuilder(storeService)
+	NewItem(sb, NewPrefix(0), "item", StringValue)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+	ic, err := schema.ListCollections()[0].KeyCodec().SchemaCodec()
+	require.NoError(t, err)
+	require.Empty(t, ic.Fields)
+}
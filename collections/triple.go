@@ -153,6 +153,46 @@ func (t tripleKeyCodec[K1, K2, K3]) KeyType() string {
 	return fmt.Sprintf("Triple[%s,%s,%s]", t.keyCodec1.KeyType(), t.keyCodec2.KeyType(), t.keyCodec3.KeyType())
 }
 
+// SchemaCodec implements codec.HasSchemaCodec, with the fields of the parts of
+// the triple named k1, k2 and k3.
+func (t tripleKeyCodec[K1, K2, K3]) SchemaCodec() (codec.SchemaCodec[Triple[K1, K2, K3]], error) {
+	c1, err := codec.KeySchemaCodec(t.keyCodec1)
+	if err != nil {
+		return codec.SchemaCodec[Triple[K1, K2, K3]]{}, err
+	}
+	c2, err := codec.KeySchemaCodec(t.keyCodec2)
+	if err != nil {
+		return codec.SchemaCodec[Triple[K1, K2, K3]]{}, err
+	}
+	c3, err := codec.KeySchemaCodec(t.keyCodec3)
+	if err != nil {
+		return codec.SchemaCodec[Triple[K1, K2, K3]]{}, err
+	}
+
+	var fields []codec.SchemaField
+	fields = append(fields, keyPartFields("k1", c1.Fields)...)
+	fields = append(fields, keyPartFields("k2", c2.Fields)...)
+	fields = append(fields, keyPartFields("k3", c3.Fields)...)
+	return codec.SchemaCodec[Triple[K1, K2, K3]]{
+		Fields: fields,
+		ToSchemaType: func(key Triple[K1, K2, K3]) ([]interface{}, error) {
+			v1, err := c1.ToSchemaType(key.K1())
+			if err != nil {
+				return nil, err
+			}
+			v2, err := c2.ToSchemaType(key.K2())
+			if err != nil {
+				return nil, err
+			}
+			v3, err := c3.ToSchemaType(key.K3())
+			if err != nil {
+				return nil, err
+			}
+			return append(append(v1, v2...), v3...), nil
+		},
+	}, nil
+}
+
 func (t tripleKeyCodec[K1, K2, K3]) Encode(buffer []byte, key Triple[K1, K2, K3]) (int, error) {
 	writtenTotal := 0
 	if key.k1 != nil {
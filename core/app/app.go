@@ -6,6 +6,7 @@ import (
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
 )
 
@@ -64,6 +65,9 @@ type TxResult struct {
 	GasWanted uint64
 	GasUsed   uint64
 	Codespace string
+	// AccessSets contains the keys accessed by the transaction, for each actor.
+	// It is only populated when the state transition function traces accesses.
+	AccessSets []store.AccessSet
 }
 
 // VersionModifier defines the interface fulfilled by BaseApp
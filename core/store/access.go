@@ -0,0 +1,16 @@
+package store
+
+// AccessSet represents the keys of an actor's storage which were accessed during a state transition.
+type AccessSet struct {
+	Actor  []byte     // actor represents the space in storage which was accessed.
+	Reads  []KeyRange // Reads is the list of key ranges read, sorted and non overlapping.
+	Writes [][]byte   // Writes is the list of keys set or removed, in ascending order.
+}
+
+// KeyRange represents the range of keys going from Start (inclusive) to End (exclusive).
+// A nil Start or End means the range is unbounded on that side.
+// A single key read is represented as the range going from the key to the key followed by a zero byte.
+type KeyRange struct {
+	Start []byte
+	End   []byte
+}
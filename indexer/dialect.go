@@ -0,0 +1,107 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	collcodec "cosmossdk.io/collections/codec"
+)
+
+// Dialect is the SQL dialect of the database of an Indexer.
+type Dialect int
+
+const (
+	// Postgres is the dialect of PostgreSQL and compatible databases.
+	Postgres Dialect = iota
+	// SQLite is the dialect of SQLite, e.g. to run an Indexer without a
+	// database server.
+	SQLite
+)
+
+func (d Dialect) String() string {
+	switch d {
+	case Postgres:
+		return "postgres"
+	case SQLite:
+		return "sqlite"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(d))
+	}
+}
+
+// placeholder returns the placeholder of the n-th parameter of a statement,
+// starting at 1.
+func (d Dialect) placeholder(n int) string {
+	if d == Postgres {
+		return "$" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+// columnType returns the type of the columns of the fields of the given kind.
+func (d Dialect) columnType(kind collcodec.FieldKind) string {
+	switch kind {
+	case collcodec.StringKind:
+		return "TEXT"
+	case collcodec.BytesKind:
+		if d == Postgres {
+			return "BYTEA"
+		}
+		return "BLOB"
+	case collcodec.Int64Kind:
+		return "BIGINT"
+	case collcodec.Uint64Kind:
+		if d == Postgres {
+			// BIGINT is signed
+			return "NUMERIC(20, 0)"
+		}
+		return "INTEGER"
+	case collcodec.BoolKind:
+		return "BOOLEAN"
+	case collcodec.TimeKind:
+		if d == Postgres {
+			return "TIMESTAMPTZ"
+		}
+		return "TIMESTAMP"
+	case collcodec.JSONKind:
+		if d == Postgres {
+			return "JSONB"
+		}
+		return "TEXT"
+	default:
+		panic(fmt.Sprintf("unknown field kind %s", kind))
+	}
+}
+
+// columnValue returns the value of a field of the given kind as a parameter of
+// a statement.
+func (d Dialect) columnValue(kind collcodec.FieldKind, value interface{}) (interface{}, error) {
+	switch kind {
+	case collcodec.Uint64Kind:
+		v, ok := value.(uint64)
+		if !ok {
+			return nil, fmt.Errorf("expected uint64 value, got %T", value)
+		}
+		// database/sql doesn't support uint64 values with the high bit set
+		if d == Postgres || v > math.MaxInt64 {
+			return strconv.FormatUint(v, 10), nil
+		}
+		return int64(v), nil
+	case collcodec.JSONKind:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return nil, fmt.Errorf("expected json.RawMessage value, got %T", value)
+		}
+		return string(v), nil
+	default:
+		return value, nil
+	}
+}
+
+// quote returns the quoted SQL identifier.
+func quote(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
@@ -0,0 +1,184 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// BlocksTable is the name of the table of the indexed blocks.
+const BlocksTable = "indexed_blocks"
+
+var _ storetypes.ABCIListener = (*Indexer)(nil)
+
+// Indexer is an ABCIListener writing the changes of the collections of the
+// modules to the tables of a SQL database, in one transaction per block.
+//
+// NOTE: Each collection of a module is mapped to two tables, named after the
+// store key name of the module and the collection name:
+// - {module}_{collection} holds the current entries, with the columns of the
+// key and value fields and the height the entry was last written at
+// - {module}_{collection}_history holds the writes and deletions of the entries,
+// with the height, the columns of the key and value fields, and a deleted flag
+// The key and value fields are described by the SchemaCodec of the collection
+// codecs, which default to a single JSON column, or for the codecs of the sdk
+// types by the schema of their type. The committed blocks are
+// recorded in the BlocksTable table.
+//
+// The changes of a store are only streamed if the multi-store listens to it,
+// see CommitMultiStore.AddListeners.
+type Indexer struct {
+	db      *sql.DB
+	dialect Dialect
+	tables  map[string][]*table // by store key name
+
+	mtx           sync.Mutex
+	finalizeBlock *abci.FinalizeBlockRequest
+	appHash       []byte
+}
+
+// NewIndexer returns a new Indexer writing the changes of the collections of the
+// given schemas, by store key name, to the database of the given dialect. The
+// tables are created if they don't exist.
+func NewIndexer(ctx context.Context, db *sql.DB, dialect Dialect, schemas map[string]collections.Schema) (*Indexer, error) {
+	if dialect != Postgres && dialect != SQLite {
+		return nil, fmt.Errorf("unknown SQL dialect %d", dialect)
+	}
+
+	i := &Indexer{
+		db:      db,
+		dialect: dialect,
+		tables:  make(map[string][]*table, len(schemas)),
+	}
+
+	storeNames := make([]string, 0, len(schemas))
+	for name := range schemas {
+		storeNames = append(storeNames, name)
+	}
+	sort.Strings(storeNames)
+
+	stmts := []string{i.createBlocksTable()}
+	for _, storeName := range storeNames {
+		for _, coll := range schemas[storeName].ListCollections() {
+			t, err := newTable(dialect, storeName, coll)
+			if err != nil {
+				return nil, err
+			}
+			i.tables[storeName] = append(i.tables[storeName], t)
+			stmts = append(stmts, t.createStatements()...)
+		}
+	}
+
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("failed to create tables: %w", err)
+		}
+	}
+
+	return i, nil
+}
+
+// LastHeight returns the height of the last indexed block, or 0 if none.
+func (i *Indexer) LastHeight(ctx context.Context) (int64, error) {
+	var height sql.NullInt64
+	err := i.db.QueryRowContext(ctx, fmt.Sprintf("SELECT MAX(%s) FROM %s", quote("height"), quote(BlocksTable))).Scan(&height)
+	return height.Int64, err
+}
+
+// ListenFinalizeBlock implements ABCIListener. The block is kept until it's
+// committed.
+func (i *Indexer) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	i.finalizeBlock = &req
+	i.appHash = res.AppHash
+	return nil
+}
+
+// ListenCommit implements ABCIListener. It writes the changes of the committed
+// block to the tables of their collections. The changes of keys which don't
+// belong to a collection are ignored.
+func (i *Indexer) ListenCommit(ctx context.Context, _ abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	block := i.finalizeBlock
+	if block == nil {
+		return errors.New("no FinalizeBlock request for the committed block")
+	}
+	i.finalizeBlock = nil
+
+	tx, err := i.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := i.indexBlock(ctx, tx, block, changeSet); err != nil {
+		return errors.Join(fmt.Errorf("failed to index block %d: %w", block.Height, err), tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+func (i *Indexer) indexBlock(ctx context.Context, tx *sql.Tx, block *abci.FinalizeBlockRequest, changeSet []*storetypes.StoreKVPair) error {
+	for _, pair := range changeSet {
+		t := i.table(pair.StoreKey, pair.Key)
+		if t == nil {
+			continue
+		}
+		if err := t.write(ctx, tx, block.Height, pair); err != nil {
+			return err
+		}
+	}
+
+	// the block may be indexed again, e.g. after a rollback of the state
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s = %s", quote(BlocksTable), quote("height"), i.dialect.placeholder(1)),
+		block.Height); err != nil {
+		return err
+	}
+	_, err := tx.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (%s, %s, %s) VALUES (%s, %s, %s)", quote(BlocksTable),
+		quote("height"), quote("time"), quote("app_hash"),
+		i.dialect.placeholder(1), i.dialect.placeholder(2), i.dialect.placeholder(3)),
+		block.Height, block.Time.UTC(), i.appHash)
+	return err
+}
+
+// table returns the table of the collection the key of the store belongs to,
+// if any.
+func (i *Indexer) table(storeName string, key []byte) *table {
+	for _, t := range i.tables[storeName] {
+		if bytes.HasPrefix(key, t.prefix) {
+			return t
+		}
+	}
+	return nil
+}
+
+func (i *Indexer) createBlocksTable() string {
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s %s NOT NULL PRIMARY KEY, %s %s NOT NULL, %s %s)", quote(BlocksTable),
+		quote("height"), i.dialect.columnType(collcodec.Int64Kind),
+		quote("time"), i.dialect.columnType(collcodec.TimeKind),
+		quote("app_hash"), i.dialect.columnType(collcodec.BytesKind))
+}
+
+// Tables returns the names of the tables of the collections, sorted.
+func (i *Indexer) Tables() []string {
+	var names []string
+	for _, tables := range i.tables {
+		for _, t := range tables {
+			names = append(names, t.name, t.historyName())
+		}
+	}
+	slices.Sort(names)
+	return names
+}
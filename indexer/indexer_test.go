@@ -0,0 +1,238 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/colltest"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// noSchemaValueCodec hides the schema codec of the wrapped codec.
+type noSchemaValueCodec struct {
+	collcodec.ValueCodec[string]
+}
+
+// namedKeyCodec overrides the key type of the wrapped codec.
+type namedKeyCodec[K any] struct {
+	collcodec.KeyCodec[K]
+	keyType string
+}
+
+func (c namedKeyCodec[K]) KeyType() string { return c.keyType }
+
+var (
+	balancesPrefix = collections.NewPrefix(1)
+	balancesKey    = collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+	holdersPrefix  = collections.NewPrefix(2)
+	paramsPrefix   = collections.NewPrefix(3)
+)
+
+func testSchema(t *testing.T) collections.Schema {
+	t.Helper()
+	storeService, _ := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(storeService)
+	collections.NewMap(sb, balancesPrefix, "balances", balancesKey, collections.Uint64Value)
+	collections.NewKeySet(sb, holdersPrefix, "holders", collections.StringKey)
+	collections.NewItem(sb, paramsPrefix, "params", collcodec.ValueCodec[string](noSchemaValueCodec{collections.StringValue}))
+	schema, err := sb.Build()
+	require.NoError(t, err)
+	return schema
+}
+
+func setBalance(t *testing.T, addr, denom string, amount uint64) *storetypes.StoreKVPair {
+	t.Helper()
+	key, err := collections.EncodeKeyWithPrefix(balancesPrefix, balancesKey, collections.Join(addr, denom))
+	require.NoError(t, err)
+	value, err := collections.Uint64Value.Encode(amount)
+	require.NoError(t, err)
+	return &storetypes.StoreKVPair{StoreKey: "bank", Key: key, Value: value}
+}
+
+func deleteBalance(t *testing.T, addr, denom string) *storetypes.StoreKVPair {
+	t.Helper()
+	pair := setBalance(t, addr, denom, 0)
+	pair.Value, pair.Delete = nil, true
+	return pair
+}
+
+func commitBlock(t *testing.T, i *Indexer, height int64, changeSet ...*storetypes.StoreKVPair) error {
+	t.Helper()
+	ctx := context.Background()
+	require.NoError(t, i.ListenFinalizeBlock(ctx,
+		abci.FinalizeBlockRequest{Height: height, Time: time.Unix(height, 0)},
+		abci.FinalizeBlockResponse{AppHash: []byte{byte(height)}},
+	))
+	return i.ListenCommit(ctx, abci.CommitResponse{}, changeSet)
+}
+
+func queryRows(t *testing.T, db *sql.DB, query string) [][]interface{} {
+	t.Helper()
+	rows, err := db.Query(query)
+	require.NoError(t, err)
+	defer rows.Close()
+	columns, err := rows.Columns()
+	require.NoError(t, err)
+
+	var res [][]interface{}
+	for rows.Next() {
+		row := make([]interface{}, len(columns))
+		ptrs := make([]interface{}, len(columns))
+		for i := range row {
+			ptrs[i] = &row[i]
+		}
+		require.NoError(t, rows.Scan(ptrs...))
+		for i, v := range row {
+			if b, ok := v.([]byte); ok {
+				row[i] = string(b)
+			}
+		}
+		res = append(res, row)
+	}
+	require.NoError(t, rows.Err())
+	return res
+}
+
+func TestIndexer(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "index.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	i, err := NewIndexer(ctx, db, SQLite, map[string]collections.Schema{"bank": testSchema(t)})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"bank_balances", "bank_balances_history",
+		"bank_holders", "bank_holders_history",
+		"bank_params", "bank_params_history",
+	}, i.Tables())
+
+	height, err := i.LastHeight(ctx)
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	require.ErrorContains(t, i.ListenCommit(ctx, abci.CommitResponse{}, nil), "no FinalizeBlock")
+
+	holder, err := collections.EncodeKeyWithPrefix(holdersPrefix, collections.StringKey, "alice")
+	require.NoError(t, err)
+	params, err := collections.StringValue.Encode("v1")
+	require.NoError(t, err)
+
+	require.NoError(t, commitBlock(t, i, 1,
+		setBalance(t, "alice", "atom", 10),
+		setBalance(t, "alice", "atom", 15), // written twice in the block
+		setBalance(t, "bob", "atom", 20),
+		&storetypes.StoreKVPair{StoreKey: "bank", Key: holder, Value: []byte{}},
+		&storetypes.StoreKVPair{StoreKey: "bank", Key: paramsPrefix, Value: params},
+		// keys out of the collections and stores without a schema are ignored
+		&storetypes.StoreKVPair{StoreKey: "bank", Key: []byte{9}, Value: []byte{1}},
+		&storetypes.StoreKVPair{StoreKey: "staking", Key: []byte{1}, Value: []byte{1}},
+	))
+	require.NoError(t, commitBlock(t, i, 2,
+		deleteBalance(t, "alice", "atom"),
+		setBalance(t, "bob", "atom", 25),
+	))
+
+	require.Equal(t, [][]interface{}{
+		{"bob", "atom", int64(25), int64(2)},
+	}, queryRows(t, db, `SELECT * FROM bank_balances ORDER BY k1, k2`))
+	require.Equal(t, [][]interface{}{
+		{int64(1), "alice", "atom", int64(15), false},
+		{int64(1), "bob", "atom", int64(20), false},
+		{int64(2), "alice", "atom", nil, true},
+		{int64(2), "bob", "atom", int64(25), false},
+	}, queryRows(t, db, `SELECT height, k1, k2, value, deleted FROM bank_balances_history ORDER BY height, k1`))
+	require.Equal(t, [][]interface{}{
+		{"alice", int64(1)},
+	}, queryRows(t, db, `SELECT * FROM bank_holders`))
+	require.Equal(t, [][]interface{}{
+		{`"v1"`, int64(1)},
+	}, queryRows(t, db, `SELECT * FROM bank_params`))
+
+	height, err = i.LastHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+
+	// an undecodable key fails the whole block
+	require.Error(t, commitBlock(t, i, 3,
+		setBalance(t, "carol", "atom", 1),
+		&storetypes.StoreKVPair{StoreKey: "bank", Key: []byte{1, 'x'}, Value: []byte{1}},
+	))
+	require.Len(t, queryRows(t, db, `SELECT * FROM bank_balances`), 1)
+	height, err = i.LastHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+
+	// the tables of an existing database are reused
+	i, err = NewIndexer(ctx, db, SQLite, map[string]collections.Schema{"bank": testSchema(t)})
+	require.NoError(t, err)
+	require.NoError(t, commitBlock(t, i, 3, setBalance(t, "carol", "atom", 1)))
+	require.Len(t, queryRows(t, db, `SELECT * FROM bank_balances`), 2)
+}
+
+func TestTableStatements(t *testing.T) {
+	tables := func(dialect Dialect) []*table {
+		var res []*table
+		for _, coll := range testSchema(t).ListCollections() {
+			tbl, err := newTable(dialect, "bank", coll)
+			require.NoError(t, err)
+			res = append(res, tbl)
+		}
+		return res
+	}
+
+	balances := tables(Postgres)[0]
+	require.Equal(t, []string{
+		`CREATE TABLE IF NOT EXISTS "bank_balances" ("k1" TEXT NOT NULL, "k2" TEXT NOT NULL, "value" NUMERIC(20, 0), "height" BIGINT NOT NULL, PRIMARY KEY ("k1", "k2"))`,
+		`CREATE TABLE IF NOT EXISTS "bank_balances_history" ("height" BIGINT NOT NULL, "k1" TEXT NOT NULL, "k2" TEXT NOT NULL, "value" NUMERIC(20, 0), "deleted" BOOLEAN NOT NULL, PRIMARY KEY ("height", "k1", "k2"))`,
+	}, balances.createStatements())
+	require.Equal(t, `DELETE FROM "bank_balances" WHERE "k1" = $1 AND "k2" = $2`, balances.deleteSQL)
+	require.Equal(t, `INSERT INTO "bank_balances" ("k1", "k2", "value", "height") VALUES ($1, $2, $3, $4)`, balances.insertSQL)
+
+	params := tables(SQLite)[2]
+	require.Equal(t, []string{
+		`CREATE TABLE IF NOT EXISTS "bank_params" ("value" TEXT, "height" BIGINT NOT NULL)`,
+		`CREATE TABLE IF NOT EXISTS "bank_params_history" ("height" BIGINT NOT NULL, "value" TEXT, "deleted" BOOLEAN NOT NULL, PRIMARY KEY ("height"))`,
+	}, params.createStatements())
+	require.Equal(t, `DELETE FROM "bank_params"`, params.deleteSQL)
+	require.Equal(t, `DELETE FROM "bank_params_history" WHERE "height" = ?`, params.deleteHistorySQL)
+}
+
+func TestSDKSchemaCodecs(t *testing.T) {
+	// the codecs of the sdk types are described by the schema of their type,
+	// instead of the default JSON field
+	sc, err := keySchemaCodec(collcodec.NewUntypedKeyCodec(collcodec.KeyCodec[uint64](namedKeyCodec[uint64]{collections.Uint64Key, "little-endian-uint64"})))
+	require.NoError(t, err)
+	require.Equal(t, []collcodec.SchemaField{{Kind: collcodec.Uint64Kind}}, sc.Fields)
+	values, err := sc.ToSchemaType(uint64(42))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint64(42)}, values)
+
+	sc, err = keySchemaCodec(collcodec.NewUntypedKeyCodec(collcodec.KeyCodec[[]byte](namedKeyCodec[[]byte]{collections.BytesKey, "index_key/bytes"})))
+	require.NoError(t, err)
+	require.Equal(t, []collcodec.SchemaField{{Kind: collcodec.BytesKind}}, sc.Fields)
+
+	// addresses and math integers are stored as strings
+	values, err = sdkSchemaCodecs["sdk.AccAddress"].ToSchemaType(time.Second)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"1s"}, values)
+	_, err = sdkSchemaCodecs["math.Int"].ToSchemaType(1)
+	require.ErrorIs(t, err, collcodec.ErrEncoding)
+
+	// the other codecs keep their schema codec
+	sc, err = keySchemaCodec(collcodec.NewUntypedKeyCodec(collcodec.KeyCodec[uint64](namedKeyCodec[uint64]{collections.Uint64Key, "other"})))
+	require.NoError(t, err)
+	require.Equal(t, []collcodec.SchemaField{{Kind: collcodec.JSONKind}}, sc.Fields)
+	sc, err = valueSchemaCodec(collcodec.NewUntypedValueCodec(collections.Uint64Value))
+	require.NoError(t, err)
+	require.Equal(t, []collcodec.SchemaField{{Kind: collcodec.Uint64Kind}}, sc.Fields)
+}
@@ -0,0 +1,68 @@
+package indexer
+
+import (
+	"fmt"
+	"time"
+
+	collcodec "cosmossdk.io/collections/codec"
+)
+
+// sdkSchemaCodecs are the schema codecs of the codecs of the cosmos-sdk types
+// package, by key or value type name, as these codecs don't implement
+// collcodec.HasSchemaCodec. These codecs nested in other codecs, e.g. in pairs,
+// keep the default JSON field.
+var sdkSchemaCodecs = map[string]collcodec.UntypedSchemaCodec{
+	"sdk.AccAddress":            stringerSchemaCodec(),
+	"sdk.ValAddress":            stringerSchemaCodec(),
+	"sdk.ConsAddress":           stringerSchemaCodec(),
+	"index_key/sdk.AccAddress":  stringerSchemaCodec(),
+	"index_key/sdk.ValAddress":  stringerSchemaCodec(),
+	"index_key/sdk.ConsAddress": stringerSchemaCodec(),
+	"index_key/bytes":           singleFieldSchemaCodec[[]byte](collcodec.BytesKind),
+	"math.Int":                  stringerSchemaCodec(),
+	"math.Uint":                 stringerSchemaCodec(),
+	"sdk/time.Time":             singleFieldSchemaCodec[time.Time](collcodec.TimeKind),
+	"little-endian-uint64":      singleFieldSchemaCodec[uint64](collcodec.Uint64Kind),
+}
+
+// keySchemaCodec returns the schema codec of the key codec, see KeySchemaCodec.
+func keySchemaCodec(c collcodec.UntypedKeyCodec) (collcodec.UntypedSchemaCodec, error) {
+	if sc, ok := sdkSchemaCodecs[c.KeyType()]; ok {
+		return sc, nil
+	}
+	return c.SchemaCodec()
+}
+
+// valueSchemaCodec returns the schema codec of the value codec, see ValueSchemaCodec.
+func valueSchemaCodec(c collcodec.UntypedValueCodec) (collcodec.UntypedSchemaCodec, error) {
+	if sc, ok := sdkSchemaCodecs[c.ValueType()]; ok {
+		return sc, nil
+	}
+	return c.SchemaCodec()
+}
+
+// stringerSchemaCodec returns the schema codec of a type with a single string
+// field holding the String of its values, e.g. the bech32 form of addresses.
+func stringerSchemaCodec() collcodec.UntypedSchemaCodec {
+	return collcodec.UntypedSchemaCodec{
+		Fields: []collcodec.SchemaField{{Kind: collcodec.StringKind}},
+		ToSchemaType: func(value interface{}) ([]interface{}, error) {
+			s, ok := value.(fmt.Stringer)
+			if !ok {
+				return nil, fmt.Errorf("%w: expected a fmt.Stringer, got %T", collcodec.ErrEncoding, value)
+			}
+			return []interface{}{s.String()}, nil
+		},
+	}
+}
+
+// singleFieldSchemaCodec returns the schema codec of a type with a single field
+// of the given kind holding its values.
+func singleFieldSchemaCodec[T any](kind collcodec.FieldKind) collcodec.UntypedSchemaCodec {
+	return collcodec.NewUntypedSchemaCodec(collcodec.SchemaCodec[T]{
+		Fields: []collcodec.SchemaField{{Kind: kind}},
+		ToSchemaType: func(value T) ([]interface{}, error) {
+			return []interface{}{value}, nil
+		},
+	})
+}
@@ -0,0 +1,235 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// table maps a collection to its tables.
+type table struct {
+	dialect     Dialect
+	name        string
+	prefix      []byte
+	keyCodec    collcodec.UntypedKeyCodec
+	valueCodec  collcodec.UntypedValueCodec
+	keySchema   collcodec.UntypedSchemaCodec
+	valueSchema collcodec.UntypedSchemaCodec
+	keyColumns  []string
+	valColumns  []string
+
+	deleteSQL        string
+	insertSQL        string
+	deleteHistorySQL string
+	insertHistorySQL string
+}
+
+func newTable(dialect Dialect, storeName string, coll collections.Collection) (*table, error) {
+	t := &table{
+		dialect:    dialect,
+		name:       storeName + "_" + coll.GetName(),
+		prefix:     coll.GetPrefix(),
+		keyCodec:   coll.KeyCodec(),
+		valueCodec: coll.ValueCodec(),
+	}
+
+	var err error
+	if t.keySchema, err = keySchemaCodec(t.keyCodec); err != nil {
+		return nil, fmt.Errorf("failed to get the key schema of %s: %w", t.name, err)
+	}
+	if t.valueSchema, err = valueSchemaCodec(t.valueCodec); err != nil {
+		return nil, fmt.Errorf("failed to get the value schema of %s: %w", t.name, err)
+	}
+	t.keyColumns = columnNames(t.keySchema.Fields, "key")
+	t.valColumns = columnNames(t.valueSchema.Fields, "value")
+
+	fields := slices.Concat(t.keySchema.Fields, t.valueSchema.Fields)
+	seen := map[string]bool{"height": true, "deleted": true}
+	for i, column := range slices.Concat(t.keyColumns, t.valColumns) {
+		if seen[column] {
+			return nil, fmt.Errorf("duplicate or reserved column %s in %s", column, t.name)
+		}
+		seen[column] = true
+		if kind := fields[i].Kind; kind <= collcodec.InvalidKind || kind > collcodec.JSONKind {
+			return nil, fmt.Errorf("invalid kind %s of column %s in %s", kind, column, t.name)
+		}
+	}
+
+	t.deleteSQL = t.deleteStatement(t.name, t.keyColumns)
+	t.insertSQL = t.insertStatement(t.name, slices.Concat(t.keyColumns, t.valColumns, []string{"height"}))
+	historyKey := slices.Concat([]string{"height"}, t.keyColumns)
+	t.deleteHistorySQL = t.deleteStatement(t.historyName(), historyKey)
+	t.insertHistorySQL = t.insertStatement(t.historyName(), slices.Concat(historyKey, t.valColumns, []string{"deleted"}))
+
+	return t, nil
+}
+
+// columnNames returns the names of the columns of the fields. A single unnamed
+// field is named after the given name, e.g. "key".
+func columnNames(fields []collcodec.SchemaField, name string) []string {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		switch {
+		case field.Name != "":
+			names[i] = field.Name
+		case len(fields) == 1:
+			names[i] = name
+		default:
+			names[i] = fmt.Sprintf("%s_%d", name, i+1)
+		}
+	}
+	return names
+}
+
+func (t *table) historyName() string {
+	return t.name + "_history"
+}
+
+// createStatements returns the statements creating the tables of the collection.
+func (t *table) createStatements() []string {
+	columns := func(names []string, fields []collcodec.SchemaField, notNull bool) []string {
+		defs := make([]string, len(names))
+		for i, name := range names {
+			defs[i] = quote(name) + " " + t.dialect.columnType(fields[i].Kind)
+			if notNull {
+				defs[i] += " NOT NULL"
+			}
+		}
+		return defs
+	}
+	height := quote("height") + " " + t.dialect.columnType(collcodec.Int64Kind) + " NOT NULL"
+	keyColumns := quoteAll(t.keyColumns)
+
+	defs := columns(t.keyColumns, t.keySchema.Fields, true)
+	defs = append(defs, columns(t.valColumns, t.valueSchema.Fields, false)...)
+	defs = append(defs, height)
+	if len(keyColumns) > 0 {
+		defs = append(defs, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(keyColumns, ", ")))
+	}
+
+	historyDefs := []string{height}
+	historyDefs = append(historyDefs, columns(t.keyColumns, t.keySchema.Fields, true)...)
+	historyDefs = append(historyDefs, columns(t.valColumns, t.valueSchema.Fields, false)...)
+	historyDefs = append(historyDefs,
+		quote("deleted")+" "+t.dialect.columnType(collcodec.BoolKind)+" NOT NULL",
+		fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(append([]string{quote("height")}, keyColumns...), ", ")),
+	)
+
+	return []string{
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", quote(t.name), strings.Join(defs, ", ")),
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", quote(t.historyName()), strings.Join(historyDefs, ", ")),
+	}
+}
+
+// write writes the change of an entry of the collection at the given height.
+func (t *table) write(ctx context.Context, tx *sql.Tx, height int64, pair *storetypes.StoreKVPair) error {
+	keyValues, err := t.keyValues(pair.Key[len(t.prefix):])
+	if err != nil {
+		return fmt.Errorf("failed to decode key %X of %s: %w", pair.Key, t.name, err)
+	}
+	values := make([]interface{}, len(t.valColumns)) // NULL if deleted
+	if !pair.Delete {
+		if values, err = t.valueValues(pair.Value); err != nil {
+			return fmt.Errorf("failed to decode value of key %X of %s: %w", pair.Key, t.name, err)
+		}
+	}
+
+	// the entry is deleted and inserted again, as items have no primary key
+	if _, err := tx.ExecContext(ctx, t.deleteSQL, keyValues...); err != nil {
+		return err
+	}
+	if !pair.Delete {
+		if _, err := tx.ExecContext(ctx, t.insertSQL, slices.Concat(keyValues, values, []interface{}{height})...); err != nil {
+			return err
+		}
+	}
+
+	// the entry may be written several times in a block
+	historyKey := slices.Concat([]interface{}{height}, keyValues)
+	if _, err := tx.ExecContext(ctx, t.deleteHistorySQL, historyKey...); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, t.insertHistorySQL, slices.Concat(historyKey, values, []interface{}{pair.Delete})...)
+	return err
+}
+
+// keyValues returns the column values of the encoded key.
+func (t *table) keyValues(key []byte) ([]interface{}, error) {
+	k, err := t.keyCodec.Decode(key)
+	if err != nil {
+		return nil, err
+	}
+	values, err := t.keySchema.ToSchemaType(k)
+	if err != nil {
+		return nil, err
+	}
+	return t.columnValues(t.keySchema.Fields, values)
+}
+
+// valueValues returns the column values of the encoded value.
+func (t *table) valueValues(value []byte) ([]interface{}, error) {
+	if len(t.valueSchema.Fields) == 0 {
+		return nil, nil
+	}
+	v, err := t.valueCodec.Decode(value)
+	if err != nil {
+		return nil, err
+	}
+	values, err := t.valueSchema.ToSchemaType(v)
+	if err != nil {
+		return nil, err
+	}
+	return t.columnValues(t.valueSchema.Fields, values)
+}
+
+func (t *table) columnValues(fields []collcodec.SchemaField, values []interface{}) ([]interface{}, error) {
+	if len(values) != len(fields) {
+		return nil, fmt.Errorf("expected %d field values, got %d", len(fields), len(values))
+	}
+	res := make([]interface{}, len(values))
+	for i, value := range values {
+		v, err := t.dialect.columnValue(fields[i].Kind, value)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = v
+	}
+	return res, nil
+}
+
+// deleteStatement returns the statement deleting the rows of the given values of
+// the key columns.
+func (t *table) deleteStatement(table string, columns []string) string {
+	if len(columns) == 0 {
+		return "DELETE FROM " + quote(table)
+	}
+
+	conds := make([]string, len(columns))
+	for i, column := range columns {
+		conds[i] = fmt.Sprintf("%s = %s", quote(column), t.dialect.placeholder(i+1))
+	}
+	return fmt.Sprintf("DELETE FROM %s WHERE %s", quote(table), strings.Join(conds, " AND "))
+}
+
+// insertStatement returns the statement inserting a row of the given columns.
+func (t *table) insertStatement(table string, columns []string) string {
+	params := make([]string, len(columns))
+	for i := range columns {
+		params[i] = t.dialect.placeholder(i + 1)
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", quote(table), strings.Join(quoteAll(columns), ", "), strings.Join(params, ", "))
+}
+
+func quoteAll(identifiers []string) []string {
+	quoted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		quoted[i] = quote(identifier)
+	}
+	return quoted
+}
@@ -139,6 +139,10 @@ type GRPCConfig struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+
+	// EnableStateService defines if the state gRPC service, decoding the state
+	// of the modules, should be registered by the applications supporting it.
+	EnableStateService bool `mapstructure:"enable-state-service"`
 }
 
 // StateSyncConfig defines the state sync snapshot configuration.
@@ -167,6 +171,7 @@ type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
 		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		File FileListenerConfig `mapstructure:"file"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -174,6 +179,15 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// FileListenerConfig defines application configuration for the file streaming service
+	FileListenerConfig struct {
+		Dir           string   `mapstructure:"dir"`
+		Keys          []string `mapstructure:"keys"`
+		MaxBlocks     int64    `mapstructure:"max-blocks"`
+		MaxSize       int64    `mapstructure:"max-size"`
+		Fsync         bool     `mapstructure:"fsync"`
+		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -251,6 +265,11 @@ func DefaultConfig() *Config {
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			File: FileListenerConfig{
+				Keys:          []string{},
+				MaxSize:       128 << 20,
+				StopNodeOnErr: true,
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: -1,
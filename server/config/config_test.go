@@ -52,6 +52,14 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			File: FileListenerConfig{
+				Dir:           "data/streaming",
+				Keys:          []string{"three"},
+				MaxBlocks:     100,
+				MaxSize:       1024,
+				Fsync:         true,
+				StopNodeOnErr: true,
+			},
 		},
 	}
 
@@ -69,6 +77,12 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`dir = "data/streaming"`,
+		`keys = ["three", ]`,
+		`max-blocks = 100`,
+		`max-size = 1024`,
+		`fsync = true`,
+		`stop-node-on-err = true`,
 	}
 
 	for _, line := range expectedLines {
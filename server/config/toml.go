@@ -182,6 +182,11 @@ max-recv-msg-size = "{{ .GRPC.MaxRecvMsgSize }}"
 # The default value is math.MaxInt32.
 max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
 
+# EnableStateService defines if the state gRPC service, decoding the state of the
+# modules, should be registered. The service iterates over the collections of the
+# modules, so it should only be enabled on nodes whose gRPC server isn't public.
+enable-state-service = {{ .GRPC.EnableStateService }}
+
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
@@ -223,6 +228,37 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.file specifies the configuration for the streaming service writing
+# the FinalizeBlock requests and responses and the state changes of the committed
+# blocks to rotating local files, which can be replayed with the reader of the
+# baseapp/streaming/file package.
+[streaming.file]
+
+# Directory of the files, relative to the node home directory if not absolute.
+# Streaming to files is only enabled if this is set.
+dir = "{{ .Streaming.File.Dir }}"
+
+# List of kv store keys whose changes are written.
+# The store key names MUST match the module's StoreKey name.
+#
+# Example:
+# ["acc", "bank", "gov", "staking", "mint"[,...]]
+# ["*"] to expose all keys.
+keys = [{{ range .Streaming.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# Number of blocks after which a new file is started (0 for no limit).
+max-blocks = {{ .Streaming.File.MaxBlocks }}
+
+# Size in bytes after which a new file is started (0 for no limit).
+max-size = {{ .Streaming.File.MaxSize }}
+
+# fsync specifies whether to sync the file to disk after each block.
+fsync = {{ .Streaming.File.Fsync }}
+
+# stop-node-on-err specifies whether to stop the node on write error.
+# The node is stopped on streaming errors if it is set for any of the services.
+stop-node-on-err = {{ .Streaming.File.StopNodeOnErr }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -10,11 +10,13 @@ import (
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino" // Import amino.proto file for reflection
 )
 
@@ -39,6 +41,12 @@ func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.G
 
 	app.RegisterGRPCServer(grpcSrv)
 
+	// The mempool service is served by the node directly rather than through the
+	// app query router, as it reads the local mempool and streams its events.
+	if mpApp, ok := app.(interface{ Mempool() mempool.Mempool }); ok && mpApp.Mempool() != nil {
+		mempoolservice.RegisterMempoolService(grpcSrv, mpApp.Mempool())
+	}
+
 	// Reflection allows consumers to build dynamic clients that can write to any
 	// Cosmos SDK application without relying on application packages at compile
 	// time.
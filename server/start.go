@@ -270,6 +270,7 @@ func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.C
 		app.RegisterTxService(clientCtx)
 		app.RegisterTendermintService(clientCtx)
 		app.RegisterNodeService(clientCtx, svrCfg)
+		registerStateService(svrCtx, svrCfg, clientCtx, app)
 	}
 
 	grpcSrv, clientCtx, err := startGrpcServer(ctx, g, svrCfg.GRPC, clientCtx, svrCtx, app)
@@ -335,6 +336,7 @@ func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Co
 			app.RegisterTxService(clientCtx)
 			app.RegisterTendermintService(clientCtx)
 			app.RegisterNodeService(clientCtx, svrCfg)
+			registerStateService(svrCtx, svrCfg, clientCtx, app)
 		}
 	}
 
@@ -472,6 +474,21 @@ func SetupTraceWriter(logger log.Logger, traceWriterFile string) (traceWriter io
 	return traceWriter, cleanup, nil
 }
 
+// registerStateService registers the state gRPC service of the app if it is
+// enabled in the gRPC config.
+func registerStateService(svrCtx *Context, svrCfg serverconfig.Config, clientCtx client.Context, app types.Application) {
+	if !svrCfg.GRPC.EnableStateService {
+		return
+	}
+
+	stateApp, ok := app.(types.StateServiceApplication)
+	if !ok {
+		svrCtx.Logger.Error("the state gRPC service is enabled but not supported by the app")
+		return
+	}
+	stateApp.RegisterStateService(clientCtx)
+}
+
 func startGrpcServer(
 	ctx context.Context,
 	g *errgroup.Group,
@@ -63,6 +63,16 @@ type (
 		Close() error
 	}
 
+	// StateServiceApplication defines an Application serving the state gRPC
+	// service, which decodes the state of its modules. The service is only
+	// registered if enabled in the gRPC config.
+	StateServiceApplication interface {
+		Application
+
+		// RegisterStateService registers the state gRPC Query service.
+		RegisterStateService(client.Context)
+	}
+
 	// AppCreator is a function that allows us to lazily initialize an
 	// application using various configurations.
 	AppCreator[T Application] func(log.Logger, dbm.DB, io.Writer, AppOptions) T
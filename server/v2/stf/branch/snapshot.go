@@ -0,0 +1,70 @@
+package branch
+
+import (
+	"cosmossdk.io/core/store"
+)
+
+var _ store.ReaderMap = snapshot{}
+
+// NewSnapshot returns a read only view of state with the provided state changes applied on top.
+// The snapshot is never mutated after construction, hence it can be read concurrently by many
+// goroutines as long as the provided state supports concurrent reads.
+func NewSnapshot(state store.ReaderMap, changes []store.StateChanges) store.ReaderMap {
+	s := snapshot{
+		state:   state,
+		changes: make(map[string]changeSet, len(changes)),
+	}
+	for _, sc := range changes {
+		actor := string(sc.Actor)
+		cs, ok := s.changes[actor]
+		if !ok {
+			cs = newChangeSet()
+			s.changes[actor] = cs
+		}
+		for _, kv := range sc.StateChanges {
+			if kv.Remove {
+				cs.delete(kv.Key)
+			} else {
+				cs.set(kv.Key, kv.Value)
+			}
+		}
+	}
+	return s
+}
+
+// snapshot implements a store.ReaderMap which overlays a set of frozen
+// changes on top of a readonly state.
+type snapshot struct {
+	state   store.ReaderMap
+	changes map[string]changeSet
+}
+
+func (s snapshot) GetReader(actor []byte) (store.Reader, error) {
+	parent, err := s.state.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	cs, ok := s.changes[unsafeString(actor)]
+	if !ok {
+		return parent, nil
+	}
+	return readonlyStore{Store[store.Reader]{changeSet: cs, parent: parent}}, nil
+}
+
+// readonlyStore exposes only the read methods of a Store, so that
+// the frozen changeSet of a snapshot cannot be mutated.
+type readonlyStore struct {
+	s Store[store.Reader]
+}
+
+func (r readonlyStore) Has(key []byte) (bool, error) { return r.s.Has(key) }
+
+func (r readonlyStore) Get(key []byte) ([]byte, error) { return r.s.Get(key) }
+
+func (r readonlyStore) Iterator(start, end []byte) (store.Iterator, error) {
+	return r.s.Iterator(start, end)
+}
+
+func (r readonlyStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return r.s.ReverseIterator(start, end)
+}
@@ -0,0 +1,201 @@
+package branch
+
+import (
+	"bytes"
+	"slices"
+
+	"cosmossdk.io/core/store"
+)
+
+var _ store.ReaderMap = (*TracingReaderMap)(nil)
+
+// NewTracingReaderMap returns a TracingReaderMap which traces the reads done on state.
+func NewTracingReaderMap(state store.ReaderMap) *TracingReaderMap {
+	return &TracingReaderMap{
+		state: state,
+		reads: make(map[string]*[]store.KeyRange),
+	}
+}
+
+// TracingReaderMap wraps a store.ReaderMap and records, for each actor, the key
+// ranges which were read, including the ranges walked through by iterators.
+// When used as the parent of a WriterMap only the reads which are not served by
+// the WriterMap's own changes are traced, which are exactly the reads depending
+// on the state.
+// It is not safe for concurrent use.
+type TracingReaderMap struct {
+	state store.ReaderMap
+	reads map[string]*[]store.KeyRange
+}
+
+func (t *TracingReaderMap) GetReader(actor []byte) (store.Reader, error) {
+	parent, err := t.state.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	reads, ok := t.reads[string(actor)]
+	if !ok {
+		reads = new([]store.KeyRange)
+		t.reads[string(actor)] = reads
+	}
+	return tracingReader{parent: parent, reads: reads}, nil
+}
+
+// AccessSets returns, sorted by actor, the access sets of every actor which
+// was read through the TracingReaderMap or written in the provided changes.
+func (t *TracingReaderMap) AccessSets(changes []store.StateChanges) []store.AccessSet {
+	sets := make(map[string]*store.AccessSet, len(t.reads))
+	get := func(actor string) *store.AccessSet {
+		set, ok := sets[actor]
+		if !ok {
+			set = &store.AccessSet{Actor: []byte(actor)}
+			sets[actor] = set
+		}
+		return set
+	}
+
+	for actor, reads := range t.reads {
+		if len(*reads) == 0 {
+			continue
+		}
+		get(actor).Reads = mergeKeyRanges(*reads)
+	}
+	for _, sc := range changes {
+		if len(sc.StateChanges) == 0 {
+			continue
+		}
+		set := get(string(sc.Actor))
+		for _, kv := range sc.StateChanges {
+			set.Writes = append(set.Writes, kv.Key)
+		}
+		slices.SortFunc(set.Writes, bytes.Compare)
+		set.Writes = slices.CompactFunc(set.Writes, bytes.Equal)
+	}
+
+	accessSets := make([]store.AccessSet, 0, len(sets))
+	for _, set := range sets {
+		accessSets = append(accessSets, *set)
+	}
+	slices.SortFunc(accessSets, func(a, b store.AccessSet) int {
+		return bytes.Compare(a.Actor, b.Actor)
+	})
+	return accessSets
+}
+
+// mergeKeyRanges sorts the provided ranges and merges the overlapping ones.
+func mergeKeyRanges(ranges []store.KeyRange) []store.KeyRange {
+	ranges = slices.Clone(ranges)
+	slices.SortFunc(ranges, func(a, b store.KeyRange) int {
+		// a nil start is the lowest possible key.
+		switch {
+		case a.Start == nil && b.Start == nil:
+			return 0
+		case a.Start == nil:
+			return -1
+		case b.Start == nil:
+			return 1
+		default:
+			return bytes.Compare(a.Start, b.Start)
+		}
+	})
+
+	merged := []store.KeyRange{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if last.End == nil {
+			// the last range is unbounded and contains every following range.
+			break
+		}
+		if bytes.Compare(r.Start, last.End) > 0 {
+			merged = append(merged, r)
+			continue
+		}
+		if r.End == nil || bytes.Compare(r.End, last.End) > 0 {
+			last.End = r.End
+		}
+	}
+	return merged
+}
+
+// keyRange returns the range containing only key.
+func keyRange(key []byte) store.KeyRange {
+	return store.KeyRange{
+		Start: bytes.Clone(key),
+		End:   append(bytes.Clone(key), 0),
+	}
+}
+
+// tracingReader is a store.Reader which records the key ranges read.
+type tracingReader struct {
+	parent store.Reader
+	reads  *[]store.KeyRange
+}
+
+func (r tracingReader) Has(key []byte) (bool, error) {
+	*r.reads = append(*r.reads, keyRange(key))
+	return r.parent.Has(key)
+}
+
+func (r tracingReader) Get(key []byte) ([]byte, error) {
+	*r.reads = append(*r.reads, keyRange(key))
+	return r.parent.Get(key)
+}
+
+func (r tracingReader) Iterator(start, end []byte) (store.Iterator, error) {
+	iter, err := r.parent.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return r.trace(iter, start, end, true), nil
+}
+
+func (r tracingReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	iter, err := r.parent.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return r.trace(iter, start, end, false), nil
+}
+
+func (r tracingReader) trace(iter store.Iterator, start, end []byte, ascending bool) store.Iterator {
+	*r.reads = append(*r.reads, store.KeyRange{})
+	ti := &tracingIterator{
+		Iterator:  iter,
+		start:     bytes.Clone(start),
+		end:       bytes.Clone(end),
+		ascending: ascending,
+		reads:     r.reads,
+		index:     len(*r.reads) - 1,
+	}
+	ti.record()
+	return ti
+}
+
+// tracingIterator is a store.Iterator which keeps the key range it walked through
+// up to date, as it is advanced.
+type tracingIterator struct {
+	store.Iterator
+	start, end []byte
+	ascending  bool
+
+	reads *[]store.KeyRange
+	index int
+}
+
+func (i *tracingIterator) Next() {
+	i.Iterator.Next()
+	i.record()
+}
+
+// record updates the traced range with the current position of the iterator.
+func (i *tracingIterator) record() {
+	kr := store.KeyRange{Start: i.start, End: i.end}
+	if i.Iterator.Valid() {
+		if i.ascending {
+			kr.End = append(bytes.Clone(i.Iterator.Key()), 0)
+		} else {
+			kr.Start = bytes.Clone(i.Iterator.Key())
+		}
+	}
+	(*i.reads)[i.index] = kr
+}
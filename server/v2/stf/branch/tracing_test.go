@@ -0,0 +1,90 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+)
+
+func TestTracingReaderMap(t *testing.T) {
+	parent := newMemState()
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		require.NoError(t, parent.Set([]byte(k), []byte(k)))
+	}
+
+	tracer := NewTracingReaderMap(singleActorState{parent})
+	state := DefaultNewWriterMap(tracer)
+	actor := []byte("actor")
+	writer, err := state.GetWriter(actor)
+	require.NoError(t, err)
+
+	// reads of keys written by the branch do not depend on the state and are not traced.
+	require.NoError(t, writer.Set([]byte("z"), []byte("z")))
+	_, err = writer.Get([]byte("z"))
+	require.NoError(t, err)
+
+	_, err = writer.Get([]byte("a"))
+	require.NoError(t, err)
+	_, err = writer.Has([]byte("x"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Delete([]byte("b")))
+
+	// iteration stops at c, only [b, c] is read.
+	iter, err := writer.Iterator([]byte("b"), nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("c"), iter.Key())
+	require.NoError(t, iter.Close())
+
+	// exhausted reverse iteration reads its whole domain.
+	iter, err = writer.ReverseIterator([]byte("d"), []byte("e\x00"))
+	require.NoError(t, err)
+	for ; iter.Valid(); iter.Next() {
+	}
+	require.NoError(t, iter.Close())
+
+	changes, err := state.GetStateChanges()
+	require.NoError(t, err)
+	require.Equal(t, []store.AccessSet{
+		{
+			Actor: actor,
+			Reads: []store.KeyRange{
+				{Start: []byte("a"), End: []byte("a\x00")},
+				{Start: []byte("b"), End: []byte("c\x00")},
+				{Start: []byte("d"), End: []byte("e\x00")},
+				{Start: []byte("x"), End: []byte("x\x00")},
+			},
+			Writes: [][]byte{[]byte("b"), []byte("z")},
+		},
+	}, tracer.AccessSets(changes))
+}
+
+func TestMergeKeyRanges(t *testing.T) {
+	kr := func(start, end string) store.KeyRange {
+		r := store.KeyRange{}
+		if start != "" {
+			r.Start = []byte(start)
+		}
+		if end != "" {
+			r.End = []byte(end)
+		}
+		return r
+	}
+
+	require.Equal(t,
+		[]store.KeyRange{kr("", "c"), kr("d", "f")},
+		mergeKeyRanges([]store.KeyRange{kr("e", "f"), kr("b", "c"), kr("", "b"), kr("d", "e")}),
+	)
+	require.Equal(t,
+		[]store.KeyRange{kr("a", "")},
+		mergeKeyRanges([]store.KeyRange{kr("a", "b"), kr("b", ""), kr("c", "d")}),
+	)
+}
+
+// singleActorState is a store.ReaderMap returning the same store for every actor.
+type singleActorState struct {
+	s store.Reader
+}
+
+func (s singleActorState) GetReader(_ []byte) (store.Reader, error) { return s.s, nil }
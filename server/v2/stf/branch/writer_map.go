@@ -54,7 +54,7 @@ func (b WriterMap) ApplyStateChanges(stateChanges []store.StateChanges) error {
 }
 
 func (b WriterMap) GetStateChanges() ([]store.StateChanges, error) {
-	sc := make([]store.StateChanges, len(b.branchedWriterState))
+	sc := make([]store.StateChanges, 0, len(b.branchedWriterState))
 	for account, stateChange := range b.branchedWriterState {
 		kvChanges, err := stateChange.ChangeSets()
 		if err != nil {
@@ -0,0 +1,56 @@
+package gas
+
+import (
+	coregas "cosmossdk.io/core/gas"
+	"cosmossdk.io/core/store"
+)
+
+// GasSchedule defines the gas costs of the stores, by actor. The stores without
+// costs of their own use the Default costs.
+type GasSchedule struct {
+	Default StoreConfig
+	Stores  map[string]StoreConfig
+}
+
+// StoreConfig returns the gas costs of the store of actor.
+func (s GasSchedule) StoreConfig(actor []byte) StoreConfig {
+	if conf, ok := s.Stores[unsafeString(actor)]; ok {
+		return conf
+	}
+	return s.Default
+}
+
+// WrapWithGasMeter is like DefaultWrapWithGasMeter, but meters the stores with
+// the costs of the schedule.
+func (s GasSchedule) WrapWithGasMeter(meter coregas.Meter, state store.WriterMap) store.WriterMap {
+	if meter.Limit() == coregas.NoGasLimit {
+		return state
+	}
+	return NewScheduledWriterMap(s, meter, state)
+}
+
+// NewGasSchedule returns the schedule metering the stores with the core gas
+// configs, e.g. the ones governed by x/consensus. The stores missing from stores
+// use def.
+func NewGasSchedule(def coregas.GasConfig, stores map[string]coregas.GasConfig) GasSchedule {
+	schedule := GasSchedule{
+		Default: storeConfig(def),
+		Stores:  make(map[string]StoreConfig, len(stores)),
+	}
+	for storeKey, conf := range stores {
+		schedule.Stores[storeKey] = storeConfig(conf)
+	}
+	return schedule
+}
+
+func storeConfig(conf coregas.GasConfig) StoreConfig {
+	return StoreConfig{
+		ReadCostFlat:     conf.ReadCostFlat,
+		ReadCostPerByte:  conf.ReadCostPerByte,
+		HasCost:          conf.HasCost,
+		WriteCostFlat:    conf.WriteCostFlat,
+		WriteCostPerByte: conf.WriteCostPerByte,
+		DeleteCostFlat:   conf.DeleteCost,
+		IterNextCostFlat: conf.IterNextCostFlat,
+	}
+}
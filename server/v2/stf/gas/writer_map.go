@@ -8,8 +8,14 @@ import (
 )
 
 func NewMeteredWriterMap(conf StoreConfig, meter gas.Meter, state store.WriterMap) MeteredWriterMap {
+	return NewScheduledWriterMap(GasSchedule{Default: conf}, meter, state)
+}
+
+// NewScheduledWriterMap returns a MeteredWriterMap metering each store with its
+// costs in the schedule.
+func NewScheduledWriterMap(schedule GasSchedule, meter gas.Meter, state store.WriterMap) MeteredWriterMap {
 	return MeteredWriterMap{
-		config:             conf,
+		schedule:           schedule,
 		meter:              meter,
 		state:              state,
 		cacheMeteredStores: make(map[string]*Store),
@@ -20,7 +26,7 @@ func NewMeteredWriterMap(conf StoreConfig, meter gas.Meter, state store.WriterMa
 // version of it. Since the gas meter is shared across different
 // writers, the metered writers are memoized.
 type MeteredWriterMap struct {
-	config             StoreConfig
+	schedule           GasSchedule
 	meter              gas.Meter
 	state              store.WriterMap
 	cacheMeteredStores map[string]*Store
@@ -39,7 +45,7 @@ func (m MeteredWriterMap) GetWriter(actor []byte) (store.Writer, error) {
 		return nil, err
 	}
 
-	meteredState := NewStore(m.config, m.meter, state)
+	meteredState := NewStore(m.schedule.StoreConfig(actor), m.meter, state)
 	m.cacheMeteredStores[string(actor)] = meteredState
 
 	return meteredState, nil
@@ -0,0 +1,36 @@
+package stf
+
+import (
+	"context"
+	"fmt"
+
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/store"
+	stfgas "cosmossdk.io/server/v2/stf/gas"
+)
+
+// SetGasSchedule sets the function loading the gas costs of the stores from
+// state, e.g. from x/consensus. The schedule is loaded from the state blocks,
+// simulations and tx validations start from, so that updates of the schedule
+// take effect from the next block. The stores are metered with
+// stfgas.DefaultConfig when no loader is set.
+func (s *STF[T]) SetGasSchedule(load func(ctx context.Context) (stfgas.GasSchedule, error)) {
+	s.loadGasSchedule = load
+}
+
+// withGasSchedule returns a copy of the STF metering the stores with the gas
+// schedule loaded from state.
+func (s STF[T]) withGasSchedule(ctx context.Context, state store.ReaderMap) (STF[T], error) {
+	if s.loadGasSchedule == nil {
+		return s, nil
+	}
+
+	loadCtx := s.makeContext(ctx, nil, s.branchFn(state), corecontext.ExecModeSimulate)
+	schedule, err := s.loadGasSchedule(loadCtx)
+	if err != nil {
+		return s, fmt.Errorf("unable to load gas schedule: %w", err)
+	}
+	s.makeGasMeteredState = schedule.WrapWithGasMeter
+
+	return s, nil
+}
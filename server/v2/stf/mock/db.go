@@ -1,6 +1,9 @@
 package mock
 
 import (
+	"bytes"
+	"sort"
+
 	"cosmossdk.io/core/store"
 )
 
@@ -26,13 +29,56 @@ func (m memState) Has(key []byte) (bool, error) {
 	return v != nil, err
 }
 
-func (m memState) Get(bytes []byte) ([]byte, error) {
-	key := append(m.address, bytes...)
-	return m.kv[string(key)], nil
+func (m memState) Get(key []byte) ([]byte, error) {
+	return m.kv[string(m.address)+string(key)], nil
 }
 
-func (m memState) Iterator(start, end []byte) (store.Iterator, error) { panic("implement me") }
+func (m memState) Iterator(start, end []byte) (store.Iterator, error) {
+	return m.iterator(start, end, true), nil
+}
 
 func (m memState) ReverseIterator(start, end []byte) (store.Iterator, error) {
-	panic("implement me")
+	return m.iterator(start, end, false), nil
+}
+
+func (m memState) iterator(start, end []byte, ascending bool) store.Iterator {
+	var pairs []store.KVPair
+	for k, v := range m.kv {
+		if !bytes.HasPrefix([]byte(k), m.address) {
+			continue
+		}
+		key := []byte(k)[len(m.address):]
+		if (start != nil && bytes.Compare(key, start) < 0) || (end != nil && bytes.Compare(key, end) >= 0) {
+			continue
+		}
+		pairs = append(pairs, store.KVPair{Key: key, Value: v})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		less := bytes.Compare(pairs[i].Key, pairs[j].Key) < 0
+		if !ascending {
+			return !less
+		}
+		return less
+	})
+	return &memIterator{start: start, end: end, pairs: pairs}
 }
+
+// memIterator iterates over a sorted slice of pairs.
+type memIterator struct {
+	start, end []byte
+	pairs      []store.KVPair
+}
+
+func (i *memIterator) Domain() (start, end []byte) { return i.start, i.end }
+
+func (i *memIterator) Valid() bool { return len(i.pairs) != 0 }
+
+func (i *memIterator) Next() { i.pairs = i.pairs[1:] }
+
+func (i *memIterator) Key() []byte { return i.pairs[0].Key }
+
+func (i *memIterator) Value() []byte { return i.pairs[0].Value }
+
+func (i *memIterator) Error() error { return nil }
+
+func (i *memIterator) Close() error { return nil }
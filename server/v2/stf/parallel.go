@@ -0,0 +1,143 @@
+package stf
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	appmanager "cosmossdk.io/core/app"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/server/v2/stf/branch"
+)
+
+// SetParallelExecution sets the number of workers used to deliver the txs of a block.
+// When workers is greater than one, txs are executed optimistically in parallel and
+// committed in block order, see deliverTxsParallel. The state provided to DeliverBlock
+// must then support concurrent reads, and the in-memory caches held by the keepers
+// outside of the state, e.g. lazily loaded params, must be safe for concurrent use
+// since the handlers of several txs run at the same time.
+// The results of a block are the same regardless of the number of workers.
+func (s *STF[T]) SetParallelExecution(workers int) {
+	s.txWorkers = workers
+}
+
+// deliverTxs executes the txs of a block on the provided state.
+func (s STF[T]) deliverTxs(
+	ctx context.Context,
+	state store.ReaderMap,
+	newState store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]appmanager.TxResult, error) {
+	if s.txWorkers > 1 && len(txs) > 1 {
+		return s.deliverTxsParallel(ctx, state, newState, txs, hi)
+	}
+
+	txResults := make([]appmanager.TxResult, len(txs))
+	for i, tx := range txs {
+		// check if we need to return early or continue delivering txs
+		if err := isCtxCancelled(ctx); err != nil {
+			return nil, err
+		}
+		txResults[i] = s.deliverTx(ctx, newState, tx, corecontext.ExecModeFinalize, hi)
+	}
+	return txResults, nil
+}
+
+// speculativeTx holds the outcome of the optimistic execution of a tx.
+type speculativeTx struct {
+	result  appmanager.TxResult
+	reads   *readSet
+	changes []store.StateChanges
+	err     error
+	done    chan struct{}
+}
+
+// deliverTxsParallel executes the txs of a block using optimistic concurrency control.
+//
+// Every tx is first executed speculatively by a pool of workers, on its own branch of a
+// snapshot of the state before any tx was executed, while the keys it reads, and the
+// values it observes, are recorded.
+// Results are then committed strictly in block order: before being committed, the reads
+// of a tx are validated against newState, which contains the changes of all the txs
+// preceding it. If every read still observes the same value, the speculative execution
+// is identical to the sequential one and its changes are applied to newState. Otherwise
+// the tx is invalidated and re-executed on top of newState, which is by construction
+// equivalent to a sequential execution.
+func (s STF[T]) deliverTxsParallel(
+	ctx context.Context,
+	state store.ReaderMap,
+	newState store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]appmanager.TxResult, error) {
+	changes, err := newState.GetStateChanges()
+	if err != nil {
+		return nil, err
+	}
+	snapshot := branch.NewSnapshot(state, changes)
+
+	specs := make([]*speculativeTx, len(txs))
+	for i := range specs {
+		specs[i] = &speculativeTx{done: make(chan struct{})}
+	}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	var (
+		next atomic.Int64
+		wg   sync.WaitGroup
+	)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	workers := min(s.txWorkers, len(txs))
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(txs) || workerCtx.Err() != nil {
+					return
+				}
+				spec := specs[i]
+				spec.reads = newReadSet(snapshot)
+				specState := s.branchFn(spec.reads)
+				spec.result = s.deliverTx(workerCtx, specState, txs[i], corecontext.ExecModeFinalize, hi)
+				spec.changes, spec.err = specState.GetStateChanges()
+				close(spec.done)
+			}
+		}()
+	}
+
+	txResults := make([]appmanager.TxResult, len(txs))
+	for i, tx := range txs {
+		spec := specs[i]
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-spec.done:
+		}
+
+		valid := false
+		if spec.err == nil {
+			valid, err = spec.reads.validate(newState)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !valid {
+			txResults[i] = s.deliverTx(ctx, newState, tx, corecontext.ExecModeFinalize, hi)
+			continue
+		}
+		if err = newState.ApplyStateChanges(spec.changes); err != nil {
+			return nil, err
+		}
+		txResults[i] = spec.result
+	}
+	return txResults, nil
+}
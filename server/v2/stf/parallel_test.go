@@ -0,0 +1,142 @@
+package stf
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	appmanager "cosmossdk.io/core/app"
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/stf/branch"
+	"cosmossdk.io/server/v2/stf/gas"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+func TestSTFParallelExecution(t *testing.T) {
+	// every tx writes a key owned by its sender, txs with a true message also
+	// increment a shared counter and count the senders seen so far, which makes
+	// them conflict with each other.
+	handleMsg := func(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+		execCtx := ctx.(*executionContext)
+		kv, err := execCtx.state.GetWriter(actorName)
+		if err != nil {
+			return nil, err
+		}
+		if err := kv.Set(append([]byte("sender/"), execCtx.sender...), []byte("done")); err != nil {
+			return nil, err
+		}
+		if !msg.(*wrapperspb.BoolValue).Value {
+			return nil, nil
+		}
+
+		counter := uint64(0)
+		bz, err := kv.Get([]byte("counter"))
+		if err != nil {
+			return nil, err
+		}
+		if bz != nil {
+			counter = binary.BigEndian.Uint64(bz)
+		}
+		counter++
+		if err := kv.Set([]byte("counter"), binary.BigEndian.AppendUint64(nil, counter)); err != nil {
+			return nil, err
+		}
+
+		iter, err := kv.Iterator([]byte("sender/"), []byte("sender0"))
+		if err != nil {
+			return nil, err
+		}
+		defer iter.Close()
+		seen := uint64(0)
+		for ; iter.Valid(); iter.Next() {
+			seen++
+		}
+		return wrapperspb.UInt64(counter*1000 + seen), nil
+	}
+
+	newSTF := func(workers int) *STF[mock.Tx] {
+		s := &STF[mock.Tx]{
+			handleMsg:         handleMsg,
+			doPreBlock:        func(ctx context.Context, txs []mock.Tx) error { return nil },
+			doBeginBlock:      func(ctx context.Context) error { return nil },
+			doEndBlock:        func(ctx context.Context) error { return nil },
+			doValidatorUpdate: func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error) { return nil, nil },
+			doTxValidation: func(ctx context.Context, tx mock.Tx) error {
+				kv, err := ctx.(*executionContext).state.GetWriter(actorName)
+				if err != nil {
+					return err
+				}
+				return kv.Set([]byte("validate"), []byte("validate"))
+			},
+			postTxExec:          func(ctx context.Context, tx mock.Tx, success bool) error { return nil },
+			branchFn:            branch.DefaultNewWriterMap,
+			makeGasMeter:        gas.DefaultGasMeter,
+			makeGasMeteredState: gas.DefaultWrapWithGasMeter,
+		}
+		s.SetParallelExecution(workers)
+		// access sets must also match the sequential execution.
+		s.SetAccessTracing(true)
+		return s
+	}
+
+	sum := sha256.Sum256([]byte("test-hash"))
+	conflicting := 0
+	txs := make([]mock.Tx, 64)
+	for i := range txs {
+		conflict := i%3 == 0
+		if conflict {
+			conflicting++
+		}
+		txs[i] = mock.Tx{
+			Sender:   []byte(fmt.Sprintf("sender-%02d", i)),
+			Msg:      wrapperspb.Bool(conflict),
+			GasLimit: 1_000_000,
+		}
+	}
+	// a non conflicting tx runs out of gas.
+	txs[1].GasLimit = 1
+
+	deliver := func(workers int) (*appmanager.BlockResponse, store.WriterMap) {
+		result, newState, err := newSTF(workers).DeliverBlock(context.Background(), &appmanager.BlockRequest[mock.Tx]{
+			Height:  uint64(1),
+			Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+			AppHash: sum[:],
+			Hash:    sum[:],
+			Txs:     txs,
+		}, mock.DB())
+		require.NoError(t, err)
+		return result, newState
+	}
+
+	seqResult, seqState := deliver(0)
+	parResult, parState := deliver(8)
+
+	require.Equal(t, seqResult, parResult)
+	require.Equal(t, stateChanges(t, seqState), stateChanges(t, parState))
+
+	counter, err := parState.GetReader(actorName)
+	require.NoError(t, err)
+	bz, err := counter.Get([]byte("counter"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(conflicting), binary.BigEndian.Uint64(bz))
+	require.Error(t, parResult.TxResults[1].Error)
+}
+
+func stateChanges(t *testing.T, state store.WriterMap) map[string][]store.KVPair {
+	t.Helper()
+	changes, err := state.GetStateChanges()
+	require.NoError(t, err)
+	m := make(map[string][]store.KVPair, len(changes))
+	for _, sc := range changes {
+		m[string(sc.Actor)] = sc.StateChanges
+	}
+	return m
+}
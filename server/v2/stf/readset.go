@@ -0,0 +1,206 @@
+package stf
+
+import (
+	"bytes"
+
+	"cosmossdk.io/core/store"
+)
+
+var _ store.ReaderMap = (*readSet)(nil)
+
+// readSet is a store.ReaderMap which records every read performed on the
+// underlying state, alongside the observed values, so that they can later be
+// validated against a different version of the state.
+// It is not safe for concurrent use.
+type readSet struct {
+	state  store.ReaderMap
+	stores map[string]*storeReadSet
+}
+
+// newReadSet returns a readSet recording the reads done on state.
+func newReadSet(state store.ReaderMap) *readSet {
+	return &readSet{
+		state:  state,
+		stores: make(map[string]*storeReadSet),
+	}
+}
+
+func (r *readSet) GetReader(actor []byte) (store.Reader, error) {
+	parent, err := r.state.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	rs, ok := r.stores[string(actor)]
+	if !ok {
+		rs = &storeReadSet{
+			gets: make(map[string][]byte),
+			has:  make(map[string]bool),
+		}
+		r.stores[string(actor)] = rs
+	}
+	return recordingReader{parent: parent, rs: rs}, nil
+}
+
+// validate reports whether every read recorded would observe the same
+// result if it was performed against the provided state.
+func (r *readSet) validate(state store.ReaderMap) (bool, error) {
+	for actor, rs := range r.stores {
+		reader, err := state.GetReader([]byte(actor))
+		if err != nil {
+			return false, err
+		}
+		valid, err := rs.validate(reader)
+		if err != nil || !valid {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// storeReadSet contains the reads done on a single actor's store.
+type storeReadSet struct {
+	gets      map[string][]byte
+	has       map[string]bool
+	iterators []*iteratorRead
+}
+
+func (rs *storeReadSet) validate(reader store.Reader) (bool, error) {
+	for key, value := range rs.gets {
+		got, err := reader.Get([]byte(key))
+		if err != nil {
+			return false, err
+		}
+		if (got == nil) != (value == nil) || !bytes.Equal(got, value) {
+			return false, nil
+		}
+	}
+	for key, has := range rs.has {
+		got, err := reader.Has([]byte(key))
+		if err != nil {
+			return false, err
+		}
+		if got != has {
+			return false, nil
+		}
+	}
+	for _, ir := range rs.iterators {
+		valid, err := ir.validate(reader)
+		if err != nil || !valid {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// iteratorRead contains the range of an iterator and the pairs it went through.
+type iteratorRead struct {
+	start, end []byte
+	ascending  bool
+	// pairs are the key value pairs the iterator has been positioned on, in order.
+	pairs []store.KVPair
+	// exhausted reports whether the iterator has reached the end of its domain.
+	exhausted bool
+}
+
+// observe records the current position of the iterator.
+func (ir *iteratorRead) observe(iter store.Iterator) {
+	if !iter.Valid() {
+		ir.exhausted = true
+		return
+	}
+	ir.pairs = append(ir.pairs, store.KVPair{
+		Key:   bytes.Clone(iter.Key()),
+		Value: bytes.Clone(iter.Value()),
+	})
+}
+
+func (ir *iteratorRead) validate(reader store.Reader) (valid bool, err error) {
+	var iter store.Iterator
+	if ir.ascending {
+		iter, err = reader.Iterator(ir.start, ir.end)
+	} else {
+		iter, err = reader.ReverseIterator(ir.start, ir.end)
+	}
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	for _, pair := range ir.pairs {
+		if !iter.Valid() || !bytes.Equal(iter.Key(), pair.Key) || !bytes.Equal(iter.Value(), pair.Value) {
+			return false, nil
+		}
+		iter.Next()
+	}
+	if ir.exhausted && iter.Valid() {
+		return false, nil
+	}
+	return true, nil
+}
+
+// recordingReader is a store.Reader which records reads in a storeReadSet.
+type recordingReader struct {
+	parent store.Reader
+	rs     *storeReadSet
+}
+
+func (r recordingReader) Has(key []byte) (bool, error) {
+	has, err := r.parent.Has(key)
+	if err != nil {
+		return false, err
+	}
+	if _, ok := r.rs.has[string(key)]; !ok {
+		r.rs.has[string(key)] = has
+	}
+	return has, nil
+}
+
+func (r recordingReader) Get(key []byte) ([]byte, error) {
+	value, err := r.parent.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := r.rs.gets[string(key)]; !ok {
+		r.rs.gets[string(key)] = bytes.Clone(value)
+	}
+	return value, nil
+}
+
+func (r recordingReader) Iterator(start, end []byte) (store.Iterator, error) {
+	return r.iterator(start, end, true)
+}
+
+func (r recordingReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return r.iterator(start, end, false)
+}
+
+func (r recordingReader) iterator(start, end []byte, ascending bool) (iter store.Iterator, err error) {
+	if ascending {
+		iter, err = r.parent.Iterator(start, end)
+	} else {
+		iter, err = r.parent.ReverseIterator(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ir := &iteratorRead{
+		start:     bytes.Clone(start),
+		end:       bytes.Clone(end),
+		ascending: ascending,
+	}
+	r.rs.iterators = append(r.rs.iterators, ir)
+	ir.observe(iter)
+	return recordingIterator{Iterator: iter, ir: ir}, nil
+}
+
+// recordingIterator is a store.Iterator which records every
+// position it goes through in an iteratorRead.
+type recordingIterator struct {
+	store.Iterator
+	ir *iteratorRead
+}
+
+func (i recordingIterator) Next() {
+	i.Iterator.Next()
+	i.ir.observe(i.Iterator)
+}
@@ -34,6 +34,10 @@ type STF[T transaction.Tx] struct {
 	branchFn            branchFn // branchFn is a function that given a readonly state it returns a writable version of it.
 	makeGasMeter        makeGasMeterFn
 	makeGasMeteredState makeGasMeteredStateFn
+	loadGasSchedule     func(ctx context.Context) (stfgas.GasSchedule, error) // loadGasSchedule loads the gas costs of the stores, see SetGasSchedule.
+
+	txWorkers   int  // txWorkers is the number of workers used to deliver txs, see SetParallelExecution.
+	traceAccess bool // traceAccess reports whether txs access sets are traced, see SetAccessTracing.
 }
 
 // NewSTF returns a new STF instance.
@@ -71,6 +75,11 @@ func (s STF[T]) DeliverBlock(
 	block *appmanager.BlockRequest[T],
 	state store.ReaderMap,
 ) (blockResult *appmanager.BlockResponse, newState store.WriterMap, err error) {
+	s, err = s.withGasSchedule(ctx, state)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// creates a new branchFn state, from the readonly view of the state
 	// that can be written to.
 	newState = s.branchFn(state)
@@ -120,14 +129,10 @@ func (s STF[T]) DeliverBlock(
 	}
 
 	// execute txs
-	txResults := make([]appmanager.TxResult, len(block.Txs))
 	// TODO: skip first tx if vote extensions are enabled (marko)
-	for i, txBytes := range block.Txs {
-		// check if we need to return early or continue delivering txs
-		if err = isCtxCancelled(ctx); err != nil {
-			return nil, nil, err
-		}
-		txResults[i] = s.deliverTx(ctx, newState, txBytes, corecontext.ExecModeFinalize, hi)
+	txResults, err := s.deliverTxs(ctx, state, newState, block.Txs, hi)
+	if err != nil {
+		return nil, nil, err
 	}
 	// reset events
 	exCtx.events = make([]event.Event, 0)
@@ -148,13 +153,28 @@ func (s STF[T]) DeliverBlock(
 	}, newState, nil
 }
 
-// deliverTx executes a TX and returns the result.
+// deliverTx executes a TX and returns the result. If access tracing is enabled
+// the result contains the access sets of the TX.
 func (s STF[T]) deliverTx(
 	ctx context.Context,
 	state store.WriterMap,
 	tx T,
 	execMode corecontext.ExecMode,
 	hi header.Info,
+) appmanager.TxResult {
+	if s.traceAccess {
+		return s.deliverTracedTx(ctx, state, tx, execMode, hi)
+	}
+	return s.runTx(ctx, state, tx, execMode, hi)
+}
+
+// runTx validates and executes a TX on the provided state.
+func (s STF[T]) runTx(
+	ctx context.Context,
+	state store.WriterMap,
+	tx T,
+	execMode corecontext.ExecMode,
+	hi header.Info,
 ) appmanager.TxResult {
 	// recover in the case of a panic
 	var recoveryError error
@@ -445,6 +465,11 @@ func (s STF[T]) Simulate(
 	gasLimit uint64,
 	tx T,
 ) (appmanager.TxResult, store.WriterMap) {
+	s, err := s.withGasSchedule(ctx, state)
+	if err != nil {
+		return appmanager.TxResult{Error: err}, nil
+	}
+
 	simulationState := s.branchFn(state)
 	hi, err := s.getHeaderInfo(simulationState)
 	if err != nil {
@@ -463,6 +488,11 @@ func (s STF[T]) ValidateTx(
 	gasLimit uint64,
 	tx T,
 ) appmanager.TxResult {
+	s, err := s.withGasSchedule(ctx, state)
+	if err != nil {
+		return appmanager.TxResult{Error: err}
+	}
+
 	validationState := s.branchFn(state)
 	gasUsed, events, err := s.validateTx(ctx, validationState, gasLimit, tx)
 	return appmanager.TxResult{
@@ -521,6 +551,9 @@ func (s STF[T]) clone() STF[T] {
 		branchFn:            s.branchFn,
 		makeGasMeter:        s.makeGasMeter,
 		makeGasMeteredState: s.makeGasMeteredState,
+		loadGasSchedule:     s.loadGasSchedule,
+		txWorkers:           s.txWorkers,
+		traceAccess:         s.traceAccess,
 	}
 }
 
@@ -90,6 +90,77 @@ func TestSTF(t *testing.T) {
 		require.Equal(t, mockTx.GasLimit, txResult.GasWanted)
 	})
 
+	t.Run("access tracing", func(t *testing.T) {
+		s := s.clone()
+		s.SetAccessTracing(true)
+		s.handleMsg = func(ctx context.Context, msg transaction.Msg) (msgResp transaction.Msg, err error) {
+			state, err := ctx.(*executionContext).state.GetWriter(actorName)
+			require.NoError(t, err)
+			_, err = state.Get([]byte("balance"))
+			require.NoError(t, err)
+			kvSet(t, ctx, "exec")
+			return nil, nil
+		}
+
+		result, _, err := s.DeliverBlock(context.Background(), &appmanager.BlockRequest[mock.Tx]{
+			Height:  uint64(1),
+			Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+			AppHash: sum[:],
+			Hash:    sum[:],
+			Txs:     []mock.Tx{mockTx},
+		}, state)
+		require.NoError(t, err)
+		require.Len(t, result.TxResults, 1)
+		require.Equal(t, []store.AccessSet{
+			{
+				Actor:  actorName,
+				Reads:  []store.KeyRange{{Start: []byte("balance"), End: []byte("balance\x00")}},
+				Writes: [][]byte{[]byte("exec"), []byte("post-tx-exec"), []byte("validate")},
+			},
+			{
+				// header info read during validation.
+				Actor: appmanager.RuntimeIdentity,
+				Reads: []store.KeyRange{{Start: []byte{headerInfoPrefix}, End: []byte{headerInfoPrefix, 0}}},
+			},
+		}, result.TxResults[0].AccessSets)
+	})
+
+	t.Run("gas schedule", func(t *testing.T) {
+		block := &appmanager.BlockRequest[mock.Tx]{
+			Height:  uint64(1),
+			Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+			AppHash: sum[:],
+			Hash:    sum[:],
+			Txs:     []mock.Tx{mockTx},
+		}
+		result, _, err := s.DeliverBlock(context.Background(), block, state)
+		require.NoError(t, err)
+		defaultGasUsed := result.TxResults[0].GasUsed
+
+		// the writes to the store of the actor are free.
+		s := s.clone()
+		s.SetGasSchedule(func(ctx context.Context) (gas.GasSchedule, error) {
+			return gas.NewGasSchedule(coregas.GasConfig{}, map[string]coregas.GasConfig{string(actorName): {}}), nil
+		})
+		result, newState, err := s.DeliverBlock(context.Background(), block, state)
+		require.NoError(t, err)
+		stateHas(t, newState, "exec")
+		require.NotZero(t, defaultGasUsed)
+		require.Zero(t, result.TxResults[0].GasUsed)
+
+		// simulations and tx validations are metered with the schedule too.
+		simResult, _ := s.Simulate(context.Background(), state, 100000, mockTx)
+		require.NoError(t, simResult.Error)
+		require.Zero(t, simResult.GasUsed)
+		require.Zero(t, s.ValidateTx(context.Background(), state, 100000, mockTx).GasUsed)
+
+		s.SetGasSchedule(func(ctx context.Context) (gas.GasSchedule, error) {
+			return gas.GasSchedule{}, fmt.Errorf("failure")
+		})
+		_, _, err = s.DeliverBlock(context.Background(), block, state)
+		require.ErrorContains(t, err, "failure")
+	})
+
 	t.Run("exec tx out of gas", func(t *testing.T) {
 		s := s.clone()
 
@@ -0,0 +1,44 @@
+package stf
+
+import (
+	"context"
+
+	appmanager "cosmossdk.io/core/app"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/server/v2/stf/branch"
+)
+
+// SetAccessTracing enables or disables access tracing. When enabled, the keys
+// each tx reads and writes are recorded, for every actor, and returned in the
+// AccessSets of the tx result.
+func (s *STF[T]) SetAccessTracing(enabled bool) {
+	s.traceAccess = enabled
+}
+
+// deliverTracedTx executes the tx on a branch of state whose reads are traced,
+// then applies the branch changes to state and attaches the access sets to the result.
+func (s STF[T]) deliverTracedTx(
+	ctx context.Context,
+	state store.WriterMap,
+	tx T,
+	execMode corecontext.ExecMode,
+	hi header.Info,
+) appmanager.TxResult {
+	tracer := branch.NewTracingReaderMap(state)
+	txState := s.branchFn(tracer)
+	result := s.runTx(ctx, txState, tx, execMode, hi)
+
+	changes, err := txState.GetStateChanges()
+	if err == nil {
+		err = state.ApplyStateChanges(changes)
+	}
+	if err != nil {
+		// keep the gas used and events of the execution, as the untraced path does
+		result.Error = err
+		return result
+	}
+	result.AccessSets = tracer.AccessSets(changes)
+	return result
+}
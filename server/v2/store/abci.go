@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/server/v2/store/types"
+	storev2 "cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/proof"
+)
+
+// QueryPathMultiProof is the path of the ABCI query proving keys across stores
+// with a single multi-proof.
+const QueryPathMultiProof = "/store/multiproof"
+
+// ABCIQueryHandler serves the ABCI store queries of a root store.
+type ABCIQueryHandler struct {
+	rs storev2.RootStore
+}
+
+// NewABCIQueryHandler returns an ABCI query handler serving the store queries
+// of the given root store.
+func NewABCIQueryHandler(rs storev2.RootStore) ABCIQueryHandler {
+	return ABCIQueryHandler{rs: rs}
+}
+
+// Query handles the ABCI store query of the request.
+func (h ABCIQueryHandler) Query(_ context.Context, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+	switch req.Path {
+	case QueryPathMultiProof:
+		return h.queryMultiProof(req)
+
+	default:
+		return nil, fmt.Errorf("%w: unknown store query path %s", storeerrors.ErrUnknownRequest, req.Path)
+	}
+}
+
+// queryMultiProof returns the values of the keys of the request at its height,
+// the one of the multi-proof request if zero, with their multi-proof against the
+// app hash.
+func (h ABCIQueryHandler) queryMultiProof(req *abci.QueryRequest) (*abci.QueryResponse, error) {
+	var multiReq types.QueryMultiProofRequest
+	if err := multiReq.Unmarshal(req.Data); err != nil {
+		return nil, fmt.Errorf("%w: %w", storeerrors.ErrInvalidRequest, err)
+	}
+	if req.Height < 0 {
+		return nil, fmt.Errorf("%w: negative height %d", storeerrors.ErrInvalidRequest, req.Height)
+	}
+	if req.Height > 0 {
+		multiReq.Height = uint64(req.Height)
+	}
+
+	res, err := queryMultiProof(h.rs, &multiReq)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := res.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &abci.QueryResponse{
+		Value:  bz,
+		Height: int64(res.Height),
+	}, nil
+}
+
+// queryMultiProof returns the values of the keys of the request at its height,
+// the latest one if zero, with their multi-proof against the app hash.
+func queryMultiProof(rs storev2.RootStore, req *types.QueryMultiProofRequest) (*types.QueryMultiProofResponse, error) {
+	if len(req.Keys) == 0 {
+		return nil, fmt.Errorf("%w: no key to prove", storeerrors.ErrInvalidRequest)
+	}
+
+	version := req.Height
+	if version == 0 {
+		latestVersion, err := rs.GetLatestVersion()
+		if err != nil {
+			return nil, err
+		}
+
+		version = latestVersion
+	}
+
+	items := make([]proof.MultiProofItem, len(req.Keys))
+	for i, key := range req.Keys {
+		items[i] = proof.MultiProofItem{StoreKey: []byte(key.StoreKey), Key: key.Key}
+	}
+	result, err := rs.QueryMultiProof(version, items)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryMultiProofResponse{
+		Values: make([]types.MultiProofValue, len(result.Items)),
+		Height: version,
+	}
+	for i, item := range result.Items {
+		res.Values[i] = types.MultiProofValue{
+			StoreKey: string(item.StoreKey),
+			Key:      item.Key,
+			Value:    item.Value,
+			Exists:   item.Value != nil,
+		}
+		if i < len(result.Errors) && result.Errors[i] != nil {
+			res.Values[i].Error = result.Errors[i].Error()
+		}
+	}
+	if result.Proof != nil {
+		if res.Proof, err = result.Proof.Marshal(); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/server/v2/store"
+	"cosmossdk.io/server/v2/store/types"
+	storev2 "cosmossdk.io/store/v2"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/root"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+)
+
+// newRootStore returns a root store with an IAVL store "store1", holding key1,
+// and an empty SMT store "empty", committed at version 1 with the returned app
+// hash.
+func newRootStore(t *testing.T) (storev2.RootStore, []byte) {
+	t.Helper()
+
+	logger := log.NewNopLogger()
+	scOpts := &storev2.SCOptions{
+		Type:       storev2.SCTypeIavl,
+		StoreTypes: map[string]storev2.SCType{"empty": storev2.SCTypeSMT},
+	}
+	sc, err := root.NewCommitStore(dbm.NewMemDB(), []string{"store1", "empty"}, scOpts, nil, logger)
+	require.NoError(t, err)
+	db, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+	rs, err := root.New(logger, storage.NewStorageStore(db, nil, logger), sc, nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, rs.Close()) })
+	require.NoError(t, rs.LoadLatestVersion())
+
+	cs := corestore.NewChangeset()
+	cs.Add([]byte("store1"), []byte("key1"), []byte("value1"), false)
+	_, err = rs.WorkingHash(cs)
+	require.NoError(t, err)
+	appHash, err := rs.Commit(cs)
+	require.NoError(t, err)
+
+	return rs, appHash
+}
+
+func TestABCIQueryHandler_MultiProof(t *testing.T) {
+	rs, appHash := newRootStore(t)
+
+	h := store.NewABCIQueryHandler(rs)
+	data, err := (&types.QueryMultiProofRequest{Keys: []types.MultiProofKey{
+		{StoreKey: "store1", Key: []byte("key1")},
+		{StoreKey: "store1", Key: []byte("key2")},
+		{StoreKey: "empty", Key: []byte("key1")},
+	}}).Marshal()
+	require.NoError(t, err)
+	res, err := h.Query(context.Background(), &abci.QueryRequest{Path: store.QueryPathMultiProof, Data: data})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Height)
+
+	var multiRes types.QueryMultiProofResponse
+	require.NoError(t, multiRes.Unmarshal(res.Value))
+	require.Len(t, multiRes.Values, 3)
+	require.True(t, multiRes.Values[0].Exists)
+	require.Equal(t, []byte("value1"), multiRes.Values[0].Value)
+	require.False(t, multiRes.Values[1].Exists)
+	require.Empty(t, multiRes.Values[1].Error)
+	// the keys of an empty SMT store fail alone
+	require.Contains(t, multiRes.Values[2].Error, "empty tree")
+
+	var mp proof.MultiProof
+	require.NoError(t, mp.Unmarshal(multiRes.Proof))
+	require.NoError(t, mp.Verify(appHash, []proof.MultiProofItem{
+		{StoreKey: []byte("store1"), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: []byte("store1"), Key: []byte("key2")},
+	}))
+
+	_, err = h.Query(context.Background(), &abci.QueryRequest{Path: store.QueryPathMultiProof})
+	require.ErrorContains(t, err, "no key to prove")
+
+	_, err = h.Query(context.Background(), &abci.QueryRequest{Path: "/store/unknown", Data: data})
+	require.Error(t, err)
+}
@@ -0,0 +1,87 @@
+// Package store serves the state storage of store/v2 over gRPC, apart from
+// store/v2 so that it doesn't depend on gRPC.
+package store
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/server/v2/store/types"
+	storev2 "cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+// DefaultKeyHistoryLimit defines the maximum number of entries returned by a
+// KeyHistory query which does not set a limit.
+const DefaultKeyHistoryLimit = 100
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	db storev2.VersionedDatabase
+}
+
+// NewQueryServer returns a gRPC query server querying the history of the keys
+// of the given state storage.
+func NewQueryServer(db storev2.VersionedDatabase) types.QueryServer {
+	return queryServer{db: db}
+}
+
+// KeyHistory implements the Query/KeyHistory gRPC method.
+func (q queryServer) KeyHistory(_ context.Context, req *types.QueryKeyHistoryRequest) (*types.QueryKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.StoreKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty store key")
+	}
+
+	endHeight := req.EndHeight
+	if endHeight == 0 {
+		latestVersion, err := q.db.GetLatestVersion()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		endHeight = latestVersion
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultKeyHistoryLimit
+	}
+
+	itr, err := q.db.History([]byte(req.StoreKey), req.Key, req.StartHeight, endHeight)
+	if err != nil {
+		var errPruned storeerrors.ErrVersionPruned
+		if errors.As(err, &errPruned) || errors.Is(err, storeerrors.ErrKeyEmpty) || errors.Is(err, storeerrors.ErrStartVersionAfterEnd) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer itr.Close()
+
+	res := &types.QueryKeyHistoryResponse{}
+	for ; itr.Valid(); itr.Next() {
+		if uint64(len(res.Entries)) == limit {
+			res.NextHeight = itr.Version()
+			break
+		}
+
+		res.Entries = append(res.Entries, types.KeyHistoryEntry{
+			Height:  itr.Version(),
+			Value:   itr.Value(),
+			Deleted: itr.Tombstone(),
+		})
+	}
+	if err := itr.Error(); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,99 @@
+package store_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/log"
+	"cosmossdk.io/server/v2/store"
+	"cosmossdk.io/server/v2/store/types"
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+)
+
+func TestQueryServer_KeyHistory(t *testing.T) {
+	db, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(db, nil, log.NewNopLogger())
+	defer ss.Close()
+
+	key := []byte("key")
+	storage.DBApplyChangeset(t, ss, 1, "store1", [][]byte{key}, [][]byte{[]byte("val001")})
+	storage.DBApplyChangeset(t, ss, 2, "store1", [][]byte{key}, [][]byte{nil})
+	storage.DBApplyChangeset(t, ss, 3, "store1", [][]byte{key}, [][]byte{[]byte("val003")})
+	storage.DBApplyChangeset(t, ss, 4, "store1", [][]byte{[]byte("other")}, [][]byte{[]byte("val004")})
+
+	qs := store.NewQueryServer(ss)
+
+	// the first page ends before the last write of the key
+	res, err := qs.KeyHistory(context.Background(), &types.QueryKeyHistoryRequest{StoreKey: "store1", Key: key, StartHeight: 1, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.KeyHistoryEntry{
+		{Height: 1, Value: []byte("val001")},
+		{Height: 2, Deleted: true},
+	}, res.Entries)
+	require.Equal(t, uint64(3), res.NextHeight)
+
+	res, err = qs.KeyHistory(context.Background(), &types.QueryKeyHistoryRequest{StoreKey: "store1", Key: key, StartHeight: res.NextHeight, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.KeyHistoryEntry{{Height: 3, Value: []byte("val003")}}, res.Entries)
+	require.Zero(t, res.NextHeight)
+
+	// the end height is inclusive
+	res, err = qs.KeyHistory(context.Background(), &types.QueryKeyHistoryRequest{StoreKey: "store1", Key: key, StartHeight: 1, EndHeight: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.KeyHistoryEntry{{Height: 1, Value: []byte("val001")}}, res.Entries)
+
+	_, err = qs.KeyHistory(context.Background(), &types.QueryKeyHistoryRequest{StoreKey: "store1", Key: key, StartHeight: 3, EndHeight: 2})
+	require.Error(t, err)
+
+	_, err = qs.KeyHistory(context.Background(), &types.QueryKeyHistoryRequest{Key: key})
+	require.Error(t, err)
+}
+
+type queryRouter map[string]appmodulev2.Handler
+
+func (r queryRouter) RegisterHandler(msgType string, handler appmodulev2.Handler) error {
+	if _, ok := r[msgType]; ok {
+		return fmt.Errorf("handler already registered: %s", msgType)
+	}
+	r[msgType] = handler
+	return nil
+}
+
+func TestRegisterQueryHandlers(t *testing.T) {
+	rs, appHash := newRootStore(t)
+
+	router := queryRouter{}
+	require.NoError(t, store.RegisterQueryHandlers(router, rs))
+	require.Error(t, store.RegisterQueryHandlers(router, rs))
+
+	handler := router["cosmos.store.storage.v1.QueryKeyHistoryRequest"]
+	require.NotNil(t, handler)
+	res, err := handler(context.Background(), &types.QueryKeyHistoryRequest{StoreKey: "store1", Key: []byte("key1"), StartHeight: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.KeyHistoryEntry{{Height: 1, Value: []byte("value1")}}, res.(*types.QueryKeyHistoryResponse).Entries)
+
+	_, err = handler(context.Background(), &types.QueryKeyHistoryResponse{})
+	require.Error(t, err)
+
+	handler = router["cosmos.store.proof.v1.QueryMultiProofRequest"]
+	require.NotNil(t, handler)
+	res, err = handler(context.Background(), &types.QueryMultiProofRequest{Keys: []types.MultiProofKey{{StoreKey: "store1", Key: []byte("key1")}}})
+	require.NoError(t, err)
+	multiRes := res.(*types.QueryMultiProofResponse)
+	require.Equal(t, uint64(1), multiRes.Height)
+	require.Equal(t, []byte("value1"), multiRes.Values[0].Value)
+
+	var mp proof.MultiProof
+	require.NoError(t, mp.Unmarshal(multiRes.Proof))
+	require.NoError(t, mp.Verify(appHash, []proof.MultiProofItem{{StoreKey: []byte("store1"), Key: []byte("key1"), Value: []byte("value1")}}))
+
+	_, err = handler(context.Background(), &types.QueryMultiProofRequest{})
+	require.Error(t, err)
+}
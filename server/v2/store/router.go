@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/server/v2/store/types"
+	storev2 "cosmossdk.io/store/v2"
+)
+
+// QueryRouter is the router of the queries of a server/v2 app, e.g. the
+// stf.MsgRouterBuilder building the query handler of its STF.
+type QueryRouter interface {
+	RegisterHandler(msgType string, handler appmodulev2.Handler) error
+}
+
+// RegisterQueryHandlers registers the store queries on the query router of a
+// server/v2 app, serving the key history of the state storage of the given root
+// store and the multi-proofs of its keys.
+func RegisterQueryHandlers(router QueryRouter, rs storev2.RootStore) error {
+	qs := NewQueryServer(rs.GetStateStorage())
+	err := router.RegisterHandler(
+		gogoproto.MessageName(&types.QueryKeyHistoryRequest{}),
+		func(ctx context.Context, msg appmodulev2.Message) (appmodulev2.Message, error) {
+			req, ok := msg.(*types.QueryKeyHistoryRequest)
+			if !ok {
+				return nil, fmt.Errorf("unexpected type %T, wanted: %T", msg, req)
+			}
+			return qs.KeyHistory(ctx, req)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return router.RegisterHandler(
+		gogoproto.MessageName(&types.QueryMultiProofRequest{}),
+		func(_ context.Context, msg appmodulev2.Message) (appmodulev2.Message, error) {
+			req, ok := msg.(*types.QueryMultiProofRequest)
+			if !ok {
+				return nil, fmt.Errorf("unexpected type %T, wanted: %T", msg, req)
+			}
+			return queryMultiProof(rs, req)
+		},
+	)
+}
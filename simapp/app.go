@@ -18,12 +18,16 @@ import (
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/accounts/accountstd"
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	lockup "cosmossdk.io/x/accounts/defaults/lockup"
+	"cosmossdk.io/x/accounts/defaults/passkey"
+	"cosmossdk.io/x/accounts/defaults/recovery"
+	"cosmossdk.io/x/accounts/defaults/session"
 	"cosmossdk.io/x/accounts/testing/account_abstraction"
 	"cosmossdk.io/x/accounts/testing/counter"
 	"cosmossdk.io/x/auth"
@@ -93,7 +97,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	stateservice "github.com/cosmos/cosmos-sdk/client/grpc/state"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -135,8 +141,9 @@ var (
 )
 
 var (
-	_ runtime.AppI            = (*SimApp)(nil)
-	_ servertypes.Application = (*SimApp)(nil)
+	_ runtime.AppI                        = (*SimApp)(nil)
+	_ servertypes.Application             = (*SimApp)(nil)
+	_ servertypes.StateServiceApplication = (*SimApp)(nil)
 )
 
 // SimApp extends an ABCI application, but with most of its parameters exported.
@@ -284,8 +291,9 @@ func NewSimApp(
 	cometService := runtime.NewContextAwareCometInfoService()
 
 	// set the BaseApp's parameter store
-	app.ConsensusParamsKeeper = consensusparamkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[consensusparamtypes.StoreKey]), logger.With(log.ModuleKey, "x/consensus")), authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.ConsensusParamsKeeper = consensusparamkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[consensusparamtypes.StoreKey]), logger.With(log.ModuleKey, "x/consensus")), authtypes.NewModuleAddress(govtypes.ModuleName).String(), consensusparamkeeper.WithStoreLookup(bApp.HasStore))
 	bApp.SetParamStore(app.ConsensusParamsKeeper.ParamsStore)
+	bApp.SetGasConfigStore(app.ConsensusParamsKeeper)
 
 	// add keepers
 	accountsKeeper, err := accounts.NewKeeper(
@@ -303,6 +311,9 @@ func NewSimApp(
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
 		// PRODUCTION: add
 		baseaccount.NewAccount("base", txConfig.SignModeHandler()),
+		passkey.NewAccount("passkey", txConfig.SignModeHandler()),
+		session.NewAccount("session", txConfig.SignModeHandler()),
+		recovery.NewAccount("recovery", txConfig.SignModeHandler()),
 	)
 	if err != nil {
 		panic(err)
@@ -485,6 +496,7 @@ func NewSimApp(
 	app.ModuleManager.SetOrderEndBlockers(
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		authtypes.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
@@ -744,6 +756,26 @@ func (app *SimApp) GetStoreKeys() []storetypes.StoreKey {
 	return keys
 }
 
+// CollectionsSchemas returns the collections schemas of the modules by store key.
+// They are used to decode the application state, e.g. by `debug state-diff`.
+func (app *SimApp) CollectionsSchemas() map[string]collections.Schema {
+	return map[string]collections.Schema{
+		accounts.StoreKey:      app.AccountsKeeper.Schema,
+		authtypes.StoreKey:     app.AuthKeeper.Schema,
+		banktypes.StoreKey:     app.BankKeeper.Schema,
+		circuittypes.StoreKey:  app.CircuitKeeper.Schema,
+		distrtypes.StoreKey:    app.DistrKeeper.Schema,
+		epochstypes.StoreKey:   app.EpochsKeeper.Schema,
+		evidencetypes.StoreKey: app.EvidenceKeeper.Schema,
+		feegrant.StoreKey:      app.FeeGrantKeeper.Schema,
+		govtypes.StoreKey:      app.GovKeeper.Schema,
+		minttypes.StoreKey:     app.MintKeeper.Schema,
+		pooltypes.StoreKey:     app.PoolKeeper.Schema,
+		slashingtypes.StoreKey: app.SlashingKeeper.Schema,
+		stakingtypes.StoreKey:  app.StakingKeeper.Schema,
+	}
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -762,6 +794,12 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register mempool gRPC service for grpc-gateway.
+	mempoolservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register state gRPC service for grpc-gateway.
+	stateservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	app.ModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -791,6 +829,12 @@ func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Conf
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
 }
 
+// RegisterStateService implements the StateServiceApplication interface,
+// registering the state gRPC service when enabled in the gRPC config.
+func (app *SimApp) RegisterStateService(_ client.Context) {
+	stateservice.RegisterStateService(app.GRPCQueryRouter(), app.GetStoreKeys(), app.CollectionsSchemas())
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 //
 // NOTE: This is solely to be used for testing purposes.
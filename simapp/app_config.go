@@ -126,6 +126,7 @@ var (
 					EndBlockers: []string{
 						govtypes.ModuleName,
 						stakingtypes.ModuleName,
+						authtypes.ModuleName,
 						feegrant.ModuleName,
 						group.ModuleName,
 						pooltypes.ModuleName,
@@ -12,6 +12,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cast"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -24,25 +25,37 @@ import (
 	authtypes "cosmossdk.io/x/auth/types"
 	authzkeeper "cosmossdk.io/x/authz/keeper"
 	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktypes "cosmossdk.io/x/bank/types"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	circuittypes "cosmossdk.io/x/circuit/types"
 	consensuskeeper "cosmossdk.io/x/consensus/keeper"
 	distrkeeper "cosmossdk.io/x/distribution/keeper"
+	distrtypes "cosmossdk.io/x/distribution/types"
 	epochskeeper "cosmossdk.io/x/epochs/keeper"
+	epochstypes "cosmossdk.io/x/epochs/types"
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	"cosmossdk.io/x/feegrant"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	govkeeper "cosmossdk.io/x/gov/keeper"
+	govtypes "cosmossdk.io/x/gov/types"
 	groupkeeper "cosmossdk.io/x/group/keeper"
 	mintkeeper "cosmossdk.io/x/mint/keeper"
+	minttypes "cosmossdk.io/x/mint/types"
 	nftkeeper "cosmossdk.io/x/nft/keeper"
 	_ "cosmossdk.io/x/protocolpool"
 	poolkeeper "cosmossdk.io/x/protocolpool/keeper"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
 	slashingkeeper "cosmossdk.io/x/slashing/keeper"
+	slashingtypes "cosmossdk.io/x/slashing/types"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	stateservice "github.com/cosmos/cosmos-sdk/client/grpc/state"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -58,8 +71,9 @@ import (
 var DefaultNodeHome string
 
 var (
-	_ runtime.AppI            = (*SimApp)(nil)
-	_ servertypes.Application = (*SimApp)(nil)
+	_ runtime.AppI                        = (*SimApp)(nil)
+	_ servertypes.Application             = (*SimApp)(nil)
+	_ servertypes.StateServiceApplication = (*SimApp)(nil)
 )
 
 // SimApp extends an ABCI application, but with most of its parameters exported.
@@ -386,15 +400,43 @@ func (app *SimApp) kvStoreKeys() map[string]*storetypes.KVStoreKey {
 	return keys
 }
 
+// CollectionsSchemas returns the collections schemas of the modules by store key.
+// They are used to decode the application state, e.g. by `debug state-diff`.
+func (app *SimApp) CollectionsSchemas() map[string]collections.Schema {
+	return map[string]collections.Schema{
+		accounts.StoreKey:      app.AccountsKeeper.Schema,
+		authtypes.StoreKey:     app.AuthKeeper.Schema,
+		banktypes.StoreKey:     app.BankKeeper.(bankkeeper.BaseKeeper).Schema,
+		circuittypes.StoreKey:  app.CircuitBreakerKeeper.Schema,
+		distrtypes.StoreKey:    app.DistrKeeper.Schema,
+		epochstypes.StoreKey:   app.EpochsKeeper.Schema,
+		evidencetypes.StoreKey: app.EvidenceKeeper.Schema,
+		feegrant.StoreKey:      app.FeeGrantKeeper.Schema,
+		govtypes.StoreKey:      app.GovKeeper.Schema,
+		minttypes.StoreKey:     app.MintKeeper.Schema,
+		pooltypes.StoreKey:     app.PoolKeeper.Schema,
+		slashingtypes.StoreKey: app.SlashingKeeper.Schema,
+		stakingtypes.StoreKey:  app.StakingKeeper.Schema,
+	}
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return app.sm
 }
 
+// RegisterStateService implements the StateServiceApplication interface,
+// registering the state gRPC service when enabled in the gRPC config.
+func (app *SimApp) RegisterStateService(_ client.Context) {
+	stateservice.RegisterStateService(app.GRPCQueryRouter(), app.GetStoreKeys(), app.CollectionsSchemas())
+}
+
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
 func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	app.App.RegisterAPIRoutes(apiSvr, apiConfig)
+	// Register state gRPC service for grpc-gateway.
+	stateservice.RegisterGRPCGatewayRoutes(apiSvr.ClientCtx, apiSvr.GRPCGatewayRouter)
 	// register swagger API in app.go so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
@@ -38,10 +38,13 @@ func initRootCmd(
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(debug.StateDiffCmd(newApp), debug.StateCmd(newApp))
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(moduleManager),
 		NewTestnetCmd(moduleManager, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp),
 		snapshot.Cmd(newApp),
@@ -104,6 +104,11 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// base_height is the height of the snapshot an incremental snapshot is based
+	// on, zero for a full snapshot.
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
+	// base_format is the format of the snapshot an incremental snapshot is based on.
+	BaseFormat uint32 `protobuf:"varint,3,opt,name=base_format,json=baseFormat,proto3" json:"base_format,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -146,16 +151,30 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
+func (m *Metadata) GetBaseFormat() uint32 {
+	if m != nil {
+		return m.BaseFormat
+	}
+	return 0
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_IAVLBase
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -210,11 +229,15 @@ type SnapshotItem_Extension struct {
 type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof" json:"extension_payload,omitempty"`
 }
+type SnapshotItem_IAVLBase struct {
+	IAVLBase *SnapshotIAVLBaseItem `protobuf:"bytes,5,opt,name=iavl_base,json=iavlBase,proto3,oneof" json:"iavl_base,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
 func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
+func (*SnapshotItem_IAVLBase) isSnapshotItem_Item()         {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -251,6 +274,13 @@ func (m *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (m *SnapshotItem) GetIAVLBase() *SnapshotIAVLBaseItem {
+	if x, ok := m.GetItem().(*SnapshotItem_IAVLBase); ok {
+		return x.IAVLBase
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -258,6 +288,7 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_IAVL)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_IAVLBase)(nil),
 	}
 }
 
@@ -377,6 +408,61 @@ func (m *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLBaseItem references exported IAVL nodes of the base snapshot of an
+// incremental snapshot. The nodes of the current store in the base snapshot are
+// read in order, skipping the first skip nodes and copying the next count nodes.
+type SnapshotIAVLBaseItem struct {
+	Skip  uint64 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *SnapshotIAVLBaseItem) Reset()         { *m = SnapshotIAVLBaseItem{} }
+func (m *SnapshotIAVLBaseItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotIAVLBaseItem) ProtoMessage()    {}
+func (*SnapshotIAVLBaseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+}
+func (m *SnapshotIAVLBaseItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotIAVLBaseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotIAVLBaseItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotIAVLBaseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotIAVLBaseItem.Merge(m, src)
+}
+func (m *SnapshotIAVLBaseItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotIAVLBaseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotIAVLBaseItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotIAVLBaseItem proto.InternalMessageInfo
+
+func (m *SnapshotIAVLBaseItem) GetSkip() uint64 {
+	if m != nil {
+		return m.Skip
+	}
+	return 0
+}
+
+func (m *SnapshotIAVLBaseItem) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 type SnapshotExtensionMeta struct {
 	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -387,7 +473,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+	return fileDescriptor_3d5cca1aa5b69183, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +525,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{6}
+	return fileDescriptor_3d5cca1aa5b69183, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,6 +567,7 @@ func init() {
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.store.snapshots.v1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLItem")
+	proto.RegisterType((*SnapshotIAVLBaseItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem")
 	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionMeta")
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionPayload")
 }
@@ -490,41 +577,47 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0xd3, 0x3c,
-	0x18, 0x8e, 0xd7, 0xb4, 0x5f, 0xf7, 0x26, 0x9f, 0xe8, 0xcc, 0x40, 0x61, 0x87, 0x2c, 0x84, 0x4b,
-	0x24, 0x68, 0xba, 0x75, 0x88, 0x03, 0xda, 0x85, 0x8a, 0x49, 0xad, 0x00, 0x69, 0xf2, 0x24, 0x84,
-	0xb8, 0x54, 0xde, 0x6a, 0x9a, 0xaa, 0x4d, 0x5d, 0xd5, 0x5e, 0x45, 0x8f, 0xfc, 0x03, 0xfe, 0x08,
-	0x37, 0x7e, 0xc4, 0x8e, 0x13, 0x27, 0x4e, 0x13, 0x6a, 0xff, 0x02, 0x3f, 0x00, 0xd9, 0x4e, 0x0a,
-	0xda, 0x52, 0x34, 0x6e, 0xef, 0xf3, 0xfa, 0x79, 0x1e, 0xfb, 0x7d, 0xec, 0x04, 0xa2, 0x33, 0x2e,
-	0x52, 0x2e, 0x1a, 0x42, 0xf2, 0x29, 0x6b, 0x88, 0x31, 0x9d, 0x88, 0x84, 0x4b, 0xd1, 0x98, 0xed,
-	0xaf, 0x40, 0x3c, 0x99, 0x72, 0xc9, 0xf1, 0x03, 0xc3, 0x8c, 0x35, 0x33, 0x5e, 0x31, 0xe3, 0xd9,
-	0xfe, 0xce, 0x76, 0x9f, 0xf7, 0xb9, 0x66, 0x35, 0x54, 0x65, 0x04, 0x3b, 0x99, 0xa0, 0x6b, 0x16,
-	0x32, 0xb5, 0x06, 0xe1, 0x17, 0x04, 0xd5, 0x93, 0xcc, 0x01, 0xdf, 0x87, 0x4a, 0xc2, 0x06, 0xfd,
-	0x44, 0x7a, 0x28, 0x40, 0x91, 0x4d, 0x32, 0xa4, 0xfa, 0x1f, 0xf8, 0x34, 0xa5, 0xd2, 0xdb, 0x08,
-	0x50, 0xf4, 0x3f, 0xc9, 0x90, 0xea, 0x9f, 0x25, 0xe7, 0xe3, 0xa1, 0xf0, 0x4a, 0xa6, 0x6f, 0x10,
-	0xc6, 0x60, 0x27, 0x54, 0x24, 0x9e, 0x1d, 0xa0, 0xc8, 0x25, 0xba, 0xc6, 0x47, 0x50, 0x4d, 0x99,
-	0xa4, 0x3d, 0x2a, 0xa9, 0x57, 0x0e, 0x50, 0xe4, 0x34, 0x1f, 0xc5, 0x6b, 0xe7, 0x88, 0xdf, 0x64,
-	0xd4, 0x96, 0x7d, 0x71, 0xb5, 0x6b, 0x91, 0x95, 0x34, 0xac, 0x43, 0x35, 0x5f, 0xc3, 0x0f, 0xc1,
-	0xd5, 0x1b, 0x76, 0xd5, 0x06, 0x4c, 0x78, 0x28, 0x28, 0x45, 0x2e, 0x71, 0x74, 0xaf, 0xad, 0x5b,
-	0xe1, 0xcf, 0x0d, 0x70, 0xf3, 0xf1, 0x3a, 0x92, 0xa5, 0xf8, 0x25, 0x94, 0xf5, 0x76, 0x7a, 0x42,
-	0xa7, 0xf9, 0xe4, 0x2f, 0x67, 0xc8, 0x75, 0x27, 0x6a, 0x49, 0x89, 0xdb, 0x16, 0x31, 0x62, 0xfc,
-	0x0a, 0xec, 0x01, 0x9d, 0x8d, 0x74, 0x1c, 0x4e, 0xf3, 0xf1, 0x2d, 0x4c, 0x3a, 0x2f, 0xde, 0xbe,
-	0x56, 0x1e, 0xad, 0xea, 0xe2, 0x6a, 0xd7, 0x56, 0xa8, 0x6d, 0x11, 0x6d, 0x82, 0x8f, 0x61, 0x93,
-	0x7d, 0x94, 0x6c, 0x2c, 0x06, 0x7c, 0xac, 0x83, 0x74, 0x9a, 0x7b, 0xb7, 0x70, 0x3c, 0xca, 0x35,
-	0x2a, 0x8f, 0xb6, 0x45, 0x7e, 0x9b, 0xe0, 0x53, 0xd8, 0x5a, 0x81, 0xee, 0x84, 0xce, 0x47, 0x9c,
-	0xf6, 0xf4, 0x65, 0x38, 0xcd, 0x83, 0x7f, 0x71, 0x3e, 0x36, 0xd2, 0xb6, 0x45, 0x6a, 0xec, 0x5a,
-	0xef, 0xf9, 0xdd, 0x6f, 0x5f, 0xeb, 0x77, 0x8c, 0x57, 0x5d, 0xf4, 0x86, 0xc1, 0x5e, 0xfc, 0xf4,
-	0x59, 0xab, 0x02, 0xf6, 0x40, 0xb2, 0x34, 0x3c, 0x84, 0xad, 0x1b, 0xe9, 0xa9, 0x57, 0x31, 0xa6,
-	0xa9, 0x49, 0x7e, 0x93, 0xe8, 0xba, 0xd0, 0x25, 0xfc, 0x84, 0xa0, 0x76, 0x3d, 0x37, 0x5c, 0x83,
-	0xd2, 0x90, 0xcd, 0xb5, 0xd8, 0x25, 0xaa, 0xc4, 0xdb, 0x50, 0x9e, 0xd1, 0xd1, 0x39, 0xd3, 0xb7,
-	0xe0, 0x12, 0x03, 0xb0, 0x07, 0xff, 0xcd, 0xd8, 0x74, 0x95, 0x65, 0x89, 0xe4, 0xf0, 0x8f, 0xd7,
-	0xad, 0xa2, 0x28, 0xe7, 0xaf, 0xbb, 0xf8, 0x0c, 0xef, 0xe0, 0x5e, 0x61, 0xd0, 0x45, 0x53, 0xac,
-	0xfb, 0x3e, 0x8a, 0x9d, 0x3b, 0xe0, 0xad, 0x0b, 0x5a, 0x1d, 0x3e, 0xbf, 0x2e, 0x33, 0x68, 0x0e,
-	0x8b, 0xe3, 0x3e, 0xbc, 0x58, 0xf8, 0xe8, 0x72, 0xe1, 0xa3, 0x1f, 0x0b, 0x1f, 0x7d, 0x5e, 0xfa,
-	0xd6, 0xe5, 0xd2, 0xb7, 0xbe, 0x2f, 0x7d, 0xeb, 0x7d, 0x68, 0xa8, 0xa2, 0x37, 0x8c, 0x07, 0xfc,
-	0xc6, 0x2f, 0x45, 0xce, 0x27, 0x4c, 0x9c, 0x56, 0xf4, 0x1f, 0xe0, 0xe0, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0xe2, 0xd5, 0x36, 0x79, 0x04, 0x00, 0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xee, 0xb8, 0x5d, 0x5c, 0x5e, 0x6b, 0x84, 0x01, 0x4d, 0xe5, 0xb0, 0xd4, 0x7a, 0x69, 0xa2,
+	0x74, 0xa1, 0x80, 0x07, 0xc3, 0xc5, 0x46, 0x4c, 0x89, 0x9a, 0x90, 0x21, 0x1a, 0x63, 0x4c, 0x36,
+	0x03, 0x3b, 0xd2, 0x66, 0x69, 0x67, 0xb3, 0x53, 0x36, 0x72, 0xf4, 0x1f, 0x78, 0xf5, 0x47, 0x78,
+	0xf3, 0x47, 0x70, 0x24, 0x9c, 0x8c, 0x07, 0x62, 0x96, 0x3f, 0x62, 0x66, 0xa6, 0x5d, 0x0d, 0x14,
+	0x82, 0xb7, 0xf7, 0xbd, 0x79, 0xdf, 0x9b, 0xf7, 0xbe, 0xf7, 0x66, 0xc0, 0xdf, 0xe3, 0x22, 0xe3,
+	0xa2, 0x23, 0x0a, 0x3e, 0x64, 0x1d, 0x91, 0xd3, 0x81, 0x48, 0x78, 0x21, 0x3a, 0xa3, 0x95, 0x09,
+	0x08, 0x06, 0x43, 0x5e, 0x70, 0xfc, 0x40, 0x47, 0x06, 0x2a, 0x32, 0x98, 0x44, 0x06, 0xa3, 0x95,
+	0x85, 0xf9, 0x7d, 0xbe, 0xcf, 0x55, 0x54, 0x47, 0x5a, 0x9a, 0xb0, 0x50, 0x12, 0xba, 0xfa, 0xa0,
+	0x64, 0x2b, 0xe0, 0x7d, 0x47, 0xd0, 0xda, 0x29, 0x33, 0xe0, 0xfb, 0x30, 0x95, 0xb0, 0x74, 0x3f,
+	0x29, 0x1c, 0xe4, 0x22, 0xdf, 0x24, 0x25, 0x92, 0xfe, 0x4f, 0x7c, 0x98, 0xd1, 0xc2, 0xb9, 0xe5,
+	0x22, 0xff, 0x0e, 0x29, 0x91, 0xf4, 0xef, 0x25, 0x87, 0x79, 0x5f, 0x38, 0x0d, 0xed, 0xd7, 0x08,
+	0x63, 0x30, 0x13, 0x2a, 0x12, 0xc7, 0x74, 0x91, 0x6f, 0x13, 0x65, 0xe3, 0x4d, 0x68, 0x65, 0xac,
+	0xa0, 0x3d, 0x5a, 0x50, 0xa7, 0xe9, 0x22, 0xdf, 0x0a, 0x1f, 0x05, 0x57, 0xf6, 0x11, 0xbc, 0x29,
+	0x43, 0x23, 0xf3, 0xf8, 0x6c, 0xd1, 0x20, 0x13, 0xaa, 0xf7, 0x0d, 0x41, 0xab, 0x3a, 0xc4, 0x0f,
+	0xc1, 0x56, 0x37, 0x76, 0xe5, 0x0d, 0x4c, 0x38, 0xc8, 0x6d, 0xf8, 0x36, 0xb1, 0x94, 0x2f, 0x56,
+	0x2e, 0xbc, 0x06, 0xd6, 0x2e, 0x15, 0xac, 0x5b, 0xf6, 0x25, 0xeb, 0x37, 0xa3, 0xb9, 0x5f, 0x3f,
+	0x96, 0xee, 0xea, 0xcb, 0x97, 0x44, 0xaf, 0xef, 0x2e, 0x07, 0xeb, 0x21, 0x01, 0x19, 0x17, 0xeb,
+	0x86, 0x2b, 0x56, 0xd9, 0xb5, 0xea, 0xee, 0x1a, 0xd6, 0x4b, 0x15, 0xe6, 0x9d, 0x36, 0xc0, 0xae,
+	0xb4, 0xdc, 0x2a, 0x58, 0x86, 0x5f, 0x40, 0x53, 0xf5, 0xa6, 0xe4, 0xb4, 0xc2, 0x27, 0xd7, 0x34,
+	0x5c, 0xf1, 0x76, 0xe4, 0x91, 0x24, 0xc7, 0x06, 0xd1, 0x64, 0xfc, 0x0a, 0xcc, 0x94, 0x8e, 0x0e,
+	0x54, 0xed, 0x56, 0xf8, 0xf8, 0x06, 0x49, 0xb6, 0x9e, 0xbf, 0x7b, 0x2d, 0x73, 0x44, 0xad, 0xf1,
+	0xd9, 0xa2, 0x29, 0x51, 0x6c, 0x10, 0x95, 0x04, 0x6f, 0xc3, 0x34, 0xfb, 0x5c, 0xb0, 0x5c, 0xa4,
+	0x3c, 0x57, 0x7d, 0x59, 0xe1, 0xf2, 0x0d, 0x32, 0x6e, 0x56, 0x1c, 0xa9, 0x7d, 0x6c, 0x90, 0xbf,
+	0x49, 0xf0, 0x2e, 0xcc, 0x4e, 0x40, 0x77, 0x40, 0x8f, 0x0e, 0x38, 0xed, 0xa9, 0xc9, 0x5b, 0xe1,
+	0xea, 0xff, 0x64, 0xde, 0xd6, 0xd4, 0xd8, 0x20, 0x33, 0xec, 0x82, 0x0f, 0x7f, 0x84, 0x69, 0x59,
+	0x7d, 0x57, 0x8a, 0x5d, 0x6e, 0x4f, 0xe7, 0x86, 0x3a, 0x44, 0x54, 0x28, 0x3d, 0x23, 0x7b, 0x7c,
+	0xb6, 0xd8, 0xaa, 0x3c, 0xb1, 0x41, 0x5a, 0x32, 0xa3, 0xb4, 0x9f, 0xcd, 0x9d, 0x5e, 0x1c, 0xec,
+	0xda, 0xd3, 0x68, 0x0a, 0xcc, 0xb4, 0x60, 0x99, 0xb7, 0x01, 0xb3, 0x97, 0x66, 0x23, 0x17, 0x3c,
+	0xa7, 0x99, 0x9e, 0xeb, 0x34, 0x51, 0x76, 0x6d, 0x16, 0xef, 0x0b, 0x82, 0x99, 0x8b, 0x53, 0xc1,
+	0x33, 0xd0, 0xe8, 0xb3, 0x23, 0x45, 0xb6, 0x89, 0x34, 0xf1, 0x3c, 0x34, 0x47, 0xf4, 0xe0, 0x90,
+	0xa9, 0x19, 0xdb, 0x44, 0x03, 0xec, 0xc0, 0xed, 0x11, 0x1b, 0x4e, 0x26, 0xd5, 0x20, 0x15, 0xfc,
+	0xe7, 0xa1, 0x4a, 0xa1, 0x9b, 0xd5, 0x43, 0xad, 0xaf, 0xe1, 0x2d, 0xcc, 0xd7, 0x09, 0x22, 0x9b,
+	0x10, 0xfd, 0x74, 0x50, 0xbe, 0x75, 0x65, 0xcb, 0x42, 0xf6, 0xf8, 0x61, 0x5e, 0x3e, 0x14, 0xa2,
+	0x41, 0x4d, 0xda, 0xf5, 0xd0, 0x7b, 0x0f, 0xf7, 0x6a, 0xb7, 0xa3, 0x4e, 0x9c, 0xab, 0x7e, 0x90,
+	0xfa, 0x82, 0xb7, 0xc0, 0xb9, 0x6a, 0x3b, 0xa4, 0x26, 0xd5, 0x8e, 0x69, 0xfd, 0x2a, 0x58, 0x3f,
+	0xc5, 0x8d, 0xe3, 0x71, 0x1b, 0x9d, 0x8c, 0xdb, 0xe8, 0xf7, 0xb8, 0x8d, 0xbe, 0x9e, 0xb7, 0x8d,
+	0x93, 0xf3, 0xb6, 0xf1, 0xf3, 0xbc, 0x6d, 0x7c, 0xf0, 0x74, 0xa8, 0xe8, 0xf5, 0x83, 0x94, 0x5f,
+	0xfa, 0x74, 0x8b, 0xa3, 0x01, 0x13, 0xbb, 0x53, 0xea, 0x8f, 0x5c, 0xfd, 0x33, 0x00, 0xa7, 0x10,
+	0x0e, 0x56, 0x9b, 0x05, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -602,6 +695,16 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseFormat != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseFormat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseHeight != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -730,6 +833,27 @@ func (m *SnapshotItem_ExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_IAVLBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_IAVLBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IAVLBase != nil {
+		{
+			size, err := m.IAVLBase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -807,6 +931,39 @@ func (m *SnapshotIAVLItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotIAVLBaseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotIAVLBaseItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotIAVLBaseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Skip != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Skip))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotExtensionMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -919,6 +1076,12 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseHeight))
+	}
+	if m.BaseFormat != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseFormat))
+	}
 	return n
 }
 
@@ -982,6 +1145,18 @@ func (m *SnapshotItem_ExtensionPayload) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_IAVLBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IAVLBase != nil {
+		l = m.IAVLBase.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1018,6 +1193,21 @@ func (m *SnapshotIAVLItem) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotIAVLBaseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Skip != 0 {
+		n += 1 + sovSnapshot(uint64(m.Skip))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSnapshot(uint64(m.Count))
+	}
+	return n
+}
+
 func (m *SnapshotExtensionMeta) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1288,6 +1478,44 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFormat", wireType)
+			}
+			m.BaseFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFormat |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1478,6 +1706,41 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_ExtensionPayload{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLBase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotIAVLBaseItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_IAVLBase{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1737,6 +2000,94 @@ func (m *SnapshotIAVLItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotIAVLBaseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotIAVLBaseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotIAVLBaseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
+			}
+			m.Skip = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Skip |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SnapshotExtensionMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,15 @@
+package smt
+
+// Config is the configuration for the sparse Merkle tree.
+type Config struct {
+	// CacheDepth is the number of levels of the tree kept in memory between
+	// commits, the deeper nodes are loaded from the database when needed.
+	CacheDepth int `mapstructure:"cache_depth"`
+}
+
+// DefaultConfig returns the default configuration for the sparse Merkle tree.
+func DefaultConfig() *Config {
+	return &Config{
+		CacheDepth: 16,
+	}
+}
@@ -0,0 +1,50 @@
+package smt
+
+import (
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// Exporter exports the leaves of a version of the tree, in the order of their
+// key hashes. The inner nodes aren't exported since they only depend on the
+// leaves.
+type Exporter struct {
+	tree  *SMTTree
+	stack []*node
+}
+
+// Next returns the next item in the exporter.
+func (e *Exporter) Next() (*snapshotstypes.SnapshotIAVLItem, error) {
+	for len(e.stack) > 0 {
+		n := e.stack[len(e.stack)-1]
+		e.stack = e.stack[:len(e.stack)-1]
+		n, err := e.tree.load(n)
+		if err != nil {
+			return nil, err
+		}
+
+		if n.isLeaf() {
+			return &snapshotstypes.SnapshotIAVLItem{
+				Key:     n.key,
+				Value:   n.value,
+				Version: int64(n.version),
+				Height:  0,
+			}, nil
+		}
+		if n.right != nil {
+			e.stack = append(e.stack, n.right)
+		}
+		if n.left != nil {
+			e.stack = append(e.stack, n.left)
+		}
+	}
+
+	return nil, commitment.ErrorExportDone
+}
+
+// Close closes the exporter.
+func (e *Exporter) Close() error {
+	e.stack = nil
+
+	return nil
+}
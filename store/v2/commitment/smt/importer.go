@@ -0,0 +1,58 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// Importer imports the leaves of a version of the tree. The inner nodes are
+// ignored, so that the leaves of an IAVL tree can be imported as well.
+type Importer struct {
+	tree    *SMTTree
+	version uint64
+	leaves  []*node
+}
+
+// Add adds the given item to the importer.
+func (i *Importer) Add(item *snapshotstypes.SnapshotIAVLItem) error {
+	if item.Height != 0 {
+		return nil
+	}
+
+	keyHash := sha256.Sum256(item.Key)
+	i.leaves = append(i.leaves, newLeaf(keyHash[:], bytes.Clone(item.Key), bytes.Clone(item.Value), i.version))
+	return nil
+}
+
+// Commit commits the importer, saving the imported version.
+func (i *Importer) Commit() error {
+	sort.Slice(i.leaves, func(a, b int) bool {
+		return bytes.Compare(i.leaves[a].keyHash, i.leaves[b].keyHash) < 0
+	})
+	root := build(0, i.leaves, i.version)
+
+	w := i.tree.newBatchWriter()
+	defer w.close()
+	if err := w.writeNodes(root, i.version); err != nil {
+		return err
+	}
+	if err := w.set(rootKey(i.version), encodeRef(nil, root)); err != nil {
+		return err
+	}
+	if err := w.write(); err != nil {
+		return err
+	}
+	i.leaves = nil
+
+	return i.tree.loadRoot(i.version)
+}
+
+// Close closes the importer.
+func (i *Importer) Close() error {
+	i.leaves = nil
+
+	return nil
+}
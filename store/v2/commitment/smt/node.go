@@ -0,0 +1,210 @@
+package smt
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	hashSize = sha256.Size
+
+	nodeKeyPrefix   = 'n' // n/<version><hash>
+	rootKeyPrefix   = 'r' // r/<version>
+	orphanKeyPrefix = 'o' // o/<orphaned version><version><hash>
+)
+
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+
+	// emptyHash is the hash of an empty subtree, as defined by ics23.SmtSpec.
+	emptyHash = make([]byte, hashSize)
+)
+
+// node is a node of the tree, either a leaf or an inner node. The subtrees with
+// a single leaf are replaced by the leaf itself and the empty subtrees are nil,
+// so that the shape of the tree only depends on its leaves.
+//
+// The nodes are stored by version and hash, the children of an inner node are
+// loaded lazily, a node which isn't loaded yet only has its version and hash.
+type node struct {
+	hash    []byte
+	version uint64
+	loaded  bool
+
+	// leaf node fields
+	keyHash []byte
+	key     []byte
+	value   []byte
+
+	// inner node fields
+	left  *node
+	right *node
+}
+
+func newLeaf(keyHash, key, value []byte, version uint64) *node {
+	valueHash := sha256.Sum256(value)
+	h := sha256.New()
+	h.Write(leafPrefix)
+	h.Write(keyHash)
+	h.Write(valueHash[:])
+
+	return &node{
+		hash:    h.Sum(nil),
+		version: version,
+		loaded:  true,
+		keyHash: keyHash,
+		key:     key,
+		value:   value,
+	}
+}
+
+func newInner(left, right *node, version uint64) *node {
+	h := sha256.New()
+	h.Write(innerPrefix)
+	h.Write(hashOf(left))
+	h.Write(hashOf(right))
+
+	return &node{
+		hash:    h.Sum(nil),
+		version: version,
+		loaded:  true,
+		left:    left,
+		right:   right,
+	}
+}
+
+// hashOf returns the hash of the given subtree.
+func hashOf(n *node) []byte {
+	if n == nil {
+		return emptyHash
+	}
+	return n.hash
+}
+
+func (n *node) isLeaf() bool {
+	return n.keyHash != nil
+}
+
+// bit returns the bit of the key hash at the given depth, which is the
+// direction of the key in the inner node at this depth, 0 for left.
+func bit(keyHash []byte, depth int) byte {
+	return (keyHash[depth/8] >> (7 - depth%8)) & 1
+}
+
+func nodeKey(version uint64, hash []byte) []byte {
+	key := make([]byte, 1+8+hashSize)
+	key[0] = nodeKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], version)
+	copy(key[9:], hash)
+	return key
+}
+
+func rootKey(version uint64) []byte {
+	return versionKey(rootKeyPrefix, version)
+}
+
+// versionKey returns the first key of the given prefix and version.
+func versionKey(prefix byte, version uint64) []byte {
+	key := make([]byte, 1+8)
+	key[0] = prefix
+	binary.BigEndian.PutUint64(key[1:], version)
+	return key
+}
+
+func orphanKey(orphanedVersion uint64, n *node) []byte {
+	key := make([]byte, 1+8+8+hashSize)
+	key[0] = orphanKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], orphanedVersion)
+	binary.BigEndian.PutUint64(key[9:], n.version)
+	copy(key[17:], n.hash)
+	return key
+}
+
+// encodeRef encodes the reference to a subtree, i.e. its version and hash.
+func encodeRef(buf []byte, n *node) []byte {
+	if n == nil {
+		return append(buf, 0)
+	}
+	buf = append(buf, 1)
+	buf = binary.BigEndian.AppendUint64(buf, n.version)
+	return append(buf, n.hash...)
+}
+
+// decodeRef decodes the reference to a subtree, returning a node which isn't
+// loaded yet.
+func decodeRef(bz []byte) (*node, []byte, error) {
+	if len(bz) == 0 {
+		return nil, nil, errors.New("invalid node reference")
+	}
+	if bz[0] == 0 {
+		return nil, bz[1:], nil
+	}
+	if len(bz) < 1+8+hashSize {
+		return nil, nil, errors.New("invalid node reference")
+	}
+	n := &node{
+		version: binary.BigEndian.Uint64(bz[1:]),
+		hash:    bz[9 : 9+hashSize],
+	}
+	return n, bz[9+hashSize:], nil
+}
+
+// encode encodes the node, without its hash and version which are in its key.
+func (n *node) encode() []byte {
+	if n.isLeaf() {
+		buf := make([]byte, 0, 1+hashSize+binary.MaxVarintLen64+len(n.key)+len(n.value))
+		buf = append(buf, leafPrefix...)
+		buf = append(buf, n.keyHash...)
+		buf = binary.AppendUvarint(buf, uint64(len(n.key)))
+		buf = append(buf, n.key...)
+		return append(buf, n.value...)
+	}
+
+	buf := make([]byte, 0, 1+2*(1+8+hashSize))
+	buf = append(buf, innerPrefix...)
+	buf = encodeRef(buf, n.left)
+	return encodeRef(buf, n.right)
+}
+
+// decode decodes the fields of the node from bz.
+func (n *node) decode(bz []byte) error {
+	if len(bz) == 0 {
+		return errors.New("empty node")
+	}
+
+	switch bz[0] {
+	case leafPrefix[0]:
+		bz = bz[1:]
+		if len(bz) < hashSize {
+			return errors.New("invalid leaf node")
+		}
+		n.keyHash, bz = bz[:hashSize], bz[hashSize:]
+		keyLen, m := binary.Uvarint(bz)
+		if m <= 0 || uint64(len(bz)-m) < keyLen {
+			return errors.New("invalid leaf node key")
+		}
+		n.key = bz[m : m+int(keyLen)]
+		n.value = bz[m+int(keyLen):]
+
+	case innerPrefix[0]:
+		var err error
+		if n.left, bz, err = decodeRef(bz[1:]); err != nil {
+			return err
+		}
+		if n.right, bz, err = decodeRef(bz); err != nil {
+			return err
+		}
+		if len(bz) != 0 {
+			return errors.New("invalid inner node")
+		}
+
+	default:
+		return fmt.Errorf("invalid node type %d", bz[0])
+	}
+
+	n.loaded = true
+	return nil
+}
@@ -0,0 +1,135 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+// proof returns the existence proof of the key in the given tree if it
+// exists, its non-existence proof otherwise.
+func (t *SMTTree) proof(root *node, key []byte) (*ics23.CommitmentProof, error) {
+	keyHash := sha256.Sum256(key)
+	leaf, err := t.existenceProof(root, keyHash[:])
+	if err != nil {
+		return nil, err
+	}
+	if leaf != nil && bytes.Equal(leaf.Key, key) {
+		return &ics23.CommitmentProof{
+			Proof: &ics23.CommitmentProof_Exist{Exist: leaf},
+		}, nil
+	}
+
+	nonExist, err := t.nonExistenceProof(root, key, keyHash[:])
+	if err != nil {
+		return nil, err
+	}
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonExist},
+	}, nil
+}
+
+// existenceProof returns the existence proof of the leaf found at the path of
+// the key hash, nil if there is none.
+func (t *SMTTree) existenceProof(root *node, keyHash []byte) (*ics23.ExistenceProof, error) {
+	leaf, path, err := t.leafPath(root, keyHash)
+	if err != nil || leaf == nil {
+		return nil, err
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   leaf.key,
+		Value: leaf.value,
+		Leaf:  ics23.SmtSpec.LeafSpec,
+		Path:  path,
+	}, nil
+}
+
+// nonExistenceProof returns the non-existence proof of the key, made of the
+// existence proofs of its neighbors in the order of the key hashes.
+func (t *SMTTree) nonExistenceProof(root *node, key, keyHash []byte) (*ics23.NonExistenceProof, error) {
+	if root == nil {
+		return nil, fmt.Errorf("cannot prove the non-existence of a key: %w", storeerrors.ErrEmptyTree)
+	}
+
+	// the neighbors are the leaf at the end of the path of the key hash, if
+	// any, and the closest leaves of the deepest sibling subtrees on each side
+	var (
+		left, right, leftSubtree, rightSubtree *node
+		err                                    error
+	)
+	n := root
+	for depth := 0; n != nil; depth++ {
+		if n, err = t.load(n); err != nil {
+			return nil, err
+		}
+		if n.isLeaf() {
+			if bytes.Compare(n.keyHash, keyHash) < 0 {
+				left = n
+			} else {
+				right = n
+			}
+			break
+		}
+
+		if bit(keyHash, depth) == 0 {
+			if n.right != nil {
+				rightSubtree = n.right
+			}
+			n = n.left
+		} else {
+			if n.left != nil {
+				leftSubtree = n.left
+			}
+			n = n.right
+		}
+	}
+
+	if left == nil && leftSubtree != nil {
+		if left, err = t.edgeLeaf(leftSubtree, func(n *node) *node { return n.right }, func(n *node) *node { return n.left }); err != nil {
+			return nil, err
+		}
+	}
+	if right == nil && rightSubtree != nil {
+		if right, err = t.edgeLeaf(rightSubtree, func(n *node) *node { return n.left }, func(n *node) *node { return n.right }); err != nil {
+			return nil, err
+		}
+	}
+
+	proof := &ics23.NonExistenceProof{Key: key}
+	if left != nil {
+		if proof.Left, err = t.existenceProof(root, left.keyHash); err != nil {
+			return nil, err
+		}
+	}
+	if right != nil {
+		if proof.Right, err = t.existenceProof(root, right.keyHash); err != nil {
+			return nil, err
+		}
+	}
+
+	return proof, nil
+}
+
+// edgeLeaf returns the leaf at the edge of the subtree n, following the first
+// child if it isn't empty, the other child otherwise.
+func (t *SMTTree) edgeLeaf(n *node, first, other func(*node) *node) (*node, error) {
+	for {
+		var err error
+		if n, err = t.load(n); err != nil {
+			return nil, err
+		}
+		if n.isLeaf() {
+			return n, nil
+		}
+		if child := first(n); child != nil {
+			n = child
+		} else {
+			n = other(n)
+		}
+	}
+}
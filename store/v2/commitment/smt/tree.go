@@ -0,0 +1,685 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/proof"
+)
+
+// maxBatchSize is the size in bytes above which a batch is written to the
+// database while writing the nodes of a version.
+const maxBatchSize = 16 << 20
+
+var (
+	_ commitment.Tree           = (*SMTTree)(nil)
+	_ commitment.ProofOpBuilder = (*SMTTree)(nil)
+)
+
+// update is a pending update of the working tree.
+type update struct {
+	keyHash []byte
+	key     []byte
+	value   []byte
+	remove  bool
+}
+
+// SMTTree is a versioned sparse Merkle tree, whose leaves are placed by the
+// SHA-256 hash of their keys, emitting proofs compatible with ics23.SmtSpec.
+//
+// The updates are buffered and applied in a single pass when the working hash
+// is computed, so that each updated inner node is hashed and written once per
+// version, whatever the number of updates below it.
+type SMTTree struct {
+	db     corestore.KVStoreWithBatch
+	logger log.Logger
+	cfg    *Config
+
+	// version and root are the latest saved version and its root
+	version        uint64
+	root           *node
+	initialVersion uint64
+
+	// working is the root of the working tree, which the pending updates are
+	// applied to, and orphans are the saved nodes removed from it
+	working *node
+	pending map[string]*update
+	orphans []*node
+	err     error
+}
+
+// NewSMTTree creates a new SMTTree instance, loading its latest version.
+func NewSMTTree(db corestore.KVStoreWithBatch, logger log.Logger, cfg *Config) (*SMTTree, error) {
+	t := &SMTTree{
+		db:      db,
+		logger:  logger,
+		cfg:     cfg,
+		pending: make(map[string]*update),
+	}
+
+	version, err := t.latestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if err := t.loadRoot(version); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Set sets the given key-value pair in the tree.
+func (t *SMTTree) Set(key, value []byte) error {
+	keyHash := sha256.Sum256(key)
+	t.pending[string(keyHash[:])] = &update{
+		keyHash: keyHash[:],
+		key:     bytes.Clone(key),
+		value:   bytes.Clone(value),
+	}
+	return nil
+}
+
+// Remove removes the given key from the tree. Removing a key which doesn't
+// exist is a no-op.
+func (t *SMTTree) Remove(key []byte) error {
+	keyHash := sha256.Sum256(key)
+	t.pending[string(keyHash[:])] = &update{
+		keyHash: keyHash[:],
+		key:     bytes.Clone(key),
+		remove:  true,
+	}
+	return nil
+}
+
+// GetLatestVersion returns the latest version of the tree.
+func (t *SMTTree) GetLatestVersion() uint64 {
+	return t.version
+}
+
+// Hash returns the hash of the latest saved version of the tree.
+func (t *SMTTree) Hash() []byte {
+	return hashOf(t.root)
+}
+
+// WorkingHash returns the working hash of the tree. It returns nil if the
+// pending updates can't be applied, the error is then returned by Commit.
+func (t *SMTTree) WorkingHash() []byte {
+	if err := t.applyPending(); err != nil {
+		t.logger.Error("failed to apply the pending updates", "err", err)
+		return nil
+	}
+	return hashOf(t.working)
+}
+
+// LoadVersion loads the state at the given version, deleting the later
+// versions. The version 0 loads the latest version.
+func (t *SMTTree) LoadVersion(version uint64) error {
+	if version == 0 {
+		latestVersion, err := t.latestVersion()
+		if err != nil {
+			return err
+		}
+		return t.loadRoot(latestVersion)
+	}
+
+	if has, err := t.db.Has(rootKey(version)); err != nil {
+		return err
+	} else if !has {
+		return fmt.Errorf("version %d does not exist", version)
+	}
+
+	// delete the roots and the nodes of the later versions, and the orphans
+	// records of the nodes which are part of the loaded version again
+	w := t.newBatchWriter()
+	defer w.close()
+	for _, prefix := range []byte{rootKeyPrefix, nodeKeyPrefix, orphanKeyPrefix} {
+		keys, err := t.keys(versionKey(prefix, version+1), []byte{prefix + 1})
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := w.delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	if err := w.write(); err != nil {
+		return err
+	}
+
+	return t.loadRoot(version)
+}
+
+// Commit commits the working tree as a new version.
+func (t *SMTTree) Commit() ([]byte, uint64, error) {
+	if err := t.applyPending(); err != nil {
+		return nil, 0, err
+	}
+
+	version := t.workingVersion()
+	w := t.newBatchWriter()
+	defer w.close()
+	if err := w.writeNodes(t.working, version); err != nil {
+		return nil, 0, err
+	}
+	for _, n := range t.orphans {
+		if err := w.set(orphanKey(version, n), []byte{}); err != nil {
+			return nil, 0, err
+		}
+	}
+	// the root is written last, so that the version only exists once all its
+	// nodes are written
+	if err := w.set(rootKey(version), encodeRef(nil, t.working)); err != nil {
+		return nil, 0, err
+	}
+	if err := w.write(); err != nil {
+		return nil, 0, err
+	}
+
+	t.version = version
+	t.root = t.working
+	t.orphans = nil
+	t.unloadDeep(t.root, 0, version)
+
+	return hashOf(t.root), version, nil
+}
+
+// SetInitialVersion sets the initial version of the tree, i.e. the version of
+// its first commit.
+func (t *SMTTree) SetInitialVersion(version uint64) error {
+	t.initialVersion = version
+	return nil
+}
+
+// GetProof returns a proof for the given key and version.
+func (t *SMTTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	root, err := t.rootAt(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.proof(root, key)
+}
+
+// NewCommitmentOp implements commitment.ProofOpBuilder.
+func (t *SMTTree) NewCommitmentOp(key []byte, p *ics23.CommitmentProof) proof.CommitmentOp {
+	return proof.NewSMTCommitmentOp(key, p)
+}
+
+// Get returns the value of the given key at the given version, nil if the key
+// doesn't exist.
+func (t *SMTTree) Get(version uint64, key []byte) ([]byte, error) {
+	root, err := t.rootAt(version)
+	if err != nil {
+		return nil, err
+	}
+
+	keyHash := sha256.Sum256(key)
+	leaf, _, err := t.leafPath(root, keyHash[:])
+	if err != nil {
+		return nil, err
+	}
+	if leaf == nil || !bytes.Equal(leaf.keyHash, keyHash[:]) {
+		return nil, nil
+	}
+
+	return leaf.value, nil
+}
+
+// Prune prunes all versions up to and including the provided version.
+func (t *SMTTree) Prune(version uint64) error {
+	if version >= t.version {
+		return fmt.Errorf("cannot prune the latest version %d", t.version)
+	}
+
+	// the nodes orphaned at version+1 at the latest are only part of the
+	// pruned versions
+	orphans, err := t.keys([]byte{orphanKeyPrefix}, versionKey(orphanKeyPrefix, version+2))
+	if err != nil {
+		return err
+	}
+	roots, err := t.keys([]byte{rootKeyPrefix}, rootKey(version+1))
+	if err != nil {
+		return err
+	}
+
+	w := t.newBatchWriter()
+	defer w.close()
+	for _, key := range orphans {
+		if err := w.delete(append([]byte{nodeKeyPrefix}, key[9:]...)); err != nil {
+			return err
+		}
+		if err := w.delete(key); err != nil {
+			return err
+		}
+	}
+	for _, key := range roots {
+		if err := w.delete(key); err != nil {
+			return err
+		}
+	}
+
+	return w.write()
+}
+
+// Export exports the tree exporter at the given version.
+func (t *SMTTree) Export(version uint64) (commitment.Exporter, error) {
+	root, err := t.rootAt(version)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter := &Exporter{tree: t}
+	if root != nil {
+		exporter.stack = []*node{root}
+	}
+	return exporter, nil
+}
+
+// Import imports the tree importer at the given version. The tree must be
+// empty.
+func (t *SMTTree) Import(version uint64) (commitment.Importer, error) {
+	if t.version != 0 {
+		return nil, fmt.Errorf("cannot import into a non-empty tree at version %d", t.version)
+	}
+
+	return &Importer{
+		tree:    t,
+		version: version,
+	}, nil
+}
+
+// Close closes the tree.
+func (t *SMTTree) Close() error {
+	t.root = nil
+	t.working = nil
+	t.pending = make(map[string]*update)
+	t.orphans = nil
+	return nil
+}
+
+// workingVersion returns the version of the working tree.
+func (t *SMTTree) workingVersion() uint64 {
+	if t.version == 0 && t.initialVersion > 1 {
+		return t.initialVersion
+	}
+	return t.version + 1
+}
+
+// applyPending applies the pending updates to the working tree.
+func (t *SMTTree) applyPending() error {
+	if t.err != nil {
+		return t.err
+	}
+	if len(t.pending) == 0 {
+		return nil
+	}
+
+	updates := make([]*update, 0, len(t.pending))
+	for _, u := range t.pending {
+		updates = append(updates, u)
+	}
+	sort.Slice(updates, func(i, j int) bool {
+		return bytes.Compare(updates[i].keyHash, updates[j].keyHash) < 0
+	})
+
+	working, err := t.update(t.working, 0, updates, t.workingVersion())
+	if err != nil {
+		t.err = err
+		return err
+	}
+	t.working = working
+	t.pending = make(map[string]*update)
+
+	return nil
+}
+
+// update applies the updates, sorted by key hash, to the subtree n at the
+// given depth and returns the new subtree. The replaced nodes are new nodes
+// of the given version.
+func (t *SMTTree) update(n *node, depth int, updates []*update, version uint64) (*node, error) {
+	if len(updates) == 0 {
+		return n, nil
+	}
+
+	if n == nil {
+		leaves := make([]*node, 0, len(updates))
+		for _, u := range updates {
+			if !u.remove {
+				leaves = append(leaves, newLeaf(u.keyHash, u.key, u.value, version))
+			}
+		}
+		return build(depth, leaves, version), nil
+	}
+
+	saved := n
+	n, err := t.load(n)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.isLeaf() {
+		// the leaf is kept unless it is updated, and moved down with the
+		// new leaves if any
+		leaves := make([]*node, 0, len(updates)+1)
+		merged := false
+		for _, u := range updates {
+			if !merged {
+				if c := bytes.Compare(u.keyHash, n.keyHash); c == 0 {
+					merged = true
+					if !u.remove && bytes.Equal(u.value, n.value) {
+						leaves = append(leaves, n)
+						continue
+					}
+					t.orphan(n, version)
+				} else if c > 0 {
+					merged = true
+					leaves = append(leaves, n)
+				}
+			}
+			if !u.remove {
+				leaves = append(leaves, newLeaf(u.keyHash, u.key, u.value, version))
+			}
+		}
+		if !merged {
+			leaves = append(leaves, n)
+		}
+		// the updates only remove missing keys or set the value of the leaf
+		// again, which leaves the subtree unchanged
+		if len(leaves) == 1 && leaves[0] == n {
+			return saved, nil
+		}
+		return build(depth, leaves, version), nil
+	}
+
+	split := sort.Search(len(updates), func(i int) bool {
+		return bit(updates[i].keyHash, depth) == 1
+	})
+	left, err := t.update(n.left, depth+1, updates[:split], version)
+	if err != nil {
+		return nil, err
+	}
+	right, err := t.update(n.right, depth+1, updates[split:], version)
+	if err != nil {
+		return nil, err
+	}
+	if left == n.left && right == n.right {
+		return saved, nil
+	}
+	t.orphan(n, version)
+
+	// a subtree with a single leaf is replaced by the leaf
+	switch {
+	case left == nil && right == nil:
+		return nil, nil
+	case left == nil:
+		if right, err = t.load(right); err != nil {
+			return nil, err
+		}
+		if right.isLeaf() {
+			return right, nil
+		}
+	case right == nil:
+		if left, err = t.load(left); err != nil {
+			return nil, err
+		}
+		if left.isLeaf() {
+			return left, nil
+		}
+	}
+
+	return newInner(left, right, version), nil
+}
+
+// build builds the subtree of the given leaves, sorted by key hash, at the
+// given depth.
+func build(depth int, leaves []*node, version uint64) *node {
+	switch len(leaves) {
+	case 0:
+		return nil
+	case 1:
+		return leaves[0]
+	}
+
+	split := sort.Search(len(leaves), func(i int) bool {
+		return bit(leaves[i].keyHash, depth) == 1
+	})
+	return newInner(build(depth+1, leaves[:split], version), build(depth+1, leaves[split:], version), version)
+}
+
+// orphan records the node removed from the working tree, if it is saved.
+func (t *SMTTree) orphan(n *node, version uint64) {
+	if n.version < version {
+		t.orphans = append(t.orphans, n)
+	}
+}
+
+// load returns the node with its fields loaded from the database. The nodes
+// which aren't loaded yet are copied, so that the saved trees are never
+// modified.
+func (t *SMTTree) load(n *node) (*node, error) {
+	if n.loaded {
+		return n, nil
+	}
+
+	bz, err := t.db.Get(nodeKey(n.version, n.hash))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("node %X at version %d not found", n.hash, n.version)
+	}
+
+	loaded := &node{hash: n.hash, version: n.version}
+	if err := loaded.decode(bz); err != nil {
+		return nil, err
+	}
+	return loaded, nil
+}
+
+// unloadDeep unloads the nodes of the given version below the configured
+// cache depth, the nodes of the previous versions are already unloaded.
+func (t *SMTTree) unloadDeep(n *node, depth int, version uint64) {
+	if n == nil || n.version != version || n.isLeaf() {
+		return
+	}
+
+	if depth+1 >= t.cfg.CacheDepth {
+		if n.left != nil {
+			n.left = &node{hash: n.left.hash, version: n.left.version}
+		}
+		if n.right != nil {
+			n.right = &node{hash: n.right.hash, version: n.right.version}
+		}
+		return
+	}
+	t.unloadDeep(n.left, depth+1, version)
+	t.unloadDeep(n.right, depth+1, version)
+}
+
+// leafPath returns the leaf found at the path of the key hash, nil if the path
+// ends with an empty subtree, and the inner ops of its path, from the bottom.
+func (t *SMTTree) leafPath(root *node, keyHash []byte) (*node, []*ics23.InnerOp, error) {
+	var path []*ics23.InnerOp
+	n := root
+	for depth := 0; n != nil; depth++ {
+		var err error
+		if n, err = t.load(n); err != nil {
+			return nil, nil, err
+		}
+		if n.isLeaf() {
+			break
+		}
+
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if bit(keyHash, depth) == 0 {
+			op.Prefix = innerPrefix
+			op.Suffix = hashOf(n.right)
+			n = n.left
+		} else {
+			op.Prefix = append(bytes.Clone(innerPrefix), hashOf(n.left)...)
+			n = n.right
+		}
+		path = append(path, op)
+	}
+
+	// the ops are applied from the leaf to the root
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return n, path, nil
+}
+
+// latestVersion returns the latest saved version, 0 if there is none.
+func (t *SMTTree) latestVersion() (uint64, error) {
+	itr, err := t.db.ReverseIterator([]byte{rootKeyPrefix}, []byte{rootKeyPrefix + 1})
+	if err != nil {
+		return 0, err
+	}
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return 0, itr.Error()
+	}
+	key := itr.Key()
+	if len(key) != 9 {
+		return 0, fmt.Errorf("invalid root key %X", key)
+	}
+	return binary.BigEndian.Uint64(key[1:]), nil
+}
+
+// loadRoot loads the given saved version, dropping the working tree.
+func (t *SMTTree) loadRoot(version uint64) error {
+	root, err := t.rootAt(version)
+	if err != nil {
+		return err
+	}
+
+	t.version = version
+	t.root = root
+	t.working = root
+	t.pending = make(map[string]*update)
+	t.orphans = nil
+	t.err = nil
+
+	return nil
+}
+
+// rootAt returns the root of the given saved version, which isn't loaded yet.
+func (t *SMTTree) rootAt(version uint64) (*node, error) {
+	if version == 0 {
+		return nil, nil
+	}
+
+	bz, err := t.db.Get(rootKey(version))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+	root, _, err := decodeRef(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// keys returns the keys in [start, end).
+func (t *SMTTree) keys(start, end []byte) ([][]byte, error) {
+	itr, err := t.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	var keys [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+
+	return keys, itr.Error()
+}
+
+// batchWriter writes to the database in batches of bounded size.
+type batchWriter struct {
+	db    corestore.KVStoreWithBatch
+	batch corestore.Batch
+}
+
+func (t *SMTTree) newBatchWriter() *batchWriter {
+	return &batchWriter{
+		db:    t.db,
+		batch: t.db.NewBatch(),
+	}
+}
+
+func (w *batchWriter) set(key, value []byte) error {
+	if err := w.batch.Set(key, value); err != nil {
+		return err
+	}
+	return w.flushIfFull()
+}
+
+func (w *batchWriter) delete(key []byte) error {
+	if err := w.batch.Delete(key); err != nil {
+		return err
+	}
+	return w.flushIfFull()
+}
+
+func (w *batchWriter) flushIfFull() error {
+	size, err := w.batch.GetByteSize()
+	if err != nil {
+		return err
+	}
+	if size < maxBatchSize {
+		return nil
+	}
+
+	if err := w.batch.Write(); err != nil {
+		return err
+	}
+	if err := w.batch.Close(); err != nil {
+		return err
+	}
+	w.batch = w.db.NewBatch()
+
+	return nil
+}
+
+// writeNodes writes the nodes of the subtree n created at the given version.
+func (w *batchWriter) writeNodes(n *node, version uint64) error {
+	if n == nil || n.version != version {
+		return nil
+	}
+
+	if err := w.set(nodeKey(n.version, n.hash), n.encode()); err != nil {
+		return err
+	}
+	if n.isLeaf() {
+		return nil
+	}
+	if err := w.writeNodes(n.left, version); err != nil {
+		return err
+	}
+	return w.writeNodes(n.right, version)
+}
+
+func (w *batchWriter) write() error {
+	return w.batch.Write()
+}
+
+func (w *batchWriter) close() {
+	_ = w.batch.Close()
+}
@@ -0,0 +1,256 @@
+package smt
+
+import (
+	"fmt"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+func TestCommitterSuite(t *testing.T) {
+	s := &commitment.CommitStoreTestSuite{
+		NewStore: func(db corestore.KVStoreWithBatch, storeKeys []string, pruneOpts *store.PruneOptions, logger log.Logger) (*commitment.CommitStore, error) {
+			multiTrees := make(map[string]commitment.Tree)
+			cfg := DefaultConfig()
+			for _, storeKey := range storeKeys {
+				prefixDB := dbm.NewPrefixDB(db, []byte(storeKey))
+				tree, err := NewSMTTree(prefixDB, logger, cfg)
+				if err != nil {
+					return nil, err
+				}
+				multiTrees[storeKey] = tree
+			}
+			return commitment.NewCommitStore(multiTrees, db, pruneOpts, logger)
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func generateTree(t *testing.T, db corestore.KVStoreWithBatch) *SMTTree {
+	t.Helper()
+
+	tree, err := NewSMTTree(db, log.NewNopLogger(), &Config{CacheDepth: 2})
+	require.NoError(t, err)
+	return tree
+}
+
+func TestSMTTree(t *testing.T) {
+	// generate a new tree
+	db := dbm.NewMemDB()
+	tree := generateTree(t, db)
+	require.Equal(t, uint64(0), tree.GetLatestVersion())
+
+	// write a batch of version 1
+	require.NoError(t, tree.Set([]byte("key1"), []byte("value1")))
+	require.NoError(t, tree.Set([]byte("key2"), []byte("value2")))
+	require.NoError(t, tree.Set([]byte("key3"), []byte("value3")))
+
+	workingHash := tree.WorkingHash()
+	require.NotNil(t, workingHash)
+	require.Equal(t, uint64(0), tree.GetLatestVersion())
+
+	// commit the batch
+	commitHash, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+	require.Equal(t, workingHash, commitHash)
+	require.Equal(t, commitHash, tree.Hash())
+
+	bz, err := tree.Get(1, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), bz)
+
+	_, err = tree.Get(2, []byte("key1"))
+	require.Error(t, err)
+
+	// write a batch of version 2
+	require.NoError(t, tree.Set([]byte("key4"), []byte("value4")))
+	require.NoError(t, tree.Set([]byte("key5"), []byte("value5")))
+	require.NoError(t, tree.Remove([]byte("key1")))
+	version2Hash := tree.WorkingHash()
+	commitHash, version, err = tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), version)
+	require.Equal(t, version2Hash, commitHash)
+
+	bz, err = tree.Get(2, []byte("key1"))
+	require.NoError(t, err)
+	require.Nil(t, bz)
+	bz, err = tree.Get(1, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), bz)
+
+	// write a batch of version 3
+	require.NoError(t, tree.Set([]byte("key2"), []byte("value2-3")))
+	_, _, err = tree.Commit()
+	require.NoError(t, err)
+
+	// the tree is reopened at its latest version
+	reopened := generateTree(t, db)
+	require.Equal(t, uint64(3), reopened.GetLatestVersion())
+	require.Equal(t, tree.Hash(), reopened.Hash())
+
+	// prune version 1
+	require.NoError(t, tree.Prune(1))
+	_, err = tree.Get(1, []byte("key1"))
+	require.Error(t, err)
+	require.Error(t, tree.LoadVersion(1))
+	bz, err = tree.Get(2, []byte("key2"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), bz)
+	require.Error(t, tree.Prune(3))
+
+	// load version 2, deleting version 3
+	require.NoError(t, tree.LoadVersion(2))
+	require.Equal(t, uint64(2), tree.GetLatestVersion())
+	require.Equal(t, version2Hash, tree.WorkingHash())
+	_, err = tree.Get(3, []byte("key2"))
+	require.Error(t, err)
+
+	require.NoError(t, tree.Close())
+}
+
+func TestSMTTreeNoopUpdate(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := generateTree(t, db)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	hash, _, err := tree.Commit()
+	require.NoError(t, err)
+
+	// removing missing keys and setting the same values writes no node
+	require.NoError(t, tree.Remove([]byte("missing")))
+	require.NoError(t, tree.Set([]byte("key3"), []byte("value3")))
+	commitHash, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, hash, commitHash)
+
+	keys, err := tree.keys(versionKey(nodeKeyPrefix, version), versionKey(nodeKeyPrefix, version+1))
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	keys, err = tree.keys(versionKey(orphanKeyPrefix, version), versionKey(orphanKeyPrefix, version+1))
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
+func TestSMTTreeCanonical(t *testing.T) {
+	// the hash only depends on the leaves, not on the order of the updates
+	batched := generateTree(t, dbm.NewMemDB())
+	for i := 0; i < 100; i++ {
+		require.NoError(t, batched.Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+	_, _, err := batched.Commit()
+	require.NoError(t, err)
+
+	incremental := generateTree(t, dbm.NewMemDB())
+	for i := 199; i >= 0; i-- {
+		require.NoError(t, incremental.Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+		if i%7 == 0 {
+			_, _, err := incremental.Commit()
+			require.NoError(t, err)
+		}
+	}
+	for i := 100; i < 200; i++ {
+		require.NoError(t, incremental.Remove([]byte(fmt.Sprintf("key-%d", i))))
+		if i%13 == 0 {
+			_, _, err := incremental.Commit()
+			require.NoError(t, err)
+		}
+	}
+	_, _, err = incremental.Commit()
+	require.NoError(t, err)
+
+	require.Equal(t, batched.Hash(), incremental.Hash())
+
+	// removing all the leaves results in an empty tree
+	for i := 0; i < 100; i++ {
+		require.NoError(t, incremental.Remove([]byte(fmt.Sprintf("key-%d", i))))
+	}
+	hash, _, err := incremental.Commit()
+	require.NoError(t, err)
+	require.Equal(t, emptyHash, hash)
+}
+
+func TestSMTTreeProofs(t *testing.T) {
+	tree := generateTree(t, dbm.NewMemDB())
+	for i := 0; i < 50; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+	root, version, err := tree.Commit()
+	require.NoError(t, err)
+
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("key-%d", i))
+		proof, err := tree.GetProof(version, key)
+		require.NoError(t, err)
+
+		if i < 50 {
+			require.NotNil(t, proof.GetExist())
+			require.True(t, ics23.VerifyMembership(ics23.SmtSpec, root, proof, key, []byte(fmt.Sprintf("value-%d", i))))
+			require.False(t, ics23.VerifyMembership(ics23.SmtSpec, root, proof, key, []byte("other")))
+		} else {
+			require.NotNil(t, proof.GetNonexist())
+			require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, root, proof, key))
+		}
+	}
+
+	// the proofs are emitted as SMT commitment ops
+	op := tree.NewCommitmentOp([]byte("key-1"), &ics23.CommitmentProof{})
+	require.Equal(t, ics23.SmtSpec, op.Spec)
+
+	// a tree with a single leaf
+	single := generateTree(t, dbm.NewMemDB())
+	require.NoError(t, single.Set([]byte("key"), []byte("value")))
+	root, version, err = single.Commit()
+	require.NoError(t, err)
+	proof, err := single.GetProof(version, []byte("key"))
+	require.NoError(t, err)
+	require.True(t, ics23.VerifyMembership(ics23.SmtSpec, root, proof, []byte("key"), []byte("value")))
+	proof, err = single.GetProof(version, []byte("other"))
+	require.NoError(t, err)
+	require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, root, proof, []byte("other")))
+}
+
+func TestSMTTreeExportImport(t *testing.T) {
+	tree := generateTree(t, dbm.NewMemDB())
+	for i := 0; i < 100; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+
+	exporter, err := tree.Export(version)
+	require.NoError(t, err)
+	defer exporter.Close()
+
+	target := generateTree(t, dbm.NewMemDB())
+	importer, err := target.Import(version)
+	require.NoError(t, err)
+	count := 0
+	for {
+		item, err := exporter.Next()
+		if err == commitment.ErrorExportDone {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, importer.Add(item))
+		count++
+	}
+	require.Equal(t, 100, count)
+	require.NoError(t, importer.Commit())
+
+	require.Equal(t, version, target.GetLatestVersion())
+	require.Equal(t, hash, target.Hash())
+	_, err = target.Import(version)
+	require.Error(t, err)
+}
@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 
 	protoio "github.com/cosmos/gogoproto/io"
+	ics23 "github.com/cosmos/ics23/go"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
 	internal "cosmossdk.io/store/v2/internal/conv"
 	"cosmossdk.io/store/v2/internal/encoding"
 	"cosmossdk.io/store/v2/proof"
@@ -231,10 +234,8 @@ func (c *CommitStore) Commit(version uint64) (*proof.CommitInfo, error) {
 	}
 
 	// Prune the old versions.
-	if prune, pruneVersion := c.pruneOptions.ShouldPrune(version); prune {
-		if err := c.Prune(pruneVersion); err != nil {
-			c.logger.Info("failed to prune SC", "prune_version", pruneVersion, "err", err)
-		}
+	if err := c.prune(version); err != nil {
+		c.logger.Info("failed to prune SC", "version", version, "err", err)
 	}
 
 	return cInfo, nil
@@ -267,7 +268,7 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 	if cInfo == nil {
 		return nil, fmt.Errorf("commit info not found for version %d", version)
 	}
-	commitOp := proof.NewIAVLCommitmentOp(key, iProof)
+	commitOp := newCommitmentOp(tree, key, iProof)
 	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
 	if err != nil {
 		return nil, err
@@ -276,6 +277,99 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 	return []proof.CommitmentOp{commitOp, *storeCommitmentOp}, nil
 }
 
+// GetMultiProof returns the multi-proof of the given keys across stores at the
+// given version. The values of the items are ignored. The keys which can't be
+// proven on their own, i.e. the keys of an empty SMT store, are left out of the
+// proof, with their error set in the returned errors.
+func (c *CommitStore) GetMultiProof(version uint64, items []proof.MultiProofItem) (*proof.MultiProof, []error, error) {
+	// storeKeyPair identifies a key of a store, the store key and the key
+	// being kept apart since either one may contain any byte
+	type storeKeyPair struct {
+		storeKey string
+		key      string
+	}
+
+	// group the keys by store, dropping the duplicates
+	keys := make(map[string][][]byte)
+	seen := make(map[storeKeyPair]struct{})
+	for _, item := range items {
+		storeKey := string(item.StoreKey)
+		if _, ok := c.multiTrees[storeKey]; !ok {
+			return nil, nil, fmt.Errorf("store %s not found", item.StoreKey)
+		}
+		id := storeKeyPair{storeKey: storeKey, key: string(item.Key)}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		keys[storeKey] = append(keys[storeKey], item.Key)
+	}
+
+	storeKeys := make([]string, 0, len(keys))
+	for storeKey := range keys {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+
+	mp := &proof.MultiProof{Stores: make([]proof.StoreMultiProof, 0, len(storeKeys))}
+	storeKeysBz := make([][]byte, 0, len(storeKeys))
+	keyErrs := make(map[storeKeyPair]error)
+	for _, storeKey := range storeKeys {
+		tree := c.multiTrees[storeKey]
+		ops := make([]proof.CommitmentOp, 0, len(keys[storeKey]))
+		for _, key := range keys[storeKey] {
+			iProof, err := tree.GetProof(version, key)
+			if errors.Is(err, storeerrors.ErrEmptyTree) {
+				keyErrs[storeKeyPair{storeKey: storeKey, key: string(key)}] = err
+				continue
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			ops = append(ops, newCommitmentOp(tree, key, iProof))
+		}
+		if len(ops) == 0 {
+			continue
+		}
+		storeProof, err := proof.NewStoreMultiProof([]byte(storeKey), ops)
+		if err != nil {
+			return nil, nil, err
+		}
+		mp.Stores = append(mp.Stores, storeProof)
+		storeKeysBz = append(storeKeysBz, []byte(storeKey))
+	}
+
+	errs := make([]error, len(items))
+	for i, item := range items {
+		errs[i] = keyErrs[storeKeyPair{storeKey: string(item.StoreKey), key: string(item.Key)}]
+	}
+	if len(mp.Stores) == 0 {
+		return nil, errs, nil
+	}
+
+	cInfo, err := c.GetCommitInfo(version)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cInfo == nil {
+		return nil, nil, fmt.Errorf("commit info not found for version %d", version)
+	}
+	if mp.CommitProof, err = cInfo.GetStoresProof(storeKeysBz); err != nil {
+		return nil, nil, err
+	}
+
+	return mp, errs, nil
+}
+
+// newCommitmentOp returns the commitment op of the proof of the key in the
+// tree, an IAVL one unless the tree is a ProofOpBuilder.
+func newCommitmentOp(tree Tree, key []byte, iProof *ics23.CommitmentProof) proof.CommitmentOp {
+	if builder, ok := tree.(ProofOpBuilder); ok {
+		return builder.NewCommitmentOp(key, iProof)
+	}
+	return proof.NewIAVLCommitmentOp(key, iProof)
+}
+
 func (c *CommitStore) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
 	tree, ok := c.multiTrees[internal.UnsafeBytesToStr(storeKey)]
 	if !ok {
@@ -290,8 +384,69 @@ func (c *CommitStore) Get(storeKey []byte, version uint64, key []byte) ([]byte,
 	return bz, nil
 }
 
+// prune prunes the trees according to the pruning options, after the given
+// version is committed. The commit info of a version is pruned once the trees of
+// all the stores are pruned at this version.
+func (c *CommitStore) prune(version uint64) (ferr error) {
+	if len(c.pruneOptions.StoreOptions) == 0 {
+		if prune, pruneVersion := c.pruneOptions.ShouldPrune(version); prune {
+			return c.Prune(pruneVersion)
+		}
+		return nil
+	}
+
+	for storeKey, tree := range c.multiTrees {
+		if prune, pruneVersion := c.pruneOptions.ForStore(storeKey).ShouldPrune(version); prune {
+			if err := tree.Prune(pruneVersion); err != nil {
+				ferr = errors.Join(ferr, fmt.Errorf("failed to prune store %s: %w", storeKey, err))
+			}
+		}
+	}
+
+	if pruneVersion := c.pruneOptions.PrunedVersion(version); pruneVersion > c.pruneOptions.PrunedVersion(version-1) {
+		ferr = errors.Join(ferr, c.pruneCommitInfo(pruneVersion))
+	}
+
+	return ferr
+}
+
+// Prune prunes the trees of all the stores and the commit info up to the given
+// version. The trees of the stores with their own pruning options are pruned as
+// allowed by them at the latest version, see PruneOptions.StorePruneVersion, and
+// the commit info up to the version pruned by all of them.
 func (c *CommitStore) Prune(version uint64) (ferr error) {
+	latestVersion, err := c.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	commitInfoVersion := version
+	for storeKey := range c.pruneOptions.StoreOptions {
+		commitInfoVersion = min(commitInfoVersion, c.pruneOptions.StorePruneVersion(storeKey, version, latestVersion))
+	}
+
 	// prune the metadata
+	if commitInfoVersion > 0 {
+		if err := c.pruneCommitInfo(commitInfoVersion); err != nil {
+			return err
+		}
+	}
+
+	for storeKey, tree := range c.multiTrees {
+		pruneVersion := c.pruneOptions.StorePruneVersion(storeKey, version, latestVersion)
+		if pruneVersion == 0 {
+			continue
+		}
+		if err := tree.Prune(pruneVersion); err != nil {
+			ferr = errors.Join(ferr, err)
+		}
+	}
+
+	return ferr
+}
+
+// pruneCommitInfo prunes the commit info up to the given version.
+func (c *CommitStore) pruneCommitInfo(version uint64) error {
 	batch := c.db.NewBatch()
 	for v := version; v > 0; v-- {
 		cInfoKey := []byte(fmt.Sprintf(commitInfoKeyFmt, v))
@@ -302,17 +457,7 @@ func (c *CommitStore) Prune(version uint64) (ferr error) {
 			return err
 		}
 	}
-	if err := batch.WriteSync(); err != nil {
-		return err
-	}
-
-	for _, tree := range c.multiTrees {
-		if err := tree.Prune(version); err != nil {
-			ferr = errors.Join(ferr, err)
-		}
-	}
-
-	return ferr
+	return batch.WriteSync()
 }
 
 // Snapshot implements snapshotstypes.CommitSnapshotter.
@@ -329,7 +474,16 @@ func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error
 		return fmt.Errorf("the snapshot version %d is greater than the latest version %d", version, latestVersion)
 	}
 
-	for storeKey, tree := range c.multiTrees {
+	// the stores are exported in a deterministic order, which incremental
+	// snapshots rely on to compare them with their base snapshot
+	storeKeys := make([]string, 0, len(c.multiTrees))
+	for storeKey := range c.multiTrees {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+
+	for _, storeKey := range storeKeys {
+		tree := c.multiTrees[storeKey]
 		// TODO: check the parallelism of this loop
 		if err := func() error {
 			exporter, err := tree.Export(version)
@@ -402,11 +556,17 @@ loop:
 				importer.Close()
 			}
 
+			importer = nil
 			storeKey = []byte(item.Store.Name)
 			tree := c.multiTrees[item.Store.Name]
 			if tree == nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("store %s not found", item.Store.Name)
 			}
+			// The tree was already imported by a previous restore of the snapshot
+			// which was interrupted, its leaves are only written to the storage.
+			if tree.GetLatestVersion() == version {
+				continue
+			}
 			importer, err = tree.Import(version)
 			if err != nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to import tree for version %d: %w", version, err)
@@ -414,7 +574,7 @@ loop:
 			defer importer.Close()
 
 		case *snapshotstypes.SnapshotItem_IAVL:
-			if importer == nil {
+			if storeKey == nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("received IAVL node item before store item")
 			}
 			node := item.IAVL
@@ -443,6 +603,9 @@ loop:
 					},
 				}
 			}
+			if importer == nil {
+				continue
+			}
 			err := importer.Add(node)
 			if err != nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to add node to importer: %w", err)
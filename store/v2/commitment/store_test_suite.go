@@ -121,6 +121,35 @@ func (s *CommitStoreTestSuite) TestStore_Snapshotter() {
 		}
 		s.Require().True(matched)
 	}
+
+	// restoring the snapshot again, e.g. after an interrupted restore, skips the
+	// imported trees but still passes their leaves to the storage
+	chunks = make(chan io.ReadCloser, kvCount*int(latestVersion))
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		s.Require().NotNil(streamWriter)
+		defer streamWriter.Close()
+		s.Require().NoError(commitStore.Snapshot(latestVersion, streamWriter))
+	}()
+
+	streamReader, err = snapshots.NewStreamReader(chunks)
+	s.Require().NoError(err)
+	chStorage = make(chan *corestore.StateChanges, 100)
+	leavesCount := 0
+	wg.Add(1)
+	go func() {
+		for kv := range chStorage {
+			leavesCount += len(kv.StateChanges)
+		}
+		wg.Done()
+	}()
+	_, err = targetStore.Restore(latestVersion, snapshotstypes.CurrentFormat, streamReader, chStorage)
+	s.Require().NoError(err)
+
+	close(chStorage)
+	wg.Wait()
+	s.Require().Equal(len(storeKeys)*kvCount*int(latestVersion), leavesCount)
+	s.Require().Equal(targetCommitInfo.Hash(), targetStore.WorkingCommitInfo(latestVersion).Hash())
 }
 
 func (s *CommitStoreTestSuite) TestStore_Pruning() {
@@ -161,3 +190,48 @@ func (s *CommitStoreTestSuite) TestStore_Pruning() {
 		}
 	}
 }
+
+func (s *CommitStoreTestSuite) TestStore_PruningStoreOptions() {
+	storeKeys := []string{storeKey1, storeKey2}
+	pruneOpts := &store.PruneOptions{
+		KeepRecent: 10,
+		Interval:   5,
+		StoreOptions: map[string]store.PruneOptions{
+			storeKey2: {KeepRecent: 20, Interval: 10},
+		},
+	}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, pruneOpts, log.NewNopLogger())
+	s.Require().NoError(err)
+
+	latestVersion := uint64(55)
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			kvPairs[storeKey] = corestore.KVPairs{{Key: []byte("key"), Value: []byte(fmt.Sprintf("value-%d", i))}}
+		}
+		s.Require().NoError(commitStore.WriteBatch(corestore.NewChangesetWithPairs(kvPairs)))
+
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+
+	// the first store is pruned up to 55-10-1, the second one up to 50-20-1
+	for storeKey, pruneVersion := range map[string]uint64{storeKey1: 44, storeKey2: 29} {
+		_, err := commitStore.Get([]byte(storeKey), pruneVersion, []byte("key"))
+		s.Require().Error(err, storeKey)
+
+		value, err := commitStore.Get([]byte(storeKey), pruneVersion+1, []byte("key"))
+		s.Require().NoError(err, storeKey)
+		s.Require().Equal([]byte(fmt.Sprintf("value-%d", pruneVersion+1)), value)
+	}
+
+	// the commit info is pruned up to the version pruned by both stores
+	for i := uint64(1); i <= latestVersion; i++ {
+		commitInfo, _ := commitStore.GetCommitInfo(i)
+		if i <= 29 {
+			s.Require().Nil(commitInfo)
+		} else {
+			s.Require().NotNil(commitInfo)
+		}
+	}
+}
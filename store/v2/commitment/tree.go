@@ -6,6 +6,7 @@ import (
 
 	ics23 "github.com/cosmos/ics23/go"
 
+	"cosmossdk.io/store/v2/proof"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -42,6 +43,12 @@ type Tree interface {
 	io.Closer
 }
 
+// ProofOpBuilder is an optional interface of Tree, implemented by the trees
+// whose proofs aren't IAVL proofs, to wrap them in the matching CommitmentOp.
+type ProofOpBuilder interface {
+	NewCommitmentOp(key []byte, proof *ics23.CommitmentProof) proof.CommitmentOp
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
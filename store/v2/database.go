@@ -18,6 +18,10 @@ type VersionedDatabase interface {
 	Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error)
 	ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error)
 
+	// History returns an iterator over the writes of the given key between the
+	// startVersion and endVersion, both inclusive, in ascending version order.
+	History(storeKey, key []byte, startVersion, endVersion uint64) (HistoryIterator, error)
+
 	ApplyChangeset(version uint64, cs *corestore.Changeset) error
 
 	// Prune attempts to prune all versions up to and including the provided
@@ -30,6 +34,31 @@ type VersionedDatabase interface {
 	io.Closer
 }
 
+// HistoryIterator iterates over the writes of a single key across versions.
+// Each write either sets the key to a value or deletes it, i.e. tombstones it.
+type HistoryIterator interface {
+	// Valid returns whether the iterator is positioned at a write.
+	Valid() bool
+
+	// Next moves the iterator to the next write.
+	Next()
+
+	// Version returns the version of the current write.
+	Version() uint64
+
+	// Value returns the value set by the current write, <nil> for a tombstone.
+	Value() []byte
+
+	// Tombstone returns whether the current write deletes the key.
+	Tombstone() bool
+
+	// Error returns the error of the iterator, if any.
+	Error() error
+
+	// Close releases associated resources.
+	Close() error
+}
+
 // Committer defines an API for committing state.
 type Committer interface {
 	// WriteBatch writes a batch of key-value pairs to the tree.
@@ -50,6 +79,13 @@ type Committer interface {
 	// GetProof returns the proof of existence or non-existence for the given key.
 	GetProof(storeKey []byte, version uint64, key []byte) ([]proof.CommitmentOp, error)
 
+	// GetMultiProof returns a single proof of existence or non-existence for
+	// the given keys across stores. The keys which can't be proven, e.g. the
+	// missing keys of an empty SMT store, are left out of the proof with their
+	// error set at their index in the returned errors. The proof is nil if no
+	// key is proven.
+	GetMultiProof(version uint64, items []proof.MultiProofItem) (*proof.MultiProof, []error, error)
+
 	// Get returns the value for the given key at the given version.
 	//
 	// NOTE: This method only exists to support migration from IAVL v0/v1 to v2.
@@ -33,11 +33,18 @@ var (
 	ErrKeyEmpty        = errors.New("key empty")
 	ErrStartAfterEnd   = errors.New("start key after end key")
 
+	// ErrStartVersionAfterEnd is returned when a version range starts after it ends.
+	ErrStartVersionAfterEnd = errors.New("start version after end version")
+
 	// ErrBatchClosed is returned when a closed or written batch is used.
 	ErrBatchClosed = errors.New("batch has been written or closed")
 
 	// ErrValueNil is returned when attempting to set a nil value.
 	ErrValueNil = errors.New("value nil")
+
+	// ErrEmptyTree is returned when proving the non-existence of a key in an
+	// empty tree, which has no leaf to prove it against.
+	ErrEmptyTree = errors.New("empty tree")
 )
 
 // ErrVersionPruned defines an error returned when a version queried is pruned
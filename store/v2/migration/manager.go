@@ -43,8 +43,14 @@ type Manager struct {
 	stateCommitment *commitment.CommitStore
 
 	db              corestore.KVStoreWithBatch
-	mtx             sync.Mutex // mutex for migratedVersion
+	mtx             sync.Mutex // mutex for migratedVersion and err
+	cond            *sync.Cond // signals the changes of migratedVersion and err
 	migratedVersion uint64
+	err             error
+
+	// switchVersion is the first version committed by the store/v2 backends,
+	// 0 means the RootStore switches over as soon as the migration catches up.
+	switchVersion uint64
 
 	chChangeset <-chan *VersionedChangeset
 	chDone      <-chan struct{}
@@ -54,13 +60,46 @@ type Manager struct {
 //
 // NOTE: `sc` can be `nil` if don't want to migrate the commitment.
 func NewManager(db corestore.KVStoreWithBatch, sm *snapshots.Manager, ss *storage.StorageStore, sc *commitment.CommitStore, logger log.Logger) *Manager {
-	return &Manager{
+	m := &Manager{
 		logger:           logger,
 		snapshotsManager: sm,
 		stateStorage:     ss,
 		stateCommitment:  sc,
 		db:               db,
 	}
+	m.cond = sync.NewCond(&m.mtx)
+	return m
+}
+
+// SetSwitchVersion sets the version at which the RootStore switches over to
+// the migrated store/v2 backends. Until then, the RootStore keeps committing
+// with the original SC backend and the Changesets are written to both.
+// If the migration hasn't caught up when the switch version is reached, the
+// RootStore waits for it, so that all nodes switch over at the same version.
+//
+// NOTE: It must be called before the RootStore is loaded.
+func (m *Manager) SetSwitchVersion(version uint64) {
+	m.switchVersion = version
+}
+
+// GetSwitchVersion returns the version at which the RootStore switches over to
+// the migrated store/v2 backends, 0 if it isn't set.
+func (m *Manager) GetSwitchVersion() uint64 {
+	return m.switchVersion
+}
+
+// IsSwitched returns true if the RootStore already switched over to the
+// migrated store/v2 backends, i.e. the state storage has been committed at the
+// switch version. It is used to resume from a restart after the switch over.
+func (m *Manager) IsSwitched() (bool, error) {
+	if m.switchVersion == 0 {
+		return false, nil
+	}
+	latestVersion, err := m.stateStorage.GetLatestVersion()
+	if err != nil {
+		return false, err
+	}
+	return latestVersion >= m.switchVersion, nil
 }
 
 // Start starts the whole migration process.
@@ -69,13 +108,21 @@ func NewManager(db corestore.KVStoreWithBatch, sm *snapshots.Manager, ss *storag
 // `chChangeset` is the channel to receive the committed Changesets from the RootStore.
 // `chDone` is the channel to receive the done signal from the RootStore.
 // NOTE: It should be called by the RootStore, running in the background.
-func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset, chDone <-chan struct{}) error {
+func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset, chDone <-chan struct{}) (err error) {
 	m.chChangeset = chChangeset
 	m.chDone = chDone
 
+	// the error is recorded to stop the RootStore waiting for the migration
+	defer func() {
+		if err != nil {
+			m.setError(err)
+		}
+	}()
+
 	go func() {
 		if err := m.writeChangeset(); err != nil {
 			m.logger.Error("failed to write changeset", "err", err)
+			m.setError(err)
 		}
 	}()
 
@@ -161,9 +208,7 @@ func (m *Manager) Migrate(height uint64) error {
 		return err
 	}
 
-	m.mtx.Lock()
-	m.migratedVersion = height
-	m.mtx.Unlock()
+	m.setMigratedVersion(height)
 
 	return nil
 }
@@ -201,6 +246,36 @@ func (m *Manager) GetMigratedVersion() uint64 {
 	return m.migratedVersion
 }
 
+// WaitForVersion blocks until the migration has caught up to the given version.
+// It returns an error if the migration has failed.
+func (m *Manager) WaitForVersion(version uint64) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for m.migratedVersion < version && m.err == nil {
+		m.cond.Wait()
+	}
+	if m.err != nil {
+		return fmt.Errorf("migration failed: %w", m.err)
+	}
+	return nil
+}
+
+func (m *Manager) setMigratedVersion(version uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.migratedVersion = version
+	m.cond.Broadcast()
+}
+
+func (m *Manager) setError(err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.err == nil {
+		m.err = err
+	}
+	m.cond.Broadcast()
+}
+
 // Sync catches up the Changesets which are committed while the migration is in progress.
 // It should be called after the migration is done.
 func (m *Manager) Sync() error {
@@ -244,9 +319,7 @@ func (m *Manager) Sync() error {
 				return fmt.Errorf("failed to write changeset to storage: %w", err)
 			}
 
-			m.mtx.Lock()
-			m.migratedVersion = version
-			m.mtx.Unlock()
+			m.setMigratedVersion(version)
 
 			version += 1
 		}
@@ -112,3 +112,19 @@ func TestMigrateState(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitForVersion(t *testing.T) {
+	m, _ := setupMigrationManager(t, false)
+
+	go func() {
+		for version := uint64(1); version <= 10; version++ {
+			m.setMigratedVersion(version)
+		}
+	}()
+	require.NoError(t, m.WaitForVersion(10))
+	require.Equal(t, uint64(10), m.GetMigratedVersion())
+
+	// the migration failure stops the waiting
+	go m.setError(fmt.Errorf("test error"))
+	require.ErrorContains(t, m.WaitForVersion(20), "test error")
+}
@@ -3,11 +3,16 @@ package store
 // PruneOptions defines the pruning configuration.
 type PruneOptions struct {
 	// KeepRecent sets the number of recent versions to keep.
-	KeepRecent uint64
+	KeepRecent uint64 `mapstructure:"keep-recent"`
 
 	// Interval sets the number of how often to prune.
 	// If set to 0, no pruning will be done.
-	Interval uint64
+	Interval uint64 `mapstructure:"interval"`
+
+	// StoreOptions overrides the options of the given store keys, e.g. to keep
+	// the full history of a store with an Interval of 0. The StoreOptions of the
+	// overrides are ignored.
+	StoreOptions map[string]PruneOptions `mapstructure:"store-options"`
 }
 
 // DefaultPruneOptions returns the default pruning options.
@@ -38,6 +43,101 @@ func (opts *PruneOptions) ShouldPrune(version uint64) (bool, uint64) {
 	return false, 0
 }
 
+// ForStore returns the pruning options of the given store key.
+func (opts *PruneOptions) ForStore(storeKey string) *PruneOptions {
+	if storeOpts, ok := opts.StoreOptions[storeKey]; ok {
+		return &PruneOptions{
+			KeepRecent: storeOpts.KeepRecent,
+			Interval:   storeOpts.Interval,
+		}
+	}
+	return &PruneOptions{
+		KeepRecent: opts.KeepRecent,
+		Interval:   opts.Interval,
+	}
+}
+
+// PrunedVersion returns the latest version which is pruned at the given version
+// by the default options and the options of every store, or 0 if none. The data
+// shared by the stores, e.g. the commit info, can be pruned up to this version.
+func (opts *PruneOptions) PrunedVersion(version uint64) uint64 {
+	pruned := prunedVersion(opts.KeepRecent, opts.Interval, version)
+	for _, storeOpts := range opts.StoreOptions {
+		pruned = min(pruned, prunedVersion(storeOpts.KeepRecent, storeOpts.Interval, version))
+	}
+	return pruned
+}
+
+// StorePruneVersion returns the version up to which the given store is pruned
+// by a pruning of all the stores up to the given version, e.g. by the CLI, at
+// the given latest version, or 0 if it isn't pruned. A store with its own
+// options keeps at least its KeepRecent latest versions, and all of them if its
+// Interval is 0, as it does with the pruning done on commit.
+func (opts *PruneOptions) StorePruneVersion(storeKey string, version, latestVersion uint64) uint64 {
+	storeOpts, ok := opts.StoreOptions[storeKey]
+	if !ok {
+		return version
+	}
+	if storeOpts.Interval == 0 || latestVersion <= storeOpts.KeepRecent {
+		return 0
+	}
+	return min(version, latestVersion-storeOpts.KeepRecent-1)
+}
+
+// prunedVersion returns the latest version pruned at the given version by the
+// given options, i.e. at the last multiple of the interval.
+func prunedVersion(keepRecent, interval, version uint64) uint64 {
+	if interval == 0 {
+		return 0
+	}
+	lastPruned := version - version%interval
+	if lastPruned <= keepRecent {
+		return 0
+	}
+	return lastPruned - keepRecent - 1
+}
+
+// SCType defines the type of the commitment tree of a store.
+type SCType string
+
+const (
+	// SCTypeIavl is the IAVL tree.
+	SCTypeIavl SCType = "iavl"
+	// SCTypeSMT is the sparse Merkle tree, with ICS23 proofs of ics23.SmtSpec.
+	SCTypeSMT SCType = "smt"
+)
+
+// SCOptions defines the state commitment (SC) configuration.
+//
+// NOTE: The tree types are part of the consensus, they must be the same on all
+// the nodes and the type of an existing store can't be changed.
+type SCOptions struct {
+	// Type sets the type of tree of the stores.
+	Type SCType `mapstructure:"type"`
+
+	// StoreTypes overrides the type of tree of the given store keys.
+	StoreTypes map[string]SCType `mapstructure:"store-types"`
+}
+
+// DefaultSCOptions returns the default SC options, which use IAVL trees for
+// all the stores.
+func DefaultSCOptions() *SCOptions {
+	return &SCOptions{
+		Type: SCTypeIavl,
+	}
+}
+
+// TreeType returns the type of tree of the given store key.
+func (opts *SCOptions) TreeType(storeKey string) SCType {
+	if t, ok := opts.StoreTypes[storeKey]; ok {
+		return t
+	}
+	if opts.Type == "" {
+		return SCTypeIavl
+	}
+	return opts.Type
+}
+
 // DBOptions defines the interface of a database options.
 type DBOptions interface {
 	Get(string) interface{}
@@ -0,0 +1,51 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneOptions_StoreOptions(t *testing.T) {
+	opts := &PruneOptions{
+		KeepRecent: 10,
+		Interval:   5,
+		StoreOptions: map[string]PruneOptions{
+			"staking": {Interval: 0},
+			"ibc":     {KeepRecent: 2, Interval: 1},
+		},
+	}
+
+	require.Equal(t, &PruneOptions{KeepRecent: 10, Interval: 5}, opts.ForStore("bank"))
+	require.Equal(t, &PruneOptions{KeepRecent: 2, Interval: 1}, opts.ForStore("ibc"))
+
+	prune, pruneVersion := opts.ForStore("ibc").ShouldPrune(7)
+	require.True(t, prune)
+	require.Equal(t, uint64(4), pruneVersion)
+	prune, _ = opts.ForStore("staking").ShouldPrune(100)
+	require.False(t, prune)
+
+	// staking is never pruned
+	require.Zero(t, opts.PrunedVersion(100))
+
+	// a manual pruning keeps the versions retained by the options of a store
+	require.Equal(t, uint64(50), opts.StorePruneVersion("bank", 50, 100))
+	require.Zero(t, opts.StorePruneVersion("staking", 50, 100))
+	require.Equal(t, uint64(50), opts.StorePruneVersion("ibc", 50, 100))
+	require.Equal(t, uint64(49), opts.StorePruneVersion("ibc", 50, 52))
+	require.Zero(t, opts.StorePruneVersion("ibc", 50, 2))
+
+	delete(opts.StoreOptions, "staking")
+	testCases := []struct {
+		version, pruned uint64
+	}{
+		{version: 4, pruned: 0},
+		{version: 10, pruned: 0},
+		{version: 15, pruned: 4},
+		{version: 19, pruned: 4},
+		{version: 20, pruned: 9},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.pruned, opts.PrunedVersion(tc.version), "version %d", tc.version)
+	}
+}
@@ -0,0 +1,252 @@
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	"cosmossdk.io/errors"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/internal/encoding"
+)
+
+type (
+	// MultiProofItem defines a key of a store proven by a MultiProof, with its
+	// value, nil if the key doesn't exist.
+	MultiProofItem struct {
+		StoreKey []byte
+		Key      []byte
+		Value    []byte
+	}
+
+	// StoreMultiProof defines the proof of the keys of a store against the store
+	// hash, as a compressed ics23 batch proof whose spec is given by Type, the
+	// type of the commitment op of the store proofs.
+	StoreMultiProof struct {
+		StoreKey []byte
+		Type     string
+		Proof    *ics23.CommitmentProof
+	}
+
+	// MultiProof defines the proof of keys of several stores against the commit
+	// info hash, i.e. the app hash. The inner nodes shared by the proofs of a
+	// store, and by the proofs of the stores in the commit info, are only
+	// included once.
+	MultiProof struct {
+		// Stores are the proofs of the keys of each store, sorted by store key.
+		Stores []StoreMultiProof
+		// CommitProof is the compressed ics23 batch proof of the store hashes
+		// against the commit info hash.
+		CommitProof *ics23.CommitmentProof
+	}
+)
+
+// specs maps the commitment op types to their ics23 proof spec.
+var specs = map[string]*ics23.ProofSpec{
+	ProofOpIAVLCommitment:         ics23.IavlSpec,
+	ProofOpSimpleMerkleCommitment: SimpleMerkleSpec,
+	ProofOpSMTCommitment:          ics23.SmtSpec,
+}
+
+// NewStoreMultiProof combines the commitment ops of keys of a store, which
+// must be of the same type, into a StoreMultiProof.
+func NewStoreMultiProof(storeKey []byte, ops []CommitmentOp) (StoreMultiProof, error) {
+	if len(ops) == 0 {
+		return StoreMultiProof{}, fmt.Errorf("no proof of store %s", storeKey)
+	}
+
+	proofs := make([]*ics23.CommitmentProof, len(ops))
+	for i, op := range ops {
+		if op.Type != ops[0].Type {
+			return StoreMultiProof{}, fmt.Errorf("proofs of store %s have different types %s and %s", storeKey, ops[0].Type, op.Type)
+		}
+		proofs[i] = op.Proof
+	}
+	proof, err := ics23.CombineProofs(proofs)
+	if err != nil {
+		return StoreMultiProof{}, err
+	}
+
+	return StoreMultiProof{
+		StoreKey: storeKey,
+		Type:     ops[0].Type,
+		Proof:    proof,
+	}, nil
+}
+
+// GetStoresProof returns the compressed batch proof of the hashes of the given
+// stores against the commit info hash.
+func (ci *CommitInfo) GetStoresProof(storeKeys [][]byte) (*ics23.CommitmentProof, error) {
+	proofs := make([]*ics23.CommitmentProof, 0, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		if ci.GetStoreCommitID(storeKey).Hash == nil {
+			return nil, fmt.Errorf("store %s not found in commit info", storeKey)
+		}
+		_, op, err := ci.GetStoreProof(storeKey)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, op.Proof)
+	}
+
+	return ics23.CombineProofs(proofs)
+}
+
+// Verify verifies that the multi-proof proves the given items against the
+// root, i.e. the app hash.
+func (mp *MultiProof) Verify(root []byte, items []MultiProofItem) error {
+	if mp.CommitProof == nil {
+		return errors.Wrap(storeerrors.ErrInvalidProof, "missing commit info proof")
+	}
+	commitProof := ics23.Decompress(mp.CommitProof)
+
+	storeProofs := make(map[string]*ics23.CommitmentProof, len(mp.Stores))
+	storeRoots := make(map[string][]byte, len(mp.Stores))
+	storeSpecs := make(map[string]*ics23.ProofSpec, len(mp.Stores))
+	for _, store := range mp.Stores {
+		spec, ok := specs[store.Type]
+		if !ok {
+			return errors.Wrapf(storeerrors.ErrInvalidProof, "unknown proof type %s of store %s", store.Type, store.StoreKey)
+		}
+		if store.Proof == nil {
+			return errors.Wrapf(storeerrors.ErrInvalidProof, "missing proof of store %s", store.StoreKey)
+		}
+		storeProof := ics23.Decompress(store.Proof)
+		storeRoot, err := storeProof.Calculate()
+		if err != nil {
+			return errors.Wrapf(storeerrors.ErrInvalidProof, "could not calculate root of store %s: %v", store.StoreKey, err)
+		}
+
+		// the store hash is proven by the commit info proof
+		if !ics23.VerifyMembership(SimpleMerkleSpec, root, commitProof, store.StoreKey, storeRoot) {
+			return errors.Wrapf(storeerrors.ErrInvalidProof, "proof did not verify the hash %X of store %s", storeRoot, store.StoreKey)
+		}
+
+		storeProofs[string(store.StoreKey)] = storeProof
+		storeRoots[string(store.StoreKey)] = storeRoot
+		storeSpecs[string(store.StoreKey)] = spec
+	}
+
+	for _, item := range items {
+		storeProof, ok := storeProofs[string(item.StoreKey)]
+		if !ok {
+			return errors.Wrapf(storeerrors.ErrInvalidProof, "missing proof of store %s", item.StoreKey)
+		}
+		spec, storeRoot := storeSpecs[string(item.StoreKey)], storeRoots[string(item.StoreKey)]
+
+		if item.Value == nil {
+			if !ics23.VerifyNonMembership(spec, storeRoot, storeProof, item.Key) {
+				return errors.Wrapf(storeerrors.ErrInvalidProof, "proof did not verify absence of key %s in store %s", item.Key, item.StoreKey)
+			}
+		} else if !ics23.VerifyMembership(spec, storeRoot, storeProof, item.Key, item.Value) {
+			return errors.Wrapf(storeerrors.ErrInvalidProof, "proof did not verify existence of key %s in store %s with given value %x", item.Key, item.StoreKey, item.Value)
+		}
+	}
+
+	return nil
+}
+
+// Marshal returns the compact encoding of the multi-proof.
+//
+// NOTE: The MultiProof is encoded as follows:
+// - number of stores (uvarint)
+// - for each store, sorted by store key:
+// -- store key (bytes)
+// -- proof type (bytes)
+// -- proof (bytes, ics23 protobuf)
+// - commit info proof (bytes, ics23 protobuf)
+func (mp *MultiProof) Marshal() ([]byte, error) {
+	// the stores are sorted on a copy, leaving the multi-proof unchanged
+	stores := make([]StoreMultiProof, len(mp.Stores))
+	copy(stores, mp.Stores)
+	sort.Slice(stores, func(i, j int) bool {
+		return bytes.Compare(stores[i].StoreKey, stores[j].StoreKey) < 0
+	})
+
+	var buf bytes.Buffer
+	if err := encoding.EncodeUvarint(&buf, uint64(len(stores))); err != nil {
+		return nil, err
+	}
+	for _, store := range stores {
+		proofBz, err := store.Proof.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		for _, bz := range [][]byte{store.StoreKey, []byte(store.Type), proofBz} {
+			if err := encoding.EncodeBytes(&buf, bz); err != nil {
+				return nil, err
+			}
+		}
+	}
+	commitProofBz, err := mp.CommitProof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := encoding.EncodeBytes(&buf, commitProofBz); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal unmarshals the encoded multi-proof.
+func (mp *MultiProof) Unmarshal(buf []byte) error {
+	count, n, err := encoding.DecodeUvarint(buf)
+	if err != nil {
+		return err
+	}
+	buf = buf[n:]
+	if count > uint64(len(buf)) {
+		return fmt.Errorf("invalid number of stores %d", count)
+	}
+
+	decodeBytes := func() ([]byte, error) {
+		bz, n, err := encoding.DecodeBytes(buf)
+		if err != nil {
+			return nil, err
+		}
+		buf = buf[n:]
+		return bz, nil
+	}
+
+	mp.Stores = make([]StoreMultiProof, count)
+	for i := range mp.Stores {
+		storeKey, err := decodeBytes()
+		if err != nil {
+			return err
+		}
+		proofType, err := decodeBytes()
+		if err != nil {
+			return err
+		}
+		proofBz, err := decodeBytes()
+		if err != nil {
+			return err
+		}
+		proof := &ics23.CommitmentProof{}
+		if err := proof.Unmarshal(proofBz); err != nil {
+			return err
+		}
+		mp.Stores[i] = StoreMultiProof{
+			StoreKey: storeKey,
+			Type:     string(proofType),
+			Proof:    proof,
+		}
+	}
+
+	commitProofBz, err := decodeBytes()
+	if err != nil {
+		return err
+	}
+	mp.CommitProof = &ics23.CommitmentProof{}
+	if err := mp.CommitProof.Unmarshal(commitProofBz); err != nil {
+		return err
+	}
+	if len(buf) != 0 {
+		return fmt.Errorf("invalid multi-proof, %d trailing bytes", len(buf))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package root
+
+import (
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/commitment/smt"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+// NewCommitStore creates a CommitStore with a tree per store key, whose type is
+// selected by the SC options. The trees are stored in db, prefixed by their
+// store key, which is terminated by "/" for the SMT trees so that the key ranges
+// an SMT tree iterates over never include the keys of a store whose name it
+// prefixes, e.g. "acc" and "accounts".
+func NewCommitStore(
+	db corestore.KVStoreWithBatch,
+	storeKeys []string,
+	scOpts *store.SCOptions,
+	pruneOpts *store.PruneOptions,
+	logger log.Logger,
+) (*commitment.CommitStore, error) {
+	if scOpts == nil {
+		scOpts = store.DefaultSCOptions()
+	}
+
+	multiTrees := make(map[string]commitment.Tree)
+	for _, storeKey := range storeKeys {
+		switch treeType := scOpts.TreeType(storeKey); treeType {
+		case store.SCTypeIavl:
+			prefixDB := dbm.NewPrefixDB(db, []byte(storeKey))
+			multiTrees[storeKey] = iavl.NewIavlTree(prefixDB, logger, iavl.DefaultConfig())
+		case store.SCTypeSMT:
+			prefixDB := dbm.NewPrefixDB(db, []byte(storeKey+"/"))
+			tree, err := smt.NewSMTTree(prefixDB, logger, smt.DefaultConfig())
+			if err != nil {
+				return nil, fmt.Errorf("failed to create SMT tree for store %s: %w", storeKey, err)
+			}
+			multiTrees[storeKey] = tree
+		default:
+			return nil, fmt.Errorf("unknown SC type %q for store %s", treeType, storeKey)
+		}
+	}
+
+	return commitment.NewCommitStore(multiTrees, db, pruneOpts, logger)
+}
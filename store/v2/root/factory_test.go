@@ -0,0 +1,124 @@
+package root
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	dbm "cosmossdk.io/store/v2/db"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+func TestNewCommitStore(t *testing.T) {
+	noopLog := log.NewNopLogger()
+	scOpts := &store.SCOptions{
+		Type:       store.SCTypeIavl,
+		StoreTypes: map[string]store.SCType{testStoreKey2: store.SCTypeSMT, testStoreKey3: store.SCTypeSMT},
+	}
+	sc, err := NewCommitStore(dbm.NewMemDB(), []string{testStoreKey, testStoreKey2, testStoreKey3}, scOpts, nil, noopLog)
+	require.NoError(t, err)
+
+	sqliteDB, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+	rs, err := New(noopLog, storage.NewStorageStore(sqliteDB, nil, noopLog), sc, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	cs := corestore.NewChangeset()
+	cs.Add(testStoreKeyBytes, []byte("key1"), []byte("value1"), false)
+	cs.Add(testStoreKey2Bytes, []byte("key2"), []byte("value2"), false)
+	cs.Add(testStoreKey2Bytes, []byte("key3"), []byte("value3"), false)
+	_, err = rs.WorkingHash(cs)
+	require.NoError(t, err)
+	_, err = rs.Commit(cs)
+	require.NoError(t, err)
+
+	cInfo, err := sc.GetCommitInfo(1)
+	require.NoError(t, err)
+
+	// the proofs of each store are emitted by its tree type
+	for _, tc := range []struct {
+		storeKey  []byte
+		key       []byte
+		value     []byte
+		proofType string
+	}{
+		{testStoreKeyBytes, []byte("key1"), []byte("value1"), proof.ProofOpIAVLCommitment},
+		{testStoreKey2Bytes, []byte("key2"), []byte("value2"), proof.ProofOpSMTCommitment},
+		{testStoreKey2Bytes, []byte("key4"), nil, proof.ProofOpSMTCommitment},
+	} {
+		result, err := rs.Query(tc.storeKey, 1, tc.key, true)
+		require.NoError(t, err)
+		require.Equal(t, tc.proofType, result.ProofOps[0].Type)
+
+		var args [][]byte
+		if tc.value != nil {
+			args = [][]byte{tc.value}
+		}
+		storeHash := cInfo.GetStoreCommitID(tc.storeKey).Hash
+		treeRoots, err := result.ProofOps[0].Run(args)
+		require.NoError(t, err)
+		require.Equal(t, storeHash, treeRoots[0])
+		expRoots, err := result.ProofOps[1].Run([][]byte{storeHash})
+		require.NoError(t, err)
+		require.Equal(t, cInfo.Hash(), expRoots[0])
+	}
+
+	// the keys of an empty SMT store can't be proven absent, which fails them
+	// alone in a multi-proof
+	items := []proof.MultiProofItem{
+		{StoreKey: testStoreKeyBytes, Key: []byte("key1")},
+		{StoreKey: testStoreKey3Bytes, Key: []byte("key1")},
+		{StoreKey: testStoreKey2Bytes, Key: []byte("key4")},
+	}
+	multiResult, err := rs.QueryMultiProof(1, items)
+	require.NoError(t, err)
+	require.NoError(t, multiResult.Errors[0])
+	require.ErrorIs(t, multiResult.Errors[1], storeerrors.ErrEmptyTree)
+	require.NoError(t, multiResult.Errors[2])
+	require.Equal(t, []proof.MultiProofItem{multiResult.Items[0], multiResult.Items[2]}, multiResult.ProvenItems())
+	require.NoError(t, multiResult.Proof.Verify(cInfo.Hash(), multiResult.ProvenItems()))
+
+	multiResult, err = rs.QueryMultiProof(1, items[1:2])
+	require.NoError(t, err)
+	require.ErrorIs(t, multiResult.Errors[0], storeerrors.ErrEmptyTree)
+	require.Nil(t, multiResult.Proof)
+
+	_, err = NewCommitStore(dbm.NewMemDB(), []string{testStoreKey}, &store.SCOptions{Type: "unknown"}, nil, noopLog)
+	require.ErrorContains(t, err, "unknown SC type")
+}
+
+func TestNewCommitStoreOverlappingStoreKeys(t *testing.T) {
+	storeKeys := []string{"acc", "accounts"}
+	sc, err := NewCommitStore(dbm.NewMemDB(), storeKeys, &store.SCOptions{Type: store.SCTypeSMT}, nil, log.NewNopLogger())
+	require.NoError(t, err)
+	require.NoError(t, sc.LoadVersion(0))
+
+	for version := uint64(1); version <= 3; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			cs.Add([]byte(storeKey), []byte("key"), []byte(fmt.Sprintf("value%d", version)), false)
+		}
+		require.NoError(t, sc.WriteBatch(cs))
+		_, err := sc.Commit(version)
+		require.NoError(t, err)
+	}
+	cInfo, err := sc.GetCommitInfo(1)
+	require.NoError(t, err)
+
+	// rolling back "acc" doesn't delete the nodes of "accounts"
+	require.NoError(t, sc.LoadVersion(1))
+	for _, storeKey := range storeKeys {
+		value, err := sc.Get([]byte(storeKey), 1, []byte("key"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), value)
+	}
+	require.Equal(t, cInfo.Hash(), sc.WorkingCommitInfo(1).Hash())
+}
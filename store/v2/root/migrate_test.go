@@ -24,7 +24,14 @@ var storeKeys = []string{"store1", "store2", "store3"}
 type MigrateStoreTestSuite struct {
 	suite.Suite
 
-	rootStore store.RootStore
+	rootStore        store.RootStore
+	migrationManager *migration.Manager
+
+	// backends used to reopen the root store
+	orgSC           store.Committer
+	ss              *storage.StorageStore
+	sc              *commitment.CommitStore
+	snapshotManager *snapshots.Manager
 }
 
 func TestMigrateStoreTestSuite(t *testing.T) {
@@ -79,6 +86,12 @@ func (s *MigrateStoreTestSuite) SetupTest() {
 	// assume no storage store, simulate the migration process
 	s.rootStore, err = New(testLog, ss, orgSC, migrationManager, nil)
 	s.Require().NoError(err)
+
+	s.migrationManager = migrationManager
+	s.orgSC = orgSC
+	s.ss = ss
+	s.sc = sc
+	s.snapshotManager = snapshotManager
 }
 
 func (s *MigrateStoreTestSuite) TestMigrateState() {
@@ -166,3 +179,62 @@ func (s *MigrateStoreTestSuite) TestMigrateState() {
 	s.Require().NoError(err)
 	s.Require().Equal(latestVersion+10, version)
 }
+
+func (s *MigrateStoreTestSuite) TestMigrateStateAtSwitchVersion() {
+	originalLatestVersion, err := s.rootStore.GetLatestVersion()
+	s.Require().NoError(err)
+	switchVersion := originalLatestVersion + 5
+	s.migrationManager.SetSwitchVersion(switchVersion)
+	s.Require().NoError(s.rootStore.LoadLatestVersion())
+
+	rs := s.rootStore.(*Store)
+	keyCount := 10
+	for version := originalLatestVersion + 1; version <= switchVersion+5; version++ {
+		// the migration is in progress until the switch version is committed
+		s.Require().Equal(version <= switchVersion, rs.isMigrating)
+
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			for i := 0; i < keyCount; i++ {
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte(fmt.Sprintf("value-%d-%d", version, i)), false)
+			}
+		}
+		_, err := s.rootStore.WorkingHash(cs)
+		s.Require().NoError(err)
+		_, err = s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+
+		if version == switchVersion {
+			s.Require().False(rs.isMigrating)
+			s.Require().Equal(s.sc, rs.stateCommitment)
+		}
+	}
+
+	// the state before and after the switch version is in the new backends
+	for _, version := range []uint64{1, switchVersion - 1, switchVersion, switchVersion + 5} {
+		res, err := s.rootStore.Query([]byte("store1"), switchVersion+5, []byte(fmt.Sprintf("key-%d-0", version)), true)
+		s.Require().NoError(err)
+		s.Require().Equal([]byte(fmt.Sprintf("value-%d-0", version)), res.Value)
+	}
+	ssVersion, err := s.ss.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(switchVersion+5, ssVersion)
+
+	// a restarted node with the same configuration loads the new backends
+	migrationManager := migration.NewManager(dbm.NewMemDB(), s.snapshotManager, s.ss, s.sc, log.NewNopLogger())
+	migrationManager.SetSwitchVersion(switchVersion)
+	rootStore, err := New(log.NewNopLogger(), s.ss, s.orgSC, migrationManager, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(rootStore.LoadLatestVersion())
+	s.Require().False(rootStore.(*Store).isMigrating)
+	latestVersion, err := rootStore.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(switchVersion+5, latestVersion)
+}
+
+func (s *MigrateStoreTestSuite) TestMigrateStatePastSwitchVersion() {
+	originalLatestVersion, err := s.rootStore.GetLatestVersion()
+	s.Require().NoError(err)
+	s.migrationManager.SetSwitchVersion(originalLatestVersion)
+	s.Require().ErrorContains(s.rootStore.LoadLatestVersion(), "past the migration switch version")
+}
@@ -208,12 +208,47 @@ func (s *Store) Query(storeKey []byte, version uint64, key []byte, prove bool) (
 	return result, nil
 }
 
+func (s *Store) QueryMultiProof(version uint64, items []proof.MultiProofItem) (store.MultiQueryResult, error) {
+	if s.telemetry != nil {
+		now := time.Now()
+		defer s.telemetry.MeasureSince(now, "root_store", "query_multi_proof")
+	}
+
+	result := store.MultiQueryResult{
+		Items:   make([]proof.MultiProofItem, len(items)),
+		Version: version,
+	}
+	for i, item := range items {
+		res, err := s.Query(item.StoreKey, version, item.Key, false)
+		if err != nil {
+			return store.MultiQueryResult{}, err
+		}
+		result.Items[i] = proof.MultiProofItem{
+			StoreKey: item.StoreKey,
+			Key:      item.Key,
+			Value:    res.Value,
+		}
+	}
+
+	var err error
+	result.Proof, result.Errors, err = s.stateCommitment.GetMultiProof(version, result.Items)
+	if err != nil {
+		return store.MultiQueryResult{}, fmt.Errorf("failed to get SC store multi-proof: %w", err)
+	}
+
+	return result, nil
+}
+
 func (s *Store) LoadLatestVersion() error {
 	if s.telemetry != nil {
 		now := time.Now()
 		defer s.telemetry.MeasureSince(now, "root_store", "load_latest_version")
 	}
 
+	if err := s.resumeMigration(); err != nil {
+		return err
+	}
+
 	lv, err := s.GetLatestVersion()
 	if err != nil {
 		return err
@@ -228,6 +263,10 @@ func (s *Store) LoadVersion(version uint64) error {
 		defer s.telemetry.MeasureSince(now, "root_store", "load_version")
 	}
 
+	if err := s.resumeMigration(); err != nil {
+		return err
+	}
+
 	return s.loadVersion(version)
 }
 
@@ -246,6 +285,9 @@ func (s *Store) loadVersion(v uint64) error {
 
 	// if we're migrating, we need to start the migration process
 	if s.isMigrating {
+		if switchVersion := s.migrationManager.GetSwitchVersion(); switchVersion != 0 && v >= switchVersion {
+			return fmt.Errorf("cannot migrate from version %d, past the migration switch version %d", v, switchVersion)
+		}
 		s.startMigration()
 	}
 
@@ -385,6 +427,51 @@ func (s *Store) startMigration() {
 	defer mtx.Unlock()
 }
 
+// completeMigration stops the migration process and replaces the current SC
+// backend with the migrated one, if any. The SS backend is the one the
+// migration manager restores into, so it is kept.
+func (s *Store) completeMigration() error {
+	if s.chDone != nil {
+		close(s.chDone)
+		close(s.chChangeset)
+	}
+	s.isMigrating = false
+
+	// close the old state commitment and replace it with the new one
+	if newStateCommitment := s.migrationManager.GetStateCommitment(); newStateCommitment != nil {
+		if err := s.stateCommitment.Close(); err != nil {
+			return fmt.Errorf("failed to close the old SC store: %w", err)
+		}
+		s.stateCommitment = newStateCommitment
+	}
+	if err := s.migrationManager.Close(); err != nil {
+		return fmt.Errorf("failed to close migration manager: %w", err)
+	}
+	s.logger.Info("migration completed", "version", s.lastCommitInfo.GetVersion())
+
+	return nil
+}
+
+// resumeMigration completes the migration if the store already switched over
+// to the migrated backends before a restart, so that the original SC backend,
+// which is behind, isn't loaded again.
+func (s *Store) resumeMigration() error {
+	if !s.isMigrating {
+		return nil
+	}
+
+	switched, err := s.migrationManager.IsSwitched()
+	if err != nil {
+		return fmt.Errorf("failed to check the migration status: %w", err)
+	}
+	if !switched {
+		return nil
+	}
+
+	s.logger.Info("migration already switched over", "switch_version", s.migrationManager.GetSwitchVersion())
+	return s.completeMigration()
+}
+
 // writeSC accepts a Changeset and writes that as a batch to the underlying SC
 // tree, which allows us to retrieve the working hash of the SC tree. Finally,
 // we construct a *CommitInfo and set that as lastCommitInfo. Note, this should
@@ -392,26 +479,29 @@ func (s *Store) startMigration() {
 // If migration is in progress, the changeset is sent to the migration manager.
 func (s *Store) writeSC(cs *corestore.Changeset) error {
 	if s.isMigrating {
-		// if the migration manager has already migrated to the version, close the
-		// channels and replace the state commitment
-		if s.migrationManager.GetMigratedVersion() == s.lastCommitInfo.Version {
-			close(s.chDone)
-			close(s.chChangeset)
-			s.isMigrating = false
-			// close the old state commitment and replace it with the new one
-			if err := s.stateCommitment.Close(); err != nil {
-				return fmt.Errorf("failed to close the old SC store: %w", err)
+		version := s.lastCommitInfo.Version
+		switchVersion := s.migrationManager.GetSwitchVersion()
+		switch {
+		case switchVersion == 0 && s.migrationManager.GetMigratedVersion() == version:
+			// the migration manager has already migrated to the version, switch
+			// over as soon as possible
+			if err := s.completeMigration(); err != nil {
+				return err
+			}
+		case switchVersion != 0 && version+1 == switchVersion:
+			// switch over at the configured version, waiting for the migration
+			// manager to catch up if needed
+			if s.migrationManager.GetMigratedVersion() < version {
+				s.logger.Info("waiting for migration to catch up", "version", version)
 			}
-			newStateCommitment := s.migrationManager.GetStateCommitment()
-			if newStateCommitment != nil {
-				s.stateCommitment = newStateCommitment
+			if err := s.migrationManager.WaitForVersion(version); err != nil {
+				return err
 			}
-			if err := s.migrationManager.Close(); err != nil {
-				return fmt.Errorf("failed to close migration manager: %w", err)
+			if err := s.completeMigration(); err != nil {
+				return err
 			}
-			s.logger.Info("migration completed", "version", s.lastCommitInfo.Version)
-		} else {
-			s.chChangeset <- &migration.VersionedChangeset{Version: s.lastCommitInfo.Version + 1, Changeset: cs}
+		default:
+			s.chChangeset <- &migration.VersionedChangeset{Version: version + 1, Changeset: cs}
 		}
 	}
 
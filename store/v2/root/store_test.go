@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	coreheader "cosmossdk.io/core/header"
@@ -13,6 +14,7 @@ import (
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/commitment/iavl"
 	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
 	"cosmossdk.io/store/v2/storage"
 	"cosmossdk.io/store/v2/storage/sqlite"
 )
@@ -166,6 +168,123 @@ func (s *RootStoreTestSuite) TestQueryProof() {
 	s.Require().Equal(expRoots[0], cInfo.Hash())
 }
 
+func (s *RootStoreTestSuite) TestQueryMultiProof() {
+	cs := corestore.NewChangeset()
+	for i := 0; i < 100; i++ {
+		key, val := []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%03d", i))
+		cs.Add(testStoreKeyBytes, key, val, false)
+		cs.Add(testStoreKey2Bytes, key, val, false)
+	}
+	cs.Add(testStoreKey3Bytes, []byte("key"), []byte("value"), false)
+	_, err := s.rootStore.WorkingHash(cs)
+	s.Require().NoError(err)
+	_, err = s.rootStore.Commit(cs)
+	s.Require().NoError(err)
+
+	items := []proof.MultiProofItem{
+		{StoreKey: testStoreKeyBytes, Key: []byte("key001")},
+		{StoreKey: testStoreKeyBytes, Key: []byte("key050")},
+		{StoreKey: testStoreKeyBytes, Key: []byte("key099")},
+		{StoreKey: testStoreKeyBytes, Key: []byte("key100")}, // missing
+		{StoreKey: testStoreKey2Bytes, Key: []byte("key010")},
+		{StoreKey: testStoreKey2Bytes, Key: []byte("key010")},  // duplicate
+		{StoreKey: testStoreKey2Bytes, Key: []byte("key0505")}, // missing
+	}
+	result, err := s.rootStore.QueryMultiProof(1, items)
+	s.Require().NoError(err)
+	s.Require().Len(result.Items, len(items))
+	s.Require().Equal([]byte("value001"), result.Items[0].Value)
+	s.Require().Nil(result.Items[3].Value)
+	s.Require().Nil(result.Items[6].Value)
+
+	commitID, err := s.rootStore.LastCommitID()
+	s.Require().NoError(err)
+	root := commitID.Hash
+	s.Require().NoError(result.Proof.Verify(root, result.Items))
+
+	// the encoded multi-proof round-trips and is smaller than the single proofs
+	bz, err := result.Proof.Marshal()
+	s.Require().NoError(err)
+	var mp proof.MultiProof
+	s.Require().NoError(mp.Unmarshal(bz))
+	s.Require().NoError(mp.Verify(root, result.Items))
+
+	size := 0
+	for _, item := range items {
+		res, err := s.rootStore.Query(item.StoreKey, 1, item.Key, true)
+		s.Require().NoError(err)
+		for _, op := range res.ProofOps {
+			opBz, err := op.Proof.Marshal()
+			s.Require().NoError(err)
+			size += len(opBz)
+		}
+	}
+	s.Require().Less(len(bz), size)
+
+	// the stores are encoded sorted, without reordering the multi-proof
+	reversed := &proof.MultiProof{CommitProof: result.Proof.CommitProof}
+	for i := len(result.Proof.Stores) - 1; i >= 0; i-- {
+		reversed.Stores = append(reversed.Stores, result.Proof.Stores[i])
+	}
+	reversedBz, err := reversed.Marshal()
+	s.Require().NoError(err)
+	s.Require().Equal(bz, reversedBz)
+	s.Require().Equal(testStoreKey2Bytes, reversed.Stores[0].StoreKey)
+
+	// the items of a result without errors are all proven
+	s.Require().Equal(result.Items, store.MultiQueryResult{Items: result.Items}.ProvenItems())
+
+	// a wrong value, a wrong absence or a wrong root fail the verification
+	wrong := append([]proof.MultiProofItem(nil), result.Items...)
+	wrong[0].Value = []byte("value002")
+	s.Require().Error(mp.Verify(root, wrong))
+	wrong = append([]proof.MultiProofItem(nil), result.Items...)
+	wrong[1].Value = nil
+	s.Require().Error(mp.Verify(root, wrong))
+	wrong = append([]proof.MultiProofItem(nil), result.Items...)
+	wrong[3].Value = []byte("value100")
+	s.Require().Error(mp.Verify(root, wrong))
+	s.Require().Error(mp.Verify([]byte("root"), result.Items))
+
+	// keys of stores which aren't in the proof fail the verification
+	s.Require().Error(mp.Verify(root, append(result.Items, proof.MultiProofItem{StoreKey: testStoreKey3Bytes, Key: []byte("key"), Value: []byte("value")})))
+
+	// unknown stores fail the query
+	_, err = s.rootStore.QueryMultiProof(1, []proof.MultiProofItem{{StoreKey: []byte("unknown"), Key: []byte("key")}})
+	s.Require().Error(err)
+}
+
+func TestQueryMultiProofStoreKeyPairs(t *testing.T) {
+	noopLog := log.NewNopLogger()
+	storeKeys := []string{"a", "a/b"}
+	sc, err := NewCommitStore(dbm.NewMemDB(), storeKeys, nil, nil, noopLog)
+	require.NoError(t, err)
+	sqliteDB, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+	rs, err := New(noopLog, storage.NewStorageStore(sqliteDB, nil, noopLog), sc, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	cs := corestore.NewChangeset()
+	cs.Add([]byte("a"), []byte("b/c"), []byte("value1"), false)
+	cs.Add([]byte("a/b"), []byte("c"), []byte("value2"), false)
+	_, err = rs.WorkingHash(cs)
+	require.NoError(t, err)
+	appHash, err := rs.Commit(cs)
+	require.NoError(t, err)
+
+	// the keys are only duplicates if both their store and key are equal
+	result, err := rs.QueryMultiProof(1, []proof.MultiProofItem{
+		{StoreKey: []byte("a"), Key: []byte("b/c")},
+		{StoreKey: []byte("a/b"), Key: []byte("c")},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Proof.Stores, 2)
+	require.Equal(t, []byte("value1"), result.Items[0].Value)
+	require.Equal(t, []byte("value2"), result.Items[1].Value)
+	require.NoError(t, result.Proof.Verify(appHash, result.ProvenItems()))
+}
+
 func (s *RootStoreTestSuite) TestLoadVersion() {
 	// write and commit a few changesets
 	for v := 1; v <= 5; v++ {
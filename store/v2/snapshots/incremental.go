@@ -0,0 +1,276 @@
+package snapshots
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+// An incremental snapshot is written from the full snapshot stream of the
+// commitment state, replacing the IAVL nodes which are not changed since the
+// base snapshot by SnapshotIAVLBaseItem references to them. IAVL nodes are
+// immutable and exported in post-order, so the unchanged nodes of a store are
+// found in the same order in the base snapshot.
+//
+// The references are relative to a cursor over the nodes of the same store in
+// the base snapshot, which is moved identically when writing the incremental
+// snapshot and when reading it back as a full snapshot stream.
+
+// baseReader reads the IAVL nodes of the stores of a base snapshot stream.
+type baseReader struct {
+	reader  protoio.Reader
+	next    *types.SnapshotItem
+	eof     bool
+	inStore bool
+}
+
+func newBaseReader(reader protoio.Reader) *baseReader {
+	return &baseReader{reader: reader}
+}
+
+// peek returns the next item of the stream without consuming it, or nil at the
+// end of the stream.
+func (r *baseReader) peek() (*types.SnapshotItem, error) {
+	if r.next == nil && !r.eof {
+		item := &types.SnapshotItem{}
+		err := r.reader.ReadMsg(item)
+		if errors.Is(err, io.EOF) {
+			r.eof = true
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		r.next = item
+	}
+
+	return r.next, nil
+}
+
+// seekStore moves the reader to the nodes of the given store, returning false
+// if there are none. The stores are exported in ascending order of their names,
+// so the stores before the given one are skipped.
+func (r *baseReader) seekStore(name string) (bool, error) {
+	r.inStore = false
+	for {
+		item, err := r.peek()
+		if err != nil || item == nil {
+			return false, err
+		}
+
+		switch item := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			if item.Store.Name > name {
+				return false, nil
+			}
+			r.next = nil
+			if item.Store.Name == name {
+				r.inStore = true
+				return true, nil
+			}
+
+		case *types.SnapshotItem_IAVL:
+			r.next = nil
+
+		default:
+			// the stores are followed by the extensions
+			return false, nil
+		}
+	}
+}
+
+// nextNode returns the next node of the current store, or nil once all of them
+// are read.
+func (r *baseReader) nextNode() (*types.SnapshotIAVLItem, error) {
+	if !r.inStore {
+		return nil, nil
+	}
+
+	item, err := r.peek()
+	if err != nil {
+		return nil, err
+	}
+	if node := item.GetIAVL(); node != nil {
+		r.next = nil
+		return node, nil
+	}
+
+	r.inStore = false
+	return nil, nil
+}
+
+// findNode moves the reader past the given node of the current store, returning
+// the number of nodes skipped before it, or false if it is not found.
+func (r *baseReader) findNode(node *types.SnapshotIAVLItem) (uint64, bool, error) {
+	skipped := uint64(0)
+	for {
+		next, err := r.nextNode()
+		if err != nil || next == nil {
+			return 0, false, err
+		}
+
+		if next.Version == node.Version && next.Height == node.Height && bytes.Equal(next.Key, node.Key) {
+			return skipped, true, nil
+		}
+		skipped++
+	}
+}
+
+// writeIncremental writes the items read from reader, a full snapshot stream of
+// the commitment state, to writer, replacing the IAVL nodes found in the base
+// snapshot at baseHeight with references to them. Every node of the stream is
+// compared against the base snapshot, the nodes are not filtered by version.
+func writeIncremental(reader protoio.Reader, base *baseReader, baseHeight uint64, writer protoio.Writer) error {
+	var (
+		ref    *types.SnapshotIAVLBaseItem
+		inBase bool
+	)
+
+	flush := func() error {
+		if ref == nil {
+			return nil
+		}
+
+		err := writer.WriteMsg(&types.SnapshotItem{Item: &types.SnapshotItem_IAVLBase{IAVLBase: ref}})
+		ref = nil
+		return err
+	}
+
+	for {
+		item := &types.SnapshotItem{}
+		err := reader.ReadMsg(item)
+		if errors.Is(err, io.EOF) {
+			return flush()
+		} else if err != nil {
+			return err
+		}
+
+		switch item := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			if err := flush(); err != nil {
+				return err
+			}
+
+			inBase, err = base.seekStore(item.Store.Name)
+			if err != nil {
+				return fmt.Errorf("failed to read base snapshot: %w", err)
+			}
+
+		case *types.SnapshotItem_IAVL:
+			if !inBase || item.IAVL.Version > int64(baseHeight) {
+				break
+			}
+
+			skipped, found, err := base.findNode(item.IAVL)
+			if err != nil {
+				return fmt.Errorf("failed to read base snapshot: %w", err)
+			}
+			if !found {
+				// the remaining nodes of the store are written as they are
+				inBase = false
+				break
+			}
+
+			if ref != nil && skipped == 0 {
+				ref.Count++
+				continue
+			}
+
+			if err := flush(); err != nil {
+				return err
+			}
+			ref = &types.SnapshotIAVLBaseItem{Skip: skipped, Count: 1}
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return err
+		}
+		if err := writer.WriteMsg(item); err != nil {
+			return err
+		}
+	}
+}
+
+var _ protoio.ReadCloser = (*incrementalReader)(nil)
+
+// incrementalReader reads an incremental snapshot stream as a full snapshot
+// stream, replacing the references to the nodes of the base snapshot by them.
+type incrementalReader struct {
+	reader    protoio.ReadCloser
+	base      protoio.ReadCloser
+	nodes     *baseReader
+	remaining uint64
+}
+
+// newIncrementalReader returns a reader of the full snapshot stream of the
+// incremental snapshot stream read from reader, given the full snapshot stream
+// of its base snapshot.
+func newIncrementalReader(reader, base protoio.ReadCloser) *incrementalReader {
+	return &incrementalReader{
+		reader: reader,
+		base:   base,
+		nodes:  newBaseReader(base),
+	}
+}
+
+// ReadMsg implements protoio.Reader interface
+func (r *incrementalReader) ReadMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return fmt.Errorf("unexpected snapshot message %T", msg)
+	}
+
+	for {
+		if r.remaining > 0 {
+			node, err := r.nodes.nextNode()
+			if err != nil {
+				return fmt.Errorf("failed to read base snapshot: %w", err)
+			}
+			if node == nil {
+				return errorsmod.Wrap(types.ErrInvalidIncrementalSnapshot, "referenced node not found in base snapshot")
+			}
+
+			r.remaining--
+			*item = types.SnapshotItem{Item: &types.SnapshotItem_IAVL{IAVL: node}}
+			return nil
+		}
+
+		if err := r.reader.ReadMsg(item); err != nil {
+			return err
+		}
+
+		switch it := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			if _, err := r.nodes.seekStore(it.Store.Name); err != nil {
+				return fmt.Errorf("failed to read base snapshot: %w", err)
+			}
+
+		case *types.SnapshotItem_IAVLBase:
+			for i := uint64(0); i < it.IAVLBase.Skip; i++ {
+				node, err := r.nodes.nextNode()
+				if err != nil {
+					return fmt.Errorf("failed to read base snapshot: %w", err)
+				}
+				if node == nil {
+					return errorsmod.Wrap(types.ErrInvalidIncrementalSnapshot, "skipped node not found in base snapshot")
+				}
+			}
+			r.remaining = it.IAVLBase.Count
+			continue
+		}
+
+		return nil
+	}
+}
+
+// Close implements io.Closer interface
+func (r *incrementalReader) Close() error {
+	return errors.Join(r.reader.Close(), r.base.Close())
+}
@@ -0,0 +1,250 @@
+package snapshots_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+var incrementalStoreKeys = []string{"store1", "store2", "store3"}
+
+func newCommitStore(t *testing.T) *commitment.CommitStore {
+	t.Helper()
+
+	db := dbm.NewMemDB()
+	multiTrees := make(map[string]commitment.Tree)
+	for _, storeKey := range incrementalStoreKeys {
+		prefixDB := dbm.NewPrefixDB(db, []byte(storeKey))
+		multiTrees[storeKey] = iavl.NewIavlTree(prefixDB, log.NewNopLogger(), iavl.DefaultConfig())
+	}
+	commitStore, err := commitment.NewCommitStore(multiTrees, db, nil, log.NewNopLogger())
+	require.NoError(t, err)
+	return commitStore
+}
+
+// commitVersion writes count keys to the stores, overwriting the keys written
+// at the previous versions, and commits them.
+func commitVersion(t *testing.T, commitStore *commitment.CommitStore, version uint64, stores []string, count int) {
+	t.Helper()
+
+	kvPairs := make(map[string]corestore.KVPairs)
+	for _, storeKey := range stores {
+		for i := 0; i < count; i++ {
+			kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{
+				Key:   []byte(fmt.Sprintf("key-%03d", i)),
+				Value: []byte(fmt.Sprintf("value-%d-%d", version, i)),
+			})
+		}
+	}
+	require.NoError(t, commitStore.WriteBatch(corestore.NewChangesetWithPairs(kvPairs)))
+	_, err := commitStore.Commit(version)
+	require.NoError(t, err)
+}
+
+type leavesStorageSnapshotter struct {
+	mtx    sync.Mutex
+	leaves map[string]string
+}
+
+func (s *leavesStorageSnapshotter) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.leaves == nil {
+		s.leaves = make(map[string]string)
+	}
+	for changes := range chStorage {
+		for _, kv := range changes.StateChanges {
+			s.leaves[fmt.Sprintf("%s/%s", changes.Actor, kv.Key)] = string(kv.Value)
+		}
+	}
+	return nil
+}
+
+func snapshotSize(t *testing.T, manager *snapshots.Manager, snapshot *types.Snapshot) int {
+	t.Helper()
+
+	size := 0
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		size += len(chunk)
+	}
+	return size
+}
+
+func TestManager_IncrementalSnapshots(t *testing.T) {
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	source := newCommitStore(t)
+	opts := snapshots.SnapshotOptions{Interval: 1, KeepRecent: 2, Incremental: 2}
+	manager := snapshots.NewManager(store, opts, source, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+
+	// version 1 is a full snapshot
+	commitVersion(t, source, 1, incrementalStoreKeys, 1000)
+	full, err := manager.Create(1)
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentFormat, full.Format)
+	require.Zero(t, full.Metadata.BaseHeight)
+
+	// the next snapshots only contain the changes since the previous one
+	commitVersion(t, source, 2, []string{"store2"}, 10)
+	incremental, err := manager.Create(2)
+	require.NoError(t, err)
+	require.Equal(t, types.IncrementalFormat, incremental.Format)
+	require.Equal(t, uint64(1), incremental.Metadata.BaseHeight)
+	require.Equal(t, types.CurrentFormat, incremental.Metadata.BaseFormat)
+	require.Less(t, snapshotSize(t, manager, incremental)*4, snapshotSize(t, manager, full))
+
+	commitVersion(t, source, 3, []string{"store1", "store3"}, 5)
+	incremental, err = manager.Create(3)
+	require.NoError(t, err)
+	require.Equal(t, types.IncrementalFormat, incremental.Format)
+	require.Equal(t, uint64(2), incremental.Metadata.BaseHeight)
+	require.Equal(t, types.IncrementalFormat, incremental.Metadata.BaseFormat)
+
+	// the base snapshots of the retained snapshots are not pruned
+	pruned, err := manager.Prune(1)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+
+	// restoring the incremental snapshot restores the whole state
+	target := newCommitStore(t)
+	storage := &leavesStorageSnapshotter{}
+	ext := newExtSnapshotter(0)
+	restorer := snapshots.NewManager(store, opts, target, storage, nil, log.NewNopLogger())
+	require.NoError(t, restorer.RegisterExtensions(ext))
+	require.NoError(t, restorer.RestoreLocalSnapshot(3, types.IncrementalFormat))
+
+	expected, err := source.GetCommitInfo(3)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), target.WorkingCommitInfo(3).Hash())
+	require.Len(t, storage.leaves, len(incrementalStoreKeys)*1000)
+	require.Equal(t, "value-3-0", storage.leaves["store1/key-000"])
+	require.Equal(t, "value-2-9", storage.leaves["store2/key-009"])
+	require.Equal(t, "value-1-999", storage.leaves["store3/key-999"])
+	require.Len(t, ext.state, 10)
+
+	// a full snapshot is taken after the configured number of incremental ones
+	commitVersion(t, source, 4, []string{"store1"}, 1)
+	full, err = manager.Create(4)
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentFormat, full.Format)
+
+	commitVersion(t, source, 5, []string{"store1"}, 1)
+	incremental, err = manager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, types.IncrementalFormat, incremental.Format)
+	require.Equal(t, uint64(4), incremental.Metadata.BaseHeight)
+
+	pruned, err = manager.Prune(2)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, pruned)
+}
+
+func TestManager_RestoreIncrementalWithoutBase(t *testing.T) {
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(store, opts, newCommitStore(t), &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+
+	err = manager.Restore(types.Snapshot{
+		Height: 2,
+		Format: types.IncrementalFormat,
+		Chunks: 1,
+		Metadata: types.Metadata{
+			ChunkHashes: [][]byte{{1, 2, 3}},
+			BaseHeight:  1,
+			BaseFormat:  types.CurrentFormat,
+		},
+	})
+	require.ErrorIs(t, err, types.ErrBaseSnapshotNotFound)
+}
+
+// failingStorageSnapshotter records the stores of the KV pairs it restores, and
+// fails the restore when given a KV pair of the failing store.
+type failingStorageSnapshotter struct {
+	leavesStorageSnapshotter
+	failStore string
+	stores    map[string]int
+}
+
+func (s *failingStorageSnapshotter) Restore(_ uint64, chStorage <-chan *corestore.StateChanges) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.leaves == nil {
+		s.leaves = make(map[string]string)
+	}
+	for changes := range chStorage {
+		if string(changes.Actor) == s.failStore {
+			return fmt.Errorf("failed to restore %s", s.failStore)
+		}
+		for _, kv := range changes.StateChanges {
+			s.stores[string(changes.Actor)]++
+			s.leaves[fmt.Sprintf("%s/%s", changes.Actor, kv.Key)] = string(kv.Value)
+		}
+	}
+	return nil
+}
+
+func TestManager_RestoreResumeProgress(t *testing.T) {
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	source := newCommitStore(t)
+	manager := snapshots.NewManager(store, opts, source, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+
+	commitVersion(t, source, 1, incrementalStoreKeys, 100)
+	snapshot, err := manager.Create(1)
+	require.NoError(t, err)
+	var chunks [][]byte
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		chunks = append(chunks, chunk)
+	}
+
+	restoreStore, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	target := newCommitStore(t)
+	storage := &failingStorageSnapshotter{failStore: "store3", stores: make(map[string]int)}
+
+	// the restore is interrupted while restoring the last store
+	restorer := snapshots.NewManager(restoreStore, opts, target, storage, nil, log.NewNopLogger())
+	require.NoError(t, restorer.Restore(*snapshot))
+	for _, chunk := range chunks {
+		_, err = restorer.RestoreChunk(chunk)
+		if err != nil {
+			break
+		}
+	}
+	require.ErrorContains(t, err, "failed to restore store3")
+	require.Equal(t, map[string]int{"store1": 100, "store2": 100}, storage.stores)
+
+	// the resumed restore only restores the last store
+	storage.failStore = ""
+	storage.stores = make(map[string]int)
+	restorer = snapshots.NewManager(restoreStore, opts, target, storage, nil, log.NewNopLogger())
+	require.NoError(t, restorer.Restore(*snapshot))
+	for i, chunk := range chunks {
+		done, err := restorer.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == len(chunks)-1, done)
+	}
+	require.Equal(t, map[string]int{"store3": 100}, storage.stores)
+
+	expected, err := source.GetCommitInfo(1)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), target.WorkingCommitInfo(1).Hash())
+	require.Len(t, storage.leaves, len(incrementalStoreKeys)*100)
+}
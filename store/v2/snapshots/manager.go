@@ -10,6 +10,10 @@ import (
 	"os"
 	"sort"
 	"sync"
+	"sync/atomic"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
 
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
@@ -49,6 +53,9 @@ type Manager struct {
 	chRestoreDone     <-chan restoreDone
 	restoreSnapshot   *types.Snapshot
 	restoreChunkIndex uint32
+	// restoreResumed is the number of chunks of the restoring snapshot saved by
+	// an interrupted restore.
+	restoreResumed uint32
 }
 
 // operation represents a Manager operation. Only one operation can be in progress at a time.
@@ -144,6 +151,7 @@ func (m *Manager) endLocked() {
 	m.chRestoreDone = nil
 	m.restoreSnapshot = nil
 	m.restoreChunkIndex = 0
+	m.restoreResumed = 0
 }
 
 // GetInterval returns snapshot interval represented in heights.
@@ -185,11 +193,53 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	base, err := m.incrementalBase(latest)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to examine base snapshot")
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	if base == nil {
+		go m.createSnapshot(height, ch)
+		return m.store.Save(height, types.CurrentFormat, ch)
+	}
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	go m.createIncrementalSnapshot(height, base, ch)
+	return m.store.SaveIncremental(height, base, ch)
+}
+
+// incrementalBase returns the snapshot the next snapshot is based on, or nil if
+// a full snapshot should be taken.
+func (m *Manager) incrementalBase(latest *types.Snapshot) (*types.Snapshot, error) {
+	if m.opts.Incremental == 0 || latest == nil {
+		return nil, nil
+	}
+
+	// walk the chain of the incremental snapshots down to the full one
+	incrementals := uint32(0)
+	snapshot := latest
+	for snapshot.Format == types.IncrementalFormat {
+		incrementals++
+		if incrementals >= m.opts.Incremental {
+			return nil, nil
+		}
+
+		base, err := m.store.Get(snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil {
+			return nil, nil
+		}
+		snapshot = base
+	}
+
+	if snapshot.Format != types.CurrentFormat {
+		return nil, nil
+	}
+
+	return latest, nil
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
@@ -209,6 +259,60 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.snapshotExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// createIncrementalSnapshot is the counterpart of createSnapshot for the
+// snapshots based on another one, where the IAVL nodes of the commitment state
+// found in the base snapshot are replaced by references to them. The whole
+// commitment state is still exported and compared against the base snapshot,
+// only the snapshot is smaller than a full one.
+func (m *Manager) createIncrementalSnapshot(height uint64, base *types.Snapshot, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	defer func() {
+		if err := streamWriter.Close(); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	baseReader, err := m.openSnapshotStream(base)
+	if err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	defer baseReader.Close()
+
+	// the commitment state is exported through a pipe to be compared with the base snapshot
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		protoWriter := protoio.NewDelimitedWriter(pw)
+		if err := m.commitSnapshotter.Snapshot(height, protoWriter); err != nil {
+			_ = pw.CloseWithError(err)
+			return
+		}
+		_ = pw.Close()
+	}()
+
+	protoReader := protoio.NewDelimitedReader(pr, snapshotMaxItemSize)
+	if err := writeIncremental(protoReader, newBaseReader(baseReader), base.Height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := m.snapshotExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// snapshotExtensions writes the snapshots of the extensions to the stream.
+func (m *Manager) snapshotExtensions(height uint64, streamWriter protoio.Writer) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
@@ -221,17 +325,61 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
 			return types.WriteExtensionPayload(streamWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 	}
+	return nil
+}
+
+// openSnapshotStream opens the full snapshot stream of a local snapshot,
+// expanding it with its base snapshots if it is incremental. The stream must be
+// closed.
+func (m *Manager) openSnapshotStream(snapshot *types.Snapshot) (protoio.ReadCloser, error) {
+	_, chunks, err := m.store.Load(snapshot.Height, snapshot.Format)
+	if err != nil {
+		return nil, err
+	}
+	if chunks == nil {
+		return nil, errorsmod.Wrapf(types.ErrBaseSnapshotNotFound, "height %v format %v", snapshot.Height, snapshot.Format)
+	}
+
+	streamReader, err := NewStreamReader(chunks)
+	if err != nil {
+		DrainChunks(chunks)
+		return nil, err
+	}
+	if snapshot.Format != types.IncrementalFormat {
+		return streamReader, nil
+	}
+
+	baseReader, err := m.openBaseStream(snapshot)
+	if err != nil {
+		streamReader.Close()
+		return nil, err
+	}
+
+	return newIncrementalReader(streamReader, baseReader), nil
+}
+
+// openBaseStream opens the full snapshot stream of the base of an incremental
+// snapshot.
+func (m *Manager) openBaseStream(snapshot *types.Snapshot) (protoio.ReadCloser, error) {
+	base, err := m.store.Get(snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		return nil, errorsmod.Wrapf(types.ErrBaseSnapshotNotFound, "height %v format %v",
+			snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+	}
+
+	return m.openSnapshotStream(base)
 }
 
 // CreateMigration creates a migration snapshot and writes it to the given writer.
@@ -310,7 +458,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if snapshot.Format != types.CurrentFormat && snapshot.Format != types.IncrementalFormat {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -321,24 +469,57 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 			"snapshot height %v cannot exceed %v", snapshot.Height, int64(math.MaxInt64))
 	}
 
+	// an incremental snapshot can only be restored on top of its base snapshots
+	if snapshot.Format == types.IncrementalFormat {
+		if err := m.checkBaseSnapshots(&snapshot); err != nil {
+			return err
+		}
+	}
+
 	err := m.beginLocked(opRestore)
 	if err != nil {
 		return err
 	}
 
-	// Start an asynchronous snapshot restoration, passing chunks and completion status via channels.
-	chChunkIDs := make(chan uint32, chunkIDBufferSize)
-	chDone := make(chan restoreDone, 1)
-
 	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
 	if err := os.MkdirAll(dir, 0o750); err != nil {
+		m.endLocked()
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
+	// The chunks saved by a previous restore of the snapshot which was interrupted
+	// are restored right away, they are only verified when given again.
+	resumed, err := m.store.savedChunks(&snapshot)
+	if err != nil {
+		m.endLocked()
+		return err
+	}
+	// The restore resumes from the progress saved by the interrupted restore, the
+	// stores it restored are skipped.
+	progress, err := m.store.loadRestoreProgress(snapshot.Height, snapshot.Format)
+	if err != nil {
+		m.endLocked()
+		return err
+	}
+	if progress.chunks > resumed {
+		progress = restoreProgress{}
+	}
+	if resumed > 0 {
+		m.logger.Info("resuming snapshot restore", "height", snapshot.Height, "format", snapshot.Format,
+			"chunks", resumed, "items", progress.items)
+	}
+
+	// Start an asynchronous snapshot restoration, passing chunks and completion status via channels.
+	chChunkIDs := make(chan uint32, chunkIDBufferSize+int(resumed))
+	for i := uint32(0); i < resumed; i++ {
+		chChunkIDs <- i
+	}
+	chDone := make(chan restoreDone, 1)
+
 	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs)
 
 	go func() {
-		err := m.doRestoreSnapshot(snapshot, chChunks)
+		err := m.doRestoreSnapshot(snapshot, chChunks, progress)
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -350,6 +531,24 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	m.chRestoreDone = chDone
 	m.restoreSnapshot = &snapshot
 	m.restoreChunkIndex = 0
+	m.restoreResumed = resumed
+	return nil
+}
+
+// checkBaseSnapshots checks that the base snapshots of an incremental snapshot
+// are available locally.
+func (m *Manager) checkBaseSnapshots(snapshot *types.Snapshot) error {
+	for snapshot.Format == types.IncrementalFormat {
+		base, err := m.store.Get(snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		if err != nil {
+			return err
+		}
+		if base == nil {
+			return errorsmod.Wrapf(types.ErrBaseSnapshotNotFound, "height %v format %v",
+				snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		}
+		snapshot = base
+	}
 	return nil
 }
 
@@ -372,19 +571,69 @@ func (m *Manager) loadChunkStream(height uint64, format uint32, chunkIDs <-chan
 }
 
 // doRestoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
-func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+// The restore resumes from the given progress of an interrupted restore of the snapshot.
+func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser, progress restoreProgress) error {
 	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
 	if err := os.MkdirAll(dir, 0o750); err != nil {
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
+	// the chunks read by the stream are counted to save the progress of the restore
+	var chunksRead atomic.Uint32
+	chCountedChunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chCountedChunks)
+		for chunk := range chChunks {
+			chCountedChunks <- chunk
+			chunksRead.Add(1)
+		}
+	}()
+
 	var nextItem types.SnapshotItem
-	streamReader, err := NewStreamReader(chChunks)
+	var streamReader protoio.ReadCloser
+	streamReader, err := NewStreamReader(chCountedChunks)
 	if err != nil {
+		DrainChunks(chCountedChunks)
 		return err
 	}
 	defer streamReader.Close()
 
+	// the commitment state of an incremental snapshot is restored from the full
+	// snapshot stream it expands to with its base snapshots
+	format := snapshot.Format
+	if format == types.IncrementalFormat {
+		baseReader, err := m.openBaseStream(&snapshot)
+		if err != nil {
+			return err
+		}
+		defer baseReader.Close()
+
+		streamReader = newIncrementalReader(streamReader, baseReader)
+		format = types.CurrentFormat
+	}
+
+	// chStorage is the channel to pass the KV pairs to the storage snapshotter.
+	chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
+	chFlushed := make(chan error)
+
+	storageErrs := make(chan error, 1)
+	go func() {
+		storageErrs <- m.restoreStorage(snapshot.Height, chStorage, chFlushed)
+	}()
+
+	streamReader = &progressReader{
+		reader: streamReader,
+		skip:   progress.items,
+		chunks: &chunksRead,
+		flush: func() error {
+			chStorage <- storageFlush
+			return <-chFlushed
+		},
+		save: func(progress restoreProgress) error {
+			return m.store.saveRestoreProgress(snapshot.Height, snapshot.Format, progress)
+		},
+	}
+
 	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
 	payloadReader := func() ([]byte, error) {
 		nextItem.Reset()
@@ -398,20 +647,10 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	// chStorage is the channel to pass the KV pairs to the storage snapshotter.
-	chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
-	defer close(chStorage)
-
-	storageErrs := make(chan error, 1)
-	go func() {
-		defer close(storageErrs)
-		err := m.storageSnapshotter.Restore(snapshot.Height, chStorage)
-		if err != nil {
-			storageErrs <- err
-		}
-	}()
-
-	nextItem, err = m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+	nextItem, err = m.commitSnapshotter.Restore(snapshot.Height, format, streamReader, chStorage)
+	// the commitment snapshotter is done with passing the KV pairs, so that the
+	// storage snapshotter can complete
+	close(chStorage)
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -447,9 +686,135 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return errorsmod.Wrap(err, "storage snapshotter")
 	}
 
+	return m.store.deleteRestoreProgress(snapshot.Height, snapshot.Format)
+}
+
+// storageFlush is passed among the KV pairs of a restore to write the KV pairs
+// passed before it to the storage, see restoreStorage.
+var storageFlush = &corestore.StateChanges{}
+
+// restoreStorage passes the KV pairs of chStorage to the storage snapshotter until
+// the channel is closed. When storageFlush is received, the storage snapshotter is
+// given the end of the KV pairs passed so far, so that it writes them, and the
+// result is sent to chFlushed. The restore of the storage then goes on with the
+// next KV pairs.
+func (m *Manager) restoreStorage(version uint64, chStorage <-chan *corestore.StateChanges, chFlushed chan<- error) error {
+	var (
+		segment  chan *corestore.StateChanges
+		chResult chan error
+		err      error
+	)
+	begin := func() {
+		segment = make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
+		chResult = make(chan error, 1)
+		go func(segment <-chan *corestore.StateChanges, chResult chan<- error) {
+			chResult <- m.storageSnapshotter.Restore(version, segment)
+		}(segment, chResult)
+	}
+	end := func() error {
+		close(segment)
+		return <-chResult
+	}
+
+	begin()
+	for changes := range chStorage {
+		// once failed, the KV pairs are drained so that the restore of the
+		// commitment state doesn't block
+		if err != nil {
+			if changes == storageFlush {
+				chFlushed <- err
+			}
+			continue
+		}
+
+		if changes == storageFlush {
+			err = end()
+			chFlushed <- err
+			if err == nil {
+				begin()
+			}
+			continue
+		}
+
+		select {
+		case segment <- changes:
+			continue
+		default:
+		}
+		select {
+		case segment <- changes:
+		case err = <-chResult:
+			if err == nil {
+				err = errors.New("storage snapshotter returned before the end of the restore")
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return end()
+}
+
+var _ protoio.ReadCloser = (*progressReader)(nil)
+
+// progressReader reads the snapshot stream of a restore, skipping the items
+// restored by an interrupted restore of the snapshot. The progress of the restore
+// is saved at the start of each store, once the previous stores are written to
+// both the commitment and the storage.
+type progressReader struct {
+	reader protoio.ReadCloser
+	// skip is the number of leading items to skip.
+	skip uint64
+	// items is the number of items read.
+	items uint64
+	// chunks is the number of chunks of the snapshot read.
+	chunks *atomic.Uint32
+	// flush writes the KV pairs passed so far to the storage.
+	flush func() error
+	// save saves the progress of the restore.
+	save func(progress restoreProgress) error
+	// pending is the progress to save once the commitment snapshotter has
+	// committed the previous stores.
+	pending *restoreProgress
+}
+
+// ReadMsg implements protoio.Reader interface
+func (r *progressReader) ReadMsg(msg proto.Message) error {
+	// the commitment snapshotter commits a store when reading the store item
+	// following it, which is done once it reads the next item.
+	if r.pending != nil {
+		if err := r.save(*r.pending); err != nil {
+			return err
+		}
+		r.pending = nil
+	}
+
+	for {
+		if err := r.reader.ReadMsg(msg); err != nil {
+			return err
+		}
+		r.items++
+		if r.items > r.skip {
+			break
+		}
+	}
+
+	if item, ok := msg.(*types.SnapshotItem); ok && item.GetStore() != nil && r.items > 1 {
+		if err := r.flush(); err != nil {
+			return err
+		}
+		r.pending = &restoreProgress{items: r.items - 1, chunks: r.chunks.Load()}
+	}
+
 	return nil
 }
 
+// Close implements io.Closer interface
+func (r *progressReader) Close() error {
+	return r.reader.Close()
+}
+
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
 // Chunks must be given until the restore is complete, returning true, or a chunk errors.
 func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
@@ -482,12 +847,16 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 			"expected %x, got %x", hash, expected)
 	}
 
-	if err := m.store.saveChunkContent(chunk, m.restoreChunkIndex, m.restoreSnapshot); err != nil {
-		return false, errorsmod.Wrapf(err, "save chunk content %d", m.restoreChunkIndex)
-	}
+	// The chunks already saved by an interrupted restore were passed to the restore
+	// when it began.
+	if m.restoreChunkIndex >= m.restoreResumed {
+		if err := m.store.saveChunkContent(chunk, m.restoreChunkIndex, m.restoreSnapshot); err != nil {
+			return false, errorsmod.Wrapf(err, "save chunk content %d", m.restoreChunkIndex)
+		}
 
-	// Pass the chunk to the restore, and wait for completion if it was the final one.
-	m.chRestore <- m.restoreChunkIndex
+		// Pass the chunk to the restore, and wait for completion if it was the final one.
+		m.chRestore <- m.restoreChunkIndex
+	}
 	m.restoreChunkIndex++
 
 	if int(m.restoreChunkIndex) >= len(m.restoreSnapshot.Metadata.ChunkHashes) {
@@ -534,7 +903,7 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	return m.doRestoreSnapshot(*snapshot, ch, restoreProgress{})
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -2,6 +2,8 @@ package snapshots_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -254,3 +256,54 @@ func TestManager_TakeError(t *testing.T) {
 	_, err = manager.Create(1)
 	require.Error(t, err)
 }
+
+func TestManager_RestoreResume(t *testing.T) {
+	store := setupStore(t)
+	target := &mockCommitSnapshotter{}
+	manager := snapshots.NewManager(store, opts, target, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+
+	expectItems := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	chunks := snapshotItems(expectItems, newExtSnapshotter(10))
+	snapshot := types.Snapshot{
+		Height:   4,
+		Format:   types.CurrentFormat,
+		Hash:     []byte{1, 2, 3},
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	}
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(0)))
+
+	// the chunks saved by an interrupted restore are restored right away
+	require.NoError(t, os.MkdirAll(filepath.Dir(store.PathChunk(4, types.CurrentFormat, 0)), 0o750))
+	require.NoError(t, os.WriteFile(store.PathChunk(4, types.CurrentFormat, 0), chunks[0], 0o600))
+	require.NoError(t, manager.Restore(snapshot))
+
+	// a chunk given again is still verified
+	_, err := manager.RestoreChunk([]byte{9, 9, 9})
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+
+	for i, chunk := range chunks {
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == len(chunks)-1, done)
+	}
+	assert.Equal(t, expectItems, target.items)
+
+	// a corrupted chunk is saved again
+	target.items = nil
+	require.NoError(t, os.WriteFile(store.PathChunk(4, types.CurrentFormat, 0), []byte{9, 9, 9}, 0o600))
+	require.NoError(t, manager.Restore(snapshot))
+	for _, chunk := range chunks {
+		_, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, expectItems, target.items)
+
+	saved, err := os.ReadFile(store.PathChunk(4, types.CurrentFormat, 0))
+	require.NoError(t, err)
+	assert.Equal(t, chunks[0], saved)
+}
@@ -8,6 +8,11 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// Incremental defines how many incremental snapshots are taken after each
+	// full snapshot, each one based on the previous snapshot. 0 disables the
+	// incremental snapshots.
+	Incremental uint32
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
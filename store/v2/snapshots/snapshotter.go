@@ -19,7 +19,9 @@ type CommitSnapshotter interface {
 
 // StorageSnapshotter defines an API for restoring snapshots of the storage state.
 type StorageSnapshotter interface {
-	// Restore restores the storage state from the given channel.
+	// Restore restores the storage state from the given channel. The KV pairs of
+	// a snapshot may be restored in several calls at the same version, each one
+	// writing the KV pairs it is given once the channel is closed.
 	Restore(version uint64, chStorage <-chan *corestore.StateChanges) error
 }
 
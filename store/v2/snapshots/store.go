@@ -1,6 +1,7 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
@@ -203,37 +204,72 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained,
+// along with the snapshots the retained incremental snapshots are based on.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	metadata, err := os.ReadDir(s.pathMetadataDir())
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to list snapshot metadata")
 	}
 
-	pruned := uint64(0)
-	prunedHeights := make(map[uint64]bool)
-	skip := make(map[uint64]bool)
-	for i := len(metadata) - 1; i >= 0; i-- {
-		height, format, err := s.parseMetadataFilename(metadata[i].Name())
+	type snapshotKey struct {
+		height uint64
+		format uint32
+	}
+	keys := make([]snapshotKey, len(metadata))
+	for i, entry := range metadata {
+		keys[i].height, keys[i].format, err = s.parseMetadataFilename(entry.Name())
 		if err != nil {
 			return 0, err
 		}
+	}
 
-		if skip[height] || uint32(len(skip)) < retain {
-			skip[height] = true
+	keep := make(map[snapshotKey]bool)
+	keepHeights := make(map[uint64]bool)
+	retained := make(map[uint64]bool)
+	for i := len(keys) - 1; i >= 0; i-- {
+		key := keys[i]
+		if !retained[key.height] && uint32(len(retained)) >= retain {
 			continue
 		}
-		err = s.Delete(height, format)
+		retained[key.height] = true
+
+		// keep the snapshot and its base snapshots
+		for !keep[key] {
+			keep[key] = true
+			keepHeights[key.height] = true
+			if key.format != types.IncrementalFormat {
+				break
+			}
+			snapshot, err := s.Get(key.height, key.format)
+			if err != nil {
+				return 0, err
+			}
+			if snapshot == nil {
+				break
+			}
+			key = snapshotKey{height: snapshot.Metadata.BaseHeight, format: snapshot.Metadata.BaseFormat}
+		}
+	}
+
+	pruned := uint64(0)
+	prunedHeights := make(map[uint64]bool)
+	for i := len(keys) - 1; i >= 0; i-- {
+		key := keys[i]
+		if keep[key] {
+			continue
+		}
+		err = s.Delete(key.height, key.format)
 		if err != nil {
 			return 0, errors.Wrap(err, "failed to prune snapshots")
 		}
 		pruned++
-		prunedHeights[height] = true
+		prunedHeights[key.height] = true
 	}
 	// Since Delete() deletes a specific format, while we want to prune a height, we clean up
-	// the height directory as well
+	// the height directory as well, unless a snapshot is kept at this height
 	for height, ok := range prunedHeights {
-		if ok {
+		if ok && !keepHeights[height] {
 			err = os.Remove(s.pathHeight(height))
 			if err != nil {
 				return 0, errors.Wrapf(err, "failed to remove snapshot directory for height %v", height)
@@ -247,7 +283,27 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{Height: height, Format: format}, chunks)
+}
+
+// SaveIncremental saves an incremental snapshot based on the given snapshot to disk, returning it.
+func (s *Store) SaveIncremental(
+	height uint64, base *types.Snapshot, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{
+		Height: height,
+		Format: types.IncrementalFormat,
+		Metadata: types.Metadata{
+			BaseHeight: base.Height,
+			BaseFormat: base.Format,
+		},
+	}, chunks)
+}
+
+// save saves the chunks of the given snapshot to disk, filling in its chunk hashes.
+func (s *Store) save(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
+	height, format := snapshot.Height, snapshot.Format
 	if height == 0 {
 		return nil, errors.Wrap(storeerrors.ErrLogic, "snapshot height cannot be 0")
 	}
@@ -266,11 +322,6 @@ func (s *Store) Save(
 		s.mtx.Unlock()
 	}()
 
-	snapshot := &types.Snapshot{
-		Height: height,
-		Format: format,
-	}
-
 	// create height directory or do nothing
 	if err := os.MkdirAll(s.pathHeight(height), 0o750); err != nil {
 		return nil, errors.Wrapf(err, "failed to create snapshot directory for height %v", height)
@@ -330,6 +381,76 @@ func (s *Store) saveChunkContent(chunk []byte, index uint32, snapshot *types.Sna
 	return os.WriteFile(path, chunk, 0o600)
 }
 
+// savedChunks returns the number of leading chunks of the snapshot which are
+// already saved to disk, e.g. by a restore which was interrupted.
+func (s *Store) savedChunks(snapshot *types.Snapshot) (uint32, error) {
+	for i, expected := range snapshot.Metadata.ChunkHashes {
+		chunk, err := os.ReadFile(s.PathChunk(snapshot.Height, snapshot.Format, uint32(i)))
+		if os.IsNotExist(err) {
+			return uint32(i), nil
+		} else if err != nil {
+			return 0, errors.Wrapf(err, "failed to read snapshot chunk %d", i)
+		}
+		if hash := sha256.Sum256(chunk); !bytes.Equal(hash[:], expected) {
+			return uint32(i), nil
+		}
+	}
+	return uint32(len(snapshot.Metadata.ChunkHashes)), nil
+}
+
+// restoreProgress is the progress of the restore of a snapshot, saved so that a
+// restore which is interrupted resumes from it.
+type restoreProgress struct {
+	// items is the number of leading items of the snapshot stream which are
+	// restored, up to the first store which isn't completely restored.
+	items uint64
+	// chunks is the number of chunks read to restore them.
+	chunks uint32
+}
+
+// loadRestoreProgress loads the progress of the restore of the snapshot, which is
+// empty if none was saved.
+func (s *Store) loadRestoreProgress(height uint64, format uint32) (restoreProgress, error) {
+	bz, err := os.ReadFile(s.pathRestoreProgress(height, format))
+	if os.IsNotExist(err) {
+		return restoreProgress{}, nil
+	} else if err != nil {
+		return restoreProgress{}, errors.Wrap(err, "failed to read restore progress")
+	}
+	if len(bz) != 12 {
+		return restoreProgress{}, nil
+	}
+	return restoreProgress{
+		items:  binary.BigEndian.Uint64(bz[:8]),
+		chunks: binary.BigEndian.Uint32(bz[8:]),
+	}, nil
+}
+
+// saveRestoreProgress saves the progress of the restore of the snapshot.
+func (s *Store) saveRestoreProgress(height uint64, format uint32, progress restoreProgress) error {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz[:8], progress.items)
+	binary.BigEndian.PutUint32(bz[8:], progress.chunks)
+
+	// the progress is replaced atomically, so that an interruption doesn't corrupt it
+	path := s.pathRestoreProgress(height, format)
+	if err := os.WriteFile(path+".tmp", bz, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write restore progress")
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return errors.Wrap(err, "failed to write restore progress")
+	}
+	return nil
+}
+
+// deleteRestoreProgress deletes the progress of the restore of the snapshot.
+func (s *Store) deleteRestoreProgress(height uint64, format uint32) error {
+	if err := os.Remove(s.pathRestoreProgress(height, format)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to delete restore progress")
+	}
+	return nil
+}
+
 // saveSnapshot saves snapshot metadata to the database.
 func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
 	value, err := proto.Marshal(snapshot)
@@ -362,6 +483,11 @@ func (s *Store) pathMetadata(height uint64, format uint32) string {
 	return filepath.Join(s.pathMetadataDir(), fmt.Sprintf("%020d-%08d", height, format))
 }
 
+// pathRestoreProgress generates the path of the progress of the restore of a snapshot.
+func (s *Store) pathRestoreProgress(height uint64, format uint32) string {
+	return filepath.Join(s.pathSnapshot(height, format), "restore")
+}
+
 // PathChunk generates a snapshot chunk path.
 func (s *Store) PathChunk(height uint64, format, chunk uint32) string {
 	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
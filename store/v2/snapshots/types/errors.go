@@ -16,4 +16,12 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrBaseSnapshotNotFound is returned when the base snapshot of an incremental
+	// snapshot is not found.
+	ErrBaseSnapshotNotFound = errors.New("base snapshot not found")
+
+	// ErrInvalidIncrementalSnapshot is returned when an incremental snapshot does
+	// not match its base snapshot.
+	ErrInvalidIncrementalSnapshot = errors.New("invalid incremental snapshot")
 )
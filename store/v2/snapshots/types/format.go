@@ -4,3 +4,9 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// IncrementalFormat is the format of incremental snapshots. An incremental snapshot
+// only contains the IAVL nodes changed since the snapshot it is based on, given by
+// its metadata, and references the other nodes in the base snapshot. It can only
+// be restored along with its base snapshot.
+const IncrementalFormat uint32 = 4
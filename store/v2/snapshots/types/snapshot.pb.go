@@ -103,6 +103,15 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// base_height is the height of the snapshot an incremental snapshot is based
+	// on, zero for a full snapshot.
+	//
+	// Since: cosmos-sdk 0.52
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
+	// base_format is the format of the snapshot an incremental snapshot is based on.
+	//
+	// Since: cosmos-sdk 0.52
+	BaseFormat uint32 `protobuf:"varint,3,opt,name=base_format,json=baseFormat,proto3" json:"base_format,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +154,20 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
+func (m *Metadata) GetBaseFormat() uint32 {
+	if m != nil {
+		return m.BaseFormat
+	}
+	return 0
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -152,11 +175,11 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_IAVLBase
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -211,11 +234,15 @@ type SnapshotItem_Extension struct {
 type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof" json:"extension_payload,omitempty"`
 }
+type SnapshotItem_IAVLBase struct {
+	IAVLBase *SnapshotIAVLBaseItem `protobuf:"bytes,5,opt,name=iavl_base,json=iavlBase,proto3,oneof" json:"iavl_base,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
 func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
+func (*SnapshotItem_IAVLBase) isSnapshotItem_Item()         {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -252,6 +279,13 @@ func (m *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (m *SnapshotItem) GetIAVLBase() *SnapshotIAVLBaseItem {
+	if x, ok := m.GetItem().(*SnapshotItem_IAVLBase); ok {
+		return x.IAVLBase
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -259,6 +293,7 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_IAVL)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_IAVLBase)(nil),
 	}
 }
 
@@ -382,6 +417,63 @@ func (m *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLBaseItem references exported IAVL nodes of the base snapshot of an
+// incremental snapshot. The nodes of the current store in the base snapshot are
+// read in order, skipping the first skip nodes and copying the next count nodes.
+//
+// Since: cosmos-sdk 0.52
+type SnapshotIAVLBaseItem struct {
+	Skip  uint64 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *SnapshotIAVLBaseItem) Reset()         { *m = SnapshotIAVLBaseItem{} }
+func (m *SnapshotIAVLBaseItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotIAVLBaseItem) ProtoMessage()    {}
+func (*SnapshotIAVLBaseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+}
+func (m *SnapshotIAVLBaseItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotIAVLBaseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotIAVLBaseItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotIAVLBaseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotIAVLBaseItem.Merge(m, src)
+}
+func (m *SnapshotIAVLBaseItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotIAVLBaseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotIAVLBaseItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotIAVLBaseItem proto.InternalMessageInfo
+
+func (m *SnapshotIAVLBaseItem) GetSkip() uint64 {
+	if m != nil {
+		return m.Skip
+	}
+	return 0
+}
+
+func (m *SnapshotIAVLBaseItem) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 //
 // Since: cosmos-sdk 0.46
@@ -394,7 +486,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+	return fileDescriptor_3d5cca1aa5b69183, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +540,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{6}
+	return fileDescriptor_3d5cca1aa5b69183, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,6 +582,7 @@ func init() {
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.store.snapshots.v1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLItem")
+	proto.RegisterType((*SnapshotIAVLBaseItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLBaseItem")
 	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionMeta")
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionPayload")
 }
@@ -499,38 +592,44 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xb4, 0x74, 0x2f, 0x41, 0xea, 0xac, 0x81, 0x02, 0x87, 0x2c, 0x84, 0x03, 0x91,
-	0x80, 0x94, 0x65, 0x1c, 0xb9, 0x50, 0x98, 0x94, 0x09, 0x90, 0x26, 0x4f, 0xe2, 0xc0, 0x65, 0xf2,
-	0x56, 0xd3, 0x44, 0x6d, 0xe2, 0xaa, 0xf6, 0x22, 0xfa, 0x2f, 0xf8, 0x23, 0xfc, 0x8f, 0x1d, 0x77,
-	0xe4, 0x34, 0x50, 0xfb, 0x47, 0x90, 0xed, 0x26, 0xa0, 0x6d, 0x45, 0xdb, 0xed, 0x7d, 0x2f, 0xdf,
-	0xf7, 0xf9, 0xf9, 0xcb, 0x33, 0x44, 0xa7, 0x5c, 0x14, 0x5c, 0xf4, 0x85, 0xe4, 0x33, 0xd6, 0x17,
-	0x25, 0x9d, 0x8a, 0x8c, 0x4b, 0xd1, 0xaf, 0x76, 0x1b, 0x10, 0x4f, 0x67, 0x5c, 0x72, 0xfc, 0xc8,
-	0x30, 0x63, 0xcd, 0x8c, 0x1b, 0x66, 0x5c, 0xed, 0x3e, 0xde, 0x1e, 0xf1, 0x11, 0xd7, 0xac, 0xbe,
-	0xaa, 0x8c, 0x20, 0xfc, 0x81, 0xa0, 0x7b, 0xb4, 0xa2, 0xe1, 0x87, 0xd0, 0xc9, 0x58, 0x3e, 0xca,
-	0xa4, 0x87, 0x02, 0x14, 0xd9, 0x64, 0x85, 0x54, 0xff, 0x2b, 0x9f, 0x15, 0x54, 0x7a, 0x1b, 0x01,
-	0x8a, 0xee, 0x93, 0x15, 0x52, 0xfd, 0xd3, 0xec, 0xac, 0x1c, 0x0b, 0xaf, 0x65, 0xfa, 0x06, 0x61,
-	0x0c, 0x76, 0x46, 0x45, 0xe6, 0xd9, 0x01, 0x8a, 0x5c, 0xa2, 0x6b, 0xbc, 0x0f, 0xdd, 0x82, 0x49,
-	0x3a, 0xa4, 0x92, 0x7a, 0xed, 0x00, 0x45, 0x4e, 0xf2, 0x34, 0x5e, 0x3b, 0x6c, 0xfc, 0x69, 0x45,
-	0x1d, 0xd8, 0xe7, 0x97, 0x3b, 0x16, 0x69, 0xa4, 0xe1, 0x4b, 0xe8, 0xd6, 0xdf, 0xf0, 0x13, 0x70,
-	0xf5, 0x81, 0xc7, 0xea, 0x00, 0x26, 0x3c, 0x14, 0xb4, 0x22, 0x97, 0x38, 0xba, 0x97, 0xea, 0x56,
-	0xf8, 0x6b, 0x03, 0xdc, 0xfa, 0x7a, 0x07, 0x92, 0x15, 0xf8, 0x3d, 0xb4, 0xf5, 0x71, 0xfa, 0x86,
-	0x4e, 0xf2, 0xe2, 0x3f, 0x33, 0xd4, 0xba, 0x23, 0xf5, 0x49, 0x89, 0x53, 0x8b, 0x18, 0x31, 0xfe,
-	0x00, 0x76, 0x4e, 0xab, 0x89, 0x8e, 0xc3, 0x49, 0x9e, 0xdf, 0xc2, 0xe4, 0xe0, 0xed, 0xe7, 0x8f,
-	0xca, 0x63, 0xd0, 0x5d, 0x5c, 0xee, 0xd8, 0x0a, 0xa5, 0x16, 0xd1, 0x26, 0xf8, 0x10, 0x36, 0xd9,
-	0x37, 0xc9, 0x4a, 0x91, 0xf3, 0x52, 0x07, 0xe9, 0x24, 0xaf, 0x6e, 0xe1, 0xb8, 0x5f, 0x6b, 0x54,
-	0x1e, 0xa9, 0x45, 0xfe, 0x9a, 0xe0, 0x13, 0xd8, 0x6a, 0xc0, 0xf1, 0x94, 0xce, 0x27, 0x9c, 0x0e,
-	0xf5, 0xcf, 0x70, 0x92, 0xbd, 0xbb, 0x38, 0x1f, 0x1a, 0x69, 0x6a, 0x91, 0x1e, 0xbb, 0xd2, 0x1b,
-	0x74, 0xc0, 0xce, 0x25, 0x2b, 0xc2, 0x67, 0xb0, 0x75, 0x2d, 0x28, 0xb5, 0x00, 0x25, 0x2d, 0x4c,
-	0xc8, 0x9b, 0x44, 0xd7, 0xe1, 0x04, 0x7a, 0x57, 0xc3, 0xc0, 0x3d, 0x68, 0x8d, 0xd9, 0x5c, 0xd3,
-	0x5c, 0xa2, 0x4a, 0xbc, 0x0d, 0xed, 0x8a, 0x4e, 0xce, 0x98, 0x8e, 0xd6, 0x25, 0x06, 0x60, 0x0f,
-	0xee, 0x55, 0x6c, 0xd6, 0x04, 0xd4, 0x22, 0x35, 0xfc, 0x67, 0x65, 0xd5, 0xfd, 0xda, 0xf5, 0xca,
-	0x86, 0xef, 0xe0, 0xc1, 0x8d, 0x41, 0xdd, 0x34, 0xda, 0xba, 0xfd, 0x0e, 0x5f, 0x83, 0xb7, 0x2e,
-	0x13, 0x35, 0x52, 0x9d, 0xac, 0x19, 0xbf, 0x86, 0x83, 0x37, 0xe7, 0x0b, 0x1f, 0x5d, 0x2c, 0x7c,
-	0xf4, 0x7b, 0xe1, 0xa3, 0xef, 0x4b, 0xdf, 0xba, 0x58, 0xfa, 0xd6, 0xcf, 0xa5, 0x6f, 0x7d, 0x09,
-	0x4d, 0xf6, 0x62, 0x38, 0x8e, 0x73, 0x7e, 0xed, 0x35, 0xcb, 0xf9, 0x94, 0x89, 0x93, 0x8e, 0x7e,
-	0x97, 0x7b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xef, 0xe9, 0x8e, 0x10, 0xf4, 0x03, 0x00, 0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xb5, 0x1b, 0xa7, 0xb8, 0x63, 0x23, 0xb5, 0xab, 0x82, 0x0c, 0x07, 0x27, 0x18, 0x21, 0x2c,
+	0x81, 0x1c, 0xea, 0x72, 0x07, 0x0c, 0x45, 0xae, 0x00, 0xa9, 0xda, 0x4a, 0x1c, 0x10, 0x52, 0xb4,
+	0x4d, 0x96, 0xd8, 0x4a, 0xec, 0x8d, 0xb2, 0x8e, 0x45, 0xfe, 0x82, 0x1f, 0xe1, 0x13, 0xb8, 0xf7,
+	0xd8, 0x23, 0xa7, 0x08, 0x25, 0x3f, 0x82, 0x76, 0xd7, 0x0e, 0x55, 0x49, 0x50, 0xb8, 0xcd, 0x1b,
+	0xbf, 0x19, 0xcf, 0x7b, 0xb3, 0xbb, 0xe0, 0xf7, 0x18, 0xcf, 0x18, 0xef, 0xf0, 0x82, 0x4d, 0x68,
+	0x87, 0xe7, 0x64, 0xcc, 0x13, 0x56, 0xf0, 0x4e, 0x79, 0xb4, 0x02, 0xc1, 0x78, 0xc2, 0x0a, 0x86,
+	0xee, 0x29, 0x66, 0x20, 0x99, 0xc1, 0x8a, 0x19, 0x94, 0x47, 0xf7, 0x0f, 0x07, 0x6c, 0xc0, 0x24,
+	0xab, 0x23, 0x22, 0x55, 0xe0, 0x7d, 0xd7, 0xc1, 0x3c, 0xaf, 0x68, 0xe8, 0x2e, 0xec, 0x26, 0x34,
+	0x1d, 0x24, 0x85, 0xa3, 0xb7, 0x75, 0xdf, 0xc0, 0x15, 0x12, 0xf9, 0x2f, 0x6c, 0x92, 0x91, 0xc2,
+	0xd9, 0x69, 0xeb, 0xfe, 0x6d, 0x5c, 0x21, 0x91, 0xef, 0x25, 0xd3, 0x7c, 0xc8, 0x9d, 0x86, 0xca,
+	0x2b, 0x84, 0x10, 0x18, 0x09, 0xe1, 0x89, 0x63, 0xb4, 0x75, 0xdf, 0xc6, 0x32, 0x46, 0x27, 0x60,
+	0x66, 0xb4, 0x20, 0x7d, 0x52, 0x10, 0xa7, 0xd9, 0xd6, 0x7d, 0x2b, 0x7c, 0x18, 0x6c, 0x1c, 0x36,
+	0xf8, 0x50, 0x51, 0x23, 0xe3, 0x72, 0xde, 0xd2, 0xf0, 0xaa, 0xd4, 0x63, 0x60, 0xd6, 0xdf, 0xd0,
+	0x03, 0xb0, 0xe5, 0x0f, 0xbb, 0xe2, 0x07, 0x94, 0x3b, 0x7a, 0xbb, 0xe1, 0xdb, 0xd8, 0x92, 0xb9,
+	0x58, 0xa6, 0x50, 0x0b, 0xac, 0x0b, 0xc2, 0x69, 0xb7, 0x92, 0xb5, 0x23, 0x65, 0x81, 0x48, 0xc5,
+	0x4a, 0x5a, 0x4d, 0xa8, 0xf4, 0x29, 0x1d, 0x92, 0xf0, 0x56, 0x66, 0xbc, 0x1f, 0x0d, 0xb0, 0x6b,
+	0x83, 0x4e, 0x0b, 0x9a, 0xa1, 0x37, 0xd0, 0x94, 0x03, 0x4b, 0x8f, 0xac, 0xf0, 0xe9, 0x3f, 0x54,
+	0xd4, 0x75, 0xe7, 0xe2, 0x93, 0x28, 0x8e, 0x35, 0xac, 0x8a, 0xd1, 0x3b, 0x30, 0x52, 0x52, 0x8e,
+	0xe4, 0x44, 0x56, 0xf8, 0x64, 0x8b, 0x26, 0xa7, 0xaf, 0x3e, 0xbe, 0x17, 0x3d, 0x22, 0x73, 0x31,
+	0x6f, 0x19, 0x02, 0xc5, 0x1a, 0x96, 0x4d, 0xd0, 0x19, 0xec, 0xd1, 0xaf, 0x05, 0xcd, 0x79, 0xca,
+	0x72, 0x29, 0xc1, 0x0a, 0x9f, 0x6d, 0xd1, 0xf1, 0xa4, 0xae, 0x11, 0x8e, 0xc6, 0x1a, 0xfe, 0xd3,
+	0x04, 0x5d, 0xc0, 0xc1, 0x0a, 0x74, 0xc7, 0x64, 0x36, 0x62, 0xa4, 0x2f, 0xd7, 0x69, 0x85, 0xc7,
+	0xff, 0xd3, 0xf9, 0x4c, 0x95, 0xc6, 0x1a, 0xde, 0xa7, 0x37, 0x72, 0xe8, 0x33, 0xec, 0x89, 0xe9,
+	0xbb, 0xc2, 0xec, 0xea, 0x48, 0x74, 0xb6, 0xf4, 0x21, 0x22, 0x5c, 0xfa, 0x19, 0xd9, 0x8b, 0x79,
+	0xcb, 0xac, 0x33, 0xb1, 0x86, 0x4d, 0xd1, 0x51, 0xc4, 0xd1, 0x2e, 0x18, 0x69, 0x41, 0x33, 0xef,
+	0x31, 0x1c, 0xfc, 0xb5, 0x06, 0x71, 0x40, 0x73, 0x92, 0xa9, 0x15, 0xee, 0x61, 0x19, 0x7b, 0x23,
+	0xd8, 0xbf, 0x69, 0x35, 0xda, 0x87, 0xc6, 0x90, 0xce, 0x24, 0xcd, 0xc6, 0x22, 0x44, 0x87, 0xd0,
+	0x2c, 0xc9, 0x68, 0x4a, 0xe5, 0xe2, 0x6c, 0xac, 0x00, 0x72, 0xe0, 0x56, 0x49, 0x27, 0x2b, 0xfb,
+	0x1b, 0xb8, 0x86, 0xd7, 0xae, 0x94, 0x70, 0xaf, 0x59, 0x5f, 0x29, 0xef, 0x25, 0x1c, 0xae, 0x13,
+	0x24, 0x26, 0xe3, 0xc3, 0x74, 0x5c, 0x5d, 0x40, 0x19, 0x8b, 0x7f, 0xf6, 0xd8, 0x34, 0xaf, 0x8f,
+	0xaf, 0x02, 0xde, 0x6b, 0xb8, 0xb3, 0x76, 0x91, 0xeb, 0xc4, 0x6d, 0xba, 0xc1, 0xde, 0x73, 0x70,
+	0x36, 0xed, 0x4c, 0x88, 0xaa, 0x37, 0xaf, 0x0c, 0xa8, 0x61, 0xf4, 0xe2, 0x72, 0xe1, 0xea, 0x57,
+	0x0b, 0x57, 0xff, 0xb5, 0x70, 0xf5, 0x6f, 0x4b, 0x57, 0xbb, 0x5a, 0xba, 0xda, 0xcf, 0xa5, 0xab,
+	0x7d, 0x7a, 0xa4, 0xf6, 0xc7, 0xfb, 0xc3, 0x20, 0x65, 0xd5, 0x7b, 0x55, 0x86, 0xd7, 0x9e, 0xac,
+	0x62, 0x36, 0xa6, 0xfc, 0x62, 0x57, 0x3e, 0x3e, 0xc7, 0xbf, 0x07, 0x00, 0xb9, 0xf7, 0x0b, 0xc6,
+	0xd9, 0x04, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -608,6 +707,16 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseFormat != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseFormat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseHeight != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -736,6 +845,27 @@ func (m *SnapshotItem_ExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_IAVLBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_IAVLBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IAVLBase != nil {
+		{
+			size, err := m.IAVLBase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -813,6 +943,39 @@ func (m *SnapshotIAVLItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotIAVLBaseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotIAVLBaseItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotIAVLBaseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Skip != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Skip))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotExtensionMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -925,6 +1088,12 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseHeight))
+	}
+	if m.BaseFormat != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseFormat))
+	}
 	return n
 }
 
@@ -988,6 +1157,18 @@ func (m *SnapshotItem_ExtensionPayload) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_IAVLBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IAVLBase != nil {
+		l = m.IAVLBase.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1024,6 +1205,21 @@ func (m *SnapshotIAVLItem) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotIAVLBaseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Skip != 0 {
+		n += 1 + sovSnapshot(uint64(m.Skip))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSnapshot(uint64(m.Count))
+	}
+	return n
+}
+
 func (m *SnapshotExtensionMeta) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1294,6 +1490,44 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFormat", wireType)
+			}
+			m.BaseFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFormat |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1484,6 +1718,41 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_ExtensionPayload{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLBase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotIAVLBaseItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_IAVLBase{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1743,6 +2012,94 @@ func (m *SnapshotIAVLItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotIAVLBaseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotIAVLBaseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotIAVLBaseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
+			}
+			m.Skip = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Skip |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SnapshotExtensionMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,8 +19,22 @@ type Database interface {
 
 	Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error)
 	ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error)
+	History(storeKey, key []byte, startVersion, endVersion uint64) (store.HistoryIterator, error)
 
 	Prune(version uint64) error
 
 	io.Closer
 }
+
+// StorePruner is implemented by the databases which can prune the stores
+// separately, e.g. to keep the versions of the stores for different numbers of
+// versions. The prune heights of the stores are tracked by PruneHeights.
+type StorePruner interface {
+	// PruneExcept prunes the versions of all the stores but the given ones up to
+	// and including the given version, like Prune.
+	PruneExcept(version uint64, storeKeys [][]byte) error
+
+	// PruneStore prunes the versions of the given store up to and including the
+	// given version.
+	PruneStore(storeKey []byte, version uint64) error
+}
@@ -17,6 +17,10 @@ type Batch struct {
 	batch   *pebble.Batch
 	version uint64
 	sync    bool
+
+	// legacyPrefixes is whether the store prefixes of the database aren't
+	// terminated, see Database.
+	legacyPrefixes bool
 }
 
 func NewBatch(storage *pebble.DB, version uint64, sync bool) (*Batch, error) {
@@ -47,7 +51,7 @@ func (b *Batch) Reset() error {
 }
 
 func (b *Batch) set(storeKey []byte, tombstone uint64, key, value []byte) error {
-	prefixedKey := MVCCEncode(prependStoreKey(storeKey, key), b.version)
+	prefixedKey := MVCCEncode(prependStoreKey(storeKey, key, b.legacyPrefixes), b.version)
 	prefixedVal := MVCCEncode(value, tombstone)
 
 	if err := b.batch.Set(prefixedKey, prefixedVal, nil); err != nil {
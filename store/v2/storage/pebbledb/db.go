@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"slices"
+	"sync"
 
 	"github.com/cockroachdb/pebble"
 
@@ -14,6 +15,7 @@ import (
 	"cosmossdk.io/store/v2"
 	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/util"
 )
 
 const (
@@ -22,13 +24,18 @@ const (
 	// in a single batch.
 	PruneCommitBatchSize = 50
 
-	StorePrefixTpl   = "s/k:%s/"         // s/k:<storeKey>
-	latestVersionKey = "s/_latest"       // NB: latestVersionKey key must be lexically smaller than StorePrefixTpl
-	pruneHeightKey   = "s/_prune_height" // NB: pruneHeightKey key must be lexically smaller than StorePrefixTpl
-	tombstoneVal     = "TOMBSTONE"
+	StorePrefixTpl       = "s/k:%s/"                // s/k:<storeKey>/
+	latestVersionKey     = "s/_latest"              // NB: latestVersionKey key must be lexically smaller than StorePrefixTpl
+	pruneHeightKey       = "s/_prune_height"        // NB: pruneHeightKey key must be lexically smaller than StorePrefixTpl
+	storePruneHeightsKey = "s/_store_prune_heights" // NB: storePruneHeightsKey key must be lexically smaller than StorePrefixTpl
+	prefixLayoutKey      = "s/_prefix_layout"       // NB: prefixLayoutKey key must be lexically smaller than StorePrefixTpl
+	tombstoneVal         = "TOMBSTONE"
 )
 
-var _ storage.Database = (*Database)(nil)
+var (
+	_ storage.Database    = (*Database)(nil)
+	_ storage.StorePruner = (*Database)(nil)
+)
 
 type Database struct {
 	storage *pebble.DB
@@ -37,6 +44,20 @@ type Database struct {
 	// only updated when the database is pruned.
 	earliestVersion uint64
 
+	// pruneHeights tracks the prune heights of the stores pruned separately.
+	//
+	// NOTE: With legacy prefixes, the keys of the stores whose keys are a prefix
+	// of the other's, e.g. "acc" and "accounts", can't be told apart, so they
+	// share their prune heights.
+	pruneHeights    *storage.PruneHeights
+	pruneHeightsMtx sync.RWMutex
+
+	// legacyPrefixes is whether the store prefixes aren't terminated, i.e. the
+	// store key is appended to the unformatted StorePrefixTpl, as in the
+	// databases written before the prefixes were terminated. These databases
+	// keep their layout, see storePrefix.
+	legacyPrefixes bool
+
 	// Sync is whether to sync writes through the OS buffer cache and down onto
 	// the actual disk, if applicable. Setting Sync is required for durability of
 	// individual write operations but can result in slower writes.
@@ -65,9 +86,21 @@ func New(dataDir string) (*Database, error) {
 		return nil, fmt.Errorf("failed to get prune height: %w", err)
 	}
 
+	pruneHeights, err := getStorePruneHeights(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store prune heights: %w", err)
+	}
+
+	legacyPrefixes, err := getLegacyPrefixes(db, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prefix layout: %w", err)
+	}
+
 	return &Database{
 		storage:         db,
 		earliestVersion: pruneHeight + 1,
+		pruneHeights:    pruneHeights,
+		legacyPrefixes:  legacyPrefixes,
 		sync:            true,
 	}, nil
 }
@@ -78,9 +111,21 @@ func NewWithDB(storage *pebble.DB, sync bool) *Database {
 		panic(fmt.Errorf("failed to get prune height: %w", err))
 	}
 
+	pruneHeights, err := getStorePruneHeights(storage)
+	if err != nil {
+		panic(fmt.Errorf("failed to get store prune heights: %w", err))
+	}
+
+	legacyPrefixes, err := getLegacyPrefixes(storage, sync)
+	if err != nil {
+		panic(fmt.Errorf("failed to get prefix layout: %w", err))
+	}
+
 	return &Database{
 		storage:         storage,
 		earliestVersion: pruneHeight + 1,
+		pruneHeights:    pruneHeights,
+		legacyPrefixes:  legacyPrefixes,
 		sync:            sync,
 	}
 }
@@ -100,6 +145,7 @@ func (db *Database) NewBatch(version uint64) (store.Batch, error) {
 	if err != nil {
 		return nil, err
 	}
+	b.legacyPrefixes = db.legacyPrefixes
 
 	return b, nil
 }
@@ -129,13 +175,68 @@ func (db *Database) GetLatestVersion() (uint64, error) {
 	return binary.LittleEndian.Uint64(bz), closer.Close()
 }
 
-func (db *Database) setPruneHeight(pruneVersion uint64) error {
-	db.earliestVersion = pruneVersion + 1
-
+func (db *Database) setPruneHeight(pruneVersion uint64, pruneHeights *storage.PruneHeights) error {
 	var ts [VersionSize]byte
 	binary.LittleEndian.PutUint64(ts[:], pruneVersion)
 
-	return db.storage.Set([]byte(pruneHeightKey), ts[:], &pebble.WriteOptions{Sync: db.sync})
+	if err := db.setStorePruneHeights(pruneHeights, ts[:]); err != nil {
+		return err
+	}
+
+	db.earliestVersion = pruneVersion + 1
+	return nil
+}
+
+// setStorePruneHeights writes the prune heights of the stores, and the default
+// prune height if not nil.
+func (db *Database) setStorePruneHeights(pruneHeights *storage.PruneHeights, pruneHeight []byte) error {
+	bz, err := pruneHeights.Marshal()
+	if err != nil {
+		return err
+	}
+
+	batch := db.storage.NewBatch()
+	defer batch.Close()
+
+	if pruneHeight != nil {
+		if err := batch.Set([]byte(pruneHeightKey), pruneHeight, nil); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set([]byte(storePruneHeightsKey), bz, nil); err != nil {
+		return err
+	}
+	if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
+		return err
+	}
+
+	db.pruneHeightsMtx.Lock()
+	db.pruneHeights = pruneHeights
+	db.pruneHeightsMtx.Unlock()
+	return nil
+}
+
+// storeEarliestVersion returns the earliest version of the given store.
+func (db *Database) storeEarliestVersion(storeKey []byte) uint64 {
+	db.pruneHeightsMtx.RLock()
+	defer db.pruneHeightsMtx.RUnlock()
+
+	overlap := bytes.Equal
+	if db.legacyPrefixes {
+		overlap = func(a, b []byte) bool {
+			return bytes.HasPrefix(a, b) || bytes.HasPrefix(b, a)
+		}
+	}
+	return db.pruneHeights.EarliestVersion(storeKey, db.earliestVersion, overlap)
+}
+
+// clonePruneHeights returns a copy of the prune heights of the stores, to be
+// updated by a pruning.
+func (db *Database) clonePruneHeights() *storage.PruneHeights {
+	db.pruneHeightsMtx.RLock()
+	defer db.pruneHeightsMtx.RUnlock()
+
+	return db.pruneHeights.Clone()
 }
 
 func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
@@ -148,11 +249,11 @@ func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, erro
 }
 
 func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]byte, error) {
-	if targetVersion < db.earliestVersion {
-		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion}
+	if earliestVersion := db.storeEarliestVersion(storeKey); targetVersion < earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: earliestVersion}
 	}
 
-	prefixedVal, err := getMVCCSlice(db.storage, storeKey, key, targetVersion)
+	prefixedVal, err := getMVCCSlice(db.storage, db.prependStoreKey(storeKey, key), targetVersion)
 	if err != nil {
 		if errors.Is(err, storeerrors.ErrRecordNotFound) {
 			return nil, nil
@@ -196,7 +297,43 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 //
 // See: https://github.com/cockroachdb/cockroach/blob/33623e3ee420174a4fd3226d1284b03f0e3caaac/pkg/storage/mvcc.go#L3182
 func (db *Database) Prune(version uint64) error {
-	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: []byte("s/k:")})
+	return db.PruneExcept(version, nil)
+}
+
+// PruneExcept removes all versions of all keys that are <= the given version,
+// but the keys of the given stores. See Prune.
+func (db *Database) PruneExcept(version uint64, storeKeys [][]byte) error {
+	skipPrefixes := make([][]byte, len(storeKeys))
+	for i, storeKey := range storeKeys {
+		skipPrefixes[i] = db.storePrefix(storeKey)
+	}
+
+	if err := db.prune(version, []byte("s/k:"), nil, skipPrefixes); err != nil {
+		return err
+	}
+
+	pruneHeights := db.clonePruneHeights()
+	pruneHeights.Exclude(storeKeys, db.earliestVersion-1)
+	return db.setPruneHeight(version, pruneHeights)
+}
+
+// PruneStore removes all versions of the keys of the given store that are <=
+// the given version. See Prune.
+func (db *Database) PruneStore(storeKey []byte, version uint64) error {
+	prefix := db.storePrefix(storeKey)
+	if err := db.prune(version, MVCCEncode(prefix, 0), MVCCEncode(util.CopyIncr(prefix), 0), nil); err != nil {
+		return err
+	}
+
+	pruneHeights := db.clonePruneHeights()
+	pruneHeights.SetStoreHeight(storeKey, version)
+	return db.setStorePruneHeights(pruneHeights, nil)
+}
+
+// prune removes the versions <= the given version of the keys between the given
+// bounds, but the keys with the given prefixes.
+func (db *Database) prune(version uint64, lowerBound, upperBound []byte, skipPrefixes [][]byte) error {
+	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: lowerBound, UpperBound: upperBound})
 	if err != nil {
 		return err
 	}
@@ -219,6 +356,16 @@ func (db *Database) Prune(version uint64) error {
 			return fmt.Errorf("invalid PebbleDB MVCC key: %s", prefixedKey)
 		}
 
+		// seek past the keys of the skipped stores
+		if i := slices.IndexFunc(skipPrefixes, func(prefix []byte) bool { return bytes.HasPrefix(keyBz, prefix) }); i >= 0 {
+			end := util.CopyIncr(skipPrefixes[i])
+			if end == nil {
+				break
+			}
+			itr.SeekGE(MVCCEncode(end, 0))
+			continue
+		}
+
 		keyVersion, err := decodeUint64Ascending(verBz)
 		if err != nil {
 			return fmt.Errorf("failed to decode key version: %w", err)
@@ -264,7 +411,7 @@ func (db *Database) Prune(version uint64) error {
 		}
 	}
 
-	return db.setPruneHeight(version)
+	return nil
 }
 
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
@@ -276,11 +423,12 @@ func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte)
 		return nil, storeerrors.ErrStartAfterEnd
 	}
 
-	lowerBound := MVCCEncode(prependStoreKey(storeKey, start), 0)
+	lowerBound := MVCCEncode(db.prependStoreKey(storeKey, start), 0)
 
-	var upperBound []byte
+	// bound the iteration to the keys of the store
+	upperBound := MVCCEncode(util.CopyIncr(db.storePrefix(storeKey)), 0)
 	if end != nil {
-		upperBound = MVCCEncode(prependStoreKey(storeKey, end), 0)
+		upperBound = MVCCEncode(db.prependStoreKey(storeKey, end), 0)
 	}
 
 	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: lowerBound, UpperBound: upperBound})
@@ -288,7 +436,7 @@ func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte)
 		return nil, err
 	}
 
-	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, false), nil
+	return newPebbleDBIterator(itr, db.storePrefix(storeKey), start, end, version, db.storeEarliestVersion(storeKey), false), nil
 }
 
 func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
@@ -300,11 +448,12 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 		return nil, storeerrors.ErrStartAfterEnd
 	}
 
-	lowerBound := MVCCEncode(prependStoreKey(storeKey, start), 0)
+	lowerBound := MVCCEncode(db.prependStoreKey(storeKey, start), 0)
 
-	var upperBound []byte
+	// bound the iteration to the keys of the store
+	upperBound := MVCCEncode(util.CopyIncr(db.storePrefix(storeKey)), 0)
 	if end != nil {
-		upperBound = MVCCEncode(prependStoreKey(storeKey, end), 0)
+		upperBound = MVCCEncode(db.prependStoreKey(storeKey, end), 0)
 	}
 
 	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: lowerBound, UpperBound: upperBound})
@@ -312,15 +461,54 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 		return nil, err
 	}
 
-	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, true), nil
+	return newPebbleDBIterator(itr, db.storePrefix(storeKey), start, end, version, db.storeEarliestVersion(storeKey), true), nil
+}
+
+func (db *Database) storePrefix(storeKey []byte) []byte {
+	return storePrefix(storeKey, db.legacyPrefixes)
 }
 
-func storePrefix(storeKey []byte) []byte {
-	return append([]byte(StorePrefixTpl), storeKey...)
+func (db *Database) prependStoreKey(storeKey, key []byte) []byte {
+	return prependStoreKey(storeKey, key, db.legacyPrefixes)
 }
 
-func prependStoreKey(storeKey, key []byte) []byte {
-	return append(storePrefix(storeKey), key...)
+// storePrefix returns the prefix of the keys of the store, terminated by a
+// separator so that it isn't the prefix of the keys of the stores whose key it
+// prefixes, e.g. "acc" and "accounts". The legacy prefixes aren't terminated,
+// the keys of such stores overlap.
+func storePrefix(storeKey []byte, legacy bool) []byte {
+	if legacy {
+		return append([]byte(StorePrefixTpl), storeKey...)
+	}
+	return []byte(fmt.Sprintf(StorePrefixTpl, storeKey))
+}
+
+func prependStoreKey(storeKey, key []byte, legacy bool) []byte {
+	return append(storePrefix(storeKey, legacy), key...)
+}
+
+// getLegacyPrefixes returns whether the database uses the legacy store
+// prefixes, i.e. whether it was written before the prefixes were terminated. A
+// fresh database is marked as using the terminated prefixes.
+func getLegacyPrefixes(storage *pebble.DB, sync bool) (bool, error) {
+	_, closer, err := storage.Get([]byte(prefixLayoutKey))
+	if err == nil {
+		return false, closer.Close()
+	}
+	if !errors.Is(err, pebble.ErrNotFound) {
+		return false, err
+	}
+
+	// every write sets the latest version
+	_, closer, err = storage.Get([]byte(latestVersionKey))
+	if err == nil {
+		return true, closer.Close()
+	}
+	if !errors.Is(err, pebble.ErrNotFound) {
+		return false, err
+	}
+
+	return false, storage.Set([]byte(prefixLayoutKey), []byte{1}, &pebble.WriteOptions{Sync: sync})
 }
 
 func getPruneHeight(storage *pebble.DB) (uint64, error) {
@@ -341,6 +529,21 @@ func getPruneHeight(storage *pebble.DB) (uint64, error) {
 	return binary.LittleEndian.Uint64(bz), closer.Close()
 }
 
+func getStorePruneHeights(db *pebble.DB) (*storage.PruneHeights, error) {
+	bz, closer, err := db.Get([]byte(storePruneHeightsKey))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			// in cases where no store was pruned separately
+			return &storage.PruneHeights{}, nil
+		}
+
+		return nil, err
+	}
+	defer closer.Close()
+
+	return storage.UnmarshalPruneHeights(bz)
+}
+
 func valTombstoned(value []byte) bool {
 	if value == nil {
 		return false
@@ -362,15 +565,15 @@ func valTombstoned(value []byte) bool {
 	return true
 }
 
-func getMVCCSlice(db *pebble.DB, storeKey, key []byte, version uint64) ([]byte, error) {
+func getMVCCSlice(db *pebble.DB, prefixedKey []byte, version uint64) ([]byte, error) {
 	// end domain is exclusive, so we need to increment the version by 1
 	if version < math.MaxUint64 {
 		version++
 	}
 
 	itr, err := db.NewIter(&pebble.IterOptions{
-		LowerBound: MVCCEncode(prependStoreKey(storeKey, key), 0),
-		UpperBound: MVCCEncode(prependStoreKey(storeKey, key), version),
+		LowerBound: MVCCEncode(prefixedKey, 0),
+		UpperBound: MVCCEncode(prefixedKey, version),
 	})
 	if err != nil {
 		return nil, err
@@ -3,6 +3,8 @@ package pebbledb
 import (
 	"testing"
 
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/log"
@@ -27,3 +29,56 @@ func TestStorageTestSuite(t *testing.T) {
 
 	suite.Run(t, s)
 }
+
+func TestDatabase_LegacyPrefixes(t *testing.T) {
+	// a database written before the store prefixes were terminated
+	dir := t.TempDir()
+	raw, err := pebble.Open(dir, (&pebble.Options{Comparer: MVCCComparer}).EnsureDefaults())
+	require.NoError(t, err)
+	b, err := NewBatch(raw, 1, false)
+	require.NoError(t, err)
+	b.legacyPrefixes = true
+	require.NoError(t, b.Set([]byte("acc"), []byte("key"), []byte("acc")))
+	require.NoError(t, b.Write())
+	require.NoError(t, raw.Close())
+
+	db, err := New(dir)
+	require.NoError(t, err)
+	require.True(t, db.legacyPrefixes)
+
+	// the existing keys and the new ones keep the legacy layout
+	batch, err := db.NewBatch(2)
+	require.NoError(t, err)
+	require.NoError(t, batch.Set([]byte("acc"), []byte("key2"), []byte("acc2")))
+	require.NoError(t, batch.Write())
+	for key, value := range map[string]string{"key": "acc", "key2": "acc2"} {
+		bz, err := db.Get([]byte("acc"), 2, []byte(key))
+		require.NoError(t, err)
+		require.Equal(t, value, string(bz))
+	}
+	require.NoError(t, db.Close())
+
+	db, err = New(dir)
+	require.NoError(t, err)
+	require.True(t, db.legacyPrefixes)
+	require.NoError(t, db.Close())
+
+	// a fresh database uses the terminated prefixes, once reopened too
+	dir = t.TempDir()
+	db, err = New(dir)
+	require.NoError(t, err)
+	require.False(t, db.legacyPrefixes)
+	batch, err = db.NewBatch(1)
+	require.NoError(t, err)
+	require.NoError(t, batch.Set([]byte("acc"), []byte("key"), []byte("acc")))
+	require.NoError(t, batch.Write())
+	require.NoError(t, db.Close())
+
+	db, err = New(dir)
+	require.NoError(t, err)
+	defer db.Close()
+	require.False(t, db.legacyPrefixes)
+	bz, err := db.Get([]byte("acc"), 1, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, "acc", string(bz))
+}
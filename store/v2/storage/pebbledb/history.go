@@ -0,0 +1,152 @@
+package pebbledb
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/cockroachdb/pebble"
+
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+var _ store.HistoryIterator = (*historyIterator)(nil)
+
+// historyIterator implements the store.HistoryIterator interface. It wraps a
+// PebbleDB iterator bounded to the MVCC entries of a single key, where each
+// entry is either a value or a tombstone written at the version of the entry.
+type historyIterator struct {
+	source    *pebble.Iterator
+	version   uint64
+	value     []byte
+	tombstone bool
+	valid     bool
+	err       error
+}
+
+// History returns an iterator over the writes of the given key between the
+// startVersion and endVersion, both inclusive.
+func (db *Database) History(storeKey, key []byte, startVersion, endVersion uint64) (store.HistoryIterator, error) {
+	if len(key) == 0 {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+
+	if startVersion > endVersion {
+		return nil, storeerrors.ErrStartVersionAfterEnd
+	}
+
+	if earliestVersion := db.storeEarliestVersion(storeKey); startVersion < earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: earliestVersion}
+	}
+
+	// end domain is exclusive, so we need to increment the version by 1
+	if endVersion < math.MaxUint64 {
+		endVersion++
+	}
+
+	prefixedKey := db.prependStoreKey(storeKey, key)
+	itr, err := db.storage.NewIter(&pebble.IterOptions{
+		LowerBound: MVCCEncode(prefixedKey, startVersion),
+		UpperBound: MVCCEncode(prefixedKey, endVersion),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newPebbleDBHistoryIterator(itr), nil
+}
+
+func newPebbleDBHistoryIterator(src *pebble.Iterator) *historyIterator {
+	itr := &historyIterator{source: src, valid: src.First()}
+	itr.parse()
+
+	return itr
+}
+
+func (itr *historyIterator) Valid() bool {
+	return itr.valid
+}
+
+func (itr *historyIterator) Next() {
+	if !itr.valid {
+		return
+	}
+
+	itr.valid = itr.source.Next()
+	itr.parse()
+}
+
+func (itr *historyIterator) Version() uint64 {
+	itr.assertIsValid()
+	return itr.version
+}
+
+func (itr *historyIterator) Value() []byte {
+	itr.assertIsValid()
+	return itr.value
+}
+
+func (itr *historyIterator) Tombstone() bool {
+	itr.assertIsValid()
+	return itr.tombstone
+}
+
+func (itr *historyIterator) Error() error {
+	if itr.err != nil {
+		return itr.err
+	}
+
+	return itr.source.Error()
+}
+
+func (itr *historyIterator) Close() error {
+	err := itr.source.Close()
+	itr.valid = false
+
+	return err
+}
+
+func (itr *historyIterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
+
+// parse decodes the version and the value of the entry the underlying iterator
+// is positioned at.
+func (itr *historyIterator) parse() {
+	if !itr.valid {
+		return
+	}
+
+	_, vBz, ok := SplitMVCCKey(itr.source.Key())
+	if !ok {
+		itr.err = fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.source.Key())
+		itr.valid = false
+		return
+	}
+
+	version, err := decodeUint64Ascending(vBz)
+	if err != nil {
+		itr.err = fmt.Errorf("failed to decode key version: %w", err)
+		itr.valid = false
+		return
+	}
+
+	valBz, tombBz, ok := SplitMVCCKey(itr.source.Value())
+	if !ok {
+		itr.err = fmt.Errorf("invalid PebbleDB MVCC value: %s", itr.source.Value())
+		itr.valid = false
+		return
+	}
+
+	// Deletes write an entry whose tombstone suffix is the version of the entry,
+	// whereas sets write an entry with an empty tombstone suffix.
+	itr.version = version
+	itr.tombstone = len(tombBz) > 0
+	itr.value = nil
+	if !itr.tombstone {
+		itr.value = slices.Clone(valBz)
+	}
+}
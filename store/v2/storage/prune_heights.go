@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// PruneHeights tracks the heights up to which the stores of a database are
+// pruned, for the databases implementing StorePruner. The stores are pruned up
+// to the default prune height of the database by Prune and PruneExcept, but the
+// stores excluded by PruneExcept, which are pruned by PruneStore.
+type PruneHeights struct {
+	// Stores are the heights up to which the stores were pruned by PruneStore, or
+	// by the default pruning before they were excluded from it, by store key.
+	Stores map[string]uint64 `json:"stores,omitempty"`
+
+	// Excluded are the keys of the stores excluded from the default pruning by
+	// the last PruneExcept.
+	Excluded []string `json:"excluded,omitempty"`
+}
+
+// UnmarshalPruneHeights decodes the PruneHeights encoded by Marshal.
+func UnmarshalPruneHeights(bz []byte) (*PruneHeights, error) {
+	h := &PruneHeights{}
+	if len(bz) == 0 {
+		return h, nil
+	}
+	if err := json.Unmarshal(bz, h); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Marshal encodes the PruneHeights.
+func (h *PruneHeights) Marshal() ([]byte, error) {
+	return json.Marshal(h)
+}
+
+// Clone returns a copy of the PruneHeights.
+func (h *PruneHeights) Clone() *PruneHeights {
+	stores := make(map[string]uint64, len(h.Stores))
+	for storeKey, pruneHeight := range h.Stores {
+		stores[storeKey] = pruneHeight
+	}
+	return &PruneHeights{
+		Stores:   stores,
+		Excluded: slices.Clone(h.Excluded),
+	}
+}
+
+// IsExcluded returns true if the store is excluded from the default pruning.
+func (h *PruneHeights) IsExcluded(storeKey []byte) bool {
+	return slices.Contains(h.Excluded, string(storeKey))
+}
+
+// Exclude excludes the given stores from the default pruning, given the default
+// prune height. The stores which weren't excluded yet are recorded as pruned up
+// to the default prune height.
+func (h *PruneHeights) Exclude(storeKeys [][]byte, pruneHeight uint64) {
+	excluded := make([]string, len(storeKeys))
+	for i, storeKey := range storeKeys {
+		if !h.IsExcluded(storeKey) {
+			h.SetStoreHeight(storeKey, pruneHeight)
+		}
+		excluded[i] = string(storeKey)
+	}
+	slices.Sort(excluded)
+	h.Excluded = excluded
+}
+
+// SetStoreHeight records the store as pruned up to the given height.
+func (h *PruneHeights) SetStoreHeight(storeKey []byte, pruneHeight uint64) {
+	if h.Stores == nil {
+		h.Stores = make(map[string]uint64)
+	}
+	h.Stores[string(storeKey)] = max(h.Stores[string(storeKey)], pruneHeight)
+}
+
+// EarliestVersion returns the earliest version of the store, given the earliest
+// version of the database. The overlap function reports if the pruning of a
+// store may remove the versions of another store, e.g. if their keys share a
+// prefix.
+func (h *PruneHeights) EarliestVersion(storeKey []byte, earliestVersion uint64, overlap func(a, b []byte) bool) uint64 {
+	if h.IsExcluded(storeKey) {
+		earliestVersion = 0
+	}
+	for other, pruneHeight := range h.Stores {
+		if overlap(storeKey, []byte(other)) {
+			earliestVersion = max(earliestVersion, pruneHeight+1)
+		}
+	}
+	return earliestVersion
+}
@@ -19,6 +19,10 @@ type Batch struct {
 	storage  *grocksdb.DB
 	cfHandle *grocksdb.ColumnFamilyHandle
 	batch    *grocksdb.WriteBatch
+
+	// legacyPrefixes is whether the store prefixes of the database aren't
+	// terminated, see Database.
+	legacyPrefixes bool
 }
 
 // NewBatch creates a new versioned batch used for batch writes. The caller
@@ -32,11 +36,12 @@ func NewBatch(db *Database, version uint64) Batch {
 	batch.Put([]byte(latestVersionKey), ts[:])
 
 	return Batch{
-		version:  version,
-		ts:       ts,
-		storage:  db.storage,
-		cfHandle: db.cfHandle,
-		batch:    batch,
+		version:        version,
+		ts:             ts,
+		storage:        db.storage,
+		cfHandle:       db.cfHandle,
+		batch:          batch,
+		legacyPrefixes: db.legacyPrefixes,
 	}
 }
 
@@ -50,13 +55,13 @@ func (b Batch) Reset() error {
 }
 
 func (b Batch) Set(storeKey, key, value []byte) error {
-	prefixedKey := prependStoreKey(storeKey, key)
+	prefixedKey := prependStoreKey(storeKey, key, b.legacyPrefixes)
 	b.batch.PutCFWithTS(b.cfHandle, prefixedKey, b.ts[:], value)
 	return nil
 }
 
 func (b Batch) Delete(storeKey, key []byte) error {
-	prefixedKey := prependStoreKey(storeKey, key)
+	prefixedKey := prependStoreKey(storeKey, key, b.legacyPrefixes)
 	b.batch.DeleteCFWithTS(b.cfHandle, prefixedKey, b.ts[:])
 	return nil
 }
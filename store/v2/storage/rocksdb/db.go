@@ -23,6 +23,7 @@ const (
 
 	StorePrefixTpl   = "s/k:%s/"
 	latestVersionKey = "s/latest"
+	prefixLayoutKey  = "s/prefix_layout"
 )
 
 var (
@@ -32,6 +33,12 @@ var (
 	defaultReadOpts  = grocksdb.NewDefaultReadOptions()
 )
 
+// Database is a RocksDB SS backend, whose versions are the timestamps of a
+// column family.
+//
+// NOTE: It doesn't implement storage.StorePruner, as the history of the column
+// family is bounded for all the stores at once. With per-store pruning options,
+// the StorageStore prunes all the stores up to the version pruned by all of them.
 type Database struct {
 	storage  *grocksdb.DB
 	cfHandle *grocksdb.ColumnFamilyHandle
@@ -39,6 +46,12 @@ type Database struct {
 	// tsLow reflects the full_history_ts_low CF value, which is earliest version
 	// supported
 	tsLow uint64
+
+	// legacyPrefixes is whether the store prefixes aren't terminated, i.e. the
+	// store key is appended to the unformatted StorePrefixTpl, as in the
+	// databases written before the prefixes were terminated. These databases
+	// keep their layout, see storePrefix.
+	legacyPrefixes bool
 }
 
 func New(dataDir string) (*Database, error) {
@@ -58,10 +71,16 @@ func New(dataDir string) (*Database, error) {
 		tsLow = binary.LittleEndian.Uint64(tsLowBz)
 	}
 
+	legacyPrefixes, err := getLegacyPrefixes(storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prefix layout: %w", err)
+	}
+
 	return &Database{
-		storage:  storage,
-		cfHandle: cfHandle,
-		tsLow:    tsLow,
+		storage:        storage,
+		cfHandle:       cfHandle,
+		tsLow:          tsLow,
+		legacyPrefixes: legacyPrefixes,
 	}, nil
 }
 
@@ -77,10 +96,16 @@ func NewWithDB(storage *grocksdb.DB, cfHandle *grocksdb.ColumnFamilyHandle) (*Da
 		tsLow = binary.LittleEndian.Uint64(tsLowBz)
 	}
 
+	legacyPrefixes, err := getLegacyPrefixes(storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prefix layout: %w", err)
+	}
+
 	return &Database{
-		storage:  storage,
-		cfHandle: cfHandle,
-		tsLow:    tsLow,
+		storage:        storage,
+		cfHandle:       cfHandle,
+		tsLow:          tsLow,
+		legacyPrefixes: legacyPrefixes,
 	}, nil
 }
 
@@ -105,7 +130,7 @@ func (db *Database) getSlice(storeKey []byte, version uint64, key []byte) (*groc
 	return db.storage.GetCF(
 		newTSReadOptions(version),
 		db.cfHandle,
-		prependStoreKey(storeKey, key),
+		db.prependStoreKey(storeKey, key),
 	)
 }
 
@@ -173,7 +198,7 @@ func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte)
 		return nil, errors.ErrStartAfterEnd
 	}
 
-	prefix := storePrefix(storeKey)
+	prefix := db.storePrefix(storeKey)
 	start, end = util.IterateWithPrefix(prefix, start, end)
 
 	itr := db.storage.NewIteratorCF(newTSReadOptions(version), db.cfHandle)
@@ -189,7 +214,7 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 		return nil, errors.ErrStartAfterEnd
 	}
 
-	prefix := storePrefix(storeKey)
+	prefix := db.storePrefix(storeKey)
 	start, end = util.IterateWithPrefix(prefix, start, end)
 
 	itr := db.storage.NewIteratorCF(newTSReadOptions(version), db.cfHandle)
@@ -207,12 +232,51 @@ func newTSReadOptions(version uint64) *grocksdb.ReadOptions {
 	return readOpts
 }
 
-func storePrefix(storeKey []byte) []byte {
-	return append([]byte(StorePrefixTpl), storeKey...)
+func (db *Database) storePrefix(storeKey []byte) []byte {
+	return storePrefix(storeKey, db.legacyPrefixes)
+}
+
+func (db *Database) prependStoreKey(storeKey, key []byte) []byte {
+	return prependStoreKey(storeKey, key, db.legacyPrefixes)
+}
+
+// storePrefix returns the prefix of the keys of the store, terminated by a
+// separator so that it isn't the prefix of the keys of the stores whose key it
+// prefixes, e.g. "acc" and "accounts". The legacy prefixes aren't terminated,
+// the keys of such stores overlap.
+func storePrefix(storeKey []byte, legacy bool) []byte {
+	if legacy {
+		return append([]byte(StorePrefixTpl), storeKey...)
+	}
+	return []byte(fmt.Sprintf(StorePrefixTpl, storeKey))
+}
+
+func prependStoreKey(storeKey, key []byte, legacy bool) []byte {
+	return append(storePrefix(storeKey, legacy), key...)
 }
 
-func prependStoreKey(storeKey, key []byte) []byte {
-	return append(storePrefix(storeKey), key...)
+// getLegacyPrefixes returns whether the database uses the legacy store
+// prefixes, i.e. whether it was written before the prefixes were terminated. A
+// fresh database is marked as using the terminated prefixes.
+func getLegacyPrefixes(storage *grocksdb.DB) (bool, error) {
+	bz, err := storage.GetBytes(defaultReadOpts, []byte(prefixLayoutKey))
+	if err != nil {
+		return false, err
+	}
+	if len(bz) > 0 {
+		return false, nil
+	}
+
+	// every write sets the latest version
+	bz, err = storage.GetBytes(defaultReadOpts, []byte(latestVersionKey))
+	if err != nil {
+		return false, err
+	}
+	if len(bz) > 0 {
+		return true, nil
+	}
+
+	return false, storage.Put(defaultWriteOpts, []byte(prefixLayoutKey), []byte{1})
 }
 
 // copyAndFreeSlice will copy a given RocksDB slice and free it. If the slice does
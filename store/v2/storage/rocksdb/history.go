@@ -0,0 +1,145 @@
+//go:build rocksdb
+// +build rocksdb
+
+package rocksdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/errors"
+)
+
+// internalKeyFooterSize defines the size of the footer of RocksDB internal keys,
+// which packs the sequence number and the type of the entry.
+const internalKeyFooterSize = 8
+
+// RocksDB value types denoting a deletion, see db/dbformat.h.
+const (
+	typeDeletion              = 0x0
+	typeSingleDeletion        = 0x7
+	typeDeletionWithTimestamp = 0x14
+)
+
+var _ store.HistoryIterator = (*historyIterator)(nil)
+
+type historyEntry struct {
+	version   uint64
+	value     []byte
+	tombstone bool
+}
+
+// historyIterator implements the store.HistoryIterator interface over the
+// writes of a key. RocksDB iterates over the versions of a key from the newest
+// to the oldest, so the writes are read upfront and iterated over in reverse.
+type historyIterator struct {
+	entries []historyEntry
+	pos     int
+}
+
+// History returns an iterator over the writes of the given key between the
+// startVersion and endVersion, both inclusive.
+func (db *Database) History(storeKey, key []byte, startVersion, endVersion uint64) (store.HistoryIterator, error) {
+	if len(key) == 0 {
+		return nil, errors.ErrKeyEmpty
+	}
+
+	if startVersion > endVersion {
+		return nil, errors.ErrStartVersionAfterEnd
+	}
+
+	if startVersion < db.tsLow {
+		return nil, errors.ErrVersionPruned{EarliestVersion: db.tsLow}
+	}
+
+	var startTS [TimestampSize]byte
+	binary.LittleEndian.PutUint64(startTS[:], startVersion)
+
+	// Setting the iter_start_ts makes the iterator return every version of the
+	// keys, including deletions, with internal keys, i.e. the user key followed
+	// by the timestamp and the footer.
+	readOpts := newTSReadOptions(endVersion)
+	readOpts.SetIterStartTimestamp(startTS[:])
+	defer readOpts.Destroy()
+
+	source := db.storage.NewIteratorCF(readOpts, db.cfHandle)
+	defer source.Close()
+
+	prefixedKey := db.prependStoreKey(storeKey, key)
+
+	var entries []historyEntry
+	for source.Seek(prefixedKey); source.Valid(); source.Next() {
+		internalKey := copyAndFreeSlice(source.Key())
+		if len(internalKey) < TimestampSize+internalKeyFooterSize {
+			break
+		}
+
+		userKey := internalKey[:len(internalKey)-TimestampSize-internalKeyFooterSize]
+		if !bytes.Equal(userKey, prefixedKey) {
+			break
+		}
+
+		ts := internalKey[len(userKey) : len(userKey)+TimestampSize]
+		entry := historyEntry{version: binary.LittleEndian.Uint64(ts)}
+
+		switch internalKey[len(internalKey)-internalKeyFooterSize] {
+		case typeDeletion, typeSingleDeletion, typeDeletionWithTimestamp:
+			entry.tombstone = true
+		default:
+			entry.value = copyAndFreeSlice(source.Value())
+		}
+
+		entries = append(entries, entry)
+	}
+	if err := source.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(entries)
+
+	return &historyIterator{entries: entries}, nil
+}
+
+func (itr *historyIterator) Valid() bool {
+	return itr.pos < len(itr.entries)
+}
+
+func (itr *historyIterator) Next() {
+	if itr.Valid() {
+		itr.pos++
+	}
+}
+
+func (itr *historyIterator) Version() uint64 {
+	itr.assertIsValid()
+	return itr.entries[itr.pos].version
+}
+
+func (itr *historyIterator) Value() []byte {
+	itr.assertIsValid()
+	return itr.entries[itr.pos].value
+}
+
+func (itr *historyIterator) Tombstone() bool {
+	itr.assertIsValid()
+	return itr.entries[itr.pos].tombstone
+}
+
+func (itr *historyIterator) Error() error {
+	return nil
+}
+
+func (itr *historyIterator) Close() error {
+	itr.entries = nil
+	itr.pos = 0
+
+	return nil
+}
+
+func (itr *historyIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	_ "github.com/mattn/go-sqlite3"
 
@@ -22,6 +23,7 @@ const (
 	reservedStoreKey = "_RESERVED_"
 	keyLatestHeight  = "latest_height"
 	keyPruneHeight   = "prune_height"
+	keyPruneHeights  = "store_prune_heights"
 
 	reservedUpsertStmt = `
 	INSERT INTO state_storage(store_key, key, value, version)
@@ -43,7 +45,10 @@ const (
 	`
 )
 
-var _ storage.Database = (*Database)(nil)
+var (
+	_ storage.Database    = (*Database)(nil)
+	_ storage.StorePruner = (*Database)(nil)
+)
 
 type Database struct {
 	storage *sql.DB
@@ -51,6 +56,10 @@ type Database struct {
 	// earliestVersion defines the earliest version set in the database, which is
 	// only updated when the database is pruned.
 	earliestVersion uint64
+
+	// pruneHeights tracks the prune heights of the stores pruned separately.
+	pruneHeights    *storage.PruneHeights
+	pruneHeightsMtx sync.RWMutex
 }
 
 func New(dataDir string) (*Database, error) {
@@ -82,9 +91,15 @@ func New(dataDir string) (*Database, error) {
 		return nil, fmt.Errorf("failed to get prune height: %w", err)
 	}
 
+	pruneHeights, err := getStorePruneHeights(storage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store prune heights: %w", err)
+	}
+
 	return &Database{
 		storage:         storage,
 		earliestVersion: pruneHeight + 1,
+		pruneHeights:    pruneHeights,
 	}, nil
 }
 
@@ -138,8 +153,8 @@ func (db *Database) Has(storeKey []byte, version uint64, key []byte) (bool, erro
 }
 
 func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]byte, error) {
-	if targetVersion < db.earliestVersion {
-		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion}
+	if earliestVersion := db.storeEarliestVersion(storeKey); targetVersion < earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: earliestVersion}
 	}
 
 	stmt, err := db.storage.Prepare(`
@@ -182,10 +197,69 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 // We perform the prune by deleting all versions of a key, excluding reserved keys,
 // that are <= the given version, except for the latest version of the key.
 func (db *Database) Prune(version uint64) error {
+	return db.PruneExcept(version, nil)
+}
+
+// PruneExcept removes all versions of all keys that are <= the given version,
+// but the keys of the given stores. See Prune.
+func (db *Database) PruneExcept(version uint64, storeKeys [][]byte) error {
+	pruneHeights := db.clonePruneHeights()
+	pruneHeights.Exclude(storeKeys, db.earliestVersion-1)
+
+	cond := "store_key != ?"
+	args := []any{reservedStoreKey}
+	for _, storeKey := range storeKeys {
+		cond += " AND store_key != ?"
+		args = append(args, storeKey)
+	}
+
+	err := db.prune(version, cond, args, func(tx *sql.Tx) error {
+		// set the prune height so we can return <nil> for queries below this height
+		_, err := tx.Exec(reservedUpsertStmt, reservedStoreKey, keyPruneHeight, version, 0, version)
+		if err != nil {
+			return fmt.Errorf("failed to exec SQL statement: %w", err)
+		}
+
+		return setStorePruneHeights(tx, pruneHeights)
+	})
+	if err != nil {
+		return err
+	}
+
+	db.earliestVersion = version + 1
+	db.setPruneHeights(pruneHeights)
+
+	return nil
+}
+
+// PruneStore removes all versions of the keys of the given store that are <=
+// the given version. See Prune.
+func (db *Database) PruneStore(storeKey []byte, version uint64) error {
+	pruneHeights := db.clonePruneHeights()
+	pruneHeights.SetStoreHeight(storeKey, version)
+
+	err := db.prune(version, "store_key = ?", []any{storeKey}, func(tx *sql.Tx) error {
+		return setStorePruneHeights(tx, pruneHeights)
+	})
+	if err != nil {
+		return err
+	}
+
+	db.setPruneHeights(pruneHeights)
+
+	return nil
+}
+
+// prune removes the versions <= the given version of the keys matching the
+// given condition, and records the prune heights in the same transaction.
+func (db *Database) prune(version uint64, cond string, args []any, setPruneHeights func(*sql.Tx) error) error {
 	tx, err := db.storage.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to create SQL transaction: %w", err)
 	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
 
 	pruneStmt := `DELETE FROM state_storage
 	WHERE version < (
@@ -193,29 +267,49 @@ func (db *Database) Prune(version uint64) error {
 		t2.store_key = state_storage.store_key AND
 		t2.key = state_storage.key AND
 		t2.version <= ?
-	) AND store_key != ?;
+	) AND ` + cond + `;
 	`
 
-	_, err = tx.Exec(pruneStmt, version, reservedStoreKey)
+	_, err = tx.Exec(pruneStmt, append([]any{version}, args...)...)
 	if err != nil {
 		return fmt.Errorf("failed to exec SQL statement: %w", err)
 	}
 
-	// set the prune height so we can return <nil> for queries below this height
-	_, err = tx.Exec(reservedUpsertStmt, reservedStoreKey, keyPruneHeight, version, 0, version)
-	if err != nil {
-		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	if err := setPruneHeights(tx); err != nil {
+		return err
 	}
 
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("failed to write SQL transaction: %w", err)
 	}
 
-	db.earliestVersion = version + 1
-
 	return nil
 }
 
+// storeEarliestVersion returns the earliest version of the given store.
+func (db *Database) storeEarliestVersion(storeKey []byte) uint64 {
+	db.pruneHeightsMtx.RLock()
+	defer db.pruneHeightsMtx.RUnlock()
+
+	return db.pruneHeights.EarliestVersion(storeKey, db.earliestVersion, bytes.Equal)
+}
+
+// clonePruneHeights returns a copy of the prune heights of the stores, to be
+// updated by a pruning.
+func (db *Database) clonePruneHeights() *storage.PruneHeights {
+	db.pruneHeightsMtx.RLock()
+	defer db.pruneHeightsMtx.RUnlock()
+
+	return db.pruneHeights.Clone()
+}
+
+func (db *Database) setPruneHeights(pruneHeights *storage.PruneHeights) {
+	db.pruneHeightsMtx.Lock()
+	defer db.pruneHeightsMtx.Unlock()
+
+	db.pruneHeights = pruneHeights
+}
+
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, storeerrors.ErrKeyEmpty
@@ -275,6 +369,35 @@ func (db *Database) PrintRowsDebug() {
 	fmt.Println(strings.TrimSpace(sb.String()))
 }
 
+func setStorePruneHeights(tx *sql.Tx, pruneHeights *storage.PruneHeights) error {
+	bz, err := pruneHeights.Marshal()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(reservedUpsertStmt, reservedStoreKey, keyPruneHeights, bz, 0, bz)
+	if err != nil {
+		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	return nil
+}
+
+func getStorePruneHeights(db *sql.DB) (*storage.PruneHeights, error) {
+	var bz []byte
+	err := db.QueryRow(`SELECT value FROM state_storage WHERE store_key = ? AND key = ?`, reservedStoreKey, keyPruneHeights).Scan(&bz)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// in case no store was pruned separately
+			return &storage.PruneHeights{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to query row: %w", err)
+	}
+
+	return storage.UnmarshalPruneHeights(bz)
+}
+
 func getPruneHeight(storage *sql.DB) (uint64, error) {
 	stmt, err := storage.Prepare(`SELECT value FROM state_storage WHERE store_key = ? AND key = ?`)
 	if err != nil {
@@ -0,0 +1,155 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"slices"
+
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+// historyStmt selects the writes of a key within a version range. A row is
+// written when the key is set and its tombstone is set when the key is
+// deleted, so each row yields a set at its version and, if tombstoned, a delete
+// at its tombstone. A row set and tombstoned at the same version only yields
+// the delete.
+const historyStmt = `
+	SELECT version, value, 0 AS deleted FROM state_storage
+	WHERE store_key = ? AND key = ? AND version >= ? AND version <= ? AND tombstone != version
+	UNION ALL
+	SELECT tombstone, NULL, 1 AS deleted FROM state_storage
+	WHERE store_key = ? AND key = ? AND tombstone != 0 AND tombstone >= ? AND tombstone <= ?
+	ORDER BY 1 ASC, 3 ASC;
+	`
+
+var _ store.HistoryIterator = (*historyIterator)(nil)
+
+type historyIterator struct {
+	statement *sql.Stmt
+	rows      *sql.Rows
+	version   uint64
+	value     []byte
+	tombstone bool
+	valid     bool
+	err       error
+}
+
+// History returns an iterator over the writes of the given key between the
+// startVersion and endVersion, both inclusive.
+func (db *Database) History(storeKey, key []byte, startVersion, endVersion uint64) (store.HistoryIterator, error) {
+	if len(key) == 0 {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+
+	if startVersion > endVersion {
+		return nil, storeerrors.ErrStartVersionAfterEnd
+	}
+
+	if earliestVersion := db.storeEarliestVersion(storeKey); startVersion < earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: earliestVersion}
+	}
+
+	// SQLite integers are signed, so versions are capped accordingly.
+	if endVersion > math.MaxInt64 {
+		endVersion = math.MaxInt64
+	}
+
+	stmt, err := db.storage.Prepare(historyStmt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+
+	rows, err := stmt.Query(storeKey, key, startVersion, endVersion, storeKey, key, startVersion, endVersion)
+	if err != nil {
+		_ = stmt.Close()
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	itr := &historyIterator{
+		statement: stmt,
+		rows:      rows,
+		valid:     rows.Next(),
+	}
+	itr.parseRow()
+
+	return itr, nil
+}
+
+func (itr *historyIterator) Valid() bool {
+	return itr.valid
+}
+
+func (itr *historyIterator) Next() {
+	if !itr.valid {
+		return
+	}
+
+	itr.valid = itr.rows.Next()
+	itr.parseRow()
+}
+
+func (itr *historyIterator) Version() uint64 {
+	itr.assertIsValid()
+	return itr.version
+}
+
+func (itr *historyIterator) Value() []byte {
+	itr.assertIsValid()
+	return itr.value
+}
+
+func (itr *historyIterator) Tombstone() bool {
+	itr.assertIsValid()
+	return itr.tombstone
+}
+
+func (itr *historyIterator) Error() error {
+	if err := itr.rows.Err(); err != nil {
+		return err
+	}
+
+	return itr.err
+}
+
+func (itr *historyIterator) Close() error {
+	itr.valid = false
+
+	if err := itr.rows.Close(); err != nil {
+		_ = itr.statement.Close()
+		return err
+	}
+
+	return itr.statement.Close()
+}
+
+func (itr *historyIterator) parseRow() {
+	if !itr.valid {
+		return
+	}
+
+	var (
+		version uint64
+		value   []byte
+		deleted bool
+	)
+	if err := itr.rows.Scan(&version, &value, &deleted); err != nil {
+		itr.err = fmt.Errorf("failed to scan row: %w", err)
+		itr.valid = false
+		return
+	}
+
+	itr.version = version
+	itr.tombstone = deleted
+	itr.value = nil
+	if !deleted {
+		itr.value = slices.Clone(value)
+	}
+}
+
+func (itr *historyIterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
@@ -183,6 +183,38 @@ func (s *StorageTestSuite) TestDatabase_ApplyChangeset() {
 	}
 }
 
+func (s *StorageTestSuite) TestDatabase_IteratorOverlappingStoreKeys() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	// the key of a store prefixes the one of another store
+	cs := corestore.NewChangeset()
+	cs.AddKVPair([]byte("acc"), corestore.KVPair{Key: []byte("key"), Value: []byte("acc")})
+	cs.AddKVPair([]byte("accounts"), corestore.KVPair{Key: []byte("key"), Value: []byte("accounts")})
+	s.Require().NoError(db.ApplyChangeset(1, cs))
+
+	for _, reverse := range []bool{false, true} {
+		var (
+			iter corestore.Iterator
+			err  error
+		)
+		if reverse {
+			iter, err = db.ReverseIterator([]byte("acc"), 1, nil, nil)
+		} else {
+			iter, err = db.Iterator([]byte("acc"), 1, nil, nil)
+		}
+		s.Require().NoError(err)
+
+		var values []string
+		for ; iter.Valid(); iter.Next() {
+			values = append(values, string(iter.Value()))
+		}
+		s.Require().NoError(iter.Close())
+		s.Require().Equal([]string{"acc"}, values)
+	}
+}
+
 func (s *StorageTestSuite) TestDatabase_IteratorEmptyDomain() {
 	db, err := s.NewDB(s.T().TempDir())
 	s.Require().NoError(err)
@@ -636,6 +668,75 @@ func (s *StorageTestSuite) TestDatabase_Prune_KeepRecent() {
 	s.Require().Equal([]byte("val200"), bz)
 }
 
+func (s *StorageTestSuite) TestDatabase_History() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	key := []byte("key")
+
+	// set, update, delete and set again the key, interleaved with writes to a
+	// key sharing its prefix
+	DBApplyChangeset(s.T(), db, 1, storeKey1, [][]byte{key}, [][]byte{[]byte("val001")})
+	DBApplyChangeset(s.T(), db, 2, storeKey1, [][]byte{[]byte("key1")}, [][]byte{[]byte("val002")})
+	DBApplyChangeset(s.T(), db, 3, storeKey1, [][]byte{key}, [][]byte{[]byte("val003")})
+	DBApplyChangeset(s.T(), db, 4, storeKey1, [][]byte{[]byte("key1")}, [][]byte{[]byte("val004")})
+	DBApplyChangeset(s.T(), db, 5, storeKey1, [][]byte{key}, [][]byte{nil})
+	DBApplyChangeset(s.T(), db, 7, storeKey1, [][]byte{key}, [][]byte{[]byte("val007")})
+
+	type write struct {
+		version   uint64
+		value     []byte
+		tombstone bool
+	}
+
+	history := func(start, end uint64) []write {
+		itr, err := db.History(storeKey1Bytes, key, start, end)
+		s.Require().NoError(err)
+		defer itr.Close()
+
+		var writes []write
+		for ; itr.Valid(); itr.Next() {
+			writes = append(writes, write{version: itr.Version(), value: itr.Value(), tombstone: itr.Tombstone()})
+		}
+		s.Require().NoError(itr.Error())
+
+		return writes
+	}
+
+	s.Require().Equal([]write{
+		{version: 1, value: []byte("val001")},
+		{version: 3, value: []byte("val003")},
+		{version: 5, tombstone: true},
+		{version: 7, value: []byte("val007")},
+	}, history(1, 10))
+	s.Require().Equal([]write{
+		{version: 3, value: []byte("val003")},
+		{version: 5, tombstone: true},
+	}, history(2, 5))
+	s.Require().Equal([]write{{version: 7, value: []byte("val007")}}, history(7, 7))
+	s.Require().Empty(history(8, 10))
+
+	_, err = db.History(storeKey1Bytes, key, 5, 4)
+	s.Require().Error(err)
+
+	// versions up to and including the prune version are no longer available
+	s.Require().NoError(db.Prune(2))
+
+	_, err = db.History(storeKey1Bytes, key, 2, 10)
+	s.Require().Error(err)
+
+	s.Require().Equal([]write{
+		{version: 3, value: []byte("val003")},
+		{version: 5, tombstone: true},
+		{version: 7, value: []byte("val007")},
+	}, history(3, 10))
+}
+
 func DBApplyChangeset(
 	t *testing.T,
 	db store.VersionedDatabase,
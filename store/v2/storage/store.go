@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"errors"
 	"fmt"
 
 	corestore "cosmossdk.io/core/store"
@@ -76,15 +77,55 @@ func (ss *StorageStore) ApplyChangeset(version uint64, cs *corestore.Changeset)
 		return err
 	}
 
-	if prune, pruneVersion := ss.pruneOptions.ShouldPrune(version); prune {
-		if err := ss.Prune(pruneVersion); err != nil {
-			ss.logger.Info("failed to prune SS", "prune_version", pruneVersion, "err", err)
-		}
+	if err := ss.prune(version); err != nil {
+		ss.logger.Info("failed to prune SS", "version", version, "err", err)
 	}
 
 	return nil
 }
 
+// prune prunes the stores according to the pruning options, after the given
+// version is written. The stores with their own options are pruned separately
+// if the database implements StorePruner, otherwise all the stores are pruned
+// up to the version pruned by all of them.
+func (ss *StorageStore) prune(version uint64) error {
+	opts := ss.pruneOptions
+	if len(opts.StoreOptions) == 0 {
+		if prune, pruneVersion := opts.ShouldPrune(version); prune {
+			return ss.db.Prune(pruneVersion)
+		}
+		return nil
+	}
+
+	pruner, ok := ss.db.(StorePruner)
+	if !ok {
+		if pruneVersion := opts.PrunedVersion(version); pruneVersion > opts.PrunedVersion(version-1) {
+			return ss.db.Prune(pruneVersion)
+		}
+		return nil
+	}
+
+	var (
+		storeKeys = make([][]byte, 0, len(opts.StoreOptions))
+		errs      error
+	)
+	for storeKey, storeOpts := range opts.StoreOptions {
+		storeKeys = append(storeKeys, []byte(storeKey))
+		if prune, pruneVersion := storeOpts.ShouldPrune(version); prune {
+			if err := pruner.PruneStore([]byte(storeKey), pruneVersion); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("failed to prune store %s: %w", storeKey, err))
+			}
+		}
+	}
+	if prune, pruneVersion := opts.ShouldPrune(version); prune {
+		if err := pruner.PruneExcept(pruneVersion, storeKeys); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return errs
+}
+
 // GetLatestVersion returns the latest version of the store.
 func (ss *StorageStore) GetLatestVersion() (uint64, error) {
 	return ss.db.GetLatestVersion()
@@ -105,9 +146,56 @@ func (ss *StorageStore) ReverseIterator(storeKey []byte, version uint64, start,
 	return ss.db.ReverseIterator(storeKey, version, start, end)
 }
 
-// Prune prunes the store up to the given version.
+// History returns an iterator over the writes of the given key between the
+// given versions, both inclusive.
+func (ss *StorageStore) History(storeKey, key []byte, startVersion, endVersion uint64) (store.HistoryIterator, error) {
+	return ss.db.History(storeKey, key, startVersion, endVersion)
+}
+
+// Prune prunes the stores up to the given version. The stores with their own
+// pruning options are pruned as allowed by them at the latest version, see
+// PruneOptions.StorePruneVersion, separately if the database implements
+// StorePruner, otherwise all the stores are pruned up to the version pruned by
+// all of them.
 func (ss *StorageStore) Prune(version uint64) error {
-	return ss.db.Prune(version)
+	opts := ss.pruneOptions
+	if len(opts.StoreOptions) == 0 {
+		return ss.db.Prune(version)
+	}
+
+	latestVersion, err := ss.db.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	pruner, ok := ss.db.(StorePruner)
+	if !ok {
+		for storeKey := range opts.StoreOptions {
+			version = min(version, opts.StorePruneVersion(storeKey, version, latestVersion))
+		}
+		if version == 0 {
+			return nil
+		}
+		return ss.db.Prune(version)
+	}
+
+	var (
+		storeKeys = make([][]byte, 0, len(opts.StoreOptions))
+		errs      error
+	)
+	for storeKey := range opts.StoreOptions {
+		storeKeys = append(storeKeys, []byte(storeKey))
+		if pruneVersion := opts.StorePruneVersion(storeKey, version, latestVersion); pruneVersion > 0 {
+			if err := pruner.PruneStore([]byte(storeKey), pruneVersion); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("failed to prune store %s: %w", storeKey, err))
+			}
+		}
+	}
+	if err := pruner.PruneExcept(version, storeKeys); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	return errs
 }
 
 // Restore restores the store from the given channel.
@@ -116,8 +204,11 @@ func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.Stat
 	if err != nil {
 		return fmt.Errorf("failed to get latest version: %w", err)
 	}
-	if version <= latestVersion {
-		return fmt.Errorf("the snapshot version %d is not greater than latest version %d", version, latestVersion)
+	// the KV pairs of a snapshot may be restored in several calls, e.g. when a
+	// restore which was interrupted is resumed, which are all at the version of the
+	// snapshot.
+	if version < latestVersion {
+		return fmt.Errorf("the snapshot version %d is lower than latest version %d", version, latestVersion)
 	}
 
 	b, err := ss.db.NewBatch(version)
@@ -0,0 +1,218 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+// noStorePruner hides the StorePruner implementation of the database.
+type noStorePruner struct {
+	storage.Database
+}
+
+func TestStorageStore_PruneStoreOptions(t *testing.T) {
+	pruneOpts := &store.PruneOptions{
+		KeepRecent: 10,
+		Interval:   10,
+		StoreOptions: map[string]store.PruneOptions{
+			"staking": {Interval: 0},
+			"ibc":     {KeepRecent: 2, Interval: 1},
+		},
+	}
+	// stakingpool and ibcx, whose keys are prefixed by the ones of the stores
+	// with their own options, follow the default options
+	storeKeys := []string{"bank", "staking", "ibc", "stakingpool", "ibcx"}
+
+	backends := map[string]func(dir string) (storage.Database, error){
+		"pebbledb": func(dir string) (storage.Database, error) {
+			db, err := pebbledb.New(dir)
+			if err == nil {
+				db.SetSync(false)
+			}
+			return db, err
+		},
+		"sqlite": func(dir string) (storage.Database, error) {
+			return sqlite.New(dir)
+		},
+	}
+
+	// get returns the value of the key of the store at the version, or the
+	// error of the query.
+	get := func(ss *storage.StorageStore, storeKey string, version uint64) (string, error) {
+		bz, err := ss.Get([]byte(storeKey), version, []byte("key"))
+		return string(bz), err
+	}
+
+	for name, newDB := range backends {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			db, err := newDB(dir)
+			require.NoError(t, err)
+			ss := storage.NewStorageStore(db, pruneOpts, log.NewNopLogger())
+
+			for v := uint64(1); v <= 30; v++ {
+				cs := corestore.NewChangeset()
+				for _, storeKey := range storeKeys {
+					cs.AddKVPair([]byte(storeKey), corestore.KVPair{Key: []byte("key"), Value: []byte(fmt.Sprintf("v%d", v))})
+				}
+				require.NoError(t, ss.ApplyChangeset(v, cs))
+			}
+
+			check := func(ss *storage.StorageStore) {
+				// bank is pruned up to 30-10-1
+				_, err := get(ss, "bank", 19)
+				require.Error(t, err)
+				value, err := get(ss, "bank", 20)
+				require.NoError(t, err)
+				require.Equal(t, "v20", value)
+
+				// staking is never pruned
+				value, err = get(ss, "staking", 1)
+				require.NoError(t, err)
+				require.Equal(t, "v1", value)
+
+				// ibc is pruned up to 30-2-1
+				_, err = get(ss, "ibc", 27)
+				require.Error(t, err)
+				itr, err := ss.Iterator([]byte("ibc"), 27, nil, nil)
+				require.NoError(t, err)
+				require.False(t, itr.Valid())
+				require.NoError(t, itr.Close())
+				value, err = get(ss, "ibc", 28)
+				require.NoError(t, err)
+				require.Equal(t, "v28", value)
+
+				// stakingpool and ibcx are pruned up to 30-10-1 like bank
+				for _, storeKey := range []string{"stakingpool", "ibcx"} {
+					_, err = get(ss, storeKey, 19)
+					require.Error(t, err)
+					value, err = get(ss, storeKey, 20)
+					require.NoError(t, err)
+					require.Equal(t, "v20", value)
+				}
+			}
+			check(ss)
+
+			// the prune heights of the stores are persisted
+			require.NoError(t, ss.Close())
+			db, err = newDB(dir)
+			require.NoError(t, err)
+			ss = storage.NewStorageStore(db, pruneOpts, log.NewNopLogger())
+			check(ss)
+
+			// once staking is no longer excluded, it's pruned with the other stores
+			ss = storage.NewStorageStore(db, &store.PruneOptions{KeepRecent: 10, Interval: 10}, log.NewNopLogger())
+			cs := corestore.NewChangeset()
+			cs.AddKVPair([]byte("bank"), corestore.KVPair{Key: []byte("key"), Value: []byte("v40")})
+			require.NoError(t, ss.ApplyChangeset(40, cs))
+			_, err = get(ss, "staking", 29)
+			require.Error(t, err)
+			value, err := get(ss, "staking", 30)
+			require.NoError(t, err)
+			require.Equal(t, "v30", value)
+			_, err = get(ss, "ibc", 28)
+			require.Error(t, err)
+			require.NoError(t, ss.Close())
+		})
+	}
+
+	t.Run("no store pruner", func(t *testing.T) {
+		db, err := sqlite.New(t.TempDir())
+		require.NoError(t, err)
+		ss := storage.NewStorageStore(noStorePruner{db}, pruneOpts, log.NewNopLogger())
+		defer ss.Close()
+
+		for v := uint64(1); v <= 30; v++ {
+			cs := corestore.NewChangeset()
+			cs.AddKVPair([]byte("bank"), corestore.KVPair{Key: []byte("key"), Value: []byte(fmt.Sprintf("v%d", v))})
+			require.NoError(t, ss.ApplyChangeset(v, cs))
+		}
+
+		// nothing is pruned, as staking keeps all the versions
+		value, err := get(ss, "bank", 1)
+		require.NoError(t, err)
+		require.Equal(t, "v1", value)
+	})
+}
+
+func TestStorageStore_PruneManually(t *testing.T) {
+	// the stores aren't pruned on commit until version 100
+	pruneOpts := &store.PruneOptions{
+		KeepRecent: 10,
+		Interval:   100,
+		StoreOptions: map[string]store.PruneOptions{
+			"staking": {Interval: 0},
+			"ibc":     {KeepRecent: 10, Interval: 100},
+		},
+	}
+	storeKeys := []string{"bank", "staking", "ibc"}
+
+	get := func(ss *storage.StorageStore, storeKey string, version uint64) (string, error) {
+		bz, err := ss.Get([]byte(storeKey), version, []byte("key"))
+		return string(bz), err
+	}
+
+	newStore := func(t *testing.T, db storage.Database) *storage.StorageStore {
+		t.Helper()
+		ss := storage.NewStorageStore(db, pruneOpts, log.NewNopLogger())
+		for v := uint64(1); v <= 30; v++ {
+			cs := corestore.NewChangeset()
+			for _, storeKey := range storeKeys {
+				cs.AddKVPair([]byte(storeKey), corestore.KVPair{Key: []byte("key"), Value: []byte(fmt.Sprintf("v%d", v))})
+			}
+			require.NoError(t, ss.ApplyChangeset(v, cs))
+		}
+		return ss
+	}
+
+	t.Run("store pruner", func(t *testing.T) {
+		db, err := pebbledb.New(t.TempDir())
+		require.NoError(t, err)
+		db.SetSync(false)
+		ss := newStore(t, db)
+		defer ss.Close()
+
+		require.NoError(t, ss.Prune(25))
+
+		// bank is pruned up to 25
+		_, err = get(ss, "bank", 25)
+		require.Error(t, err)
+		value, err := get(ss, "bank", 26)
+		require.NoError(t, err)
+		require.Equal(t, "v26", value)
+
+		// staking is never pruned
+		value, err = get(ss, "staking", 1)
+		require.NoError(t, err)
+		require.Equal(t, "v1", value)
+
+		// ibc keeps its 10 latest versions, it's pruned up to 30-10-1
+		_, err = get(ss, "ibc", 19)
+		require.Error(t, err)
+		value, err = get(ss, "ibc", 20)
+		require.NoError(t, err)
+		require.Equal(t, "v20", value)
+	})
+
+	t.Run("no store pruner", func(t *testing.T) {
+		db, err := sqlite.New(t.TempDir())
+		require.NoError(t, err)
+		ss := newStore(t, noStorePruner{db})
+		defer ss.Close()
+
+		// nothing is pruned, as staking keeps all the versions
+		require.NoError(t, ss.Prune(25))
+		value, err := get(ss, "bank", 1)
+		require.NoError(t, err)
+		require.Equal(t, "v1", value)
+	})
+}
@@ -31,6 +31,11 @@ type RootStore interface {
 	// and key tuple. Queries should be routed to the underlying SS engine.
 	Query(storeKey []byte, version uint64, key []byte, prove bool) (QueryResult, error)
 
+	// QueryMultiProof performs a query on the RootStore for the given keys across
+	// stores at the given version, and returns their values along with a single
+	// proof of all of them against the commit info hash.
+	QueryMultiProof(version uint64, items []proof.MultiProofItem) (MultiQueryResult, error)
+
 	// LoadVersion loads the RootStore to the given version.
 	LoadVersion(version uint64) error
 
@@ -99,3 +104,28 @@ type QueryResult struct {
 	Version  uint64
 	ProofOps []proof.CommitmentOp
 }
+
+// MultiQueryResult defines the response type to performing a multi-proof query
+// on a RootStore. The values of the items are nil for missing keys. The items
+// whose error is set, at the same index in Errors, aren't proven by Proof,
+// which is nil if no item is proven.
+type MultiQueryResult struct {
+	Items   []proof.MultiProofItem
+	Errors  []error
+	Version uint64
+	Proof   *proof.MultiProof
+}
+
+// ProvenItems returns the items proven by the proof of the result. The items
+// beyond the end of Errors are deemed proven.
+func (r MultiQueryResult) ProvenItems() []proof.MultiProofItem {
+	items := make([]proof.MultiProofItem, 0, len(r.Items))
+	for i, item := range r.Items {
+		if i < len(r.Errors) && r.Errors[i] != nil {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
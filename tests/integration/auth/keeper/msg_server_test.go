@@ -15,6 +15,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/accounts"
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
 	"cosmossdk.io/x/auth"
 	authkeeper "cosmossdk.io/x/auth/keeper"
 	authsims "cosmossdk.io/x/auth/simulation"
@@ -31,6 +32,7 @@ import (
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/integration"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -280,3 +282,62 @@ func TestAsyncExec(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateAccount(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+	assert.NilError(t, testutil.FundAccount(f.ctx, f.bankKeeper, addr, coins))
+
+	acc := f.authKeeper.NewAccountWithAddress(f.ctx, addr)
+	assert.NilError(t, acc.SetPubKey(privKey.PubKey()))
+	assert.NilError(t, acc.SetSequence(5))
+	f.authKeeper.SetAccount(f.ctx, acc)
+
+	initMsg, err := codectypes.NewAnyWithValue(&basev1.MsgInit{})
+	assert.NilError(t, err)
+
+	_, err = f.app.RunMsg(
+		&authtypes.MsgMigrateAccount{
+			Signer:         addr.String(),
+			AccountType:    "base",
+			AccountInitMsg: initMsg,
+		},
+		integration.WithAutomaticFinalizeBlock(),
+		integration.WithAutomaticCommit(),
+	)
+	assert.NilError(t, err)
+
+	// the account moved to x/accounts with its address, number and balances
+	ctx := f.app.Context()
+	assert.Assert(t, f.authKeeper.GetAccount(ctx, addr) == nil)
+	assert.Assert(t, f.accountsKeeper.IsAccountsModuleAccount(ctx, addr))
+	accNum, err := f.accountsKeeper.AccountByNumber.Get(ctx, addr)
+	assert.NilError(t, err)
+	assert.Equal(t, acc.GetAccountNumber(), accNum)
+	assert.DeepEqual(t, coins, f.bankKeeper.GetAllBalances(ctx, addr))
+
+	// and with its sequence
+	resp, err := f.accountsKeeper.Query(ctx, addr, &basev1.QuerySequence{})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(5), resp.(*basev1.QuerySequenceResponse).Sequence)
+
+	// the sequence can only be set when migrating
+	_, _, err = f.accountsKeeper.Init(ctx, "base", addr, &basev1.MsgInit{PubKey: privKey.PubKey().Bytes(), InitSequence: 5}, nil)
+	assert.ErrorContains(t, err, "the init sequence can only be set when migrating a legacy account")
+
+	// it can't be migrated twice
+	_, err = f.app.RunMsg(
+		&authtypes.MsgMigrateAccount{
+			Signer:         addr.String(),
+			AccountType:    "base",
+			AccountInitMsg: initMsg,
+		},
+		integration.WithAutomaticFinalizeBlock(),
+		integration.WithAutomaticCommit(),
+	)
+	assert.ErrorContains(t, err, "does not exist")
+}
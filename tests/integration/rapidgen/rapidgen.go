@@ -100,6 +100,7 @@ var (
 	SignableTypes = []GeneratedType{
 		// auth
 		GenType(&authtypes.MsgUpdateParams{}, &authapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
+		GenType(&authtypes.MsgUpdateFeeMarketParams{}, &authapi.MsgUpdateFeeMarketParams{}, GenOpts.WithDisallowNil()),
 
 		// authz
 		GenType(&authztypes.MsgGrant{}, &authzapi.MsgGrant{},
@@ -122,6 +123,7 @@ var (
 
 		// consensus
 		GenType(&consensustypes.MsgUpdateParams{}, &consensusapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
+		GenType(&consensustypes.MsgUpdateGasSchedule{}, &consensusapi.MsgUpdateGasSchedule{}, GenOpts),
 
 		// distribution
 		GenType(&disttypes.MsgWithdrawDelegatorReward{}, &distapi.MsgWithdrawDelegatorReward{}, GenOpts),
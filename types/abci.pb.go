@@ -274,6 +274,9 @@ type GasInfo struct {
 	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
 	// GasUsed is the amount of gas actually consumed.
 	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// GasProfile attributes the gas consumed, it is only set when gas profiling
+	// is enabled.
+	GasProfile []GasProfileEntry `protobuf:"bytes,3,rep,name=gas_profile,json=gasProfile,proto3" json:"gas_profile"`
 }
 
 func (m *GasInfo) Reset()      { *m = GasInfo{} }
@@ -322,6 +325,89 @@ func (m *GasInfo) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *GasInfo) GetGasProfile() []GasProfileEntry {
+	if m != nil {
+		return m.GasProfile
+	}
+	return nil
+}
+
+// GasProfileEntry defines the gas consumed by a kind of operation on a store
+// while executing a message.
+type GasProfileEntry struct {
+	// MsgTypeURL is the type URL of the message being executed, empty outside of
+	// the messages execution, e.g. in the ante handler.
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Store is the name of the store key, which identifies the module owning the
+	// store. It is empty for the gas not consumed by store operations.
+	Store string `protobuf:"bytes,2,opt,name=store,proto3" json:"store,omitempty"`
+	// Operation is the kind of store operation: read, write, iterate, has or
+	// delete, or other for the gas not consumed by store operations.
+	Operation string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Gas is the amount of gas consumed.
+	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *GasProfileEntry) Reset()      { *m = GasProfileEntry{} }
+func (*GasProfileEntry) ProtoMessage() {}
+func (*GasProfileEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+}
+func (m *GasProfileEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasProfileEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasProfileEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasProfileEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasProfileEntry.Merge(m, src)
+}
+func (m *GasProfileEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasProfileEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasProfileEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasProfileEntry proto.InternalMessageInfo
+
+func (m *GasProfileEntry) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 // Result is the union of ResponseFormat and ResponseCheckTx.
 type Result struct {
 	// Data is any data returned from message or handler execution. It MUST be
@@ -341,7 +427,7 @@ type Result struct {
 func (m *Result) Reset()      { *m = Result{} }
 func (*Result) ProtoMessage() {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+	return fileDescriptor_4e37629bc7eb0df8, []int{6}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +466,7 @@ type SimulationResponse struct {
 func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
 func (*SimulationResponse) ProtoMessage() {}
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{6}
+	return fileDescriptor_4e37629bc7eb0df8, []int{7}
 }
 func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +514,7 @@ type MsgData struct {
 func (m *MsgData) Reset()      { *m = MsgData{} }
 func (*MsgData) ProtoMessage() {}
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{7}
+	return fileDescriptor_4e37629bc7eb0df8, []int{8}
 }
 func (m *MsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,7 +569,7 @@ type TxMsgData struct {
 func (m *TxMsgData) Reset()      { *m = TxMsgData{} }
 func (*TxMsgData) ProtoMessage() {}
 func (*TxMsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{8}
+	return fileDescriptor_4e37629bc7eb0df8, []int{9}
 }
 func (m *TxMsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +632,7 @@ type SearchTxsResult struct {
 func (m *SearchTxsResult) Reset()      { *m = SearchTxsResult{} }
 func (*SearchTxsResult) ProtoMessage() {}
 func (*SearchTxsResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{9}
+	return fileDescriptor_4e37629bc7eb0df8, []int{10}
 }
 func (m *SearchTxsResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +722,7 @@ type SearchBlocksResult struct {
 func (m *SearchBlocksResult) Reset()      { *m = SearchBlocksResult{} }
 func (*SearchBlocksResult) ProtoMessage() {}
 func (*SearchBlocksResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{10}
+	return fileDescriptor_4e37629bc7eb0df8, []int{11}
 }
 func (m *SearchBlocksResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,6 +799,7 @@ func init() {
 	proto.RegisterType((*StringEvent)(nil), "cosmos.base.abci.v1beta1.StringEvent")
 	proto.RegisterType((*Attribute)(nil), "cosmos.base.abci.v1beta1.Attribute")
 	proto.RegisterType((*GasInfo)(nil), "cosmos.base.abci.v1beta1.GasInfo")
+	proto.RegisterType((*GasProfileEntry)(nil), "cosmos.base.abci.v1beta1.GasProfileEntry")
 	proto.RegisterType((*Result)(nil), "cosmos.base.abci.v1beta1.Result")
 	proto.RegisterType((*SimulationResponse)(nil), "cosmos.base.abci.v1beta1.SimulationResponse")
 	proto.RegisterType((*MsgData)(nil), "cosmos.base.abci.v1beta1.MsgData")
@@ -726,70 +813,76 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0x5b, 0x37,
-	0x17, 0xd5, 0xd3, 0x53, 0x9e, 0x2c, 0xca, 0xfe, 0xfc, 0x81, 0x35, 0xe2, 0xe7, 0x20, 0x91, 0x54,
-	0x25, 0x05, 0x84, 0x02, 0x79, 0x8a, 0x9d, 0x26, 0x68, 0x33, 0x25, 0x72, 0x7f, 0xc4, 0x40, 0x92,
-	0x81, 0x56, 0x50, 0xa0, 0x8b, 0x40, 0x49, 0x34, 0xf5, 0x60, 0xbd, 0x47, 0x41, 0xa4, 0x6c, 0x79,
-	0xeb, 0xd8, 0xb1, 0x53, 0xa7, 0x0e, 0x5d, 0xdb, 0xb9, 0x7b, 0xd7, 0x8c, 0x1e, 0xd3, 0x22, 0x70,
-	0x53, 0x7b, 0xeb, 0x5f, 0x51, 0xdc, 0x4b, 0x3e, 0x49, 0xa9, 0x2b, 0x77, 0xe8, 0x46, 0x9e, 0x7b,
-	0x49, 0xde, 0x73, 0xee, 0x21, 0x49, 0x6e, 0xf7, 0x94, 0x4e, 0x94, 0x6e, 0x76, 0xb9, 0x16, 0x4d,
-	0xde, 0xed, 0xc5, 0xcd, 0xa3, 0xed, 0xae, 0x30, 0x7c, 0x1b, 0x27, 0xd1, 0x68, 0xac, 0x8c, 0xa2,
-	0xa1, 0x4d, 0x8a, 0x20, 0x29, 0x42, 0xdc, 0x25, 0xdd, 0xd8, 0x90, 0x4a, 0x2a, 0x4c, 0x6a, 0xc2,
-	0xc8, 0xe6, 0xdf, 0xb8, 0xd9, 0x53, 0x89, 0x30, 0xdd, 0x03, 0x93, 0xed, 0xd8, 0x34, 0x27, 0x23,
-	0xa1, 0x5d, 0xf4, 0xd6, 0x2c, 0x8a, 0x28, 0x84, 0xbb, 0x43, 0xd5, 0x3b, 0x74, 0xe1, 0x2d, 0xa9,
-	0x94, 0x1c, 0x8a, 0x26, 0xce, 0xba, 0x93, 0x83, 0x26, 0x4f, 0x4f, 0xb2, 0x90, 0xad, 0xa3, 0x63,
-	0x0f, 0x74, 0x45, 0xe1, 0xa4, 0xfe, 0xd6, 0x27, 0xa4, 0x3d, 0x65, 0x42, 0x8f, 0x54, 0xaa, 0x05,
-	0xbd, 0x4e, 0x82, 0x81, 0x88, 0xe5, 0xc0, 0x84, 0x5e, 0xcd, 0x6b, 0xf8, 0xcc, 0xcd, 0x68, 0x9d,
-	0x04, 0x66, 0x3a, 0xe0, 0x7a, 0x10, 0xe6, 0x6b, 0x5e, 0xa3, 0xd4, 0x22, 0xe7, 0x67, 0xd5, 0xa0,
-	0x3d, 0x7d, 0xca, 0xf5, 0x80, 0xb9, 0x08, 0xbd, 0x49, 0x4a, 0x3d, 0xd5, 0x17, 0x7a, 0xc4, 0x7b,
-	0x22, 0xf4, 0x21, 0x8d, 0xcd, 0x01, 0x4a, 0x49, 0x01, 0x26, 0x61, 0xa1, 0xe6, 0x35, 0xd6, 0x18,
-	0x8e, 0x01, 0xeb, 0x73, 0xc3, 0xc3, 0x6b, 0x98, 0x8c, 0x63, 0xba, 0x49, 0x8a, 0x63, 0x7e, 0xdc,
-	0x19, 0x2a, 0x19, 0x06, 0x08, 0x07, 0x63, 0x7e, 0xfc, 0x4c, 0x49, 0xfa, 0x92, 0x14, 0x86, 0x4a,
-	0xea, 0xb0, 0x58, 0xf3, 0x1b, 0xe5, 0x9d, 0x46, 0xb4, 0x4c, 0xdb, 0xe8, 0x49, 0x6b, 0x77, 0xef,
-	0xb9, 0xd0, 0x9a, 0x4b, 0xf1, 0x4c, 0xc9, 0xd6, 0xe6, 0xab, 0xb3, 0x6a, 0xee, 0xa7, 0xdf, 0xab,
-	0xeb, 0xef, 0xe2, 0x9a, 0xe1, 0x76, 0x50, 0x43, 0x9c, 0x1e, 0xa8, 0x70, 0xc5, 0xd6, 0x00, 0x63,
-	0x7a, 0x8b, 0x10, 0xc9, 0x75, 0xe7, 0x98, 0xa7, 0x46, 0xf4, 0xc3, 0x12, 0x2a, 0x51, 0x92, 0x5c,
-	0x7f, 0x89, 0x00, 0xdd, 0x22, 0x2b, 0x10, 0x9e, 0x68, 0xd1, 0x0f, 0x09, 0x06, 0x8b, 0x92, 0xeb,
-	0x97, 0x5a, 0xf4, 0xe9, 0x1d, 0x92, 0x37, 0xd3, 0xb0, 0x5c, 0xf3, 0x1a, 0xe5, 0x9d, 0x8d, 0xc8,
-	0x76, 0x24, 0xca, 0x3a, 0x12, 0x3d, 0x49, 0x4f, 0x58, 0xde, 0x4c, 0x41, 0x29, 0x13, 0x27, 0x42,
-	0x1b, 0x9e, 0x8c, 0xc2, 0x55, 0xab, 0xd4, 0x0c, 0xa0, 0x4f, 0x49, 0x20, 0x8e, 0x44, 0x6a, 0x74,
-	0xb8, 0x86, 0x54, 0x37, 0xa3, 0xac, 0xf1, 0x19, 0xcf, 0xe8, 0x33, 0x88, 0x5b, 0x66, 0xbf, 0xfd,
-	0x7c, 0x77, 0xdd, 0x4a, 0x71, 0x57, 0xf7, 0x0f, 0x6b, 0xf7, 0xa2, 0x8f, 0x1e, 0x30, 0xb7, 0xfe,
-	0x51, 0xe1, 0x9b, 0x1f, 0xaa, 0xb9, 0xfa, 0x8f, 0x1e, 0xf9, 0xdf, 0xbb, 0xdc, 0xe9, 0x87, 0xa4,
-	0x94, 0x68, 0xd9, 0x89, 0xd3, 0xbe, 0x98, 0x62, 0xa7, 0xd7, 0x5a, 0x6b, 0x7f, 0x9e, 0x55, 0xe7,
-	0x20, 0x5b, 0x49, 0xb4, 0xdc, 0x83, 0x11, 0xfd, 0x3f, 0xf1, 0xa1, 0x19, 0xd8, 0x77, 0x06, 0x43,
-	0xba, 0x3f, 0x2b, 0xd0, 0xc7, 0x02, 0x3f, 0x58, 0xde, 0x8b, 0x7d, 0x33, 0x8e, 0x53, 0x69, 0xcb,
-	0xdd, 0x70, 0x8d, 0x58, 0x5d, 0x00, 0xf5, 0xbc, 0xd6, 0xaf, 0xdf, 0xd4, 0xbc, 0xfa, 0x98, 0x94,
-	0x17, 0xa2, 0xd0, 0x1c, 0xf0, 0x3a, 0x96, 0x58, 0x62, 0x38, 0xa6, 0x7b, 0x84, 0x70, 0x63, 0xc6,
-	0x71, 0x77, 0x62, 0x84, 0x0e, 0xf3, 0x58, 0xc1, 0xed, 0x2b, 0xdc, 0x90, 0xe5, 0xb6, 0x0a, 0x70,
-	0x3e, 0x5b, 0x58, 0xec, 0xce, 0xbc, 0x4f, 0x4a, 0xb3, 0x24, 0x60, 0x7b, 0x28, 0x4e, 0xdc, 0x81,
-	0x30, 0xa4, 0x1b, 0xe4, 0xda, 0x11, 0x1f, 0x4e, 0x84, 0x53, 0xc0, 0x4e, 0xea, 0xbb, 0xa4, 0xf8,
-	0x05, 0xd7, 0x7b, 0x97, 0xdd, 0x02, 0x2b, 0x0b, 0xcb, 0xdc, 0x92, 0xc7, 0x60, 0xe6, 0x96, 0xfa,
-	0x2f, 0x1e, 0x09, 0x98, 0xd0, 0x93, 0xa1, 0xa1, 0xd7, 0xdd, 0x55, 0x80, 0xe5, 0xab, 0xad, 0x7c,
-	0xe8, 0xb9, 0xeb, 0x70, 0x59, 0xfd, 0x07, 0x7f, 0x53, 0x7f, 0xa9, 0x3d, 0x2c, 0x5f, 0x97, 0x4c,
-	0x5f, 0x90, 0x35, 0xe8, 0xee, 0xd8, 0xdd, 0x74, 0x1d, 0x16, 0x70, 0xf5, 0x3f, 0x9a, 0xb4, 0xf5,
-	0xde, 0x65, 0x57, 0x3d, 0x64, 0xab, 0x89, 0x96, 0xd9, 0x43, 0x91, 0x79, 0xeb, 0x3b, 0x8f, 0xd0,
-	0xfd, 0x38, 0x99, 0x0c, 0xb9, 0x89, 0x55, 0x3a, 0x7b, 0x46, 0x3e, 0xb7, 0x9c, 0xf1, 0x62, 0x79,
-	0x78, 0x19, 0xde, 0x5f, 0xde, 0x21, 0xa7, 0x63, 0x6b, 0x05, 0xea, 0x3d, 0x3d, 0xab, 0x7a, 0x28,
-	0x10, 0x4a, 0xfb, 0x31, 0x09, 0xc6, 0xa8, 0x0f, 0x0a, 0x50, 0xde, 0xa9, 0x2d, 0xdf, 0xc5, 0xea,
-	0xc8, 0x5c, 0x7e, 0xfd, 0x31, 0x29, 0x3e, 0xd7, 0xf2, 0x53, 0x90, 0x70, 0x8b, 0x80, 0x99, 0x3b,
-	0x0b, 0x46, 0x2a, 0x26, 0x5a, 0xb6, 0xc1, 0x4b, 0xd9, 0x03, 0x04, 0xbb, 0xaf, 0x5a, 0xc5, 0x1f,
-	0x05, 0x60, 0x8a, 0xd0, 0xab, 0x7f, 0xef, 0x91, 0x52, 0x7b, 0x9a, 0x6d, 0xf2, 0xc9, 0xac, 0x3f,
-	0xfe, 0xd5, 0x6c, 0xdc, 0x82, 0x85, 0x16, 0x5e, 0x52, 0x3e, 0xff, 0x1f, 0x95, 0x47, 0xd7, 0xbe,
-	0xf1, 0xc8, 0xfa, 0xbe, 0xe0, 0xe3, 0xde, 0xa0, 0x3d, 0xd5, 0xce, 0x44, 0x55, 0x52, 0x36, 0xca,
-	0xf0, 0x61, 0xa7, 0xa7, 0x26, 0xa9, 0x71, 0x56, 0x24, 0x08, 0xed, 0x02, 0x02, 0x5e, 0xb6, 0x21,
-	0x6b, 0x44, 0x3b, 0x81, 0x65, 0x23, 0x2e, 0x45, 0x27, 0x9d, 0x24, 0x5d, 0x31, 0xc6, 0xa7, 0xbb,
-	0xc0, 0x08, 0x40, 0x2f, 0x10, 0x01, 0x87, 0x63, 0x02, 0xee, 0x84, 0x2f, 0x78, 0x81, 0x95, 0x00,
-	0x69, 0x03, 0x00, 0xbb, 0x0e, 0xe3, 0x24, 0x36, 0xf8, 0x8e, 0x17, 0x98, 0x9d, 0xd0, 0x87, 0xc4,
-	0x37, 0x53, 0x1d, 0x06, 0x48, 0xf6, 0xce, 0x72, 0xc1, 0xe6, 0xbf, 0x0f, 0x83, 0x05, 0x8e, 0xde,
-	0xaf, 0x60, 0x2c, 0xa4, 0xd7, 0x82, 0x3f, 0xee, 0x0a, 0x86, 0xfe, 0x72, 0x86, 0xfe, 0x15, 0x0c,
-	0xfd, 0x7f, 0x61, 0xe8, 0x2f, 0x65, 0xe8, 0x67, 0x0c, 0xef, 0x91, 0x00, 0x3f, 0xe0, 0x8c, 0x64,
-	0x38, 0xbf, 0x89, 0xf6, 0xdf, 0x3e, 0xda, 0x8e, 0xb0, 0x7a, 0xe6, 0xf2, 0x2c, 0xb7, 0xd6, 0xe3,
-	0xd7, 0x7f, 0x54, 0x72, 0xaf, 0xce, 0x2b, 0xde, 0xe9, 0x79, 0xc5, 0x7b, 0x7b, 0x5e, 0xf1, 0xbe,
-	0xbd, 0xa8, 0xe4, 0x4e, 0x2f, 0x2a, 0xb9, 0xd7, 0x17, 0x95, 0xdc, 0x57, 0x75, 0x19, 0x9b, 0xc1,
-	0xa4, 0x0b, 0x7b, 0xb9, 0xaf, 0xba, 0x39, 0xf7, 0x83, 0xfd, 0xfa, 0xbb, 0x01, 0x7a, 0xe6, 0xfe,
-	0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x29, 0x1b, 0x28, 0xcc, 0x86, 0x08, 0x00, 0x00,
+	// 1093 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x7a, 0xdd, 0x75, 0x3c, 0x4e, 0x1a, 0x34, 0x44, 0xcd, 0xa6, 0x6a, 0x6d, 0xe3, 0x16,
+	0xc9, 0x20, 0x75, 0x9d, 0xa4, 0xb4, 0x82, 0x9e, 0xda, 0x2d, 0xa5, 0x8d, 0x94, 0x54, 0xd5, 0xc4,
+	0x11, 0x12, 0x17, 0x6b, 0x6c, 0x4f, 0xc6, 0xab, 0xac, 0x77, 0xac, 0x9d, 0x71, 0x62, 0xdf, 0x38,
+	0xc2, 0x8d, 0x0b, 0x9c, 0x38, 0x70, 0x85, 0x33, 0x77, 0xae, 0x3d, 0xe6, 0x58, 0x50, 0x15, 0x4a,
+	0x72, 0xe3, 0x57, 0xa0, 0x37, 0x33, 0x6b, 0xa7, 0x8d, 0x1c, 0x0e, 0xbd, 0xbd, 0xf7, 0xbd, 0x37,
+	0xb3, 0xef, 0x7b, 0xef, 0x7b, 0xbb, 0x8b, 0x6e, 0x75, 0x85, 0x1c, 0x08, 0xd9, 0xec, 0x50, 0xc9,
+	0x9a, 0xb4, 0xd3, 0x8d, 0x9a, 0x87, 0x1b, 0x1d, 0xa6, 0xe8, 0x86, 0x76, 0x82, 0x61, 0x2a, 0x94,
+	0xc0, 0xbe, 0x49, 0x0a, 0x20, 0x29, 0xd0, 0xb8, 0x4d, 0xba, 0xbe, 0xc2, 0x05, 0x17, 0x3a, 0xa9,
+	0x09, 0x96, 0xc9, 0xbf, 0x7e, 0xa3, 0x2b, 0x06, 0x4c, 0x75, 0xf6, 0x55, 0x76, 0x63, 0x53, 0x4d,
+	0x86, 0x4c, 0xda, 0xe8, 0xcd, 0x69, 0x54, 0xa3, 0x10, 0xee, 0xc4, 0xa2, 0x7b, 0x60, 0xc3, 0x6b,
+	0x5c, 0x08, 0x1e, 0xb3, 0xa6, 0xf6, 0x3a, 0xa3, 0xfd, 0x26, 0x4d, 0x26, 0x59, 0xc8, 0xd4, 0xd1,
+	0x36, 0x0f, 0xb4, 0x45, 0x69, 0xa7, 0xfe, 0xc6, 0x45, 0xa8, 0x35, 0x26, 0x4c, 0x0e, 0x45, 0x22,
+	0x19, 0xbe, 0x86, 0xbc, 0x3e, 0x8b, 0x78, 0x5f, 0xf9, 0x4e, 0xcd, 0x69, 0xb8, 0xc4, 0x7a, 0xb8,
+	0x8e, 0x3c, 0x35, 0xee, 0x53, 0xd9, 0xf7, 0xf3, 0x35, 0xa7, 0x51, 0x0a, 0xd1, 0xe9, 0x49, 0xd5,
+	0x6b, 0x8d, 0x9f, 0x51, 0xd9, 0x27, 0x36, 0x82, 0x6f, 0xa0, 0x52, 0x57, 0xf4, 0x98, 0x1c, 0xd2,
+	0x2e, 0xf3, 0x5d, 0x48, 0x23, 0x33, 0x00, 0x63, 0x54, 0x00, 0xc7, 0x2f, 0xd4, 0x9c, 0xc6, 0x12,
+	0xd1, 0x36, 0x60, 0x3d, 0xaa, 0xa8, 0x7f, 0x45, 0x27, 0x6b, 0x1b, 0xaf, 0xa2, 0x62, 0x4a, 0x8f,
+	0xda, 0xb1, 0xe0, 0xbe, 0xa7, 0x61, 0x2f, 0xa5, 0x47, 0xdb, 0x82, 0xe3, 0x3d, 0x54, 0x88, 0x05,
+	0x97, 0x7e, 0xb1, 0xe6, 0x36, 0xca, 0x9b, 0x8d, 0x60, 0x5e, 0x6f, 0x83, 0x47, 0xe1, 0xe3, 0xad,
+	0x1d, 0x26, 0x25, 0xe5, 0x6c, 0x5b, 0xf0, 0x70, 0xf5, 0xe5, 0x49, 0x35, 0xf7, 0xdb, 0xdf, 0xd5,
+	0xe5, 0xb7, 0x71, 0x49, 0xf4, 0x75, 0x50, 0x43, 0x94, 0xec, 0x0b, 0x7f, 0xc1, 0xd4, 0x00, 0x36,
+	0xbe, 0x89, 0x10, 0xa7, 0xb2, 0x7d, 0x44, 0x13, 0xc5, 0x7a, 0x7e, 0x49, 0x77, 0xa2, 0xc4, 0xa9,
+	0xfc, 0x5a, 0x03, 0x78, 0x0d, 0x2d, 0x40, 0x78, 0x24, 0x59, 0xcf, 0x47, 0x3a, 0x58, 0xe4, 0x54,
+	0xee, 0x49, 0xd6, 0xc3, 0xb7, 0x51, 0x5e, 0x8d, 0xfd, 0x72, 0xcd, 0x69, 0x94, 0x37, 0x57, 0x02,
+	0x33, 0x91, 0x20, 0x9b, 0x48, 0xf0, 0x28, 0x99, 0x90, 0xbc, 0x1a, 0x43, 0xa7, 0x54, 0x34, 0x60,
+	0x52, 0xd1, 0xc1, 0xd0, 0x5f, 0x34, 0x9d, 0x9a, 0x02, 0xf8, 0x19, 0xf2, 0xd8, 0x21, 0x4b, 0x94,
+	0xf4, 0x97, 0x34, 0xd5, 0xd5, 0x20, 0x1b, 0x7c, 0xc6, 0x33, 0x78, 0x02, 0x71, 0xc3, 0xec, 0xaf,
+	0xdf, 0xef, 0x2c, 0x9b, 0x56, 0xdc, 0x91, 0xbd, 0x83, 0xda, 0x7a, 0xf0, 0xd9, 0x3d, 0x62, 0xcf,
+	0x3f, 0x28, 0x7c, 0xf7, 0x4b, 0x35, 0x57, 0xff, 0xd5, 0x41, 0x57, 0xdf, 0xe6, 0x8e, 0x3f, 0x45,
+	0xa5, 0x81, 0xe4, 0xed, 0x28, 0xe9, 0xb1, 0xb1, 0x9e, 0xf4, 0x52, 0xb8, 0xf4, 0xef, 0x49, 0x75,
+	0x06, 0x92, 0x85, 0x81, 0xe4, 0x5b, 0x60, 0xe1, 0x0f, 0x90, 0x0b, 0xc3, 0xd0, 0x73, 0x27, 0x60,
+	0xe2, 0xdd, 0x69, 0x81, 0xae, 0x2e, 0xf0, 0xe3, 0xf9, 0xb3, 0xd8, 0x55, 0x69, 0x94, 0x70, 0x53,
+	0xee, 0x8a, 0x1d, 0xc4, 0xe2, 0x39, 0x50, 0xce, 0x6a, 0xfd, 0xf6, 0x75, 0xcd, 0xa9, 0xa7, 0xa8,
+	0x7c, 0x2e, 0x0a, 0xc3, 0x01, 0xad, 0xeb, 0x12, 0x4b, 0x44, 0xdb, 0x78, 0x0b, 0x21, 0xaa, 0x54,
+	0x1a, 0x75, 0x46, 0x8a, 0x49, 0x3f, 0xaf, 0x2b, 0xb8, 0x75, 0x89, 0x1a, 0xb2, 0xdc, 0xb0, 0x00,
+	0xcf, 0x27, 0xe7, 0x0e, 0xdb, 0x67, 0xde, 0x45, 0xa5, 0x69, 0x12, 0xb0, 0x3d, 0x60, 0x13, 0xfb,
+	0x40, 0x30, 0xf1, 0x0a, 0xba, 0x72, 0x48, 0xe3, 0x11, 0xb3, 0x1d, 0x30, 0x4e, 0xfd, 0x47, 0x07,
+	0x15, 0x9f, 0x52, 0xb9, 0x75, 0x51, 0x2e, 0x70, 0xb4, 0x30, 0x4f, 0x2e, 0x79, 0x1d, 0x9c, 0xca,
+	0xe5, 0x05, 0x2a, 0x43, 0x68, 0x98, 0x8a, 0xfd, 0x28, 0x66, 0xb6, 0x9d, 0x9f, 0xcc, 0x27, 0xf3,
+	0x94, 0xca, 0x17, 0x26, 0xf7, 0x49, 0xa2, 0xd2, 0x49, 0x46, 0x89, 0x4f, 0xe1, 0xfa, 0xf7, 0x0e,
+	0x5a, 0x7e, 0x27, 0x0b, 0xaf, 0xa3, 0x45, 0x18, 0x2c, 0x74, 0xaf, 0x3d, 0x4a, 0x63, 0x43, 0x2e,
+	0xbc, 0x7a, 0x7a, 0x52, 0x45, 0x3b, 0x92, 0xb7, 0x26, 0x43, 0xb6, 0x47, 0xb6, 0x09, 0x1a, 0x58,
+	0x3b, 0x8d, 0x81, 0xb3, 0x54, 0x22, 0x9d, 0x72, 0xd6, 0x0e, 0xc8, 0x56, 0x0c, 0x59, 0x4a, 0x55,
+	0x24, 0x92, 0x6c, 0xc1, 0xa7, 0x00, 0x74, 0x8e, 0x53, 0xa9, 0xf7, 0xbb, 0x40, 0xc0, 0xac, 0xff,
+	0xe1, 0x20, 0x8f, 0x30, 0x39, 0x8a, 0x15, 0xbe, 0x66, 0x37, 0x1d, 0x1e, 0xbd, 0x18, 0xe6, 0x7d,
+	0xc7, 0x6e, 0xfb, 0x45, 0x71, 0xdd, 0x7b, 0x47, 0x5c, 0x73, 0xd5, 0x6f, 0xb8, 0xdb, 0x64, 0xfc,
+	0x1c, 0x2d, 0x01, 0xc7, 0xd4, 0xbe, 0xc8, 0xa0, 0x0e, 0x77, 0xde, 0x0e, 0x86, 0x1f, 0x5e, 0x5c,
+	0x9a, 0xfb, 0x04, 0x7a, 0x94, 0xbd, 0x07, 0xb3, 0xd5, 0xf9, 0xc9, 0x41, 0x78, 0x37, 0x1a, 0x8c,
+	0x62, 0x4d, 0x31, 0x8b, 0xe2, 0xaf, 0xcc, 0x44, 0xf5, 0x7b, 0xc3, 0xd1, 0xbb, 0xfe, 0xd1, 0xa5,
+	0x33, 0x03, 0x95, 0x84, 0x0b, 0x50, 0xef, 0xf1, 0x49, 0xd5, 0xd1, 0xe3, 0x07, 0x08, 0x7f, 0x8e,
+	0xbc, 0x54, 0xf7, 0x47, 0x37, 0xa0, 0xbc, 0x59, 0x9b, 0x7f, 0x8b, 0xe9, 0x23, 0xb1, 0xf9, 0xf5,
+	0x87, 0xa8, 0xb8, 0x23, 0xf9, 0x97, 0xd0, 0xc2, 0x35, 0xb4, 0x90, 0x4d, 0xd7, 0xca, 0xb6, 0x68,
+	0x27, 0x39, 0x7d, 0xbf, 0xc2, 0xed, 0x8b, 0xa6, 0xe3, 0x0f, 0x3c, 0xd0, 0xbc, 0xef, 0xd4, 0x7f,
+	0x76, 0x50, 0xa9, 0x35, 0xce, 0x2e, 0xf9, 0x62, 0x3a, 0x1f, 0xf7, 0x72, 0x36, 0xf6, 0xc0, 0xb9,
+	0x11, 0x5e, 0xe8, 0x7c, 0xfe, 0x3d, 0x3b, 0xaf, 0x97, 0xf2, 0xb5, 0x83, 0x96, 0x77, 0x19, 0x4d,
+	0xbb, 0xfd, 0xd6, 0x58, 0x5a, 0x11, 0x55, 0x51, 0x59, 0x09, 0x45, 0xe3, 0x76, 0x57, 0x8c, 0x12,
+	0x65, 0x17, 0x0d, 0x69, 0xe8, 0x31, 0x20, 0x20, 0x5b, 0x13, 0x32, 0x6b, 0x66, 0x1c, 0x38, 0x36,
+	0xa4, 0x9c, 0xb5, 0x93, 0xd1, 0xa0, 0xc3, 0x52, 0x2d, 0xdc, 0x02, 0x41, 0x00, 0x3d, 0xd7, 0x08,
+	0xec, 0xaf, 0x4e, 0xd0, 0x37, 0x59, 0x01, 0x97, 0x00, 0x69, 0x01, 0x00, 0xb7, 0xc6, 0xd1, 0x20,
+	0x52, 0xfa, 0x33, 0x55, 0x20, 0xc6, 0xc1, 0xf7, 0x91, 0xab, 0xc6, 0xd2, 0xf7, 0x34, 0xd9, 0xdb,
+	0xf3, 0x1b, 0x36, 0xfb, 0xb8, 0x12, 0x38, 0x60, 0xe9, 0xfd, 0x09, 0xc2, 0xd2, 0xf4, 0x42, 0xf8,
+	0x84, 0x5f, 0xc2, 0xd0, 0x9d, 0xcf, 0xd0, 0xbd, 0x84, 0xa1, 0xfb, 0x3f, 0x0c, 0xdd, 0xb9, 0x0c,
+	0xdd, 0x8c, 0xe1, 0x3a, 0xf2, 0xf4, 0xff, 0x45, 0x46, 0xd2, 0x9f, 0x6d, 0xa2, 0xf9, 0x2d, 0x39,
+	0xdc, 0x08, 0x74, 0xf5, 0xc4, 0xe6, 0x19, 0x6e, 0xe1, 0xc3, 0x57, 0xff, 0x54, 0x72, 0x2f, 0x4f,
+	0x2b, 0xce, 0xf1, 0x69, 0xc5, 0x79, 0x73, 0x5a, 0x71, 0x7e, 0x38, 0xab, 0xe4, 0x8e, 0xcf, 0x2a,
+	0xb9, 0x57, 0x67, 0x95, 0xdc, 0x37, 0x75, 0x1e, 0xa9, 0xfe, 0xa8, 0x03, 0x77, 0xd9, 0x3f, 0x91,
+	0xe6, 0x4c, 0x0f, 0xe6, 0xcf, 0xa6, 0xe3, 0x69, 0xcd, 0xdc, 0xfd, 0x6f, 0x00, 0x51, 0x6f, 0x47,
+	0x61, 0x65, 0x09, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -1067,6 +1160,20 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasProfile) > 0 {
+		for iNdEx := len(m.GasProfile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasProfile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -1080,6 +1187,55 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasProfileEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasProfileEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasProfileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1544,6 +1700,36 @@ func (m *GasInfo) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovAbci(uint64(m.GasUsed))
 	}
+	if len(m.GasProfile) > 0 {
+		for _, e := range m.GasProfile {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasProfileEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovAbci(uint64(m.Gas))
+	}
 	return n
 }
 
@@ -2662,6 +2848,205 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasProfile = append(m.GasProfile, GasProfileEntry{})
+			if err := m.GasProfile[len(m.GasProfile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasProfileEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasProfileEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasProfileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
@@ -57,38 +57,42 @@ type Context struct {
 	eventManager         EventManagerI
 	priority             int64 // The tx priority, only relevant in CheckTx
 	kvGasConfig          storetypes.GasConfig
+	storeKVGasConfigs    map[string]storetypes.GasConfig
 	transientKVGasConfig storetypes.GasConfig
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.Info
 	headerInfo           header.Info
+	gasProfile           *GasProfile
 }
 
 // Proposed rename, not done to avoid API breakage
 type Request = Context
 
 // Read-only accessors
-func (c Context) Context() context.Context                      { return c.baseCtx }
-func (c Context) MultiStore() storetypes.MultiStore             { return c.ms }
-func (c Context) BlockHeight() int64                            { return c.header.Height }
-func (c Context) BlockTime() time.Time                          { return c.headerInfo.Time } // Deprecated: use HeaderInfo().Time
-func (c Context) ChainID() string                               { return c.chainID }
-func (c Context) TxBytes() []byte                               { return c.txBytes }
-func (c Context) Logger() log.Logger                            { return c.logger }
-func (c Context) VoteInfos() []abci.VoteInfo                    { return c.voteInfo }
-func (c Context) GasMeter() storetypes.GasMeter                 { return c.gasMeter }
-func (c Context) BlockGasMeter() storetypes.GasMeter            { return c.blockGasMeter }
-func (c Context) IsCheckTx() bool                               { return c.checkTx }   // Deprecated: use core/transaction service instead
-func (c Context) IsReCheckTx() bool                             { return c.recheckTx } // Deprecated: use core/transaction service instead
-func (c Context) IsSigverifyTx() bool                           { return c.sigverifyTx }
-func (c Context) ExecMode() ExecMode                            { return c.execMode } // Deprecated: use core/transaction service instead
-func (c Context) MinGasPrices() DecCoins                        { return c.minGasPrice }
-func (c Context) EventManager() EventManagerI                   { return c.eventManager }
-func (c Context) Priority() int64                               { return c.priority }
-func (c Context) KVGasConfig() storetypes.GasConfig             { return c.kvGasConfig }
-func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.transientKVGasConfig }
-func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
-func (c Context) CometInfo() comet.Info                         { return c.cometInfo }
-func (c Context) HeaderInfo() header.Info                       { return c.headerInfo }
+func (c Context) Context() context.Context                           { return c.baseCtx }
+func (c Context) MultiStore() storetypes.MultiStore                  { return c.ms }
+func (c Context) BlockHeight() int64                                 { return c.header.Height }
+func (c Context) BlockTime() time.Time                               { return c.headerInfo.Time } // Deprecated: use HeaderInfo().Time
+func (c Context) ChainID() string                                    { return c.chainID }
+func (c Context) TxBytes() []byte                                    { return c.txBytes }
+func (c Context) Logger() log.Logger                                 { return c.logger }
+func (c Context) VoteInfos() []abci.VoteInfo                         { return c.voteInfo }
+func (c Context) GasMeter() storetypes.GasMeter                      { return c.gasMeter }
+func (c Context) BlockGasMeter() storetypes.GasMeter                 { return c.blockGasMeter }
+func (c Context) IsCheckTx() bool                                    { return c.checkTx }   // Deprecated: use core/transaction service instead
+func (c Context) IsReCheckTx() bool                                  { return c.recheckTx } // Deprecated: use core/transaction service instead
+func (c Context) IsSigverifyTx() bool                                { return c.sigverifyTx }
+func (c Context) ExecMode() ExecMode                                 { return c.execMode } // Deprecated: use core/transaction service instead
+func (c Context) MinGasPrices() DecCoins                             { return c.minGasPrice }
+func (c Context) EventManager() EventManagerI                        { return c.eventManager }
+func (c Context) Priority() int64                                    { return c.priority }
+func (c Context) KVGasConfig() storetypes.GasConfig                  { return c.kvGasConfig }
+func (c Context) StoreKVGasConfigs() map[string]storetypes.GasConfig { return c.storeKVGasConfigs }
+func (c Context) TransientKVGasConfig() storetypes.GasConfig         { return c.transientKVGasConfig }
+func (c Context) StreamingManager() storetypes.StreamingManager      { return c.streamingManager }
+func (c Context) CometInfo() comet.Info                              { return c.cometInfo }
+func (c Context) HeaderInfo() header.Info                            { return c.headerInfo }
+func (c Context) GasProfile() *GasProfile                            { return c.gasProfile }
 
 // BlockHeader returns the header by value.
 func (c Context) BlockHeader() cmtproto.Header {
@@ -233,6 +237,21 @@ func (c Context) WithKVGasConfig(gasConfig storetypes.GasConfig) Context {
 	return c
 }
 
+// WithStoreKVGasConfigs returns a Context with updated gas configurations for
+// the KVStores and TransientStores, by store key name. The stores without a
+// configuration of their own use the KVGasConfig or the TransientKVGasConfig.
+func (c Context) WithStoreKVGasConfigs(gasConfigs map[string]storetypes.GasConfig) Context {
+	c.storeKVGasConfigs = gasConfigs
+	return c
+}
+
+// WithGasProfile returns a Context with an updated GasProfile, attributing the
+// gas consumed by the stores operations. A nil GasProfile disables gas profiling.
+func (c Context) WithGasProfile(profile *GasProfile) Context {
+	c.gasProfile = profile
+	return c
+}
+
 // WithTransientKVGasConfig returns a Context with an updated gas configuration for
 // the transient KVStore
 func (c Context) WithTransientKVGasConfig(gasConfig storetypes.GasConfig) Context {
@@ -338,12 +357,32 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	gasConfig, ok := c.storeKVGasConfigs[key.Name()]
+	if !ok {
+		gasConfig = c.kvGasConfig
+	}
+
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.storeGasMeter(key), gasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
+	gasConfig, ok := c.storeKVGasConfigs[key.Name()]
+	if !ok {
+		gasConfig = c.transientKVGasConfig
+	}
+
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.storeGasMeter(key), gasConfig)
+}
+
+// storeGasMeter returns the gas meter of the store of key, which attributes the
+// gas to the store if gas profiling is enabled.
+func (c Context) storeGasMeter(key storetypes.StoreKey) storetypes.GasMeter {
+	if c.gasProfile == nil {
+		return c.gasMeter
+	}
+
+	return c.gasProfile.storeGasMeter(c.gasMeter, key.Name())
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
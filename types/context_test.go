@@ -58,6 +58,37 @@ func (s *contextTestSuite) TestCacheContext() {
 	s.Require().Len(ctx.EventManager().Events(), 2)
 }
 
+func (s *contextTestSuite) TestStoreKVGasConfigs() {
+	key := storetypes.NewKVStoreKey("acc")
+	otherKey := storetypes.NewKVStoreKey("bank")
+	tkey := storetypes.NewTransientStoreKey("transient_acc")
+	otherTKey := storetypes.NewTransientStoreKey("transient_bank")
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{"acc": key, "bank": otherKey},
+		map[string]*storetypes.TransientStoreKey{"transient_acc": tkey, "transient_bank": otherTKey},
+		nil,
+	)
+
+	ctx = ctx.WithKVGasConfig(storetypes.GasConfig{ReadCostFlat: 10}).
+		WithTransientKVGasConfig(storetypes.GasConfig{ReadCostFlat: 5}).
+		WithStoreKVGasConfigs(map[string]storetypes.GasConfig{"acc": {ReadCostFlat: 1}, "transient_acc": {ReadCostFlat: 2}})
+
+	gasUsed := func(key storetypes.StoreKey) storetypes.Gas {
+		meter := storetypes.NewInfiniteGasMeter()
+		ctx = ctx.WithGasMeter(meter)
+		if _, ok := key.(*storetypes.TransientStoreKey); ok {
+			ctx.TransientStore(key).Get([]byte("key"))
+		} else {
+			ctx.KVStore(key).Get([]byte("key"))
+		}
+		return meter.GasConsumed()
+	}
+	s.Require().Equal(storetypes.Gas(1), gasUsed(key))
+	s.Require().Equal(storetypes.Gas(10), gasUsed(otherKey))
+	s.Require().Equal(storetypes.Gas(2), gasUsed(tkey))
+	s.Require().Equal(storetypes.Gas(5), gasUsed(otherTKey))
+}
+
 func (s *contextTestSuite) TestLogContext() {
 	key := storetypes.NewKVStoreKey(s.T().Name())
 	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+s.T().Name()))
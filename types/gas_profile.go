@@ -0,0 +1,154 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Kinds of operations gas is attributed to in a GasProfile.
+const (
+	GasOperationRead    = "read"
+	GasOperationWrite   = "write"
+	GasOperationIterate = "iterate"
+	GasOperationHas     = "has"
+	GasOperationDelete  = "delete"
+	GasOperationOther   = "other"
+)
+
+func (e GasProfileEntry) String() string {
+	return fmt.Sprintf("%s/%s/%s: %d", e.MsgTypeURL, e.Store, e.Operation, e.Gas)
+}
+
+type gasProfileKey struct {
+	msgTypeURL string
+	store      string
+	operation  string
+}
+
+// GasProfile attributes the gas consumed by transactions to the messages being
+// executed, the stores accessed and the kinds of store operations. It is
+// enabled by setting it in the Context with WithGasProfile. The methods of a
+// nil GasProfile are no-ops.
+type GasProfile struct {
+	msgTypeURL string
+	// start and storeGas are the gas consumed at the beginning of the current
+	// segment and the gas consumed by store operations since then.
+	start    storetypes.Gas
+	storeGas storetypes.Gas
+	entries  map[gasProfileKey]storetypes.Gas
+}
+
+// NewGasProfile returns a new empty GasProfile.
+func NewGasProfile() *GasProfile {
+	return &GasProfile{entries: make(map[gasProfileKey]storetypes.Gas)}
+}
+
+// Begin starts attributing the gas consumed to msgTypeURL, empty outside of
+// the messages execution. gasConsumed is the gas consumed so far by the gas
+// meter of the transaction.
+func (p *GasProfile) Begin(msgTypeURL string, gasConsumed storetypes.Gas) {
+	if p == nil {
+		return
+	}
+
+	p.msgTypeURL = msgTypeURL
+	p.start = gasConsumed
+	p.storeGas = 0
+}
+
+// End attributes the gas consumed since Begin which wasn't consumed by store
+// operations, e.g. to verify signatures, to the other operation.
+func (p *GasProfile) End(gasConsumed storetypes.Gas) {
+	if p == nil {
+		return
+	}
+
+	if gasConsumed > p.start+p.storeGas {
+		p.add(gasProfileKey{msgTypeURL: p.msgTypeURL, operation: GasOperationOther}, gasConsumed-p.start-p.storeGas)
+	}
+	p.Begin("", gasConsumed)
+}
+
+// Merge adds the entries of another profile to the profile.
+func (p *GasProfile) Merge(entries []GasProfileEntry) {
+	if p == nil {
+		return
+	}
+
+	for _, e := range entries {
+		p.add(gasProfileKey{msgTypeURL: e.MsgTypeURL, store: e.Store, operation: e.Operation}, e.Gas)
+	}
+}
+
+// Entries returns the entries of the profile sorted by message type URL, store
+// and operation.
+func (p *GasProfile) Entries() []GasProfileEntry {
+	if p == nil {
+		return nil
+	}
+
+	entries := make([]GasProfileEntry, 0, len(p.entries))
+	for k, gas := range p.entries {
+		entries = append(entries, GasProfileEntry{MsgTypeURL: k.msgTypeURL, Store: k.store, Operation: k.operation, Gas: gas})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.MsgTypeURL != b.MsgTypeURL {
+			return a.MsgTypeURL < b.MsgTypeURL
+		}
+		if a.Store != b.Store {
+			return a.Store < b.Store
+		}
+		return a.Operation < b.Operation
+	})
+
+	return entries
+}
+
+func (p *GasProfile) add(k gasProfileKey, gas storetypes.Gas) {
+	if gas == 0 {
+		return
+	}
+	p.entries[k] += gas
+}
+
+// storeGasMeter returns a gas meter attributing the gas consumed through it to
+// store.
+func (p *GasProfile) storeGasMeter(parent storetypes.GasMeter, store string) storetypes.GasMeter {
+	return &profiledGasMeter{GasMeter: parent, profile: p, store: store}
+}
+
+// profiledGasMeter is the gas meter of a store, attributing the gas consumed by
+// its operations to the GasProfile.
+type profiledGasMeter struct {
+	storetypes.GasMeter
+	profile *GasProfile
+	store   string
+}
+
+func (m *profiledGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	m.GasMeter.ConsumeGas(amount, descriptor)
+
+	m.profile.storeGas += amount
+	m.profile.add(gasProfileKey{msgTypeURL: m.profile.msgTypeURL, store: m.store, operation: gasOperation(descriptor)}, amount)
+}
+
+// gasOperation returns the kind of store operation consuming gas with descriptor.
+func gasOperation(descriptor string) string {
+	switch descriptor {
+	case storetypes.GasReadCostFlatDesc, storetypes.GasReadPerByteDesc:
+		return GasOperationRead
+	case storetypes.GasWriteCostFlatDesc, storetypes.GasWritePerByteDesc:
+		return GasOperationWrite
+	case storetypes.GasIterNextCostFlatDesc, storetypes.GasValuePerByteDesc:
+		return GasOperationIterate
+	case storetypes.GasHasDesc:
+		return GasOperationHas
+	case storetypes.GasDeleteDesc:
+		return GasOperationDelete
+	default:
+		return GasOperationOther
+	}
+}
@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasProfile(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	profile := types.NewGasProfile()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithGasProfile(profile)
+	require.Equal(t, profile, ctx.GasProfile())
+
+	cfg := storetypes.KVGasConfig()
+	msgTypeURL := "/cosmos.bank.v1beta1.MsgSend"
+	profile.Begin(msgTypeURL, ctx.GasMeter().GasConsumed())
+
+	store := ctx.KVStore(key)
+	store.Set([]byte("k"), []byte("v"))
+	require.True(t, store.Has([]byte("k")))
+	require.Equal(t, []byte("v"), store.Get([]byte("k")))
+	// the iterator consumes gas when seeking the first entry and on Next.
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		require.Equal(t, []byte("k"), it.Key())
+	}
+	require.NoError(t, it.Close())
+	store.Delete([]byte("k"))
+	ctx.GasMeter().ConsumeGas(100, "signature verification")
+
+	profile.End(ctx.GasMeter().GasConsumed())
+	// gas consumed outside of Begin and End isn't attributed.
+	ctx.GasMeter().ConsumeGas(100, "not profiled")
+
+	entries := profile.Entries()
+	require.Equal(t, []types.GasProfileEntry{
+		{MsgTypeURL: msgTypeURL, Operation: types.GasOperationOther, Gas: 100},
+		{MsgTypeURL: msgTypeURL, Store: "bank", Operation: types.GasOperationDelete, Gas: cfg.DeleteCost},
+		{MsgTypeURL: msgTypeURL, Store: "bank", Operation: types.GasOperationHas, Gas: cfg.HasCost},
+		{MsgTypeURL: msgTypeURL, Store: "bank", Operation: types.GasOperationIterate, Gas: 2 * (cfg.IterNextCostFlat + 2*cfg.ReadCostPerByte)},
+		{MsgTypeURL: msgTypeURL, Store: "bank", Operation: types.GasOperationRead, Gas: cfg.ReadCostFlat + 2*cfg.ReadCostPerByte},
+		{MsgTypeURL: msgTypeURL, Store: "bank", Operation: types.GasOperationWrite, Gas: cfg.WriteCostFlat + 2*cfg.WriteCostPerByte},
+	}, entries)
+
+	var total storetypes.Gas
+	for _, e := range entries {
+		total += e.Gas
+	}
+	require.Equal(t, ctx.GasMeter().GasConsumed()-100, total)
+
+	merged := types.NewGasProfile()
+	merged.Merge(entries)
+	merged.Merge(entries[:1])
+	require.Len(t, merged.Entries(), len(entries))
+	require.Equal(t, storetypes.Gas(200), merged.Entries()[0].Gas)
+}
+
+func TestGasProfile_Disabled(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	require.Nil(t, ctx.GasProfile())
+
+	// the methods of a nil profile are no-ops.
+	ctx.GasProfile().Begin("/cosmos.bank.v1beta1.MsgSend", 0)
+	ctx.KVStore(key).Set([]byte("k"), []byte("v"))
+	ctx.GasProfile().End(ctx.GasMeter().GasConsumed())
+	require.Nil(t, ctx.GasProfile().Entries())
+}
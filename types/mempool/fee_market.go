@@ -0,0 +1,188 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+
+	"github.com/huandu/skiplist"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool            = (*FeeMarketMempool)(nil)
+	_ InspectableMempool = (*FeeMarketMempool)(nil)
+)
+
+// ErrTxBelowBaseFee is returned when inserting a tx whose gas price is lower than the base fee.
+var ErrTxBelowBaseFee = errors.New("tx gas price is below the base fee")
+
+// gasPriceScale is the factor applied to the gas price to compute the tx priority,
+// keeping the six first decimals of the gas price.
+const gasPriceScale = 1_000_000
+
+type (
+	// FeeMarketMempoolConfig defines the configuration used to configure the
+	// FeeMarketMempool.
+	FeeMarketMempoolConfig struct {
+		// Denom is the denomination in which the base fee is paid.
+		Denom string
+
+		// BaseFee returns the base fee per unit of gas of the block the txs are
+		// included in, e.g. ante.FeeMarketDecorator.BaseFee.
+		BaseFee func(ctx context.Context) (sdkmath.LegacyDec, error)
+
+		// MaxTx sets the maximum number of transactions allowed in the mempool, with
+		// the same semantics as PriorityNonceMempoolConfig.MaxTx.
+		MaxTx int
+
+		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
+		SignerExtractor SignerExtractionAdapter
+	}
+
+	// FeeMarketMempool is a mempool implementation for chains using a dynamic base
+	// fee. It only accepts txs paying at least the base fee per unit of gas, and
+	// orders them by gas price, which orders them by tip since every tx pays the
+	// same base fee. When the base fee increases, the txs which fall below it are
+	// evicted, with the txs of the same sender following them by nonce.
+	FeeMarketMempool struct {
+		mtx     sync.Mutex
+		pool    *PriorityNonceMempool[int64]
+		cfg     FeeMarketMempoolConfig
+		baseFee sdkmath.LegacyDec
+	}
+)
+
+// NewFeeMarketMempool returns a new FeeMarketMempool.
+func NewFeeMarketMempool(cfg FeeMarketMempoolConfig) *FeeMarketMempool {
+	mp := &FeeMarketMempool{cfg: cfg}
+	mp.pool = NewPriorityMempool(PriorityNonceMempoolConfig[int64]{
+		TxPriority: TxPriority[int64]{
+			GetTxPriority: func(_ context.Context, tx sdk.Tx) int64 {
+				return gasPricePriority(mp.gasPrice(tx))
+			},
+			Compare: func(a, b int64) int {
+				return skiplist.Int64.Compare(a, b)
+			},
+			MinValue: math.MinInt64,
+		},
+		MaxTx:           cfg.MaxTx,
+		SignerExtractor: cfg.SignerExtractor,
+	})
+
+	return mp
+}
+
+// Insert attempts to insert a Tx into the mempool, returning ErrTxBelowBaseFee
+// if its gas price is lower than the base fee.
+func (mp *FeeMarketMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	baseFee, err := mp.cfg.BaseFee(ctx)
+	if err != nil {
+		return err
+	}
+	mp.updateBaseFee(baseFee)
+
+	if mp.gasPrice(tx).LT(baseFee) {
+		return ErrTxBelowBaseFee
+	}
+
+	return mp.pool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the mempool txs ordered by gas price and
+// sender-nonce, after evicting the txs below the current base fee.
+//
+// NOTE: It is not safe to use this iterator while removing transactions from
+// the underlying mempool.
+func (mp *FeeMarketMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	// the eviction is best effort, the txs below the base fee are rejected by the
+	// ante handler anyway.
+	if baseFee, err := mp.cfg.BaseFee(ctx); err == nil {
+		mp.updateBaseFee(baseFee)
+	}
+
+	return mp.pool.Select(ctx, txs)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *FeeMarketMempool) CountTx() int {
+	return mp.pool.CountTx()
+}
+
+// Remove removes a transaction from the mempool.
+func (mp *FeeMarketMempool) Remove(tx sdk.Tx) error {
+	return mp.pool.Remove(tx)
+}
+
+// WalkPendingTxs calls fn with the transactions pending in the mempool, ordered
+// by sender then nonce, until it returns true. If sender is not empty, only its
+// transactions are walked. Their priority is their gas price, see gasPricePriority.
+func (mp *FeeMarketMempool) WalkPendingTxs(sender string, fn func(TxInfo) (stop bool)) {
+	mp.pool.WalkPendingTxs(sender, fn)
+}
+
+// Subscribe registers listener to be called with every transaction inserted into
+// or removed from the mempool, including the evicted ones, until the returned
+// function is called.
+func (mp *FeeMarketMempool) Subscribe(listener func(Event)) func() {
+	return mp.pool.Subscribe(listener)
+}
+
+// updateBaseFee records the base fee, and evicts the txs below it when it increased.
+func (mp *FeeMarketMempool) updateBaseFee(baseFee sdkmath.LegacyDec) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	increased := mp.baseFee.IsNil() || baseFee.GT(mp.baseFee)
+	mp.baseFee = baseFee
+	if increased {
+		mp.evict(baseFee)
+	}
+}
+
+// evict removes the txs whose gas price is lower than baseFee, and the txs of
+// the same sender with a higher nonce which could not be included without them.
+func (mp *FeeMarketMempool) evict(baseFee sdkmath.LegacyDec) {
+	var evicted []sdk.Tx
+	mp.pool.mtx.Lock()
+	for _, senderIndex := range mp.pool.senderIndices {
+		below := false
+		for e := senderIndex.Front(); e != nil; e = e.Next() {
+			tx := e.Value.(sdk.Tx)
+			below = below || mp.gasPrice(tx).LT(baseFee)
+			if below {
+				evicted = append(evicted, tx)
+			}
+		}
+	}
+	mp.pool.mtx.Unlock()
+
+	for _, tx := range evicted {
+		_ = mp.pool.Remove(tx)
+	}
+}
+
+// gasPrice returns the price per unit of gas paid by tx in the fee market denomination.
+func (mp *FeeMarketMempool) gasPrice(tx sdk.Tx) sdkmath.LegacyDec {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	fee := feeTx.GetFee().AmountOf(mp.cfg.Denom)
+	return sdkmath.LegacyNewDecFromInt(fee).QuoInt(sdkmath.NewIntFromUint64(feeTx.GetGas()))
+}
+
+// gasPricePriority returns the priority of a tx paying gasPrice, capped to math.MaxInt64.
+func gasPricePriority(gasPrice sdkmath.LegacyDec) int64 {
+	p := gasPrice.MulInt64(gasPriceScale).TruncateInt()
+	if !p.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return p.Int64()
+}
@@ -0,0 +1,97 @@
+package mempool_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// feeTx is a testTx implementing sdk.FeeTx.
+type feeTx struct {
+	testTx
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx feeTx) GetGas() uint64 { return tx.gas }
+
+func (tx feeTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx feeTx) FeePayer() []byte { return tx.address }
+
+func (tx feeTx) FeeGranter() []byte { return nil }
+
+var _ sdk.FeeTx = feeTx{}
+
+func TestFeeMarketMempool(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accA, accB, accC := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	baseFee := math.LegacyNewDec(1)
+	mp := mempool.NewFeeMarketMempool(mempool.FeeMarketMempoolConfig{
+		Denom: "stake",
+		BaseFee: func(context.Context) (math.LegacyDec, error) {
+			return baseFee, nil
+		},
+	})
+
+	newTx := func(id int, addr sdk.AccAddress, nonce uint64, fee int64) feeTx {
+		return feeTx{
+			testTx: testTx{id: id, address: addr, nonce: nonce},
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", fee)),
+			gas:    100,
+		}
+	}
+
+	// txs below the base fee, or paying in another denomination, are rejected.
+	require.ErrorIs(t, mp.Insert(ctx, newTx(0, accA, 0, 99)), mempool.ErrTxBelowBaseFee)
+	require.ErrorIs(t, mp.Insert(ctx, feeTx{
+		testTx: testTx{address: accA},
+		fee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+		gas:    100,
+	}), mempool.ErrTxBelowBaseFee)
+	require.Equal(t, 0, mp.CountTx())
+
+	txs := []feeTx{
+		newTx(1, accA, 0, 150),
+		newTx(2, accA, 1, 400),
+		newTx(3, accB, 0, 300),
+		newTx(4, accC, 0, 250),
+		newTx(5, accC, 1, 100),
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, len(txs), mp.CountTx())
+
+	// txs are ordered by tip, and by nonce for the same sender.
+	require.Equal(t, []int{3, 4, 1, 2, 5}, selectedIDs(mp.Select(ctx, nil)))
+
+	// an increased base fee evicts the txs below it, and the following txs of their sender.
+	baseFee = math.LegacyNewDecWithPrec(2, 0)
+	require.Equal(t, []int{3, 4}, selectedIDs(mp.Select(ctx, nil)))
+	require.Equal(t, 2, mp.CountTx())
+
+	// a decreased base fee does not bring back evicted txs, but accepts cheaper txs.
+	baseFee = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, mp.Insert(ctx, newTx(6, accA, 0, 60)))
+	require.Equal(t, []int{3, 4, 6}, selectedIDs(mp.Select(ctx, nil)))
+}
+
+func selectedIDs(iterator mempool.Iterator) []int {
+	var ids []int
+	for ; iterator != nil; iterator = iterator.Next() {
+		ids = append(ids, iterator.Tx().(feeTx).id)
+	}
+	return ids
+}
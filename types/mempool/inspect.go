@@ -0,0 +1,124 @@
+package mempool
+
+import (
+	"context"
+	"crypto/sha256"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InspectableMempool is a Mempool which exposes its pending transactions and
+// notifies of their changes.
+type InspectableMempool interface {
+	Mempool
+
+	// WalkPendingTxs calls fn with the transactions pending in the mempool,
+	// ordered by sender then nonce, until it returns true. If sender is not
+	// empty, only its transactions are walked. fn is called while the mempool
+	// is locked, it must not block nor call the mempool.
+	WalkPendingTxs(sender string, fn func(TxInfo) (stop bool))
+
+	// Subscribe registers listener to be called with every transaction inserted
+	// into or removed from the mempool, until the returned function is called.
+	// The listener is called while the mempool is locked, it must not block nor
+	// call the mempool.
+	Subscribe(listener func(Event)) (unsubscribe func())
+}
+
+// TxInfo describes a transaction pending in the mempool.
+type TxInfo struct {
+	Tx sdk.Tx
+	// Hash is the hash of the tx bytes the transaction was inserted with, as
+	// computed by CometBFT. It is empty if the insertion context held no tx bytes.
+	Hash []byte
+	// Sender is the address of the first signer of the transaction.
+	Sender string
+	// Nonce is the sequence of the first signer of the transaction.
+	Nonce uint64
+	// Priority is the priority of the transaction, it is only set by mempools
+	// ordering transactions by an int64 priority.
+	Priority int64
+}
+
+// EventType defines the type of a mempool Event.
+type EventType uint8
+
+const (
+	// EventTxInserted is emitted when a transaction is inserted into the mempool.
+	EventTxInserted EventType = iota + 1
+	// EventTxRemoved is emitted when a transaction is removed from the mempool,
+	// including when it is evicted or replaced by another transaction.
+	EventTxRemoved
+)
+
+// Event describes a change of the transactions pending in an InspectableMempool.
+type Event struct {
+	Type EventType
+	TxInfo
+}
+
+// txHash returns the hash of the tx bytes of ctx, as computed by CometBFT, or
+// an empty string if ctx holds no tx bytes.
+func txHash(ctx context.Context) string {
+	bz := txBytes(ctx)
+	if len(bz) == 0 {
+		return ""
+	}
+
+	hash := sha256.Sum256(bz)
+	return string(hash[:])
+}
+
+// sortedSenders returns sender if it is a key of txs, or all the keys of txs
+// sorted if sender is empty.
+func sortedSenders[T any](txs map[string]T, sender string) []string {
+	if sender != "" {
+		if _, ok := txs[sender]; !ok {
+			return nil
+		}
+		return []string{sender}
+	}
+
+	senders := make([]string, 0, len(txs))
+	for s := range txs {
+		senders = append(senders, s)
+	}
+	sort.Strings(senders)
+
+	return senders
+}
+
+// listeners holds the listeners subscribed to the events of a mempool. Its zero
+// value is ready to use.
+type listeners struct {
+	mtx  sync.Mutex
+	next uint64
+	fns  map[uint64]func(Event)
+}
+
+func (l *listeners) subscribe(fn func(Event)) func() {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.fns == nil {
+		l.fns = make(map[uint64]func(Event))
+	}
+	id := l.next
+	l.next++
+	l.fns[id] = fn
+
+	return func() {
+		l.mtx.Lock()
+		defer l.mtx.Unlock()
+		delete(l.fns, id)
+	}
+}
+
+func (l *listeners) emit(typ EventType, info TxInfo) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for _, fn := range l.fns {
+		fn(Event{Type: typ, TxInfo: info})
+	}
+}
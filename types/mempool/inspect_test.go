@@ -0,0 +1,138 @@
+package mempool_test
+
+import (
+	"crypto/sha256"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// pendingTxs collects the transactions walked by mp for sender.
+func pendingTxs(mp mempool.InspectableMempool, sender string) []mempool.TxInfo {
+	var infos []mempool.TxInfo
+	mp.WalkPendingTxs(sender, func(info mempool.TxInfo) bool {
+		infos = append(infos, info)
+		return false
+	})
+
+	return infos
+}
+
+func TestPriorityNonceMempool_Inspect(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			TxReplacement:   mempool.NewReplaceByFeeRule(10),
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	var events []mempool.Event
+	unsubscribe := mp.Subscribe(func(event mempool.Event) { events = append(events, event) })
+
+	txs := []testTx{
+		{id: 0, priority: 5, nonce: 2, address: sb},
+		{id: 1, priority: 10, nonce: 1, address: sa},
+		{id: 2, priority: 20, nonce: 1, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	require.Len(t, pendingTxs(mp, ""), 3)
+	pending := pendingTxs(mp, sa.String())
+	require.Len(t, pending, 1)
+	require.Equal(t, sa.String(), pending[0].Sender)
+	require.Equal(t, uint64(1), pending[0].Nonce)
+	require.Equal(t, int64(10), pending[0].Priority)
+	pending = pendingTxs(mp, sb.String())
+	require.Len(t, pending, 2)
+	require.Equal(t, []uint64{1, 2}, []uint64{pending[0].Nonce, pending[1].Nonce})
+	require.Empty(t, pendingTxs(mp, "unknown"))
+
+	// the walk stops as soon as fn returns true.
+	walked := 0
+	mp.WalkPendingTxs("", func(mempool.TxInfo) bool {
+		walked++
+		return true
+	})
+	require.Equal(t, 1, walked)
+
+	// a replacement is notified as the removal of the old tx then the insertion of the new one.
+	events = nil
+	replacement := testTx{id: 3, priority: 30, nonce: 1, address: sa}
+	require.NoError(t, mp.Insert(ctx.WithPriority(replacement.priority), replacement))
+	require.Len(t, events, 2)
+	require.Equal(t, mempool.EventTxRemoved, events[0].Type)
+	require.Equal(t, txs[1], events[0].Tx)
+	require.Equal(t, mempool.EventTxInserted, events[1].Type)
+	require.Equal(t, replacement, events[1].Tx)
+	require.Equal(t, int64(30), events[1].Priority)
+
+	events = nil
+	require.NoError(t, mp.Remove(txs[0]))
+	require.Len(t, events, 1)
+	require.Equal(t, mempool.EventTxRemoved, events[0].Type)
+	require.Equal(t, sb.String(), events[0].Sender)
+	require.Equal(t, uint64(2), events[0].Nonce)
+
+	// no more events once unsubscribed.
+	unsubscribe()
+	events = nil
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[0].priority), txs[0]))
+	require.Empty(t, events)
+}
+
+func TestSenderNonceMempool_Inspect(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(10))
+
+	var events []mempool.Event
+	unsubscribe := mp.Subscribe(func(event mempool.Event) { events = append(events, event) })
+	defer unsubscribe()
+
+	txs := []testTx{
+		{id: 0, nonce: 3, address: sa},
+		{id: 1, nonce: 1, address: sb},
+		{id: 2, nonce: 2, address: sa},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithTxBytes([]byte{byte(tx.id)}), tx))
+	}
+	require.Len(t, events, 3)
+	for i, event := range events {
+		require.Equal(t, mempool.EventTxInserted, event.Type)
+		require.Equal(t, txs[i], event.Tx)
+	}
+
+	// the hash is the one of the tx bytes the transaction was inserted with.
+	pending := pendingTxs(mp, sa.String())
+	require.Len(t, pending, 2)
+	require.Equal(t, []uint64{2, 3}, []uint64{pending[0].Nonce, pending[1].Nonce})
+	hash := sha256.Sum256([]byte{2})
+	require.Equal(t, hash[:], pending[0].Hash)
+	require.Len(t, pendingTxs(mp, ""), 3)
+
+	events = nil
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Len(t, events, 1)
+	require.Equal(t, mempool.EventTxRemoved, events[0].Type)
+	require.Equal(t, sb.String(), events[0].Sender)
+	hash = sha256.Sum256([]byte{1})
+	require.Equal(t, hash[:], events[0].Hash)
+	require.Empty(t, pendingTxs(mp, sb.String()))
+}
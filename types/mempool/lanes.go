@@ -0,0 +1,186 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*LaneMempool)(nil)
+
+// ErrNoMatchingLane is returned when inserting a tx which doesn't match any lane.
+var ErrNoMatchingLane = errors.New("tx does not match any lane")
+
+type (
+	// Lane defines a partition of a LaneMempool, with its own ordering and its
+	// own share of the block space.
+	Lane struct {
+		// Name identifies the lane.
+		Name string
+
+		// Match reports whether the tx belongs to the lane. A nil Match matches
+		// every tx, which is typically used by the last, default, lane.
+		Match func(ctx context.Context, tx sdk.Tx) bool
+
+		// Mempool holds the txs of the lane, in the order they are proposed.
+		Mempool Mempool
+
+		// MaxBlockSpace is the maximum share of the block bytes and gas the txs of
+		// the lane may use, between 0 (exclusive) and 1. The shares apply to the
+		// consensus max block bytes and gas. A nil MaxBlockSpace lets the lane use
+		// the whole block.
+		MaxBlockSpace sdkmath.LegacyDec
+	}
+
+	// LaneMempool is a mempool composed of ordered lanes. A tx is routed into the
+	// first lane it matches, and proposals contain the txs of each lane in turn,
+	// each lane being capped to its share of the block space. Capping the first
+	// lanes reserves the remaining block space for the following ones.
+	//
+	// The default proposal handler of baseapp enforces the lanes ordering and
+	// shares both when preparing and when processing proposals.
+	LaneMempool struct {
+		lanes []Lane
+	}
+)
+
+// NewLaneMempool returns a new LaneMempool with the given lanes, in the order
+// their txs are proposed. It panics if the lanes are misconfigured.
+func NewLaneMempool(lanes ...Lane) *LaneMempool {
+	if len(lanes) == 0 {
+		panic("lane mempool must have at least one lane")
+	}
+
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if err := lane.Validate(); err != nil {
+			panic(err)
+		}
+		if _, ok := names[lane.Name]; ok {
+			panic(fmt.Errorf("duplicate lane %s", lane.Name))
+		}
+		names[lane.Name] = struct{}{}
+	}
+
+	return &LaneMempool{lanes: lanes}
+}
+
+// Validate validates the lane configuration.
+func (l Lane) Validate() error {
+	if l.Name == "" {
+		return errors.New("lane name cannot be empty")
+	}
+	if l.Mempool == nil {
+		return fmt.Errorf("lane %s: mempool cannot be nil", l.Name)
+	}
+	if !l.MaxBlockSpace.IsNil() && (!l.MaxBlockSpace.IsPositive() || l.MaxBlockSpace.GT(sdkmath.LegacyOneDec())) {
+		return fmt.Errorf("lane %s: max block space must be in (0, 1], got %s", l.Name, l.MaxBlockSpace)
+	}
+
+	return nil
+}
+
+// Limit returns the share of total the txs of the lane may use.
+func (l Lane) Limit(total uint64) uint64 {
+	if l.MaxBlockSpace.IsNil() {
+		return total
+	}
+
+	return sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(total)).Mul(l.MaxBlockSpace).TruncateInt().Uint64()
+}
+
+// Lanes returns the lanes of the mempool, in the order their txs are proposed.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the first lane matching tx, and false if no
+// lane matches it.
+func (mp *LaneMempool) LaneIndex(ctx context.Context, tx sdk.Tx) (int, bool) {
+	for i, lane := range mp.lanes {
+		if lane.Match == nil || lane.Match(ctx, tx) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// Insert inserts tx into the first lane it matches, returning ErrNoMatchingLane
+// if there is none.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i, ok := mp.LaneIndex(ctx, tx)
+	if !ok {
+		return ErrNoMatchingLane
+	}
+
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the txs of each lane in turn, ignoring the
+// lanes shares of the block space.
+//
+// NOTE: It is not safe to use this iterator while removing transactions from
+// the underlying mempool.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iter := &laneIterator{ctx: ctx, txs: txs, lanes: mp.lanes, lane: -1}
+	return iter.nextLane()
+}
+
+// CountTx returns the number of transactions in all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// Remove removes tx from the lane holding it. The lanes are searched as the
+// lane tx matches may have changed since it was inserted.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	for _, lane := range mp.lanes {
+		err := lane.Mempool.Remove(tx)
+		if !errors.Is(err, ErrTxNotFound) {
+			return err
+		}
+	}
+
+	return ErrTxNotFound
+}
+
+type laneIterator struct {
+	ctx   context.Context
+	txs   [][]byte
+	lanes []Lane
+	lane  int
+	iter  Iterator
+}
+
+func (i *laneIterator) Next() Iterator {
+	if i.iter = i.iter.Next(); i.iter != nil {
+		return i
+	}
+
+	return i.nextLane()
+}
+
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.iter.Tx()
+}
+
+// nextLane moves the iterator to the first tx of the next non empty lane.
+func (i *laneIterator) nextLane() Iterator {
+	for i.lane++; i.lane < len(i.lanes); i.lane++ {
+		if i.iter = i.lanes[i.lane].Mempool.Select(i.ctx, i.txs); i.iter != nil {
+			return i
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package mempool_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestLaneMempool(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	newLane := func() mempool.Mempool {
+		return mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		})
+	}
+	fromSender := func(sender sdk.AccAddress) func(context.Context, sdk.Tx) bool {
+		return func(_ context.Context, tx sdk.Tx) bool {
+			return tx.(testTx).address.Equals(sender)
+		}
+	}
+	mp := mempool.NewLaneMempool(
+		mempool.Lane{Name: "oracle", Match: fromSender(sa), Mempool: newLane(), MaxBlockSpace: sdkmath.LegacyNewDecWithPrec(1, 1)},
+		mempool.Lane{Name: "relayer", Match: fromSender(sb), Mempool: newLane(), MaxBlockSpace: sdkmath.LegacyNewDecWithPrec(3, 1)},
+		mempool.Lane{Name: "default", Mempool: newLane()},
+	)
+
+	txs := []testTx{
+		{id: 0, priority: 30, nonce: 1, address: sc},
+		{id: 1, priority: 10, nonce: 1, address: sb},
+		{id: 2, priority: 20, nonce: 1, address: sa},
+		{id: 3, priority: 40, nonce: 2, address: sc},
+		{id: 4, priority: 5, nonce: 2, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+	require.Equal(t, 5, mp.CountTx())
+	require.Equal(t, 1, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 2, mp.Lanes()[1].Mempool.CountTx())
+	require.Equal(t, 2, mp.Lanes()[2].Mempool.CountTx())
+
+	i, ok := mp.LaneIndex(ctx, txs[4])
+	require.True(t, ok)
+	require.Equal(t, 1, i)
+
+	// the txs are selected lane by lane.
+	require.Equal(t, []int{2, 1, 4, 0, 3}, laneTxIDs(mp.Select(ctx, nil)))
+
+	require.NoError(t, mp.Remove(txs[2]))
+	require.ErrorIs(t, mp.Remove(txs[2]), mempool.ErrTxNotFound)
+	require.Equal(t, []int{1, 4, 0, 3}, laneTxIDs(mp.Select(ctx, nil)))
+
+	require.Equal(t, uint64(300), mp.Lanes()[1].Limit(1000))
+	require.Equal(t, uint64(1000), mp.Lanes()[2].Limit(1000))
+	require.Equal(t, uint64(0), mp.Lanes()[0].Limit(9))
+}
+
+func TestLaneMempool_NoMatchingLane(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+
+	mp := mempool.NewLaneMempool(mempool.Lane{
+		Name:    "none",
+		Match:   func(context.Context, sdk.Tx) bool { return false },
+		Mempool: mempool.NewSenderNonceMempool(),
+	})
+	require.ErrorIs(t, mp.Insert(ctx, testTx{nonce: 1, address: accounts[0].Address}), mempool.ErrNoMatchingLane)
+	require.Nil(t, mp.Select(ctx, nil))
+}
+
+func TestNewLaneMempool_Invalid(t *testing.T) {
+	testCases := map[string][]mempool.Lane{
+		"no lanes":      nil,
+		"empty name":    {{Mempool: mempool.NoOpMempool{}}},
+		"nil mempool":   {{Name: "default"}},
+		"duplicate":     {{Name: "default", Mempool: mempool.NoOpMempool{}}, {Name: "default", Mempool: mempool.NoOpMempool{}}},
+		"zero space":    {{Name: "default", Mempool: mempool.NoOpMempool{}, MaxBlockSpace: sdkmath.LegacyZeroDec()}},
+		"too big space": {{Name: "default", Mempool: mempool.NoOpMempool{}, MaxBlockSpace: sdkmath.LegacyNewDec(2)}},
+	}
+	for name, lanes := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Panics(t, func() { mempool.NewLaneMempool(lanes...) })
+		})
+	}
+}
+
+func laneTxIDs(iterator mempool.Iterator) []int {
+	var ids []int
+	for ; iterator != nil; iterator = iterator.Next() {
+		ids = append(ids, iterator.Tx().(testTx).id)
+	}
+	return ids
+}
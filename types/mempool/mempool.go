@@ -40,4 +40,7 @@ type Iterator interface {
 var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	ErrMempoolTxMaxBytes    = errors.New("pool reached max bytes capacity")
+
+	ErrMempoolSenderTxMaxCapacity = errors.New("sender reached max pending tx capacity")
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/big"
 	"sync"
 
 	"github.com/huandu/skiplist"
@@ -12,8 +13,9 @@ import (
 )
 
 var (
-	_ Mempool  = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator = (*PriorityNonceIterator[int64])(nil)
+	_ Mempool            = (*PriorityNonceMempool[int64])(nil)
+	_ InspectableMempool = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator           = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -28,7 +30,8 @@ type (
 
 		// TxReplacement is a callback to be called when duplicated transaction nonce
 		// detected during mempool insert. An application can define a transaction
-		// replacement rule based on tx priority or certain transaction fields.
+		// replacement rule based on tx priority or certain transaction fields, e.g.
+		// NewReplaceByFeeRule.
 		TxReplacement func(op, np C, oTx, nTx sdk.Tx) bool
 
 		// MaxTx sets the maximum number of transactions allowed in the mempool with
@@ -40,6 +43,19 @@ type (
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
 
+		// MaxSenderTx sets the maximum number of transactions a single sender can
+		// have pending in the mempool. If MaxSenderTx == 0, there is no cap. Replacing
+		// a transaction of the sender is always allowed.
+		MaxSenderTx int
+
+		// MaxBytes sets the maximum total size in bytes of the transactions in the
+		// mempool, where the size of a transaction is the size of the tx bytes of
+		// the context it is inserted with. If MaxBytes == 0, there is no cap.
+		// When the cap is reached, the transactions with the lowest priority are
+		// evicted, along with the transactions of the same sender with a higher
+		// nonce, as long as their priority is lower than the inserted transaction.
+		MaxBytes int64
+
 		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
 		SignerExtractor SignerExtractionAdapter
 	}
@@ -57,7 +73,9 @@ type (
 		priorityCounts map[C]int
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
+		bytes          int64
 		cfg            PriorityNonceMempoolConfig[C]
+		listeners      listeners
 	}
 
 	// PriorityNonceIterator defines an iterator that is used for mempool iteration
@@ -99,6 +117,10 @@ type (
 		weight C
 		// senderElement is a pointer to the transaction's element in the sender index
 		senderElement *skiplist.Element
+		// size is the size of the transaction in bytes
+		size int64
+		// hash is the hash of the transaction bytes, see txHash
+		hash string
 	}
 )
 
@@ -123,6 +145,21 @@ func DefaultPriorityNonceMempoolConfig() PriorityNonceMempoolConfig[int64] {
 	}
 }
 
+// NewReplaceByFeeRule returns a TxReplacement rule for int64 priorities, such as
+// the ones of NewDefaultTxPriority, where a transaction only replaces the pending
+// transaction with the same sender and nonce if its priority is higher by at least
+// minBumpPercent percent.
+func NewReplaceByFeeRule(minBumpPercent uint64) func(op, np int64, oTx, nTx sdk.Tx) bool {
+	return func(op, np int64, _, _ sdk.Tx) bool {
+		// np >= op + |op| * minBumpPercent / 100, computed without overflowing.
+		threshold := new(big.Int).Abs(big.NewInt(op))
+		threshold.Mul(threshold, new(big.Int).SetUint64(minBumpPercent))
+		threshold.Add(threshold, new(big.Int).Mul(big.NewInt(op), big.NewInt(100)))
+
+		return new(big.Int).Mul(big.NewInt(np), big.NewInt(100)).Cmp(threshold) >= 0
+	}
+}
+
 // skiplistComparable is a comparator for txKeys that first compares priority,
 // then weight, then sender, then nonce, uniquely identifying a transaction.
 //
@@ -222,7 +259,9 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	sender := sig.Signer.String()
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
 	nonce := sig.Sequence
-	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
+	size := txSize(ctx)
+	hash := txHash(ctx)
+	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender, size: size, hash: hash}
 
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
@@ -234,6 +273,12 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 		mp.senderIndices[sender] = senderIndex
 	}
 
+	sk := txMeta[C]{nonce: nonce, sender: sender}
+	oldScore, txExists := mp.scores[sk]
+	if !txExists && mp.cfg.MaxSenderTx > 0 && senderIndex.Len() >= mp.cfg.MaxSenderTx {
+		return ErrMempoolSenderTxMaxCapacity
+	}
+
 	// Since mp.priorityIndex is scored by priority, then sender, then nonce, a
 	// changed priority will create a new key, so we must remove the old key and
 	// re-insert it to avoid having the same tx with different priorityIndex indexed
@@ -241,8 +286,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
-	if oldScore, txExists := mp.scores[sk]; txExists {
+	if txExists {
 		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
 			return fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
@@ -252,7 +296,20 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 				tx,
 			)
 		}
+	}
+
+	if mp.cfg.MaxBytes > 0 {
+		bytes := mp.bytes + size
+		if txExists {
+			bytes -= oldScore.size
+		}
+		if err := mp.evictBytes(bytes-mp.cfg.MaxBytes, sender, priority); err != nil {
+			return err
+		}
+	}
 
+	if txExists {
+		oldTx := senderIndex.Get(key).Value.(sdk.Tx)
 		mp.priorityIndex.Remove(txMeta[C]{
 			nonce:    nonce,
 			sender:   sender,
@@ -260,20 +317,103 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 			weight:   oldScore.weight,
 		})
 		mp.priorityCounts[oldScore.priority]--
+		mp.bytes -= oldScore.size
+		mp.listeners.emit(EventTxRemoved, TxInfo{Tx: oldTx, Hash: []byte(oldScore.hash), Sender: sender, Nonce: nonce, Priority: int64Priority(oldScore.priority)})
 	}
 
 	mp.priorityCounts[priority]++
+	mp.bytes += size
 
 	// Since senderIndex is scored by nonce, a changed priority will overwrite the
 	// existing key.
 	key.senderElement = senderIndex.Set(key, tx)
 
-	mp.scores[sk] = txMeta[C]{priority: priority}
+	mp.scores[sk] = txMeta[C]{priority: priority, size: size, hash: hash}
 	mp.priorityIndex.Set(key, tx)
+	mp.listeners.emit(EventTxInserted, TxInfo{Tx: tx, Hash: []byte(hash), Sender: sender, Nonce: nonce, Priority: int64Priority(priority)})
 
 	return nil
 }
 
+// evictBytes evicts the transactions with the lowest priority, and the transactions
+// of the same sender with a higher nonce which can't be included without them, until
+// at least excess bytes are freed. It fails without evicting anything if any of the
+// transactions to evict, including the higher nonce ones, does not have a lower
+// priority than the inserted one, or is sent by the same sender.
+func (mp *PriorityNonceMempool[C]) evictBytes(excess int64, sender string, priority C) error {
+	if excess <= 0 {
+		return nil
+	}
+
+	// evictFrom holds, for each sender, the lowest nonce to evict.
+	evictFrom := make(map[string]uint64)
+	var freed int64
+	for node := mp.priorityIndex.Back(); freed < excess; node = node.Prev() {
+		if node == nil {
+			return ErrMempoolTxMaxBytes
+		}
+
+		lowest := node.Key().(txMeta[C])
+		if lowest.sender == sender || mp.cfg.TxPriority.Compare(lowest.priority, priority) >= 0 {
+			return ErrMempoolTxMaxBytes
+		}
+
+		from, ok := evictFrom[lowest.sender]
+		if ok && from <= lowest.nonce {
+			// already evicted with a lower nonce tx of the sender.
+			continue
+		}
+		for e := lowest.senderElement; e != nil; e = e.Next() {
+			nonce := e.Key().(txMeta[C]).nonce
+			if ok && nonce >= from {
+				break
+			}
+			// the higher nonce txs of the sender are evicted along, none of them
+			// may outrank the inserted tx either.
+			score := mp.scores[txMeta[C]{nonce: nonce, sender: lowest.sender}]
+			if mp.cfg.TxPriority.Compare(score.priority, priority) >= 0 {
+				return ErrMempoolTxMaxBytes
+			}
+			freed += score.size
+		}
+		evictFrom[lowest.sender] = lowest.nonce
+	}
+
+	for evictedSender, from := range evictFrom {
+		var nonces []uint64
+		for e := mp.senderIndices[evictedSender].Front(); e != nil; e = e.Next() {
+			if nonce := e.Key().(txMeta[C]).nonce; nonce >= from {
+				nonces = append(nonces, nonce)
+			}
+		}
+		for _, nonce := range nonces {
+			if err := mp.remove(evictedSender, nonce); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// txSize returns the size of the tx bytes of ctx, or 0 if ctx does not wrap a sdk.Context.
+func txSize(ctx context.Context) int64 {
+	return int64(len(txBytes(ctx)))
+}
+
+// txBytes returns the tx bytes of ctx, or nil if ctx does not wrap a sdk.Context.
+func txBytes(ctx context.Context) []byte {
+	sdkCtx, ok := ctx.(sdk.Context)
+	if !ok {
+		sdkCtx, ok = ctx.Value(sdk.SdkContextKey).(sdk.Context)
+		if !ok {
+			return nil
+		}
+	}
+
+	return sdkCtx.TxBytes()
+}
+
 func (i *PriorityNonceIterator[C]) iteratePriority() Iterator {
 	// beginning of priority iteration
 	if i.priorityNode == nil {
@@ -440,9 +580,11 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	}
 
 	sig := sigs[0]
-	sender := sig.Signer.String()
-	nonce := sig.Sequence
+	return mp.remove(sig.Signer.String(), sig.Sequence)
+}
 
+// remove removes the transaction of sender with the given nonce from the mempool.
+func (mp *PriorityNonceMempool[C]) remove(sender string, nonce uint64) error {
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
@@ -456,13 +598,48 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	}
 
 	mp.priorityIndex.Remove(tk)
-	senderTxs.Remove(tk)
+	tx := senderTxs.Remove(tk).Value.(sdk.Tx)
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
+	mp.bytes -= score.size
+	mp.listeners.emit(EventTxRemoved, TxInfo{Tx: tx, Hash: []byte(score.hash), Sender: sender, Nonce: nonce, Priority: int64Priority(score.priority)})
 
 	return nil
 }
 
+// WalkPendingTxs calls fn with the transactions pending in the mempool, ordered
+// by sender then nonce, until it returns true. If sender is not empty, only its
+// transactions are walked. The mempool is locked during the walk.
+func (mp *PriorityNonceMempool[C]) WalkPendingTxs(sender string, fn func(TxInfo) (stop bool)) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for _, s := range sortedSenders(mp.senderIndices, sender) {
+		for e := mp.senderIndices[s].Front(); e != nil; e = e.Next() {
+			nonce := e.Key().(txMeta[C]).nonce
+			score := mp.scores[txMeta[C]{nonce: nonce, sender: s}]
+			if fn(TxInfo{Tx: e.Value.(sdk.Tx), Hash: []byte(score.hash), Sender: s, Nonce: nonce, Priority: int64Priority(score.priority)}) {
+				return
+			}
+		}
+	}
+}
+
+// Subscribe registers listener to be called with every transaction inserted into
+// or removed from the mempool, until the returned function is called.
+func (mp *PriorityNonceMempool[C]) Subscribe(listener func(Event)) func() {
+	return mp.listeners.subscribe(listener)
+}
+
+// int64Priority returns p if it is an int64 priority, and 0 otherwise.
+func int64Priority[C comparable](p C) int64 {
+	if v, ok := any(p).(int64); ok {
+		return v
+	}
+
+	return 0
+}
+
 func IsEmpty[C comparable](mempool Mempool) error {
 	mp := mempool.(*PriorityNonceMempool[C])
 	if mp.priorityIndex.Len() != 0 {
@@ -890,3 +890,134 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestPriorityNonceMempool_ReplaceByFee(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			TxReplacement:   mempool.NewReplaceByFeeRule(10),
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	txs := []struct {
+		tx       testTx
+		replaces bool
+	}{
+		{testTx{id: 0, priority: 100, nonce: 1, address: sa}, true},
+		{testTx{id: 1, priority: 109, nonce: 1, address: sa}, false},
+		{testTx{id: 2, priority: 110, nonce: 1, address: sa}, true},
+		{testTx{id: 3, priority: 120, nonce: 1, address: sa}, false},
+		{testTx{id: 4, priority: math.MaxInt64, nonce: 1, address: sa}, true},
+		{testTx{id: 5, priority: math.MaxInt64, nonce: 1, address: sa}, false},
+	}
+
+	current := txs[0].tx
+	for _, tc := range txs {
+		err := mp.Insert(ctx.WithPriority(tc.tx.priority), tc.tx)
+		if tc.replaces {
+			require.NoError(t, err)
+			current = tc.tx
+		} else {
+			require.Error(t, err)
+		}
+		require.Equal(t, 1, mp.CountTx())
+		require.Equal(t, current, mp.Select(ctx, nil).Tx())
+	}
+
+	// negative priorities must increase by a percentage of their absolute value.
+	rule := mempool.NewReplaceByFeeRule(10)
+	require.False(t, rule(-100, -91, nil, nil))
+	require.True(t, rule(-100, -90, nil, nil))
+	require.True(t, mempool.NewReplaceByFeeRule(0)(5, 5, nil, nil))
+}
+
+func TestPriorityNonceMempool_MaxSenderTx(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			MaxSenderTx:     2,
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 2, address: sa}))
+	require.ErrorIs(t, mp.Insert(ctx, testTx{nonce: 3, address: sa}), mempool.ErrMempoolSenderTxMaxCapacity)
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sb}))
+
+	// replacing a pending tx is allowed.
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 2, address: sa}))
+	require.Equal(t, 3, mp.CountTx())
+
+	// the limit applies to the pending txs.
+	require.NoError(t, mp.Remove(testTx{nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 3, address: sa}))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_MaxBytes(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb, sc, sd := accounts[0].Address, accounts[1].Address, accounts[2].Address, accounts[3].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			MaxBytes:        30,
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+	insert := func(tx testTx, size int) error {
+		return mp.Insert(ctx.WithPriority(tx.priority).WithTxBytes(make([]byte, size)), tx)
+	}
+	selected := func() []int {
+		var ids []int
+		for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+			ids = append(ids, iter.Tx().(testTx).id)
+		}
+		return ids
+	}
+
+	require.NoError(t, insert(testTx{id: 0, priority: 5, nonce: 1, address: sa}, 10))
+	require.NoError(t, insert(testTx{id: 1, priority: 8, nonce: 2, address: sa}, 10))
+	require.NoError(t, insert(testTx{id: 2, priority: 20, nonce: 1, address: sb}, 10))
+
+	// the lowest priority tx is evicted, with the following txs of its sender.
+	require.NoError(t, insert(testTx{id: 3, priority: 10, nonce: 1, address: sc}, 10))
+	require.Equal(t, []int{2, 3}, selected())
+
+	// txs with a lower priority than every pending tx are rejected.
+	require.NoError(t, insert(testTx{id: 4, priority: 1, nonce: 1, address: sd}, 10))
+	require.ErrorIs(t, insert(testTx{id: 5, priority: 1, nonce: 1, address: sa}, 10), mempool.ErrMempoolTxMaxBytes)
+	require.Equal(t, []int{2, 3, 4}, selected())
+
+	// as many txs as needed are evicted, nothing is evicted if room can't be made.
+	require.ErrorIs(t, insert(testTx{id: 6, priority: 15, nonce: 1, address: sa}, 30), mempool.ErrMempoolTxMaxBytes)
+	require.Equal(t, []int{2, 3, 4}, selected())
+	require.NoError(t, insert(testTx{id: 7, priority: 15, nonce: 1, address: sa}, 20))
+	require.Equal(t, []int{2, 7}, selected())
+
+	// a tx replaced by a larger one only counts once.
+	require.NoError(t, insert(testTx{id: 8, priority: 16, nonce: 1, address: sa}, 20))
+	require.Equal(t, []int{2, 8}, selected())
+	require.NoError(t, mp.Remove(testTx{nonce: 1, address: sa}))
+	require.NoError(t, insert(testTx{id: 9, priority: 1, nonce: 1, address: sd}, 20))
+	require.Equal(t, []int{2, 9}, selected())
+
+	// the higher nonce txs evicted along with the lowest priority tx must not
+	// outrank the inserted tx either.
+	require.NoError(t, mp.Remove(testTx{nonce: 1, address: sd}))
+	require.NoError(t, insert(testTx{id: 10, priority: 1, nonce: 1, address: sd}, 10))
+	require.NoError(t, insert(testTx{id: 11, priority: 100, nonce: 2, address: sd}, 10))
+	require.ErrorIs(t, insert(testTx{id: 12, priority: 10, nonce: 1, address: sa}, 10), mempool.ErrMempoolTxMaxBytes)
+	require.Equal(t, []int{2, 10, 11}, selected())
+}
@@ -16,8 +16,9 @@ import (
 )
 
 var (
-	_ Mempool  = (*SenderNonceMempool)(nil)
-	_ Iterator = (*senderNonceMempoolIterator)(nil)
+	_ Mempool            = (*SenderNonceMempool)(nil)
+	_ InspectableMempool = (*SenderNonceMempool)(nil)
+	_ Iterator           = (*senderNonceMempoolIterator)(nil)
 )
 
 var DefaultMaxTx = -1
@@ -37,7 +38,8 @@ type SenderNonceMempool struct {
 	senders    map[string]*skiplist.SkipList
 	rnd        *rand.Rand
 	maxTx      int
-	existingTx map[txKey]bool
+	existingTx map[txKey]string // the hash of the existing txs, see txHash
+	listeners  listeners
 }
 
 type SenderNonceOptions func(*SenderNonceMempool)
@@ -51,7 +53,7 @@ type txKey struct {
 // nonce, the lowest first, picking a random sender on each iteration.
 func NewSenderNonceMempool(opts ...SenderNonceOptions) *SenderNonceMempool {
 	senderMap := make(map[string]*skiplist.SkipList)
-	existingTx := make(map[txKey]bool)
+	existingTx := make(map[txKey]string)
 	snp := &SenderNonceMempool{
 		senders:    senderMap,
 		maxTx:      DefaultMaxTx,
@@ -118,7 +120,7 @@ func (snm *SenderNonceMempool) NextSenderTx(sender string) sdk.Tx {
 
 // Insert adds a tx to the mempool. It returns an error if the tx does not have
 // at least one signer. Note, priority is ignored.
-func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
+func (snm *SenderNonceMempool) Insert(ctx context.Context, tx sdk.Tx) error {
 	snm.mtx.Lock()
 	defer snm.mtx.Unlock()
 	if snm.maxTx > 0 && len(snm.existingTx) >= snm.maxTx {
@@ -146,10 +148,15 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 		snm.senders[sender] = senderTxs
 	}
 
+	key := txKey{nonce: nonce, address: sender}
+	if old := senderTxs.Get(nonce); old != nil {
+		snm.listeners.emit(EventTxRemoved, TxInfo{Tx: old.Value.(sdk.Tx), Hash: []byte(snm.existingTx[key]), Sender: sender, Nonce: nonce})
+	}
 	senderTxs.Set(nonce, tx)
 
-	key := txKey{nonce: nonce, address: sender}
-	snm.existingTx[key] = true
+	hash := txHash(ctx)
+	snm.existingTx[key] = hash
+	snm.listeners.emit(EventTxInserted, TxInfo{Tx: tx, Hash: []byte(hash), Sender: sender, Nonce: nonce})
 
 	return nil
 }
@@ -228,11 +235,37 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 	}
 
 	key := txKey{nonce: nonce, address: sender}
+	hash := snm.existingTx[key]
 	delete(snm.existingTx, key)
+	snm.listeners.emit(EventTxRemoved, TxInfo{Tx: res.Value.(sdk.Tx), Hash: []byte(hash), Sender: sender, Nonce: nonce})
 
 	return nil
 }
 
+// WalkPendingTxs calls fn with the transactions pending in the mempool, ordered
+// by sender then nonce, until it returns true. If sender is not empty, only its
+// transactions are walked. The mempool is locked during the walk.
+func (snm *SenderNonceMempool) WalkPendingTxs(sender string, fn func(TxInfo) (stop bool)) {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	for _, s := range sortedSenders(snm.senders, sender) {
+		for e := snm.senders[s].Front(); e != nil; e = e.Next() {
+			nonce := e.Key().(uint64)
+			hash := snm.existingTx[txKey{nonce: nonce, address: s}]
+			if fn(TxInfo{Tx: e.Value.(sdk.Tx), Hash: []byte(hash), Sender: s, Nonce: nonce}) {
+				return
+			}
+		}
+	}
+}
+
+// Subscribe registers listener to be called with every transaction inserted into
+// or removed from the mempool, until the returned function is called.
+func (snm *SenderNonceMempool) Subscribe(listener func(Event)) func() {
+	return snm.listeners.subscribe(listener)
+}
+
 type senderNonceMempoolIterator struct {
 	rnd           *rand.Rand
 	currentTx     *skiplist.Element
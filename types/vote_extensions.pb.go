@@ -0,0 +1,808 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/abci/v1beta1/vote_extensions.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VoteExtensionPayload defines the payload of a module in a vote extension, or
+// the aggregation of the payloads of the validators.
+type VoteExtensionPayload struct {
+	// key identifies the module the payload belongs to.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// data is the module payload.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *VoteExtensionPayload) Reset()         { *m = VoteExtensionPayload{} }
+func (m *VoteExtensionPayload) String() string { return proto.CompactTextString(m) }
+func (*VoteExtensionPayload) ProtoMessage()    {}
+func (*VoteExtensionPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e80111028fe3b6a, []int{0}
+}
+func (m *VoteExtensionPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteExtensionPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteExtensionPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteExtensionPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteExtensionPayload.Merge(m, src)
+}
+func (m *VoteExtensionPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteExtensionPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteExtensionPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteExtensionPayload proto.InternalMessageInfo
+
+func (m *VoteExtensionPayload) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *VoteExtensionPayload) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// MultiplexedVoteExtension defines a vote extension carrying the payloads of
+// several modules.
+type MultiplexedVoteExtension struct {
+	// payloads are the payloads of the modules, sorted by key.
+	Payloads []VoteExtensionPayload `protobuf:"bytes,1,rep,name=payloads,proto3" json:"payloads"`
+}
+
+func (m *MultiplexedVoteExtension) Reset()         { *m = MultiplexedVoteExtension{} }
+func (m *MultiplexedVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*MultiplexedVoteExtension) ProtoMessage()    {}
+func (*MultiplexedVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e80111028fe3b6a, []int{1}
+}
+func (m *MultiplexedVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiplexedVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiplexedVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiplexedVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiplexedVoteExtension.Merge(m, src)
+}
+func (m *MultiplexedVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiplexedVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiplexedVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiplexedVoteExtension proto.InternalMessageInfo
+
+func (m *MultiplexedVoteExtension) GetPayloads() []VoteExtensionPayload {
+	if m != nil {
+		return m.Payloads
+	}
+	return nil
+}
+
+// InjectedVoteExtensions defines the pseudo transaction injected by the proposer
+// at the start of a block, carrying the aggregation of the vote extensions of
+// the previous height.
+type InjectedVoteExtensions struct {
+	// extended_commit_info is the encoded extended commit info of the previous
+	// height, which the aggregated payloads are verified against.
+	ExtendedCommitInfo []byte `protobuf:"bytes,1,opt,name=extended_commit_info,json=extendedCommitInfo,proto3" json:"extended_commit_info,omitempty"`
+	// payloads are the aggregated payloads of the modules, sorted by key.
+	Payloads []VoteExtensionPayload `protobuf:"bytes,2,rep,name=payloads,proto3" json:"payloads"`
+}
+
+func (m *InjectedVoteExtensions) Reset()         { *m = InjectedVoteExtensions{} }
+func (m *InjectedVoteExtensions) String() string { return proto.CompactTextString(m) }
+func (*InjectedVoteExtensions) ProtoMessage()    {}
+func (*InjectedVoteExtensions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e80111028fe3b6a, []int{2}
+}
+func (m *InjectedVoteExtensions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InjectedVoteExtensions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InjectedVoteExtensions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InjectedVoteExtensions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectedVoteExtensions.Merge(m, src)
+}
+func (m *InjectedVoteExtensions) XXX_Size() int {
+	return m.Size()
+}
+func (m *InjectedVoteExtensions) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectedVoteExtensions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectedVoteExtensions proto.InternalMessageInfo
+
+func (m *InjectedVoteExtensions) GetExtendedCommitInfo() []byte {
+	if m != nil {
+		return m.ExtendedCommitInfo
+	}
+	return nil
+}
+
+func (m *InjectedVoteExtensions) GetPayloads() []VoteExtensionPayload {
+	if m != nil {
+		return m.Payloads
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*VoteExtensionPayload)(nil), "cosmos.base.abci.v1beta1.VoteExtensionPayload")
+	proto.RegisterType((*MultiplexedVoteExtension)(nil), "cosmos.base.abci.v1beta1.MultiplexedVoteExtension")
+	proto.RegisterType((*InjectedVoteExtensions)(nil), "cosmos.base.abci.v1beta1.InjectedVoteExtensions")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/abci/v1beta1/vote_extensions.proto", fileDescriptor_7e80111028fe3b6a)
+}
+
+var fileDescriptor_7e80111028fe3b6a = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xb1, 0x4e, 0x2a, 0x41,
+	0x14, 0x86, 0x77, 0x80, 0xdc, 0x5c, 0x47, 0x0a, 0x33, 0x21, 0x66, 0x63, 0xb1, 0x92, 0xad, 0x68,
+	0x9c, 0x11, 0x6d, 0xa9, 0x30, 0x16, 0x14, 0x26, 0x64, 0x0b, 0x0b, 0x1b, 0x32, 0xbb, 0x73, 0xc0,
+	0x91, 0xdd, 0x3d, 0x1b, 0x67, 0x20, 0xf0, 0x16, 0x3e, 0x80, 0x0f, 0x44, 0x49, 0x69, 0x65, 0x0c,
+	0xbc, 0x88, 0xd9, 0x59, 0x30, 0xc1, 0x68, 0x67, 0x35, 0x7f, 0x72, 0xbe, 0xfc, 0xf3, 0xe5, 0x1c,
+	0xca, 0x13, 0x34, 0x19, 0x1a, 0x11, 0x4b, 0x03, 0x42, 0xc6, 0x89, 0x16, 0xf3, 0x6e, 0x0c, 0x56,
+	0x76, 0xc5, 0x1c, 0x2d, 0x8c, 0x60, 0x61, 0x21, 0x37, 0x1a, 0x73, 0xc3, 0x8b, 0x67, 0xb4, 0xc8,
+	0xfc, 0x8a, 0xe7, 0x25, 0xcf, 0x4b, 0x9e, 0xef, 0xf8, 0xb3, 0xd6, 0x04, 0x27, 0xe8, 0x20, 0x51,
+	0xa6, 0x8a, 0x0f, 0x7b, 0xb4, 0x75, 0x8f, 0x16, 0x6e, 0xf7, 0x3d, 0x43, 0xb9, 0x4c, 0x51, 0x2a,
+	0x76, 0x42, 0xeb, 0x53, 0x58, 0xfa, 0xa4, 0x4d, 0x3a, 0x47, 0x51, 0x19, 0x19, 0xa3, 0x0d, 0x25,
+	0xad, 0xf4, 0x6b, 0x6d, 0xd2, 0x69, 0x46, 0x2e, 0x87, 0x29, 0xf5, 0xef, 0x66, 0xa9, 0xd5, 0x45,
+	0x0a, 0x0b, 0x50, 0x07, 0x45, 0x6c, 0x48, 0xff, 0x17, 0x55, 0x99, 0xf1, 0x49, 0xbb, 0xde, 0x39,
+	0xbe, 0xe2, 0xfc, 0x37, 0x39, 0xfe, 0x93, 0x43, 0xbf, 0xb1, 0x7a, 0x3f, 0xf7, 0xa2, 0xaf, 0x96,
+	0xf0, 0x95, 0xd0, 0xd3, 0x41, 0xfe, 0x04, 0x89, 0xfd, 0xf6, 0x97, 0x61, 0x97, 0xb4, 0xe5, 0x56,
+	0xa1, 0x40, 0x8d, 0x12, 0xcc, 0x32, 0x6d, 0x47, 0x3a, 0x1f, 0xa3, 0xf3, 0x6f, 0x46, 0x6c, 0x3f,
+	0xbb, 0x71, 0xa3, 0x41, 0x3e, 0xc6, 0x03, 0xbd, 0xda, 0x5f, 0xe8, 0xf5, 0x7b, 0xab, 0x4d, 0x40,
+	0xd6, 0x9b, 0x80, 0x7c, 0x6c, 0x02, 0xf2, 0xb2, 0x0d, 0xbc, 0xf5, 0x36, 0xf0, 0xde, 0xb6, 0x81,
+	0xf7, 0x10, 0x4e, 0xb4, 0x7d, 0x9c, 0xc5, 0x3c, 0xc1, 0x4c, 0xec, 0xee, 0x59, 0x3d, 0x17, 0x46,
+	0x4d, 0x85, 0x5d, 0x16, 0x60, 0xe2, 0x7f, 0xee, 0x1e, 0xd7, 0x9f, 0x03, 0x00, 0x5f, 0x4e, 0x1a,
+	0xd0, 0xf1, 0x01, 0x00, 0x00,
+}
+
+func (m *VoteExtensionPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteExtensionPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiplexedVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiplexedVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiplexedVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payloads) > 0 {
+		for iNdEx := len(m.Payloads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtensions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InjectedVoteExtensions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InjectedVoteExtensions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InjectedVoteExtensions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payloads) > 0 {
+		for iNdEx := len(m.Payloads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtensions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExtendedCommitInfo) > 0 {
+		i -= len(m.ExtendedCommitInfo)
+		copy(dAtA[i:], m.ExtendedCommitInfo)
+		i = encodeVarintVoteExtensions(dAtA, i, uint64(len(m.ExtendedCommitInfo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoteExtensions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoteExtensions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VoteExtensionPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovVoteExtensions(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovVoteExtensions(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiplexedVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payloads) > 0 {
+		for _, e := range m.Payloads {
+			l = e.Size()
+			n += 1 + l + sovVoteExtensions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InjectedVoteExtensions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExtendedCommitInfo)
+	if l > 0 {
+		n += 1 + l + sovVoteExtensions(uint64(l))
+	}
+	if len(m.Payloads) > 0 {
+		for _, e := range m.Payloads {
+			l = e.Size()
+			n += 1 + l + sovVoteExtensions(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVoteExtensions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoteExtensions(x uint64) (n int) {
+	return sovVoteExtensions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VoteExtensionPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteExtensionPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteExtensionPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiplexedVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiplexedVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiplexedVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payloads = append(m.Payloads, VoteExtensionPayload{})
+			if err := m.Payloads[len(m.Payloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InjectedVoteExtensions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InjectedVoteExtensions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InjectedVoteExtensions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommitInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedCommitInfo = append(m.ExtendedCommitInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtendedCommitInfo == nil {
+				m.ExtendedCommitInfo = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payloads = append(m.Payloads, VoteExtensionPayload{})
+			if err := m.Payloads[len(m.Payloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoteExtensions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoteExtensions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteExtensions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoteExtensions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoteExtensions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoteExtensions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoteExtensions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoteExtensions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoteExtensions = fmt.Errorf("proto: unexpected end of group")
+)
@@ -11,6 +11,8 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ implementation.Account = (*TestAccount)(nil)
@@ -115,3 +117,22 @@ func (t TestAccount) RegisterQueryHandlers(builder *implementation.QueryBuilder)
 		return &types.UInt64Value{Value: v}, nil
 	})
 }
+
+var _ implementation.LegacyAccountMigrator = (*LegacyTestAccount)(nil)
+
+func NewLegacyTestAccount(d accountstd.Dependencies) (*LegacyTestAccount, error) {
+	acc, err := NewTestAccount(d)
+	if err != nil {
+		return nil, err
+	}
+	return &LegacyTestAccount{TestAccount: *acc}, nil
+}
+
+// LegacyTestAccount is a TestAccount which legacy accounts can be migrated to.
+type LegacyTestAccount struct {
+	TestAccount
+}
+
+func (t LegacyTestAccount) MigrateLegacyAccount(_ context.Context, _ sdk.AccountI, _ implementation.ProtoMsg) (sdk.Coins, error) {
+	return nil, nil
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/x/accounts/internal/implementation"
 
@@ -32,6 +33,9 @@ type AccountCreatorFunc = implementation.AccountCreatorFunc
 // Dependencies is the exported type of Dependencies.
 type Dependencies = implementation.Dependencies
 
+// LegacyAccountMigrator is the exported type of LegacyAccountMigrator.
+type LegacyAccountMigrator = implementation.LegacyAccountMigrator
+
 func RegisterExecuteHandler[
 	Req any, ProtoReq implementation.ProtoMsgG[Req], Resp any, ProtoResp implementation.ProtoMsgG[Resp],
 ](router *ExecuteBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
@@ -86,6 +90,45 @@ func SenderIsAccountsModule(ctx context.Context) bool {
 	return bytes.Equal(Sender(ctx), accountsModuleAddress)
 }
 
+// LegacyVestingAccount is implemented by the legacy x/auth vesting accounts. It allows the smart
+// accounts to carry over their schedule when they're migrated, without depending on x/auth. The
+// type of a vesting account is given by its proto message name.
+type LegacyVestingAccount interface {
+	sdk.AccountI
+
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+	GetStartTime() int64
+	GetEndTime() int64
+	GetOriginalVesting() sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
+// LegacyVestingPeriod is a period of the schedule of a legacy x/auth periodic vesting account.
+type LegacyVestingPeriod struct {
+	// Length is the duration of the period, in seconds.
+	Length int64
+	// Amount is the amount of coins vesting at the end of the period.
+	Amount sdk.Coins
+}
+
+// LegacyPeriodicVestingAccount is implemented by the legacy x/auth periodic vesting accounts.
+type LegacyPeriodicVestingAccount interface {
+	LegacyVestingAccount
+
+	GetLegacyVestingPeriods() []LegacyVestingPeriod
+}
+
+// LegacyVestingCoins returns the coins of a legacy x/auth account which are still vesting at the
+// given time, which is nil if it isn't a vesting account. It allows the smart accounts which don't
+// lock coins to reject the migration of vesting accounts.
+func LegacyVestingCoins(legacyAcc sdk.AccountI, blockTime time.Time) sdk.Coins {
+	if acc, ok := legacyAcc.(LegacyVestingAccount); ok {
+		return acc.GetVestingCoins(blockTime)
+	}
+	return nil
+}
+
 // Funds returns if any funds were sent during the execute or init request. In queries this
 // returns nil.
 func Funds(ctx context.Context) sdk.Coins { return implementation.Funds(ctx) }
@@ -109,6 +152,11 @@ func UnpackAny[Msg any, ProtoMsg implementation.ProtoMsgG[Msg]](any *implementat
 	return implementation.UnpackAny[Msg, ProtoMsg](any)
 }
 
+// UnpackAnyRaw unpacks a protobuf Any message into the message type registered for its type URL.
+func UnpackAnyRaw(any *implementation.Any) (implementation.ProtoMsg, error) {
+	return implementation.UnpackAnyRaw(any)
+}
+
 // PackAny packs a protobuf Any message generically.
 func PackAny(msg implementation.ProtoMsg) (*implementation.Any, error) {
 	return implementation.PackAny(msg)
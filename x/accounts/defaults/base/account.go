@@ -7,6 +7,7 @@ import (
 
 	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
@@ -23,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
@@ -55,7 +57,44 @@ type Account struct {
 }
 
 func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
-	return &v1.MsgInitResponse{}, a.verifyAndSetPubKey(ctx, msg.PubKey)
+	if err := a.verifyAndSetPubKey(ctx, msg.PubKey); err != nil {
+		return nil, err
+	}
+	// the sequence of a migrated x/auth account is carried over, the account being its own
+	// creator only when migrated
+	if msg.InitSequence != 0 {
+		if !accountstd.SenderIsSelf(ctx) {
+			return nil, errors.New("the init sequence can only be set when migrating a legacy account")
+		}
+		if err := a.Sequence.Set(ctx, msg.InitSequence); err != nil {
+			return nil, err
+		}
+	}
+	return &v1.MsgInitResponse{}, nil
+}
+
+// MigrateLegacyAccount carries over the pubkey, unless the init message sets a new one, and the
+// sequence of a legacy x/auth account. Vesting accounts can only be migrated once fully vested.
+func (a Account) MigrateLegacyAccount(ctx context.Context, legacyAcc sdk.AccountI, initMsg protoiface.MessageV1) (sdk.Coins, error) {
+	msg, ok := initMsg.(*v1.MsgInit)
+	if !ok {
+		return nil, fmt.Errorf("invalid init message for a base account: %T", initMsg)
+	}
+
+	if !accountstd.LegacyVestingCoins(legacyAcc, a.hs.HeaderInfo(ctx).Time).IsZero() {
+		return nil, errors.New("a vesting account can only be migrated to the lockup account matching its schedule")
+	}
+
+	if len(msg.PubKey) == 0 {
+		pubKey, ok := legacyAcc.GetPubKey().(*secp256k1.PubKey)
+		if !ok {
+			return nil, fmt.Errorf("only a secp256k1 pubkey can be carried over to a base account, got %T", legacyAcc.GetPubKey())
+		}
+		msg.PubKey = pubKey.Bytes()
+	}
+
+	msg.InitSequence = legacyAcc.GetSequence()
+	return nil, nil
 }
 
 func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
@@ -80,41 +119,71 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
 	}
 
-	pubKey, signerData, err := a.computeSignerData(ctx)
+	pk, err := a.PubKey.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	txData, err := a.getTxData(msg)
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.VerifySignature(ctx, msg, &pk)
+}
+
+// VerifySignature verifies the signature of the account in the transaction being
+// authenticated against the given pubkey, and increases the sequence. It allows
+// the accounts extending the base account to authenticate other keys than the
+// pubkey of the account.
+func (a Account) VerifySignature(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate, pubKey *secp256k1.PubKey) error {
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	signerData, err := NewSignerData(ctx, a.addrCodec, a.hs, wantSequence, &anypb.Any{
+		TypeUrl: pkAny.TypeUrl,
+		Value:   pkAny.Value,
+	})
+	if err != nil {
+		return err
 	}
 
-	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	txData, err := GetTxData(msg)
+	if err != nil {
+		return err
+	}
+
+	gotSeq := signerInfo.Sequence
 	if gotSeq != signerData.Sequence {
-		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+		return fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
 	}
 
-	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	signMode, err := ParseSignMode(signerInfo.ModeInfo)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+		return fmt.Errorf("unable to parse sign mode: %w", err)
 	}
 
 	signature := msg.Tx.Signatures[msg.SignerIndex]
 
 	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if !pubKey.VerifySignature(signBytes, signature) {
-		return nil, errors.New("signature verification failed")
+		return errors.New("signature verification failed")
 	}
 
-	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+	return nil
 }
 
-func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+// ParseSignMode returns the sign mode of the mode info of a signer, which must
+// be a single signer.
+func ParseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 	single, ok := info.Sum.(*tx.ModeInfo_Single_)
 	if !ok {
 		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
@@ -122,47 +191,29 @@ func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 	return signingv1beta1.SignMode(single.Single.Mode), nil
 }
 
-// computeSignerData will populate signer data and also increase the sequence.
-func (a Account) computeSignerData(ctx context.Context) (secp256k1.PubKey, signing.SignerData, error) {
-	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
-	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
-	}
-	chainID := a.hs.HeaderInfo(ctx).ChainID
-
-	wantSequence, err := a.Sequence.Next(ctx)
-	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
-	}
-
-	pk, err := a.PubKey.Get(ctx)
-	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
-	}
-
-	pkAny, err := codectypes.NewAnyWithValue(&pk)
+// NewSignerData populates the signer data of the account being authenticated,
+// given its sequence and pubkey.
+func NewSignerData(ctx context.Context, addrCodec address.Codec, hs header.Service, sequence uint64, pubKey *anypb.Any) (signing.SignerData, error) {
+	addrStr, err := addrCodec.BytesToString(accountstd.Whoami(ctx))
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
-	accNum, err := a.getNumber(ctx, addrStr)
+	accNum, err := getNumber(ctx, addrStr)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
-	return pk, signing.SignerData{
+	return signing.SignerData{
 		Address:       addrStr,
-		ChainID:       chainID,
+		ChainID:       hs.HeaderInfo(ctx).ChainID,
 		AccountNumber: accNum,
-		Sequence:      wantSequence,
-		PubKey: &anypb.Any{
-			TypeUrl: pkAny.TypeUrl,
-			Value:   pkAny.Value,
-		},
+		Sequence:      sequence,
+		PubKey:        pubKey,
 	}, nil
 }
 
-func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error) {
+func getNumber(ctx context.Context, addrStr string) (uint64, error) {
 	accNum, err := accountstd.QueryModule[accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
 	if err != nil {
 		return 0, err
@@ -171,7 +222,9 @@ func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error)
 	return accNum.Number, nil
 }
 
-func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+// GetTxData returns the data of the transaction being authenticated, from which
+// the sign bytes of its signers are computed.
+func GetTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
 	// TODO: add a faster way to do this, we can avoid unmarshalling but we need
 	// to write a function that converts this into the protov2 counterparty.
 	txBody := new(txv1beta1.TxBody)
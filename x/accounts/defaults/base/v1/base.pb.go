@@ -26,6 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgInit struct {
 	// pub_key defines the secp256k1 pubkey for the account.
 	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// init_sequence defines the initial sequence of the account, it is used
+	// to carry over the sequence of a migrated x/auth account.
+	InitSequence uint64 `protobuf:"varint,2,opt,name=init_sequence,json=initSequence,proto3" json:"init_sequence,omitempty"`
 }
 
 func (m *MsgInit) Reset()         { *m = MsgInit{} }
@@ -68,6 +71,13 @@ func (m *MsgInit) GetPubKey() []byte {
 	return nil
 }
 
+func (m *MsgInit) GetInitSequence() uint64 {
+	if m != nil {
+		return m.InitSequence
+	}
+	return 0
+}
+
 // MsgInitResponse is the response returned after base account initialization.
 // This is empty.
 type MsgInitResponse struct {
@@ -287,23 +297,24 @@ func init() {
 }
 
 var fileDescriptor_7c860870b5ed6dc2 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
+	// 271 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29, 0xd6, 0x4f, 0x49, 0x4d, 0x4b,
 	0x2c, 0xcd, 0x29, 0x29, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05,
 	0x45, 0xf9, 0x25, 0xf9, 0x42, 0x0a, 0x10, 0xc5, 0x7a, 0x30, 0xc5, 0x7a, 0x30, 0xc5, 0x7a, 0x60,
-	0x45, 0x65, 0x86, 0x4a, 0x4a, 0x5c, 0xec, 0xbe, 0xc5, 0xe9, 0x9e, 0x79, 0x99, 0x25, 0x42, 0xe2,
+	0x45, 0x65, 0x86, 0x4a, 0xee, 0x5c, 0xec, 0xbe, 0xc5, 0xe9, 0x9e, 0x79, 0x99, 0x25, 0x42, 0xe2,
 	0x5c, 0xec, 0x05, 0xa5, 0x49, 0xf1, 0xd9, 0xa9, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x3c, 0x41,
-	0x6c, 0x05, 0xa5, 0x49, 0xde, 0xa9, 0x95, 0x4a, 0x82, 0x5c, 0xfc, 0x50, 0x35, 0x41, 0xa9, 0xc5,
-	0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0xfa, 0x5c, 0xbc, 0xbe, 0xc5, 0xe9, 0xc1, 0xe5, 0x89, 0x05,
-	0x01, 0x60, 0x35, 0x42, 0x72, 0x5c, 0xdc, 0x79, 0xa9, 0xe5, 0xf1, 0xa8, 0x06, 0x70, 0xe6, 0xa5,
-	0x96, 0x43, 0xe4, 0x95, 0xc4, 0xb9, 0x44, 0x51, 0x34, 0xc0, 0x4d, 0xe2, 0xe7, 0xe2, 0x0d, 0x2c,
-	0x4d, 0x2d, 0xaa, 0x0c, 0x4e, 0x2d, 0x2c, 0x4d, 0xcd, 0x4b, 0x4e, 0x55, 0x32, 0xe6, 0x12, 0x45,
-	0x11, 0x80, 0xa9, 0x14, 0x92, 0xe2, 0xe2, 0x28, 0x86, 0x8a, 0x81, 0xcd, 0x67, 0x09, 0x82, 0xf3,
-	0x9d, 0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x03, 0x12, 0x04,
-	0xc5, 0x29, 0xd9, 0x7a, 0x99, 0xf9, 0xfa, 0x15, 0xb8, 0xc3, 0x2d, 0x89, 0x0d, 0x1c, 0x66, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0x31, 0x20, 0x4a, 0x62, 0x01, 0x00, 0x00,
+	0x6c, 0x05, 0xa5, 0x49, 0xde, 0xa9, 0x95, 0x42, 0xca, 0x5c, 0xbc, 0x99, 0x79, 0x99, 0x25, 0xf1,
+	0xc5, 0xa9, 0x85, 0xa5, 0xa9, 0x79, 0xc9, 0xa9, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x3c,
+	0x20, 0xc1, 0x60, 0xa8, 0x98, 0x92, 0x20, 0x17, 0x3f, 0xd4, 0xa0, 0xa0, 0xd4, 0xe2, 0x82, 0xfc,
+	0xbc, 0xe2, 0x54, 0x25, 0x7d, 0x2e, 0x5e, 0xdf, 0xe2, 0xf4, 0xe0, 0xf2, 0xc4, 0x82, 0x00, 0x88,
+	0x41, 0x72, 0x5c, 0xdc, 0x79, 0xa9, 0xe5, 0xf1, 0xa8, 0xb6, 0x70, 0xe6, 0xa5, 0x96, 0x43, 0xe4,
+	0x95, 0xc4, 0xb9, 0x44, 0x51, 0x34, 0xc0, 0x4d, 0xe2, 0xe7, 0xe2, 0x0d, 0x2c, 0x4d, 0x2d, 0xaa,
+	0x84, 0xdb, 0x66, 0xcc, 0x25, 0x8a, 0x22, 0x00, 0x53, 0x29, 0x24, 0xc5, 0xc5, 0x01, 0x77, 0x26,
+	0x23, 0xd8, 0x99, 0x70, 0xbe, 0x93, 0xd3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
+	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
+	0x44, 0x69, 0x40, 0xc2, 0xa9, 0x38, 0x25, 0x5b, 0x2f, 0x33, 0x5f, 0xbf, 0x02, 0x77, 0xe0, 0x26,
+	0xb1, 0x81, 0x03, 0xd6, 0x18, 0x30, 0x00, 0xb2, 0xeb, 0xec, 0xab, 0x87, 0x01, 0x00, 0x00,
 }
 
 func (m *MsgInit) Marshal() (dAtA []byte, err error) {
@@ -326,6 +337,11 @@ func (m *MsgInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InitSequence != 0 {
+		i = encodeVarintBase(dAtA, i, uint64(m.InitSequence))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.PubKey) > 0 {
 		i -= len(m.PubKey)
 		copy(dAtA[i:], m.PubKey)
@@ -484,6 +500,9 @@ func (m *MsgInit) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBase(uint64(l))
 	}
+	if m.InitSequence != 0 {
+		n += 1 + sovBase(uint64(m.InitSequence))
+	}
 	return n
 }
 
@@ -608,6 +627,25 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitSequence", wireType)
+			}
+			m.InitSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBase(dAtA[iNdEx:])
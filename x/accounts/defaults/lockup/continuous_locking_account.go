@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"google.golang.org/protobuf/runtime/protoiface"
+
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/math"
@@ -16,7 +18,8 @@ import (
 
 // Compile-time type assertions
 var (
-	_ accountstd.Interface = (*ContinuousLockingAccount)(nil)
+	_ accountstd.Interface             = (*ContinuousLockingAccount)(nil)
+	_ accountstd.LegacyAccountMigrator = (*ContinuousLockingAccount)(nil)
 )
 
 // NewContinuousLockingAccount creates a new ContinuousLockingAccount object.
@@ -60,6 +63,23 @@ func (cva ContinuousLockingAccount) Init(ctx context.Context, msg *lockuptypes.M
 	return cva.BaseLockup.Init(ctx, msg)
 }
 
+// MigrateLegacyAccount migrates a legacy x/auth continuous vesting account, keeping its schedule and
+// its original vesting. Only the owner is taken from the init message.
+func (cva ContinuousLockingAccount) MigrateLegacyAccount(_ context.Context, legacyAcc sdk.AccountI, initMsg protoiface.MessageV1) (sdk.Coins, error) {
+	acc, ok := legacyVestingAccount(legacyAcc, legacyContinuousVestingAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("only a continuous vesting account can be migrated to a %s, got %T", CONTINUOUS_LOCKING_ACCOUNT, legacyAcc)
+	}
+	msg, ok := initMsg.(*lockuptypes.MsgInitLockupAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("invalid init message for a %s: %T", CONTINUOUS_LOCKING_ACCOUNT, initMsg)
+	}
+
+	msg.StartTime = time.Unix(acc.GetStartTime(), 0)
+	msg.EndTime = time.Unix(acc.GetEndTime(), 0)
+	return legacyOriginalLocking(acc)
+}
+
 func (cva *ContinuousLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (
 	*lockuptypes.MsgExecuteMessagesResponse, error,
 ) {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"google.golang.org/protobuf/runtime/protoiface"
+
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
@@ -14,7 +16,8 @@ import (
 
 // Compile-time type assertions
 var (
-	_ accountstd.Interface = (*DelayedLockingAccount)(nil)
+	_ accountstd.Interface             = (*DelayedLockingAccount)(nil)
+	_ accountstd.LegacyAccountMigrator = (*DelayedLockingAccount)(nil)
 )
 
 // NewDelayedLockingAccount creates a new DelayedLockingAccount object.
@@ -37,6 +40,23 @@ func (dva DelayedLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgI
 	return dva.BaseLockup.Init(ctx, msg)
 }
 
+// MigrateLegacyAccount migrates a legacy x/auth delayed vesting account, keeping its schedule and
+// its original vesting. Only the owner is taken from the init message.
+func (dva DelayedLockingAccount) MigrateLegacyAccount(_ context.Context, legacyAcc sdk.AccountI, initMsg protoiface.MessageV1) (sdk.Coins, error) {
+	acc, ok := legacyVestingAccount(legacyAcc, legacyDelayedVestingAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("only a delayed vesting account can be migrated to a %s, got %T", DELAYED_LOCKING_ACCOUNT, legacyAcc)
+	}
+	msg, ok := initMsg.(*lockuptypes.MsgInitLockupAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("invalid init message for a %s: %T", DELAYED_LOCKING_ACCOUNT, initMsg)
+	}
+
+	msg.StartTime = time.Time{}
+	msg.EndTime = time.Unix(acc.GetEndTime(), 0)
+	return legacyOriginalLocking(acc)
+}
+
 func (dva *DelayedLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (
 	*lockuptypes.MsgExecuteMessagesResponse, error,
 ) {
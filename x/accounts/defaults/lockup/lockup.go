@@ -74,6 +74,35 @@ type BaseLockup struct {
 	EndTime collections.Item[time.Time]
 }
 
+// The proto names of the legacy x/auth vesting accounts migrated to the lockup accounts, which
+// tell them apart without depending on x/auth.
+const (
+	legacyContinuousVestingAccount = "cosmos.vesting.v1beta1.ContinuousVestingAccount"
+	legacyDelayedVestingAccount    = "cosmos.vesting.v1beta1.DelayedVestingAccount"
+	legacyPeriodicVestingAccount   = "cosmos.vesting.v1beta1.PeriodicVestingAccount"
+	legacyPermanentLockedAccount   = "cosmos.vesting.v1beta1.PermanentLockedAccount"
+)
+
+// legacyVestingAccount returns the legacy account if it's a legacy x/auth vesting account with the
+// given proto name.
+func legacyVestingAccount(legacyAcc sdk.AccountI, name string) (accountstd.LegacyVestingAccount, bool) {
+	acc, ok := legacyAcc.(accountstd.LegacyVestingAccount)
+	if !ok || proto.MessageName(legacyAcc) != name {
+		return nil, false
+	}
+	return acc, true
+}
+
+// legacyOriginalLocking returns the original vesting of a legacy x/auth vesting account, which the
+// lockup account it migrates to is initialized with.
+func legacyOriginalLocking(acc accountstd.LegacyVestingAccount) (sdk.Coins, error) {
+	// the delegations of the legacy account are tracked by x/auth, lockup accounts track their own
+	if !acc.GetDelegatedVesting().IsZero() || !acc.GetDelegatedFree().IsZero() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("a vesting account must undelegate its coins before being migrated")
+	}
+	return acc.GetOriginalVesting(), nil
+}
+
 func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (
 	*lockuptypes.MsgInitLockupAccountResponse, error,
 ) {
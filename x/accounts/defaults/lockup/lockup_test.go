@@ -9,8 +9,11 @@ import (
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -276,3 +279,131 @@ func TestQueryLockupAccountBaseInfo(t *testing.T) {
 	_, err := baseLockup.QueryLockupAccountBaseInfo(ctx, &lockuptypes.QueryLockupAccountInfoRequest{})
 	require.NoError(t, err)
 }
+
+// mockLegacyVestingAccount mocks a legacy x/auth vesting account, named after the proto message it
+// stands for.
+type mockLegacyVestingAccount struct {
+	sdk.AccountI
+
+	name             string
+	startTime        int64
+	endTime          int64
+	originalVesting  sdk.Coins
+	delegatedVesting sdk.Coins
+	periods          []accountstd.LegacyVestingPeriod
+}
+
+func (m mockLegacyVestingAccount) XXX_MessageName() string { return m.name }
+
+func (m mockLegacyVestingAccount) GetVestingCoins(time.Time) sdk.Coins { return m.originalVesting }
+
+func (m mockLegacyVestingAccount) GetStartTime() int64 { return m.startTime }
+
+func (m mockLegacyVestingAccount) GetEndTime() int64 { return m.endTime }
+
+func (m mockLegacyVestingAccount) GetOriginalVesting() sdk.Coins { return m.originalVesting }
+
+func (m mockLegacyVestingAccount) GetDelegatedFree() sdk.Coins { return nil }
+
+func (m mockLegacyVestingAccount) GetDelegatedVesting() sdk.Coins { return m.delegatedVesting }
+
+func (m mockLegacyVestingAccount) GetLegacyVestingPeriods() []accountstd.LegacyVestingPeriod {
+	return m.periods
+}
+
+func TestMigrateLegacyVestingAccount(t *testing.T) {
+	ctx, ss := newMockContext(t)
+
+	t.Run("continuous vesting account", func(t *testing.T) {
+		acc, err := NewContinuousLockingAccount(makeMockDependencies(ss))
+		require.NoError(t, err)
+		legacyAcc := mockLegacyVestingAccount{
+			name:            legacyContinuousVestingAccount,
+			startTime:       100,
+			endTime:         200,
+			originalVesting: TestFunds,
+		}
+
+		msg := &lockuptypes.MsgInitLockupAccount{Owner: "owner"}
+		funds, err := acc.MigrateLegacyAccount(ctx, legacyAcc, msg)
+		require.NoError(t, err)
+		require.Equal(t, TestFunds, funds)
+		require.Equal(t, time.Unix(100, 0), msg.StartTime)
+		require.Equal(t, time.Unix(200, 0), msg.EndTime)
+	})
+
+	t.Run("vesting account of another type", func(t *testing.T) {
+		acc, err := NewContinuousLockingAccount(makeMockDependencies(ss))
+		require.NoError(t, err)
+		legacyAcc := mockLegacyVestingAccount{
+			name:            legacyDelayedVestingAccount,
+			endTime:         200,
+			originalVesting: TestFunds,
+		}
+
+		_, err = acc.MigrateLegacyAccount(ctx, legacyAcc, &lockuptypes.MsgInitLockupAccount{Owner: "owner"})
+		require.ErrorContains(t, err, "can be migrated")
+	})
+
+	t.Run("vesting account with delegations", func(t *testing.T) {
+		acc, err := NewDelayedLockingAccount(makeMockDependencies(ss))
+		require.NoError(t, err)
+		legacyAcc := mockLegacyVestingAccount{
+			name:             legacyDelayedVestingAccount,
+			endTime:          200,
+			originalVesting:  TestFunds,
+			delegatedVesting: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+		}
+
+		_, err = acc.MigrateLegacyAccount(ctx, legacyAcc, &lockuptypes.MsgInitLockupAccount{Owner: "owner"})
+		require.ErrorContains(t, err, "must undelegate its coins before being migrated")
+	})
+
+	t.Run("periodic vesting account", func(t *testing.T) {
+		// the locking periods are stored with a codec.Codec
+		newPeriodicLockingAccount := func(ss store.KVStoreService) (*PeriodicLockingAccount, error) {
+			deps := makeMockDependencies(ss)
+			deps.LegacyStateCodec = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+			return NewPeriodicLockingAccount(deps)
+		}
+
+		acc, err := newPeriodicLockingAccount(ss)
+		require.NoError(t, err)
+		periods := []accountstd.LegacyVestingPeriod{
+			{Length: 60, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(4)))},
+			{Length: 120, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6)))},
+		}
+		legacyAcc := mockLegacyVestingAccount{
+			name:            legacyPeriodicVestingAccount,
+			startTime:       100,
+			endTime:         280,
+			originalVesting: TestFunds,
+			periods:         periods,
+		}
+
+		msg := &lockuptypes.MsgInitPeriodicLockingAccount{Owner: "owner"}
+		funds, err := acc.MigrateLegacyAccount(ctx, legacyAcc, msg)
+		require.NoError(t, err)
+		require.Equal(t, TestFunds, funds)
+		require.True(t, msg.Migration)
+		require.Equal(t, time.Unix(100, 0), msg.StartTime)
+		require.Equal(t, []lockuptypes.Period{
+			{Length: time.Minute, Amount: periods[0].Amount},
+			{Length: 2 * time.Minute, Amount: periods[1].Amount},
+		}, msg.LockingPeriods)
+
+		// the schedule started already, so only the account itself can init it, when migrated
+		_, err = acc.Init(ctx, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+		selfCtx, selfSS := newMockContextWithSender(t, []byte("lockup_account"))
+		acc, err = newPeriodicLockingAccount(selfSS)
+		require.NoError(t, err)
+		_, err = acc.Init(selfCtx, msg)
+		require.NoError(t, err)
+
+		endTime, err := acc.EndTime.Get(selfCtx)
+		require.NoError(t, err)
+		require.True(t, time.Unix(280, 0).Equal(endTime))
+	})
+}
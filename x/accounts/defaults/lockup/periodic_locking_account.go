@@ -4,6 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
 	errorsmod "cosmossdk.io/errors"
@@ -17,7 +20,10 @@ import (
 )
 
 // Compile-time type assertions
-var _ accountstd.Interface = (*PeriodicLockingAccount)(nil)
+var (
+	_ accountstd.Interface             = (*PeriodicLockingAccount)(nil)
+	_ accountstd.LegacyAccountMigrator = (*PeriodicLockingAccount)(nil)
+)
 
 // NewPeriodicLockingAccount creates a new PeriodicLockingAccount object.
 func NewPeriodicLockingAccount(d accountstd.Dependencies) (*PeriodicLockingAccount, error) {
@@ -46,7 +52,12 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 
 	hs := pva.headerService.HeaderInfo(ctx)
 
-	if msg.StartTime.Before(hs.Time) {
+	// a vesting account migrated from x/auth keeps its schedule, which may have started already.
+	// The account being its own creator only when migrated, no one else can set the flag.
+	if msg.Migration && !accountstd.SenderIsSelf(ctx) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("the migration flag can only be set when migrating a legacy account")
+	}
+	if msg.StartTime.Before(hs.Time) && !msg.Migration {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("start time %s should be after block time")
 	}
 
@@ -122,6 +133,31 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 	return &lockuptypes.MsgInitPeriodicLockingAccountResponse{}, nil
 }
 
+// MigrateLegacyAccount migrates a legacy x/auth periodic vesting account, keeping its schedule and
+// its original vesting. Only the owner is taken from the init message.
+func (pva PeriodicLockingAccount) MigrateLegacyAccount(_ context.Context, legacyAcc sdk.AccountI, initMsg protoiface.MessageV1) (sdk.Coins, error) {
+	acc, ok := legacyAcc.(accountstd.LegacyPeriodicVestingAccount)
+	if !ok || proto.MessageName(legacyAcc) != legacyPeriodicVestingAccount {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("only a periodic vesting account can be migrated to a %s, got %T", PERIODIC_LOCKING_ACCOUNT, legacyAcc)
+	}
+	msg, ok := initMsg.(*lockuptypes.MsgInitPeriodicLockingAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("invalid init message for a %s: %T", PERIODIC_LOCKING_ACCOUNT, initMsg)
+	}
+
+	msg.StartTime = time.Unix(acc.GetStartTime(), 0)
+	periods := acc.GetLegacyVestingPeriods()
+	msg.LockingPeriods = make([]lockuptypes.Period, len(periods))
+	for i, period := range periods {
+		msg.LockingPeriods[i] = lockuptypes.Period{
+			Length: time.Duration(period.Length) * time.Second,
+			Amount: period.Amount,
+		}
+	}
+	msg.Migration = true
+	return legacyOriginalLocking(acc)
+}
+
 func (pva *PeriodicLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (
 	*lockuptypes.MsgExecuteMessagesResponse, error,
 ) {
@@ -4,16 +4,20 @@ import (
 	"context"
 	"time"
 
+	"google.golang.org/protobuf/runtime/protoiface"
+
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Compile-time type assertions
 var (
-	_ accountstd.Interface = (*PermanentLockingAccount)(nil)
+	_ accountstd.Interface             = (*PermanentLockingAccount)(nil)
+	_ accountstd.LegacyAccountMigrator = (*PermanentLockingAccount)(nil)
 )
 
 // NewPermanentLockingAccount creates a new PermanentLockingAccount object.
@@ -40,6 +44,23 @@ func (plva PermanentLockingAccount) Init(ctx context.Context, msg *lockuptypes.M
 	return resp, err
 }
 
+// MigrateLegacyAccount migrates a legacy x/auth permanent locked account, keeping its original
+// vesting. Only the owner is taken from the init message.
+func (plva PermanentLockingAccount) MigrateLegacyAccount(_ context.Context, legacyAcc sdk.AccountI, initMsg protoiface.MessageV1) (sdk.Coins, error) {
+	acc, ok := legacyVestingAccount(legacyAcc, legacyPermanentLockedAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("only a permanent locked account can be migrated to a %s, got %T", PERMANENT_LOCKING_ACCOUNT, legacyAcc)
+	}
+	msg, ok := initMsg.(*lockuptypes.MsgInitLockupAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("invalid init message for a %s: %T", PERMANENT_LOCKING_ACCOUNT, initMsg)
+	}
+
+	msg.StartTime = time.Time{}
+	msg.EndTime = time.Time{}
+	return legacyOriginalLocking(acc)
+}
+
 // GetlockedCoinsWithDenoms returns the total number of locked coins. If no coins are
 // locked, nil is returned.
 func (plva PermanentLockingAccount) GetlockedCoinsWithDenoms(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
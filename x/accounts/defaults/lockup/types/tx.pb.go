@@ -143,6 +143,10 @@ type MsgInitPeriodicLockingAccount struct {
 	// start of lockup
 	StartTime      time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	LockingPeriods []Period  `protobuf:"bytes,3,rep,name=locking_periods,json=lockingPeriods,proto3" json:"locking_periods"`
+	// migration is set when migrating a legacy x/auth periodic vesting account,
+	// whose schedule may have started before the block time. It can only be set
+	// by x/accounts when migrating the account.
+	Migration bool `protobuf:"varint,4,opt,name=migration,proto3" json:"migration,omitempty"`
 }
 
 func (m *MsgInitPeriodicLockingAccount) Reset()         { *m = MsgInitPeriodicLockingAccount{} }
@@ -199,6 +203,13 @@ func (m *MsgInitPeriodicLockingAccount) GetLockingPeriods() []Period {
 	return nil
 }
 
+func (m *MsgInitPeriodicLockingAccount) GetMigration() bool {
+	if m != nil {
+		return m.Migration
+	}
+	return false
+}
+
 // MsgInitPeriodicLockingAccountResponse defines the Msg/InitPeriodicLockingAccount
 // response type.
 type MsgInitPeriodicLockingAccountResponse struct {
@@ -555,58 +566,60 @@ func init() {
 }
 
 var fileDescriptor_e5f39108a4d67f92 = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0xaa, 0xc1, 0x43, 0x81, 0xb2, 0x58, 0xc5, 0x58, 0x65, 0x97, 0x5a, 0x42, 0x58,
-	0x56, 0xbd, 0x5b, 0x68, 0xa5, 0x56, 0x56, 0x2f, 0xb8, 0xf4, 0x97, 0x54, 0x57, 0xc8, 0xf4, 0x87,
-	0xd4, 0x1c, 0xac, 0xf1, 0xee, 0x30, 0xac, 0xf0, 0xce, 0x58, 0x3b, 0x63, 0x1b, 0xdf, 0xa2, 0x28,
-	0x87, 0x88, 0x13, 0xe7, 0x9c, 0x38, 0x45, 0x11, 0x27, 0x47, 0xe2, 0x8f, 0xe0, 0x88, 0x38, 0x71,
-	0x0a, 0x91, 0x89, 0x64, 0xfe, 0x8c, 0x68, 0x77, 0x66, 0x89, 0x0d, 0x04, 0x1c, 0x1f, 0x12, 0x29,
-	0x17, 0xef, 0xcc, 0xbc, 0xef, 0xbd, 0xf7, 0xbd, 0x6f, 0xde, 0xdb, 0x35, 0xc8, 0x5a, 0x94, 0xb9,
-	0x94, 0x99, 0xd0, 0xb2, 0x68, 0x83, 0x70, 0x66, 0xda, 0x68, 0x0b, 0x36, 0x6a, 0x9c, 0x99, 0x35,
-	0x6a, 0xed, 0x34, 0xea, 0x26, 0xdf, 0x35, 0xea, 0x1e, 0xe5, 0x54, 0xd5, 0x05, 0xd2, 0x08, 0x91,
-	0x46, 0x88, 0x34, 0x04, 0x32, 0x3d, 0x03, 0x5d, 0x87, 0x50, 0x33, 0xf8, 0x15, 0x3e, 0x69, 0x4d,
-	0x46, 0xaf, 0x42, 0x86, 0xcc, 0xe6, 0x4a, 0x15, 0x71, 0xb8, 0x62, 0x5a, 0xd4, 0x21, 0xd2, 0xfe,
-	0xcd, 0x7d, 0xd9, 0xc5, 0x43, 0xa2, 0xe7, 0x24, 0xda, 0x65, 0xd8, 0x6c, 0xae, 0xf8, 0x0f, 0x69,
-	0x98, 0x17, 0x86, 0x4a, 0xb0, 0x33, 0x25, 0x4f, 0x61, 0x4a, 0x62, 0x8a, 0xa9, 0x38, 0xf7, 0x57,
-	0xa1, 0x03, 0xa6, 0x14, 0xd7, 0x90, 0x19, 0xec, 0xaa, 0x8d, 0x2d, 0x13, 0x92, 0xb6, 0x34, 0xe9,
-	0xd7, 0x4d, 0xdc, 0x71, 0x11, 0xe3, 0xd0, 0x95, 0x2c, 0x32, 0x0f, 0xa3, 0x20, 0x59, 0x62, 0xf8,
-	0x0f, 0xe2, 0xf0, 0x3f, 0x03, 0x76, 0x6b, 0x82, 0xbc, 0x6a, 0x80, 0xcf, 0x68, 0x8b, 0x20, 0x2f,
-	0xa5, 0x2c, 0x2a, 0xd9, 0x44, 0x31, 0x75, 0x7a, 0x94, 0x4f, 0x4a, 0x2e, 0x6b, 0xb6, 0xed, 0x21,
-	0xc6, 0x36, 0xb9, 0xe7, 0x10, 0x5c, 0x16, 0x30, 0x75, 0x1d, 0x8c, 0x23, 0x62, 0x57, 0xfc, 0xf8,
-	0xa9, 0xe8, 0xa2, 0x92, 0x9d, 0x58, 0x4d, 0x1b, 0x22, 0xb9, 0x11, 0x26, 0x37, 0xfe, 0x0e, 0x93,
-	0x17, 0x27, 0x8f, 0x5f, 0xea, 0x91, 0xfd, 0x73, 0x5d, 0x79, 0xde, 0xeb, 0xe4, 0x94, 0xf2, 0x18,
-	0x22, 0xb6, 0x6f, 0x54, 0x7f, 0x07, 0x80, 0x71, 0xe8, 0x71, 0x11, 0x27, 0xf6, 0xbe, 0x71, 0x12,
-	0x81, 0xb3, 0x6f, 0x2e, 0x64, 0x2f, 0x0f, 0x74, 0x65, 0xaf, 0xd7, 0xc9, 0xc9, 0x9b, 0xce, 0x33,
-	0x7b, 0xc7, 0xbc, 0xad, 0xd2, 0x8c, 0x06, 0xbe, 0xba, 0xed, 0xbc, 0x8c, 0x58, 0x9d, 0x12, 0x86,
-	0x32, 0xcf, 0xa2, 0x60, 0x41, 0x02, 0x36, 0x90, 0xe7, 0x50, 0xdb, 0xb1, 0x7c, 0xa0, 0x43, 0xf0,
-	0xa8, 0x5a, 0x0d, 0x56, 0x19, 0x1d, 0xbd, 0x4a, 0xf5, 0x01, 0x98, 0xae, 0x09, 0x2e, 0x95, 0x7a,
-	0xc0, 0x8d, 0xa5, 0x62, 0x8b, 0xb1, 0xec, 0xc4, 0xea, 0xb2, 0x71, 0x4f, 0x83, 0x1b, 0xa2, 0x96,
-	0x62, 0xc2, 0x8f, 0x2d, 0xe2, 0x4e, 0xc9, 0x50, 0xc2, 0xc2, 0x0a, 0xc6, 0xe5, 0x81, 0x1e, 0xf1,
-	0x25, 0x5c, 0xba, 0x29, 0xa1, 0xc0, 0x0c, 0x0a, 0xb9, 0x0c, 0x96, 0xee, 0xd4, 0xe9, 0x4a, 0xd1,
-	0xae, 0x02, 0x26, 0x4a, 0x0c, 0xaf, 0xa3, 0x1a, 0xc2, 0x90, 0x23, 0xf5, 0x5b, 0x10, 0x67, 0x88,
-	0xd8, 0x43, 0x08, 0x28, 0x71, 0xea, 0x5f, 0x60, 0xa6, 0x09, 0x6b, 0x8e, 0x0d, 0x39, 0xf5, 0x2a,
-	0x50, 0x40, 0x02, 0x21, 0x13, 0xc5, 0xaf, 0x4f, 0x8f, 0xf2, 0x0b, 0xd2, 0xf9, 0xdf, 0x10, 0x33,
-	0x18, 0xe5, 0x8b, 0xe6, 0xb5, 0x73, 0xf5, 0x27, 0x10, 0x87, 0xae, 0xcf, 0x51, 0xf6, 0xdc, 0x7c,
-	0x28, 0x9f, 0x3f, 0xeb, 0x86, 0x9c, 0x75, 0xe3, 0x67, 0xea, 0x90, 0x7e, 0xc1, 0xa4, 0x4f, 0x61,
-	0xf6, 0xc9, 0x81, 0x1e, 0xf1, 0xc5, 0x7a, 0xd4, 0xeb, 0xe4, 0x24, 0xc5, 0xcc, 0x6b, 0x05, 0x4c,
-	0x96, 0x18, 0xfe, 0x87, 0xd8, 0x9f, 0x74, 0x99, 0x87, 0x0a, 0x98, 0x29, 0x31, 0xfc, 0x9f, 0xc3,
-	0xb7, 0x6d, 0x0f, 0xb6, 0xca, 0xa8, 0x05, 0x3d, 0xfb, 0xe3, 0x97, 0x7a, 0x3b, 0xd9, 0xc7, 0x51,
-	0x30, 0x56, 0x62, 0x78, 0x13, 0x91, 0x51, 0x28, 0xfe, 0x00, 0x00, 0xa7, 0xd7, 0xb8, 0xbd, 0xdb,
-	0x2b, 0xc1, 0x69, 0x28, 0x7b, 0xbb, 0x4f, 0xf6, 0xd8, 0xdd, 0xb2, 0xff, 0xea, 0xcb, 0x7e, 0x78,
-	0xae, 0x67, 0xb1, 0xc3, 0xb7, 0x1b, 0x55, 0xc3, 0xa2, 0xae, 0xfc, 0x04, 0x98, 0x7d, 0x43, 0xc8,
-	0xdb, 0x75, 0xc4, 0x02, 0x07, 0xf6, 0xb4, 0xd7, 0xc9, 0x7d, 0xee, 0x37, 0x98, 0xd5, 0xae, 0xf8,
-	0xdf, 0x22, 0x36, 0xc4, 0x9d, 0x6d, 0x80, 0x74, 0x89, 0xe1, 0x5f, 0x76, 0x91, 0xd5, 0xe0, 0xa8,
-	0x84, 0x18, 0x83, 0x18, 0xb1, 0x70, 0x3a, 0xd5, 0x55, 0x90, 0xf0, 0xe4, 0x9a, 0xa5, 0x94, 0x80,
-	0x70, 0xf2, 0xc6, 0xcb, 0x69, 0x8d, 0xb4, 0xcb, 0x6f, 0x61, 0x99, 0x17, 0x62, 0xa2, 0xc3, 0x2e,
-	0x50, 0x7f, 0x04, 0xa0, 0x25, 0xd7, 0x43, 0x08, 0xdc, 0x87, 0x1d, 0x5d, 0xe4, 0x2f, 0x41, 0xdc,
-	0x46, 0x84, 0xba, 0xe2, 0x0d, 0x98, 0x28, 0xcb, 0x5d, 0x61, 0xae, 0x5f, 0x81, 0xbe, 0x4c, 0x99,
-	0x33, 0x05, 0xcc, 0x0e, 0x74, 0xae, 0xac, 0xff, 0x7b, 0x30, 0xee, 0x21, 0x0b, 0x39, 0xcd, 0x21,
-	0x98, 0x5f, 0x21, 0xd5, 0x3d, 0x05, 0x4c, 0x0b, 0xcd, 0x2b, 0xf2, 0xcc, 0x4e, 0x45, 0x3f, 0xd4,
-	0x6d, 0x4f, 0x89, 0xcc, 0x65, 0x99, 0xb8, 0xf8, 0xdb, 0x71, 0x57, 0x53, 0x4e, 0xba, 0x9a, 0xf2,
-	0xaa, 0xab, 0x29, 0xfb, 0x17, 0x5a, 0xe4, 0xe4, 0x42, 0x8b, 0x9c, 0x5d, 0x68, 0x91, 0xff, 0xf3,
-	0x22, 0x2e, 0xb3, 0x77, 0x0c, 0x87, 0x9a, 0xbb, 0x77, 0xfc, 0x53, 0xf2, 0x93, 0x56, 0xe3, 0xc1,
-	0x85, 0x7f, 0xf7, 0x26, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xb6, 0xac, 0x3d, 0x59, 0x09, 0x00, 0x00,
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xd8, 0xe0, 0x66, 0x27, 0xb4, 0x25, 0x5b, 0x8b, 0xba, 0x56, 0xbb, 0x6b, 0x2c, 0x55,
+	0xb5, 0x2c, 0xbc, 0x4b, 0x02, 0x12, 0xc8, 0xe2, 0x12, 0x53, 0xbe, 0x24, 0x8c, 0xaa, 0x2d, 0x1f,
+	0x12, 0x1c, 0xac, 0xf1, 0xee, 0x74, 0x3a, 0x8a, 0x77, 0xc6, 0xda, 0x19, 0xdb, 0xf1, 0x0d, 0x21,
+	0x0e, 0xa8, 0xa7, 0x9e, 0x39, 0xe5, 0x88, 0x7a, 0x32, 0x52, 0x7f, 0x02, 0x87, 0x1c, 0xa3, 0x9c,
+	0x72, 0x22, 0xc8, 0x41, 0x72, 0x7e, 0x06, 0xda, 0x9d, 0xd9, 0xc4, 0x4e, 0x42, 0x62, 0x7c, 0x00,
+	0x89, 0x8b, 0x77, 0x67, 0xde, 0xe7, 0x7d, 0xde, 0xe7, 0x7d, 0xe6, 0x63, 0x0d, 0xab, 0x3e, 0x17,
+	0x21, 0x17, 0x2e, 0xf2, 0x7d, 0xde, 0x67, 0x52, 0xb8, 0x01, 0x7e, 0x82, 0xfa, 0x5d, 0x29, 0xdc,
+	0x2e, 0xf7, 0xb7, 0xfa, 0x3d, 0x57, 0x6e, 0x3b, 0xbd, 0x88, 0x4b, 0x6e, 0xda, 0x0a, 0xe9, 0xa4,
+	0x48, 0x27, 0x45, 0x3a, 0x0a, 0x59, 0x5a, 0x43, 0x21, 0x65, 0xdc, 0x4d, 0x7e, 0x55, 0x4e, 0xc9,
+	0xd2, 0xec, 0x1d, 0x24, 0xb0, 0x3b, 0x58, 0xef, 0x60, 0x89, 0xd6, 0x5d, 0x9f, 0x53, 0xa6, 0xe3,
+	0x6f, 0x5d, 0x55, 0x5d, 0x3d, 0x34, 0xfa, 0xb6, 0x46, 0x87, 0x82, 0xb8, 0x83, 0xf5, 0xf8, 0xa1,
+	0x03, 0x77, 0x54, 0xa0, 0x9d, 0x8c, 0x5c, 0xad, 0x53, 0x85, 0x0a, 0x84, 0x13, 0xae, 0xe6, 0xe3,
+	0xb7, 0x34, 0x81, 0x70, 0x4e, 0xba, 0xd8, 0x4d, 0x46, 0x9d, 0xfe, 0x13, 0x17, 0xb1, 0x91, 0x0e,
+	0xd9, 0x67, 0x43, 0x92, 0x86, 0x58, 0x48, 0x14, 0x6a, 0x15, 0x95, 0xef, 0xb3, 0xb0, 0xd0, 0x12,
+	0xe4, 0x33, 0x46, 0xe5, 0xe7, 0x89, 0xba, 0x4d, 0x25, 0xde, 0x74, 0xe0, 0xab, 0x7c, 0xc8, 0x70,
+	0x54, 0x04, 0x65, 0x50, 0x35, 0x9a, 0xc5, 0xfd, 0x97, 0xf5, 0x82, 0xd6, 0xb2, 0x19, 0x04, 0x11,
+	0x16, 0xe2, 0xb1, 0x8c, 0x28, 0x23, 0x9e, 0x82, 0x99, 0x0f, 0xe1, 0x0a, 0x66, 0x41, 0x3b, 0xe6,
+	0x2f, 0x66, 0xcb, 0xa0, 0xba, 0xba, 0x51, 0x72, 0x54, 0x71, 0x27, 0x2d, 0xee, 0x7c, 0x99, 0x16,
+	0x6f, 0x5e, 0xdf, 0xfd, 0xdd, 0xce, 0x3c, 0x3f, 0xb4, 0xc1, 0x2f, 0xd3, 0x71, 0x0d, 0x78, 0xd7,
+	0x30, 0x0b, 0xe2, 0xa0, 0xf9, 0x29, 0x84, 0x42, 0xa2, 0x48, 0x2a, 0x9e, 0xdc, 0x3f, 0xe5, 0x31,
+	0x92, 0xe4, 0x38, 0xdc, 0xa8, 0x1e, 0xef, 0xd8, 0xe0, 0xd9, 0x74, 0x5c, 0xd3, 0x2b, 0x5d, 0x17,
+	0xc1, 0x96, 0x7b, 0x51, 0xa7, 0x15, 0x0b, 0xde, 0xbd, 0x68, 0xde, 0xc3, 0xa2, 0xc7, 0x99, 0xc0,
+	0x95, 0xdf, 0xb2, 0xf0, 0x9e, 0x06, 0x3c, 0xc2, 0x11, 0xe5, 0x01, 0xf5, 0x63, 0x20, 0x65, 0x64,
+	0x59, 0xaf, 0xe6, 0xbb, 0xcc, 0x2e, 0xdf, 0xa5, 0xf9, 0x1d, 0xbc, 0xd9, 0x55, 0x5a, 0xda, 0xbd,
+	0x44, 0x9b, 0x28, 0xe6, 0xca, 0xb9, 0xea, 0xea, 0xc6, 0x03, 0xe7, 0x8a, 0x0d, 0xee, 0xa8, 0x5e,
+	0x9a, 0x46, 0xcc, 0xad, 0x78, 0x6f, 0x68, 0x2a, 0x15, 0x11, 0xe6, 0x5d, 0x68, 0x84, 0x94, 0x44,
+	0x48, 0x52, 0xce, 0x8a, 0xaf, 0x94, 0x41, 0x75, 0xc5, 0x3b, 0x9d, 0x68, 0x38, 0xc7, 0x3b, 0x76,
+	0x26, 0x36, 0xf8, 0xfe, 0x79, 0x83, 0x15, 0xc3, 0xbc, 0xcd, 0x0f, 0xe0, 0xfd, 0x4b, 0x5d, 0x3c,
+	0xf1, 0x7b, 0x02, 0xe0, 0x6a, 0x4b, 0x90, 0x87, 0xb8, 0x8b, 0x09, 0x92, 0xd8, 0x7c, 0x1b, 0xe6,
+	0x05, 0x66, 0xc1, 0x02, 0xf6, 0x6a, 0x9c, 0xf9, 0x05, 0x5c, 0x1b, 0xa0, 0x2e, 0x0d, 0x90, 0xe4,
+	0x51, 0x1b, 0x29, 0x48, 0x62, 0xb3, 0xd1, 0x7c, 0x73, 0xff, 0x65, 0xfd, 0x9e, 0x4e, 0xfe, 0x3a,
+	0xc5, 0xcc, 0xb3, 0xbc, 0x3e, 0x38, 0x33, 0x6f, 0x7e, 0x00, 0xf3, 0x28, 0x8c, 0x35, 0xea, 0x1d,
+	0x79, 0x27, 0x35, 0x37, 0xbe, 0x09, 0x1c, 0x7d, 0x13, 0x38, 0x1f, 0x72, 0xca, 0x66, 0xed, 0xd4,
+	0x39, 0x8d, 0x5b, 0x3f, 0xed, 0xd8, 0x99, 0xd8, 0xac, 0x1f, 0xa6, 0xe3, 0x9a, 0x96, 0x58, 0xf9,
+	0x13, 0xc0, 0xeb, 0x2d, 0x41, 0xbe, 0x62, 0xc1, 0xff, 0xba, 0xcd, 0x17, 0x00, 0xae, 0xb5, 0x04,
+	0xf9, 0x86, 0xca, 0xa7, 0x41, 0x84, 0x86, 0x1e, 0x1e, 0xa2, 0x28, 0xf8, 0xef, 0x5b, 0xbd, 0x58,
+	0xec, 0x8f, 0x59, 0x78, 0xad, 0x25, 0xc8, 0x63, 0xcc, 0x96, 0x91, 0xf8, 0x1e, 0x84, 0x92, 0x9f,
+	0xd1, 0xf6, 0xf7, 0x59, 0x86, 0xe4, 0xa9, 0xed, 0xa3, 0x19, 0xdb, 0x73, 0x97, 0xdb, 0xfe, 0x71,
+	0x6c, 0xfb, 0x8b, 0x43, 0xbb, 0x4a, 0xa8, 0x7c, 0xda, 0xef, 0x38, 0x3e, 0x0f, 0xf5, 0x07, 0xc2,
+	0x9d, 0x39, 0x84, 0x72, 0xd4, 0xc3, 0x22, 0x49, 0x10, 0x3f, 0x4f, 0xc7, 0xb5, 0xd7, 0xe2, 0x0d,
+	0xe6, 0x8f, 0xda, 0xf1, 0x97, 0x4a, 0x2c, 0xb0, 0x66, 0x8f, 0x60, 0xa9, 0x25, 0xc8, 0x47, 0xdb,
+	0xd8, 0xef, 0x4b, 0xdc, 0xc2, 0x42, 0x20, 0x82, 0x45, 0x7a, 0x3a, 0xcd, 0x0d, 0x68, 0x44, 0xfa,
+	0x5d, 0x14, 0x41, 0x22, 0xb8, 0x70, 0xee, 0xea, 0xda, 0x64, 0x23, 0xef, 0x14, 0x56, 0xf9, 0x55,
+	0x9d, 0xe8, 0x74, 0x17, 0x98, 0xef, 0x43, 0x38, 0xd4, 0xef, 0x0b, 0x18, 0x3c, 0x83, 0x5d, 0xde,
+	0xe4, 0x37, 0x60, 0x3e, 0xc0, 0x8c, 0x87, 0xea, 0x7e, 0x34, 0x3c, 0x3d, 0x6a, 0xdc, 0x9e, 0x75,
+	0x60, 0xa6, 0x52, 0xe5, 0x00, 0xc0, 0x5b, 0x73, 0x3b, 0x57, 0xf7, 0xff, 0x2e, 0x5c, 0x89, 0xb0,
+	0x8f, 0xe9, 0x60, 0x01, 0xe5, 0x27, 0x48, 0xf3, 0x19, 0x80, 0x37, 0x95, 0xe7, 0x6d, 0x3d, 0x17,
+	0x14, 0xb3, 0xff, 0xd6, 0x6a, 0xdf, 0x50, 0x95, 0x3d, 0x5d, 0xb8, 0xf9, 0xc9, 0xee, 0xc4, 0x02,
+	0x7b, 0x13, 0x0b, 0xfc, 0x31, 0xb1, 0xc0, 0xf3, 0x23, 0x2b, 0xb3, 0x77, 0x64, 0x65, 0x0e, 0x8e,
+	0xac, 0xcc, 0xb7, 0x75, 0xc5, 0x2b, 0x82, 0x2d, 0x87, 0x72, 0x77, 0xfb, 0x92, 0xff, 0x51, 0x71,
+	0xd1, 0x4e, 0x3e, 0x59, 0xf0, 0x77, 0xfe, 0x1a, 0x00, 0x1b, 0x76, 0x9f, 0x27, 0x77, 0x09, 0x00,
+	0x00,
 }
 
 func (this *MsgInitLockupAccount) Equal(that interface{}) bool {
@@ -728,6 +741,16 @@ func (m *MsgInitPeriodicLockingAccount) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Migration {
+		i--
+		if m.Migration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.LockingPeriods) > 0 {
 		for iNdEx := len(m.LockingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1147,6 +1170,9 @@ func (m *MsgInitPeriodicLockingAccount) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Migration {
+		n += 2
+	}
 	return n
 }
 
@@ -1626,6 +1652,26 @@ func (m *MsgInitPeriodicLockingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Migration = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3,12 +3,15 @@ package lockup
 import (
 	"context"
 	"testing"
+	"time"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/runtime/protoiface"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts/accountstd"
@@ -54,9 +57,14 @@ func (a addressCodec) StringToBytes(text string) ([]byte, error) { return []byte
 func (a addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
 
 func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
+	t.Helper()
+	return newMockContextWithSender(t, []byte("sender"))
+}
+
+func newMockContextWithSender(t *testing.T, sender []byte) (context.Context, store.KVStoreService) {
 	t.Helper()
 	return accountstd.NewMockContext(
-		0, []byte("lockup_account"), []byte("sender"), TestFunds, func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
+		0, []byte("lockup_account"), sender, TestFunds, func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
 			return nil
 		}, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
 			return nil, nil
@@ -91,5 +99,16 @@ func makeMockDependencies(storeservice store.KVStoreService) accountstd.Dependen
 		SchemaBuilder:    sb,
 		AddressCodec:     addressCodec{},
 		LegacyStateCodec: mockStateCodec{},
+		Environment: appmodule.Environment{
+			HeaderService: headerService{},
+		},
+	}
+}
+
+type headerService struct{}
+
+func (h headerService) HeaderInfo(context.Context) header.Info {
+	return header.Info{
+		Time: time.Now(),
 	}
 }
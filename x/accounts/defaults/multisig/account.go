@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/protobuf/runtime/protoiface"
+
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/event"
@@ -14,6 +16,8 @@ import (
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var MULTISIG_ACCOUNT = "multisig-account"
@@ -28,7 +32,8 @@ var (
 
 // Compile-time type assertions
 var (
-	_ accountstd.Interface = (*Account)(nil)
+	_ accountstd.Interface             = (*Account)(nil)
+	_ accountstd.LegacyAccountMigrator = (*Account)(nil)
 )
 
 type Account struct {
@@ -94,7 +99,7 @@ func (a *Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitRespons
 		totalWeight += msg.Members[i].Weight
 	}
 
-	if err := validateConfig(*msg.Config, totalWeight); err != nil {
+	if err := validateConfig(*msg.Config, totalWeight, a.addrCodec); err != nil {
 		return nil, err
 	}
 
@@ -105,6 +110,15 @@ func (a *Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitRespons
 	return &v1.MsgInitResponse{}, nil
 }
 
+// MigrateLegacyAccount allows a legacy x/auth account to be migrated to a multisig account, whose
+// members are taken from the init message. Vesting accounts can only be migrated once fully vested.
+func (a Account) MigrateLegacyAccount(ctx context.Context, legacyAcc sdk.AccountI, _ protoiface.MessageV1) (sdk.Coins, error) {
+	if !accountstd.LegacyVestingCoins(legacyAcc, a.headerService.HeaderInfo(ctx).Time).IsZero() {
+		return nil, errors.New("a vesting account can only be migrated to the lockup account matching its schedule")
+	}
+	return nil, nil
+}
+
 // Vote casts a vote on a proposal. The sender must be a member of the multisig and the proposal must be in the voting period.
 func (a Account) Vote(ctx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResponse, error) {
 	if msg.Vote <= v1.VoteOption_VOTE_OPTION_UNSPECIFIED {
@@ -187,6 +201,7 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 
 	// create the proposal
 	proposal := v1.Proposal{
+		Id:       seq,
 		Title:    msg.Proposal.Title,
 		Summary:  msg.Proposal.Summary,
 		Messages: msg.Proposal.Messages,
@@ -200,6 +215,11 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 		proposal.VotingPeriodEnd = a.headerService.HeaderInfo(ctx).Time.Add(time.Second * time.Duration(config.VotingPeriod)).Unix()
 	}
 
+	// set the expiry, after which the votes are void and the proposal can't be executed
+	if config.Expiry != 0 {
+		proposal.ExpiresAt = proposal.VotingPeriodEnd + config.Expiry
+	}
+
 	if err = a.Proposals.Set(ctx, seq, proposal); err != nil {
 		return nil, err
 	}
@@ -232,20 +252,36 @@ func (a Account) deleteProposalAndVotes(ctx context.Context, proposalID uint64)
 }
 
 // ExecuteProposal tallies the votes for a proposal and executes it if it passes. If early execution is enabled, it will
-// ignore the voting period and tally the votes without deleting them if the proposal has not passed.
+// ignore the voting period and tally the votes without deleting them if the proposal has not passed. If the config has
+// an execution delay, a passing proposal is queued instead, and executed by a later call once the delay has elapsed.
 func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal) (*v1.MsgExecuteProposalResponse, error) {
 	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
 	if err != nil {
 		return nil, err
 	}
 
+	if prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_QUEUED {
+		return nil, fmt.Errorf("proposal is not open, status: %s", prop.Status)
+	}
+
+	now := a.headerService.HeaderInfo(ctx).Time.Unix()
+
+	// votes on an expired proposal are void
+	if prop.ExpiresAt != 0 && now > prop.ExpiresAt {
+		return &v1.MsgExecuteProposalResponse{}, a.expireProposal(ctx, msg.ProposalId, prop)
+	}
+
+	if prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_QUEUED {
+		return a.executeQueuedProposal(ctx, msg.ProposalId, prop, now)
+	}
+
 	config, err := a.Config.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// check if voting period is still active and early execution is disabled
-	votingPeriodEnded := a.headerService.HeaderInfo(ctx).Time.Unix() > prop.VotingPeriodEnd
+	votingPeriodEnded := now > prop.VotingPeriodEnd
 	if !votingPeriodEnded && !config.EarlyExecution {
 		return nil, errors.New("voting period has not ended yet, and early execution is not enabled")
 	}
@@ -292,14 +328,23 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	} else if yesVotes < uint64(config.Threshold) {
 		rejectErr = errors.New("threshold not reached")
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
+	} else if config.ExecutionDelay > 0 {
+		// we have quorum and threshold, but the proposal must wait for the execution delay,
+		// during which it can be vetoed. It expires if it can't be executed before its expiry.
+		if prop.ExpiresAt != 0 && now+config.ExecutionDelay > prop.ExpiresAt {
+			return &v1.MsgExecuteProposalResponse{}, a.expireProposal(ctx, msg.ProposalId, prop)
+		}
+		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_QUEUED
+		prop.ExecutableAt = now + config.ExecutionDelay
 	} else {
 		// we have quorum and threshold, execute the proposal
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+		prop.ExecutableAt = now
 		resp.Responses, execErr = accountstd.ExecModuleAnys(ctx, prop.Messages) // do not return this error, just emit the event
 	}
 
 	// if early execution is enabled, we return early if the proposal has NOT passed
-	if config.EarlyExecution && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_PASSED {
+	if config.EarlyExecution && prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_REJECTED {
 		return nil, errors.New("early execution attempted and proposal has not passed")
 	}
 
@@ -313,6 +358,7 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 		event.NewAttribute("no_votes", fmt.Sprint(noVotes)),
 		event.NewAttribute("abstain_votes", fmt.Sprint(abstainVotes)),
 		event.NewAttribute("status", prop.Status.String()),
+		event.NewAttribute("executable_at", fmt.Sprint(prop.ExecutableAt)),
 		event.NewAttribute("reject_err", fmt.Sprint(rejectErr)),
 		event.NewAttribute("exec_err", fmt.Sprint(execErr)),
 	); err != nil {
@@ -327,6 +373,48 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	return resp, nil
 }
 
+// executeQueuedProposal executes a proposal which passed, once its execution delay has elapsed.
+func (a Account) executeQueuedProposal(ctx context.Context, proposalID uint64, prop v1.Proposal, now int64) (*v1.MsgExecuteProposalResponse, error) {
+	if now < prop.ExecutableAt {
+		return nil, fmt.Errorf("execution delay has not elapsed yet, proposal is executable at %d", prop.ExecutableAt)
+	}
+
+	resp := &v1.MsgExecuteProposalResponse{}
+	var execErr error
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+	resp.Responses, execErr = accountstd.ExecModuleAnys(ctx, prop.Messages) // do not return this error, just emit the event
+
+	if err := a.eventService.EventManager(ctx).EmitKV("proposal_executed",
+		event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+		event.NewAttribute("exec_err", fmt.Sprint(execErr)),
+	); err != nil {
+		return nil, err
+	}
+
+	if err := a.Proposals.Set(ctx, proposalID, prop); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// expireProposal marks a proposal which wasn't executed before its expiry as expired, and prunes its votes.
+func (a Account) expireProposal(ctx context.Context, proposalID uint64, prop v1.Proposal) error {
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED
+	if err := a.deleteProposalAndVotes(ctx, proposalID); err != nil {
+		return err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("proposal_expired",
+		event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+		event.NewAttribute("expires_at", fmt.Sprint(prop.ExpiresAt)),
+	); err != nil {
+		return err
+	}
+
+	return a.Proposals.Set(ctx, proposalID, prop)
+}
+
 // QuerySequence returns the current sequence number, used for proposal IDs.
 func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
 	seq, err := a.Sequence.Peek(ctx)
@@ -345,6 +433,28 @@ func (a Account) QueryProposal(ctx context.Context, q *v1.QueryProposal) (*v1.Qu
 	return &v1.QueryProposalResponse{Proposal: &proposal}, nil
 }
 
+// QueryOpenProposals returns the proposals which are in their voting period or queued for execution, paginated.
+// The proposals past their expiry are left out, even if they weren't marked as expired yet.
+func (a Account) QueryOpenProposals(ctx context.Context, q *v1.QueryOpenProposals) (*v1.QueryOpenProposalsResponse, error) {
+	now := a.headerService.HeaderInfo(ctx).Time.Unix()
+	proposals, pageRes, err := query.CollectionFilteredPaginate(ctx, a.Proposals, q.Pagination,
+		func(_ uint64, prop v1.Proposal) (bool, error) {
+			if prop.ExpiresAt != 0 && now > prop.ExpiresAt {
+				return false, nil
+			}
+			return prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD || prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_QUEUED, nil
+		},
+		func(id uint64, prop v1.Proposal) (*v1.Proposal, error) {
+			prop.Id = id
+			return &prop, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryOpenProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
 // QueryConfig returns the current multisig configuration.
 func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
 	cfg, err := a.Config.Get(ctx)
@@ -374,6 +484,7 @@ func (a *Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
 	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
 	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.VetoProposal)
 }
 
 // RegisterInitHandler implements implementation.Account.
@@ -385,5 +496,6 @@ func (a *Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
 func (a *Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
 	accountstd.RegisterQueryHandler(builder, a.QueryProposal)
+	accountstd.RegisterQueryHandler(builder, a.QueryOpenProposals)
 	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
 }
@@ -8,10 +8,14 @@ import (
 	types "github.com/cosmos/gogoproto/types/any"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
 	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func setup(t *testing.T, ctx context.Context, ss store.KVStoreService, timefn func() time.Time) *Account {
@@ -155,6 +159,43 @@ func TestInit(t *testing.T) {
 			},
 			"quorum must be less than or equal to the total weight",
 		},
+		{
+			"expiry not greater than execution delay",
+			&v1.MsgInit{
+				Config: &v1.Config{
+					Threshold:      666,
+					Quorum:         400,
+					VotingPeriod:   60,
+					ExecutionDelay: 120,
+					Expiry:         120,
+				},
+				Members: []*v1.Member{
+					{
+						Address: "addr1",
+						Weight:  1000,
+					},
+				},
+			},
+			"expiry must be greater than the execution delay",
+		},
+		{
+			"duplicate vetoer",
+			&v1.MsgInit{
+				Config: &v1.Config{
+					Threshold:    666,
+					Quorum:       400,
+					VotingPeriod: 60,
+					Vetoers:      []string{"addr2", "addr2"},
+				},
+				Members: []*v1.Member{
+					{
+						Address: "addr1",
+						Weight:  1000,
+					},
+				},
+			},
+			"duplicate vetoer address found",
+		},
 	}
 
 	for _, tc := range testcases {
@@ -658,3 +699,294 @@ func TestProposalPassing(t *testing.T) {
 
 	require.Equal(t, expectedMembers, cfg.Members)
 }
+
+func TestProposalExecutionDelayAndVeto(t *testing.T) {
+	executed := 0
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("multisig_acc"), []byte("addr1"), TestFunds, func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
+			return nil
+		}, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
+			executed++
+			return nil, nil
+		}, func(ctx context.Context, req, resp ProtoMsg) error {
+			return nil
+		},
+	)
+
+	currentTime := time.Unix(1000, 0)
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:      1000,
+			Quorum:         1000,
+			VotingPeriod:   60,
+			ExecutionDelay: 120,
+			Expiry:         300,
+			Vetoers:        []string{"guardian"},
+		},
+		Members: []*v1.Member{
+			{
+				Address: "addr1",
+				Weight:  1000,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	anymsg, err := accountstd.PackAny(&banktypes.MsgSend{FromAddress: "multisig_acc", ToAddress: "addr2", Amount: TestFunds})
+	require.NoError(t, err)
+
+	createAndPass := func() uint64 {
+		createRes, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+			Proposal: &v1.Proposal{
+				Title:    "test",
+				Summary:  "test",
+				Messages: []*types.Any{anymsg},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = acc.Vote(ctx, &v1.MsgVote{
+			ProposalId: createRes.ProposalId,
+			Vote:       v1.VoteOption_VOTE_OPTION_YES,
+		})
+		require.NoError(t, err)
+		return createRes.ProposalId
+	}
+
+	propId := createAndPass()
+	currentTime = currentTime.Add(61 * time.Second)
+
+	// the passing proposal is queued instead of being executed
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+	require.Zero(t, executed)
+
+	prop, err := acc.QueryProposal(ctx, &v1.QueryProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_QUEUED, prop.Proposal.Status)
+	require.Equal(t, currentTime.Unix()+120, prop.Proposal.ExecutableAt)
+
+	currentTime = currentTime.Add(119 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{
+		ProposalId: propId,
+	})
+	require.ErrorContains(t, err, "execution delay has not elapsed yet")
+
+	currentTime = currentTime.Add(time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, executed)
+
+	prop, err = acc.QueryProposal(ctx, &v1.QueryProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_PASSED, prop.Proposal.Status)
+
+	// an executed proposal can't be executed again
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{
+		ProposalId: propId,
+	})
+	require.ErrorContains(t, err, "proposal is not open")
+
+	// a queued proposal can be vetoed during the execution delay
+	propId = createAndPass()
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+
+	_, err = acc.VetoProposal(ctx, &v1.MsgVetoProposal{
+		ProposalId: propId,
+	})
+	require.ErrorContains(t, err, "only a vetoer can veto a proposal")
+
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("guardian")), &v1.MsgVetoProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+
+	currentTime = currentTime.Add(120 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{
+		ProposalId: propId,
+	})
+	require.ErrorContains(t, err, "proposal is not open")
+	require.Equal(t, 1, executed)
+
+	prop, err = acc.QueryProposal(ctx, &v1.QueryProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_VETOED, prop.Proposal.Status)
+}
+
+func TestProposalExpiry(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	currentTime := time.Unix(1000, 0)
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:    1000,
+			Quorum:       1000,
+			VotingPeriod: 60,
+			Expiry:       300,
+		},
+		Members: []*v1.Member{
+			{
+				Address: "sender",
+				Weight:  1000,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	createRes, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+		Proposal: &v1.Proposal{
+			Title:   "test",
+			Summary: "test",
+		},
+	})
+	require.NoError(t, err)
+	propId := createRes.ProposalId
+
+	_, err = acc.Vote(ctx, &v1.MsgVote{
+		ProposalId: propId,
+		Vote:       v1.VoteOption_VOTE_OPTION_YES,
+	})
+	require.NoError(t, err)
+
+	prop, err := acc.QueryProposal(ctx, &v1.QueryProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, currentTime.Unix()+60+300, prop.Proposal.ExpiresAt)
+
+	// the votes are void once the proposal expired
+	currentTime = currentTime.Add(361 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+
+	prop, err = acc.QueryProposal(ctx, &v1.QueryProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED, prop.Proposal.Status)
+
+	_, err = acc.Votes.Get(ctx, collections.Join(propId, []byte("sender")))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// a passing proposal tallied too late to be executed before its expiry isn't queued
+	_, err = acc.UpdateConfig(accountstd.SetSender(ctx, []byte("mock_multisig_account")), &v1.MsgUpdateConfig{
+		Config: &v1.Config{
+			Threshold:      1000,
+			Quorum:         1000,
+			VotingPeriod:   60,
+			ExecutionDelay: 120,
+			Expiry:         300,
+		},
+	})
+	require.NoError(t, err)
+
+	createRes, err = acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+		Proposal: &v1.Proposal{
+			Title:   "test",
+			Summary: "test",
+		},
+	})
+	require.NoError(t, err)
+	propId = createRes.ProposalId
+
+	_, err = acc.Vote(ctx, &v1.MsgVote{
+		ProposalId: propId,
+		Vote:       v1.VoteOption_VOTE_OPTION_YES,
+	})
+	require.NoError(t, err)
+
+	currentTime = currentTime.Add(241 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+
+	prop, err = acc.QueryProposal(ctx, &v1.QueryProposal{
+		ProposalId: propId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED, prop.Proposal.Status)
+}
+
+func TestQueryOpenProposals(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	currentTime := time.Unix(1000, 0)
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:    1000,
+			Quorum:       1000,
+			VotingPeriod: 60,
+			Expiry:       300,
+			Vetoers:      []string{"sender"},
+		},
+		Members: []*v1.Member{
+			{
+				Address: "sender",
+				Weight:  1000,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		_, err = acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+			Proposal: &v1.Proposal{
+				Title:   "test",
+				Summary: "test",
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// veto the second proposal
+	_, err = acc.VetoProposal(ctx, &v1.MsgVetoProposal{
+		ProposalId: 1,
+	})
+	require.NoError(t, err)
+
+	res, err := acc.QueryOpenProposals(ctx, &v1.QueryOpenProposals{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 2)
+	require.Equal(t, uint64(0), res.Proposals[0].Id)
+	require.Equal(t, uint64(2), res.Proposals[1].Id)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = acc.QueryOpenProposals(ctx, &v1.QueryOpenProposals{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 1)
+	require.Equal(t, uint64(3), res.Proposals[0].Id)
+
+	// the expired proposals aren't open, even if they weren't marked as expired yet
+	currentTime = currentTime.Add(361 * time.Second)
+	res, err = acc.QueryOpenProposals(ctx, &v1.QueryOpenProposals{})
+	require.NoError(t, err)
+	require.Empty(t, res.Proposals)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
 )
@@ -57,14 +58,14 @@ func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1
 		return nil, err
 	}
 
-	if err := validateConfig(config, totalWeight); err != nil {
+	if err := validateConfig(config, totalWeight, a.addrCodec); err != nil {
 		return nil, err
 	}
 
 	return &v1.MsgUpdateConfigResponse{}, nil
 }
 
-func validateConfig(cfg v1.Config, totalWeight uint64) error {
+func validateConfig(cfg v1.Config, totalWeight uint64, addrCodec address.Codec) error {
 	// check for zero values
 	if cfg.Threshold <= 0 || cfg.Quorum <= 0 || cfg.VotingPeriod <= 0 {
 		return errors.New("threshold, quorum and voting period must be greater than zero")
@@ -80,5 +81,26 @@ func validateConfig(cfg v1.Config, totalWeight uint64) error {
 		return errors.New("quorum must be less than or equal to the total weight")
 	}
 
+	if cfg.ExecutionDelay < 0 || cfg.Expiry < 0 {
+		return errors.New("execution delay and expiry must not be negative")
+	}
+
+	// a proposal must be able to wait for the execution delay before it expires
+	if cfg.Expiry != 0 && cfg.Expiry <= cfg.ExecutionDelay {
+		return errors.New("expiry must be greater than the execution delay")
+	}
+
+	vetoers := map[string]struct{}{} // to check for duplicates
+	for _, vetoer := range cfg.Vetoers {
+		if _, ok := vetoers[vetoer]; ok {
+			return errors.New("duplicate vetoer address found")
+		}
+		vetoers[vetoer] = struct{}{}
+
+		if _, err := addrCodec.StringToBytes(vetoer); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
@@ -37,6 +38,14 @@ const (
 	ProposalStatus_PROPOSAL_STATUS_PASSED ProposalStatus = 2
 	// PROPOSAL_STATUS_REJECTED defines the proposal status when the proposal was rejected.
 	ProposalStatus_PROPOSAL_STATUS_REJECTED ProposalStatus = 3
+	// PROPOSAL_STATUS_QUEUED defines the proposal status when the proposal passed and
+	// waits for the execution delay to elapse.
+	ProposalStatus_PROPOSAL_STATUS_QUEUED ProposalStatus = 4
+	// PROPOSAL_STATUS_VETOED defines the proposal status when the proposal was vetoed.
+	ProposalStatus_PROPOSAL_STATUS_VETOED ProposalStatus = 5
+	// PROPOSAL_STATUS_EXPIRED defines the proposal status when the proposal expired
+	// before being executed.
+	ProposalStatus_PROPOSAL_STATUS_EXPIRED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -44,6 +53,9 @@ var ProposalStatus_name = map[int32]string{
 	1: "PROPOSAL_STATUS_VOTING_PERIOD",
 	2: "PROPOSAL_STATUS_PASSED",
 	3: "PROPOSAL_STATUS_REJECTED",
+	4: "PROPOSAL_STATUS_QUEUED",
+	5: "PROPOSAL_STATUS_VETOED",
+	6: "PROPOSAL_STATUS_EXPIRED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -51,6 +63,9 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_VOTING_PERIOD": 1,
 	"PROPOSAL_STATUS_PASSED":        2,
 	"PROPOSAL_STATUS_REJECTED":      3,
+	"PROPOSAL_STATUS_QUEUED":        4,
+	"PROPOSAL_STATUS_VETOED":        5,
+	"PROPOSAL_STATUS_EXPIRED":       6,
 }
 
 func (x ProposalStatus) String() string {
@@ -451,6 +466,88 @@ func (m *MsgExecuteProposalResponse) GetResponses() []*any.Any {
 	return nil
 }
 
+// MsgVetoProposal is used by a vetoer to cancel a proposal which is in its voting
+// period or queued for execution.
+type MsgVetoProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{8}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+func (m *MsgVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+type MsgVetoProposalResponse struct {
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{9}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
 // MsgUpdateConfig is used to change the config or members.
 type MsgUpdateConfig struct {
 	// only the members that are changing are required, if their weight is 0, they are removed.
@@ -463,7 +560,7 @@ func (m *MsgUpdateConfig) Reset()         { *m = MsgUpdateConfig{} }
 func (m *MsgUpdateConfig) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConfig) ProtoMessage()    {}
 func (*MsgUpdateConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{8}
+	return fileDescriptor_e6da8796717704d7, []int{10}
 }
 func (m *MsgUpdateConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,7 +610,7 @@ func (m *MsgUpdateConfigResponse) Reset()         { *m = MsgUpdateConfigResponse
 func (m *MsgUpdateConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConfigResponse) ProtoMessage()    {}
 func (*MsgUpdateConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{9}
+	return fileDescriptor_e6da8796717704d7, []int{11}
 }
 func (m *MsgUpdateConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +648,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{10}
+	return fileDescriptor_e6da8796717704d7, []int{12}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,13 +700,23 @@ type Config struct {
 	Revote bool `protobuf:"varint,4,opt,name=revote,proto3" json:"revote,omitempty"`
 	// early_execution defines if the multisig can be executed before the voting period ends.
 	EarlyExecution bool `protobuf:"varint,5,opt,name=early_execution,json=earlyExecution,proto3" json:"early_execution,omitempty"`
+	// execution_delay is the minimum duration in seconds between the moment a
+	// proposal passes and its execution, during which it can be vetoed.
+	ExecutionDelay int64 `protobuf:"varint,6,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay,omitempty"`
+	// expiry is the duration in seconds after the end of the voting period after
+	// which a proposal which wasn't executed expires and its votes are void. If 0,
+	// proposals never expire.
+	Expiry int64 `protobuf:"varint,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// vetoers are the addresses allowed to veto proposals, they don't need to be
+	// members.
+	Vetoers []string `protobuf:"bytes,8,rep,name=vetoers,proto3" json:"vetoers,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
 func (m *Config) String() string { return proto.CompactTextString(m) }
 func (*Config) ProtoMessage()    {}
 func (*Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{11}
+	return fileDescriptor_e6da8796717704d7, []int{13}
 }
 func (m *Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,6 +780,27 @@ func (m *Config) GetEarlyExecution() bool {
 	return false
 }
 
+func (m *Config) GetExecutionDelay() int64 {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *Config) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *Config) GetVetoers() []string {
+	if m != nil {
+		return m.Vetoers
+	}
+	return nil
+}
+
 type Proposal struct {
 	Title    string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Summary  string     `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
@@ -680,13 +808,22 @@ type Proposal struct {
 	// voting_period_end will be set by the account when the proposal is created.
 	VotingPeriodEnd int64          `protobuf:"varint,4,opt,name=voting_period_end,json=votingPeriodEnd,proto3" json:"voting_period_end,omitempty"`
 	Status          ProposalStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.accounts.defaults.multisig.v1.ProposalStatus" json:"status,omitempty"`
+	// executable_at will be set by the account when the proposal passes, it is the
+	// unix time in seconds after which the proposal can be executed.
+	ExecutableAt int64 `protobuf:"varint,6,opt,name=executable_at,json=executableAt,proto3" json:"executable_at,omitempty"`
+	// expires_at will be set by the account when the proposal is created if the
+	// config has an expiry, it is the unix time in seconds after which the proposal
+	// can no longer be executed.
+	ExpiresAt int64 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// id will be set by the account when the proposal is created.
+	Id uint64 `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{12}
+	return fileDescriptor_e6da8796717704d7, []int{14}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,6 +887,27 @@ func (m *Proposal) GetStatus() ProposalStatus {
 	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
 }
 
+func (m *Proposal) GetExecutableAt() int64 {
+	if m != nil {
+		return m.ExecutableAt
+	}
+	return 0
+}
+
+func (m *Proposal) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 // QuerySequence is the request for the account sequence.
 type QuerySequence struct {
 }
@@ -758,7 +916,7 @@ func (m *QuerySequence) Reset()         { *m = QuerySequence{} }
 func (m *QuerySequence) String() string { return proto.CompactTextString(m) }
 func (*QuerySequence) ProtoMessage()    {}
 func (*QuerySequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{13}
+	return fileDescriptor_e6da8796717704d7, []int{15}
 }
 func (m *QuerySequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +955,7 @@ func (m *QuerySequenceResponse) Reset()         { *m = QuerySequenceResponse{} }
 func (m *QuerySequenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySequenceResponse) ProtoMessage()    {}
 func (*QuerySequenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{14}
+	return fileDescriptor_e6da8796717704d7, []int{16}
 }
 func (m *QuerySequenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +998,7 @@ func (m *QueryConfig) Reset()         { *m = QueryConfig{} }
 func (m *QueryConfig) String() string { return proto.CompactTextString(m) }
 func (*QueryConfig) ProtoMessage()    {}
 func (*QueryConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{15}
+	return fileDescriptor_e6da8796717704d7, []int{17}
 }
 func (m *QueryConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1037,7 @@ func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
 func (m *QueryConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigResponse) ProtoMessage()    {}
 func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{16}
+	return fileDescriptor_e6da8796717704d7, []int{18}
 }
 func (m *QueryConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +1088,7 @@ func (m *QueryProposal) Reset()         { *m = QueryProposal{} }
 func (m *QueryProposal) String() string { return proto.CompactTextString(m) }
 func (*QueryProposal) ProtoMessage()    {}
 func (*QueryProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{17}
+	return fileDescriptor_e6da8796717704d7, []int{19}
 }
 func (m *QueryProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1132,7 @@ func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
 func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalResponse) ProtoMessage()    {}
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{18}
+	return fileDescriptor_e6da8796717704d7, []int{20}
 }
 func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,6 +1168,104 @@ func (m *QueryProposalResponse) GetProposal() *Proposal {
 	return nil
 }
 
+// QueryOpenProposals is the request for the proposals which are in their voting
+// period or queued for execution.
+type QueryOpenProposals struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOpenProposals) Reset()         { *m = QueryOpenProposals{} }
+func (m *QueryOpenProposals) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenProposals) ProtoMessage()    {}
+func (*QueryOpenProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{21}
+}
+func (m *QueryOpenProposals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenProposals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOpenProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenProposals.Merge(m, src)
+}
+func (m *QueryOpenProposals) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOpenProposals proto.InternalMessageInfo
+
+func (m *QueryOpenProposals) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryOpenProposalsResponse struct {
+	Proposals  []*Proposal         `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOpenProposalsResponse) Reset()         { *m = QueryOpenProposalsResponse{} }
+func (m *QueryOpenProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenProposalsResponse) ProtoMessage()    {}
+func (*QueryOpenProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{22}
+}
+func (m *QueryOpenProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOpenProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenProposalsResponse.Merge(m, src)
+}
+func (m *QueryOpenProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOpenProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryOpenProposalsResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryOpenProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.accounts.defaults.multisig.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.accounts.defaults.multisig.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1021,6 +1277,8 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgExecuteProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgExecuteProposal")
 	proto.RegisterType((*MsgExecuteProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgExecuteProposalResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse")
 	proto.RegisterType((*MsgUpdateConfig)(nil), "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig")
 	proto.RegisterType((*MsgUpdateConfigResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse")
 	proto.RegisterType((*Member)(nil), "cosmos.accounts.defaults.multisig.v1.Member")
@@ -1032,6 +1290,8 @@ func init() {
 	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse")
 	proto.RegisterType((*QueryProposal)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposal")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse")
+	proto.RegisterType((*QueryOpenProposals)(nil), "cosmos.accounts.defaults.multisig.v1.QueryOpenProposals")
+	proto.RegisterType((*QueryOpenProposalsResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryOpenProposalsResponse")
 }
 
 func init() {
@@ -1039,62 +1299,76 @@ func init() {
 }
 
 var fileDescriptor_e6da8796717704d7 = []byte{
-	// 865 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0x9b, 0x6e, 0x9a, 0xbe, 0xd2, 0x24, 0x9d, 0x96, 0xad, 0x9b, 0x5d, 0xb2, 0xc5, 0x20,
-	0xb1, 0xaa, 0x16, 0xa7, 0x9b, 0xc2, 0x8d, 0x4b, 0xda, 0xb8, 0x28, 0xab, 0x4d, 0x6c, 0xc6, 0x69,
-	0x25, 0xb8, 0x58, 0x6e, 0x3c, 0x75, 0xad, 0x8d, 0x3d, 0x5e, 0xcf, 0xb8, 0x6c, 0xbe, 0x04, 0xe2,
-	0xc6, 0x07, 0x80, 0x23, 0x47, 0x3e, 0x04, 0xc7, 0x15, 0x27, 0x8e, 0xa8, 0x95, 0xf8, 0x1c, 0xa8,
-	0xe3, 0xb1, 0x9b, 0xa6, 0xd2, 0x6e, 0x10, 0x3d, 0x70, 0xf3, 0xfb, 0xf3, 0xfb, 0xbd, 0xdf, 0xbc,
-	0x79, 0xf3, 0x64, 0xd8, 0x1f, 0x51, 0x16, 0x52, 0xd6, 0x72, 0x47, 0x23, 0x9a, 0x46, 0x9c, 0xb5,
-	0x3c, 0x72, 0xe6, 0xa6, 0x63, 0xce, 0x5a, 0x61, 0x3a, 0xe6, 0x01, 0x0b, 0xfc, 0xd6, 0xc5, 0xf3,
-	0xe2, 0x5b, 0x8f, 0x13, 0xca, 0x29, 0xfa, 0x34, 0x03, 0xe9, 0x39, 0x48, 0xcf, 0x41, 0x7a, 0x91,
-	0x78, 0xf1, 0xbc, 0xb1, 0xed, 0x53, 0xea, 0x8f, 0x49, 0x4b, 0x60, 0x4e, 0xd3, 0xb3, 0x96, 0x1b,
-	0x4d, 0x32, 0x82, 0xc6, 0x96, 0xac, 0x1a, 0xb2, 0x8c, 0x9e, 0x49, 0xe6, 0xc6, 0x76, 0x16, 0x70,
-	0x84, 0xd5, 0x92, 0x65, 0x84, 0xa1, 0xfd, 0xa4, 0xc0, 0x72, 0x9f, 0xf9, 0xbd, 0x28, 0xe0, 0xe8,
-	0x08, 0x96, 0x43, 0x12, 0x9e, 0x92, 0x84, 0xa9, 0xca, 0x4e, 0xe9, 0xe9, 0x6a, 0xfb, 0x99, 0x3e,
-	0x8f, 0x24, 0xbd, 0x2f, 0x40, 0x38, 0x07, 0xa3, 0x2e, 0x94, 0x0f, 0x69, 0x74, 0x16, 0xf8, 0xea,
-	0xe2, 0x8e, 0x32, 0x3f, 0x4d, 0x86, 0xc1, 0x12, 0xab, 0xad, 0x43, 0x4d, 0x0a, 0xc3, 0x84, 0xc5,
-	0x34, 0x62, 0x44, 0x73, 0x60, 0xbd, 0xcf, 0xfc, 0xc3, 0x84, 0xb8, 0x9c, 0x58, 0x09, 0x8d, 0x29,
-	0x73, 0xc7, 0xe8, 0x05, 0x54, 0x62, 0xf9, 0xad, 0x2a, 0xa2, 0x9e, 0x3e, 0x5f, 0xbd, 0x9c, 0x01,
-	0x17, 0x78, 0xed, 0x2b, 0xd8, 0xbe, 0x53, 0x20, 0xaf, 0x8e, 0x9e, 0xc0, 0x6a, 0x9e, 0xe8, 0x04,
-	0x9e, 0xa8, 0xb5, 0x84, 0x21, 0x77, 0xf5, 0x3c, 0x2d, 0x16, 0xad, 0x3c, 0xa1, 0xfc, 0xfd, 0xb9,
-	0xa8, 0x0b, 0x4b, 0x17, 0x94, 0x13, 0xd1, 0xa1, 0x6a, 0x7b, 0x6f, 0x3e, 0xc5, 0xd7, 0xd4, 0x66,
-	0xcc, 0x03, 0x1a, 0x61, 0x81, 0x96, 0x3d, 0xba, 0x76, 0x17, 0x3d, 0xfa, 0x12, 0x50, 0x9f, 0xf9,
-	0xc6, 0x1b, 0x32, 0x4a, 0xa7, 0x9a, 0xf4, 0x5e, 0xed, 0x16, 0x34, 0xee, 0xc2, 0x8a, 0xa3, 0xb7,
-	0x61, 0x25, 0x91, 0xdf, 0xf9, 0x6c, 0x6c, 0xea, 0xd9, 0x20, 0xea, 0xf9, 0x20, 0xea, 0x9d, 0x68,
-	0x82, 0x6f, 0xd2, 0xb4, 0x5f, 0x15, 0x21, 0xee, 0x38, 0xf6, 0x5c, 0x4e, 0xb2, 0x3b, 0x45, 0x36,
-	0x54, 0x53, 0x61, 0x3b, 0xff, 0x65, 0xd0, 0xd6, 0x32, 0x8e, 0xfe, 0xbd, 0x8e, 0xdb, 0x36, 0x6c,
-	0xcd, 0xa8, 0x2d, 0x5a, 0x3a, 0x84, 0x72, 0x56, 0x0b, 0xb5, 0x61, 0xd9, 0xf5, 0xbc, 0x84, 0x30,
-	0x26, 0x5a, 0xb8, 0x72, 0xa0, 0xfe, 0xf1, 0xdb, 0xe7, 0x9b, 0xb2, 0x5c, 0x27, 0x8b, 0xd8, 0x3c,
-	0x09, 0x22, 0x1f, 0xe7, 0x89, 0xe8, 0x21, 0x94, 0xbf, 0x27, 0x81, 0x7f, 0xce, 0x85, 0xbc, 0x25,
-	0x2c, 0x2d, 0xed, 0x17, 0x25, 0xd7, 0x8d, 0x1e, 0xc3, 0x0a, 0x3f, 0x4f, 0x08, 0x3b, 0xa7, 0xe3,
-	0xec, 0x6e, 0x4a, 0xf8, 0xc6, 0x71, 0x4d, 0xf0, 0x3a, 0xa5, 0x49, 0x1a, 0x0a, 0x82, 0x12, 0x96,
-	0x16, 0xfa, 0x04, 0xd6, 0x2e, 0x28, 0x0f, 0x22, 0xdf, 0x89, 0x49, 0x12, 0x50, 0x4f, 0x2d, 0x89,
-	0xf0, 0x07, 0x99, 0xd3, 0x12, 0xbe, 0x6b, 0x70, 0x42, 0xc4, 0xa4, 0x2d, 0xed, 0x28, 0x4f, 0x2b,
-	0x58, 0x5a, 0xe8, 0x33, 0xa8, 0x11, 0x37, 0x19, 0x4f, 0x1c, 0x22, 0xae, 0x3c, 0xa0, 0x91, 0xfa,
-	0x40, 0x24, 0x54, 0x85, 0xdb, 0xc8, 0xbd, 0xda, 0xdf, 0x0a, 0x54, 0x8a, 0x31, 0xda, 0x84, 0x07,
-	0x3c, 0xe0, 0x63, 0x92, 0x9d, 0x1e, 0x67, 0x06, 0x52, 0x61, 0x99, 0xa5, 0x61, 0xe8, 0x26, 0x13,
-	0xa1, 0x70, 0x05, 0xe7, 0x26, 0xda, 0x83, 0x4a, 0x48, 0x18, 0x73, 0x7d, 0xc2, 0xd4, 0xd2, 0x3b,
-	0xc6, 0xa6, 0xc8, 0x42, 0xbb, 0xb0, 0x7e, 0xeb, 0x50, 0x0e, 0x89, 0x3c, 0x21, 0xbd, 0x84, 0x6b,
-	0xd3, 0x07, 0x33, 0x22, 0x0f, 0xbd, 0x84, 0x32, 0xe3, 0x2e, 0x4f, 0x99, 0x90, 0x5e, 0x6d, 0x7f,
-	0xf1, 0xef, 0xde, 0xbd, 0x2d, 0xb0, 0x58, 0x72, 0x68, 0x35, 0x58, 0xfb, 0x26, 0x25, 0xc9, 0xc4,
-	0x26, 0xaf, 0x53, 0x12, 0x8d, 0x88, 0xb6, 0x0f, 0x1f, 0xde, 0x72, 0x14, 0xaf, 0xa1, 0x01, 0x15,
-	0x26, 0x7d, 0xf2, 0x25, 0x15, 0xb6, 0xb6, 0x06, 0xab, 0x02, 0x24, 0xa7, 0xea, 0x67, 0x05, 0x36,
-	0xa6, 0xec, 0x82, 0xe2, 0xff, 0xb5, 0x6a, 0xf7, 0xe4, 0xd1, 0xe7, 0x5f, 0x17, 0x23, 0xd9, 0x9b,
-	0x3b, 0x9b, 0xe2, 0x1e, 0xb7, 0xf1, 0xee, 0x0f, 0x0a, 0x54, 0x6f, 0x5f, 0x16, 0x7a, 0x02, 0x8f,
-	0x2c, 0x6c, 0x5a, 0xa6, 0xdd, 0x79, 0xe9, 0xd8, 0xc3, 0xce, 0xf0, 0xd8, 0x76, 0x8e, 0x07, 0xb6,
-	0x65, 0x1c, 0xf6, 0x8e, 0x7a, 0x46, 0xb7, 0xbe, 0x80, 0x3e, 0x86, 0x8f, 0x66, 0x13, 0x4e, 0xcc,
-	0x61, 0x6f, 0xf0, 0xb5, 0x63, 0x19, 0xb8, 0x67, 0x76, 0xeb, 0x0a, 0x6a, 0xc0, 0xc3, 0xd9, 0x14,
-	0xab, 0x63, 0xdb, 0x46, 0xb7, 0xbe, 0x88, 0x1e, 0x83, 0x3a, 0x1b, 0xc3, 0xc6, 0x0b, 0xe3, 0x70,
-	0x68, 0x74, 0xeb, 0xa5, 0xdd, 0x57, 0x00, 0x37, 0x2b, 0x18, 0x3d, 0x82, 0xad, 0x13, 0x73, 0x68,
-	0x38, 0xa6, 0x35, 0xec, 0x99, 0x83, 0x19, 0x1d, 0x1b, 0x50, 0x9b, 0x0e, 0x7e, 0x6b, 0xd8, 0x75,
-	0x05, 0x6d, 0xc1, 0xc6, 0xb4, 0xb3, 0x73, 0x60, 0x0f, 0x3b, 0xbd, 0x41, 0x7d, 0x11, 0x21, 0xa8,
-	0x4e, 0x07, 0x06, 0x66, 0xbd, 0x74, 0x70, 0xf4, 0xfb, 0x65, 0x53, 0x79, 0x7b, 0xd9, 0x54, 0xfe,
-	0xba, 0x6c, 0x2a, 0x3f, 0x5e, 0x35, 0x17, 0xde, 0x5e, 0x35, 0x17, 0xfe, 0xbc, 0x6a, 0x2e, 0x7c,
-	0xf7, 0x2c, 0x6b, 0x28, 0xf3, 0x5e, 0xe9, 0x01, 0x6d, 0xbd, 0x79, 0xf7, 0x5f, 0xc6, 0x69, 0x59,
-	0xbc, 0xb4, 0xfd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2c, 0xe6, 0x8e, 0x70, 0x94, 0x08, 0x00,
-	0x00,
+	// 1091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x53, 0xdb, 0x46,
+	0x14, 0x47, 0x36, 0x31, 0xf6, 0x4b, 0x30, 0x66, 0x21, 0x41, 0x38, 0x89, 0x43, 0xd5, 0x4e, 0x93,
+	0x61, 0x52, 0x19, 0x4c, 0x7b, 0xeb, 0xc5, 0x60, 0x91, 0x71, 0x06, 0xb0, 0x23, 0x19, 0xa6, 0xed,
+	0x74, 0x46, 0x23, 0x5b, 0x8b, 0xd0, 0xc4, 0x96, 0x84, 0x76, 0xe5, 0xe2, 0x6f, 0xd1, 0x5b, 0xa7,
+	0xe7, 0x5e, 0x7b, 0x6b, 0x4f, 0xfd, 0x04, 0x3d, 0x66, 0x7a, 0xea, 0xb1, 0x03, 0x5f, 0xa4, 0xa3,
+	0xdd, 0x95, 0x6c, 0x4c, 0x06, 0x9c, 0x69, 0x0e, 0xbd, 0xe9, 0xfd, 0xf9, 0xbd, 0xf7, 0x7b, 0xbf,
+	0x7d, 0x92, 0x16, 0x76, 0x7a, 0x3e, 0x19, 0xf8, 0xa4, 0x6a, 0xf5, 0x7a, 0x7e, 0xe4, 0x51, 0x52,
+	0xb5, 0xf1, 0xa9, 0x15, 0xf5, 0x29, 0xa9, 0x0e, 0xa2, 0x3e, 0x75, 0x89, 0xeb, 0x54, 0x87, 0xdb,
+	0xe9, 0xb3, 0x1a, 0x84, 0x3e, 0xf5, 0xd1, 0x67, 0x1c, 0xa4, 0x26, 0x20, 0x35, 0x01, 0xa9, 0x69,
+	0xe2, 0x70, 0xbb, 0xbc, 0xee, 0xf8, 0xbe, 0xd3, 0xc7, 0x55, 0x86, 0xe9, 0x46, 0xa7, 0x55, 0xcb,
+	0x1b, 0xf1, 0x02, 0xe5, 0x4d, 0xd1, 0xb5, 0x6b, 0x11, 0x5c, 0x3d, 0x8f, 0x70, 0x38, 0xaa, 0x0e,
+	0xb7, 0xbb, 0x98, 0x5a, 0xdb, 0xd5, 0xc0, 0x72, 0x5c, 0xcf, 0xa2, 0xae, 0xef, 0x89, 0xdc, 0x35,
+	0x91, 0x3b, 0x20, 0x9c, 0x0a, 0x11, 0x2c, 0xca, 0xeb, 0x3c, 0x60, 0x32, 0xab, 0x2a, 0x28, 0x31,
+	0x43, 0xf9, 0x49, 0x82, 0x85, 0x43, 0xe2, 0x34, 0x3d, 0x97, 0xa2, 0x7d, 0x58, 0x18, 0xe0, 0x41,
+	0x17, 0x87, 0x44, 0x96, 0x36, 0xb2, 0x2f, 0xee, 0xd7, 0x5e, 0xaa, 0xb3, 0xd0, 0x57, 0x0f, 0x19,
+	0x48, 0x4f, 0xc0, 0xa8, 0x01, 0xb9, 0x3d, 0xdf, 0x3b, 0x75, 0x1d, 0x39, 0xb3, 0x21, 0xcd, 0x5e,
+	0x86, 0x63, 0x74, 0x81, 0x55, 0x96, 0x61, 0x49, 0x10, 0xd3, 0x31, 0x09, 0x7c, 0x8f, 0x60, 0xc5,
+	0x84, 0xe5, 0x43, 0xe2, 0xec, 0x85, 0xd8, 0xa2, 0xb8, 0x1d, 0xfa, 0x81, 0x4f, 0xac, 0x3e, 0x7a,
+	0x0d, 0xf9, 0x40, 0x3c, 0xcb, 0x12, 0xeb, 0xa7, 0xce, 0xd6, 0x2f, 0xa9, 0xa0, 0xa7, 0x78, 0xe5,
+	0x6b, 0x58, 0xbf, 0xd1, 0x20, 0xe9, 0x8e, 0x9e, 0xc1, 0xfd, 0x24, 0xd1, 0x74, 0x6d, 0xd6, 0x6b,
+	0x5e, 0x87, 0xc4, 0xd5, 0xb4, 0x95, 0x80, 0x49, 0x79, 0xe2, 0xd3, 0xbb, 0x73, 0x51, 0x03, 0xe6,
+	0x87, 0x3e, 0xc5, 0x4c, 0xa1, 0x62, 0x6d, 0x6b, 0x36, 0xc6, 0x71, 0xe9, 0x56, 0x10, 0x9f, 0xb8,
+	0xce, 0xd0, 0x42, 0xa3, 0xd8, 0x9d, 0x6a, 0xf4, 0x15, 0xa0, 0x43, 0xe2, 0x68, 0x17, 0xb8, 0x17,
+	0x4d, 0x88, 0x74, 0x27, 0xf7, 0x36, 0x94, 0x6f, 0xc2, 0xd2, 0xd1, 0x6b, 0x50, 0x08, 0xc5, 0x73,
+	0xb2, 0x1b, 0xab, 0x2a, 0x5f, 0x5a, 0x35, 0x59, 0x5a, 0xb5, 0xee, 0x8d, 0xf4, 0x71, 0x9a, 0x52,
+	0xe3, 0xdc, 0x30, 0xf5, 0x67, 0x67, 0xb1, 0x0e, 0x6b, 0x53, 0x98, 0x74, 0xae, 0x5f, 0x25, 0x56,
+	0xef, 0x38, 0xb0, 0x2d, 0x8a, 0xf9, 0x8a, 0x20, 0x03, 0x8a, 0x11, 0xb3, 0xcd, 0xff, 0xb2, 0xb7,
+	0x8b, 0xbc, 0xc6, 0xe1, 0x47, 0xdd, 0x5e, 0x3e, 0xc9, 0x24, 0xdb, 0x74, 0x92, 0x0e, 0xe4, 0x78,
+	0x2f, 0x54, 0x83, 0x05, 0xcb, 0xb6, 0x43, 0x4c, 0x08, 0xd3, 0xa2, 0xb0, 0x2b, 0xff, 0xf5, 0xfb,
+	0x17, 0xab, 0xa2, 0x5d, 0x9d, 0x47, 0x0c, 0x1a, 0xba, 0x9e, 0xa3, 0x27, 0x89, 0xe8, 0x11, 0xe4,
+	0x7e, 0xc0, 0xae, 0x73, 0x46, 0x19, 0xbd, 0x79, 0x5d, 0x58, 0xca, 0xcf, 0x99, 0x84, 0x37, 0x7a,
+	0x02, 0x05, 0x7a, 0x16, 0x62, 0x72, 0xe6, 0xf7, 0xb9, 0xc8, 0x59, 0x7d, 0xec, 0x88, 0x0b, 0x9c,
+	0x47, 0x7e, 0x18, 0x0d, 0x58, 0x81, 0xac, 0x2e, 0x2c, 0xf4, 0x29, 0x2c, 0x0e, 0x7d, 0xea, 0x7a,
+	0x8e, 0x19, 0xe0, 0xd0, 0xf5, 0x6d, 0x39, 0xcb, 0xc2, 0x0f, 0xb8, 0xb3, 0xcd, 0x7c, 0x31, 0x38,
+	0xc4, 0x6c, 0x71, 0xe7, 0x37, 0xa4, 0x17, 0x79, 0x5d, 0x58, 0xe8, 0x39, 0x2c, 0x61, 0x2b, 0xec,
+	0x8f, 0x4c, 0xcc, 0x36, 0xc8, 0xf5, 0x3d, 0xf9, 0x1e, 0x4b, 0x28, 0x32, 0xb7, 0x96, 0x78, 0x59,
+	0x62, 0x62, 0x98, 0x36, 0xee, 0x5b, 0x23, 0x39, 0xc7, 0xfa, 0x14, 0x53, 0x77, 0x23, 0xf6, 0xc6,
+	0x9d, 0xf0, 0x45, 0xe0, 0x86, 0x23, 0x79, 0x81, 0xd3, 0xe4, 0x56, 0xac, 0xd9, 0x10, 0x53, 0x3f,
+	0x3e, 0xec, 0xfc, 0x46, 0xf6, 0x76, 0xcd, 0x44, 0xa2, 0xf2, 0x47, 0x06, 0xf2, 0xe9, 0x12, 0xae,
+	0xc2, 0x3d, 0xea, 0xd2, 0x3e, 0xe6, 0x92, 0xeb, 0xdc, 0x40, 0x32, 0x2c, 0x90, 0x68, 0x30, 0xb0,
+	0xc2, 0x11, 0x93, 0xa5, 0xa0, 0x27, 0x26, 0xda, 0x82, 0xfc, 0x00, 0x13, 0x62, 0x39, 0x98, 0xc8,
+	0xd9, 0x5b, 0x56, 0x3f, 0xcd, 0x42, 0x9b, 0xb0, 0x7c, 0x4d, 0x49, 0x13, 0x7b, 0x36, 0xd3, 0x2b,
+	0xab, 0x2f, 0x4d, 0xaa, 0xa9, 0x79, 0x36, 0x3a, 0x80, 0x1c, 0xa1, 0x16, 0x8d, 0x08, 0xd3, 0xab,
+	0x58, 0xfb, 0xf2, 0xc3, 0xbe, 0x5d, 0x06, 0xc3, 0xea, 0xa2, 0x46, 0x7c, 0x86, 0x5c, 0x46, 0xab,
+	0xdb, 0xc7, 0xa6, 0x45, 0x85, 0xb6, 0x0f, 0xc6, 0xce, 0x3a, 0x45, 0x4f, 0x01, 0x98, 0x96, 0x98,
+	0xc4, 0x19, 0x5c, 0xdd, 0x82, 0xf0, 0xd4, 0x29, 0x2a, 0x42, 0xc6, 0xb5, 0xe5, 0x3c, 0x5b, 0xae,
+	0x8c, 0x6b, 0x2b, 0x4b, 0xb0, 0xf8, 0x26, 0xfe, 0xef, 0x18, 0xf8, 0x3c, 0xc2, 0x5e, 0x0f, 0x2b,
+	0x3b, 0xf0, 0xf0, 0x9a, 0x23, 0xfd, 0x4a, 0x94, 0x21, 0x4f, 0x84, 0x4f, 0xbc, 0xdb, 0xa9, 0xad,
+	0x2c, 0xc2, 0x7d, 0x06, 0x12, 0xaf, 0xc7, 0x2f, 0x12, 0xac, 0x4c, 0xd8, 0x69, 0x89, 0xff, 0xd7,
+	0x2f, 0x68, 0x4b, 0x8c, 0x3e, 0xfb, 0x07, 0xac, 0x07, 0x0f, 0xaf, 0x21, 0xd2, 0xc1, 0x3e, 0xe6,
+	0x5f, 0xea, 0x7b, 0x40, 0xac, 0x49, 0x2b, 0xc0, 0x5e, 0x12, 0x26, 0x68, 0x1f, 0x60, 0x7c, 0x23,
+	0x10, 0x3d, 0x3e, 0x4f, 0x7a, 0xc4, 0xd7, 0x07, 0x95, 0x5d, 0x1f, 0x54, 0x71, 0x7d, 0x50, 0xdb,
+	0x96, 0x83, 0xf5, 0xf8, 0x78, 0x08, 0xd5, 0x27, 0x90, 0xca, 0x6f, 0x12, 0x94, 0x6f, 0x96, 0x4f,
+	0x07, 0x39, 0x80, 0x42, 0x42, 0x24, 0x39, 0xa3, 0x0f, 0x9d, 0x64, 0x5c, 0x00, 0xbd, 0xba, 0x46,
+	0x9a, 0x9f, 0xd5, 0xf3, 0x3b, 0x49, 0x73, 0x2a, 0x93, 0xac, 0x37, 0xaf, 0x24, 0x28, 0x5e, 0x7f,
+	0x29, 0xd0, 0x33, 0x78, 0xdc, 0xd6, 0x5b, 0xed, 0x96, 0x51, 0x3f, 0x30, 0x8d, 0x4e, 0xbd, 0x73,
+	0x6c, 0x98, 0xc7, 0x47, 0x46, 0x5b, 0xdb, 0x6b, 0xee, 0x37, 0xb5, 0x46, 0x69, 0x0e, 0x7d, 0x02,
+	0x4f, 0xa7, 0x13, 0x4e, 0x5a, 0x9d, 0xe6, 0xd1, 0x2b, 0xb3, 0xad, 0xe9, 0xcd, 0x56, 0xa3, 0x24,
+	0xa1, 0x32, 0x3c, 0x9a, 0x4e, 0x69, 0xd7, 0x0d, 0x43, 0x6b, 0x94, 0x32, 0xe8, 0x09, 0xc8, 0xd3,
+	0x31, 0x5d, 0x7b, 0xad, 0xed, 0x75, 0xb4, 0x46, 0x29, 0xfb, 0x3e, 0xe4, 0x9b, 0x63, 0xed, 0x58,
+	0x6b, 0x94, 0xe6, 0xdf, 0x17, 0x3b, 0xd1, 0x3a, 0x2d, 0xad, 0x51, 0xba, 0x87, 0x1e, 0xc3, 0xda,
+	0x74, 0x4c, 0xfb, 0xa6, 0xdd, 0xd4, 0xb5, 0x46, 0x29, 0xb7, 0xf9, 0x16, 0x60, 0x7c, 0x07, 0x88,
+	0x53, 0x4f, 0x5a, 0x1d, 0xcd, 0x6c, 0xb5, 0x3b, 0xcd, 0xd6, 0xd1, 0xd4, 0x70, 0x2b, 0xb0, 0x34,
+	0x19, 0xfc, 0x56, 0x33, 0x4a, 0x12, 0x5a, 0x83, 0x95, 0x49, 0x67, 0x7d, 0xd7, 0xe8, 0xd4, 0x9b,
+	0x47, 0xa5, 0x0c, 0x42, 0x50, 0x9c, 0x0c, 0x1c, 0xb5, 0x4a, 0xd9, 0xdd, 0xfd, 0x3f, 0x2f, 0x2b,
+	0xd2, 0xbb, 0xcb, 0x8a, 0xf4, 0xcf, 0x65, 0x45, 0xfa, 0xf1, 0xaa, 0x32, 0xf7, 0xee, 0xaa, 0x32,
+	0xf7, 0xf7, 0x55, 0x65, 0xee, 0xbb, 0x97, 0xfc, 0x80, 0x88, 0xfd, 0x56, 0x75, 0xfd, 0xea, 0xc5,
+	0xed, 0x57, 0xe2, 0x6e, 0x8e, 0x7d, 0x26, 0x77, 0xfe, 0x1d, 0x00, 0xfe, 0x2c, 0xf6, 0xa0, 0x41,
+	0x0b, 0x00, 0x00,
 }
 
 func (m *MsgInit) Marshal() (dAtA []byte, err error) {
@@ -1353,6 +1627,57 @@ func (m *MsgExecuteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1480,6 +1805,25 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Vetoers) > 0 {
+		for iNdEx := len(m.Vetoers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Vetoers[iNdEx])
+			copy(dAtA[i:], m.Vetoers[iNdEx])
+			i = encodeVarintMultisig(dAtA, i, uint64(len(m.Vetoers[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExecutionDelay != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutionDelay))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.EarlyExecution {
 		i--
 		if m.EarlyExecution {
@@ -1538,6 +1882,21 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExecutableAt != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutableAt))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Status != 0 {
 		i = encodeVarintMultisig(dAtA, i, uint64(m.Status))
 		i--
@@ -1765,31 +2124,115 @@ func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMultisig(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMultisig(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryOpenProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgInit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryOpenProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovMultisig(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultisig(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Config != nil {
-		l = m.Config.Size()
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultisig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultisig(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultisig(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
 		n += 1 + l + sovMultisig(uint64(l))
 	}
 	return n
@@ -1880,6 +2323,27 @@ func (m *MsgExecuteProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovMultisig(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1945,6 +2409,18 @@ func (m *Config) Size() (n int) {
 	if m.EarlyExecution {
 		n += 2
 	}
+	if m.ExecutionDelay != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutionDelay))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovMultisig(uint64(m.Expiry))
+	}
+	if len(m.Vetoers) > 0 {
+		for _, s := range m.Vetoers {
+			l = len(s)
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1974,6 +2450,15 @@ func (m *Proposal) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovMultisig(uint64(m.Status))
 	}
+	if m.ExecutableAt != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutableAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovMultisig(uint64(m.ExpiresAt))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMultisig(uint64(m.Id))
+	}
 	return n
 }
 
@@ -2051,6 +2536,38 @@ func (m *QueryProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOpenProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOpenProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	return n
+}
+
 func sovMultisig(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2673,6 +3190,125 @@ func (m *MsgExecuteProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3070,17 +3706,87 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EarlyExecution = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMultisig(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMultisig
+			m.ExecutionDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoers = append(m.Vetoers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -3256,6 +3962,63 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAt", wireType)
+			}
+			m.ExecutableAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutableAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3721,6 +4484,212 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOpenProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpenProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMultisig(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
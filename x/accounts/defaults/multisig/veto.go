@@ -0,0 +1,53 @@
+package multisig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+)
+
+// VetoProposal cancels a proposal which is in its voting period or queued for execution. The sender must be
+// one of the vetoers of the config, it doesn't need to be a member.
+func (a Account) VetoProposal(ctx context.Context, msg *v1.MsgVetoProposal) (*v1.MsgVetoProposalResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vetoer, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(cfg.Vetoers, vetoer) {
+		return nil, errors.New("only a vetoer can veto a proposal")
+	}
+
+	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_QUEUED {
+		return nil, fmt.Errorf("proposal is not open, status: %s", prop.Status)
+	}
+
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_VETOED
+	if err = a.deleteProposalAndVotes(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("proposal_vetoed",
+		event.NewAttribute("proposal_id", fmt.Sprint(msg.ProposalId)),
+		event.NewAttribute("vetoer", vetoer),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVetoProposalResponse{}, a.Proposals.Set(ctx, msg.ProposalId, prop)
+}
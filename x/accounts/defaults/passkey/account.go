@@ -0,0 +1,287 @@
+package passkey
+
+import (
+	"context"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	secp256r1api "cosmossdk.io/api/cosmos/crypto/secp256r1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	ConfigPrefix      = collections.NewPrefix(0)
+	CredentialsPrefix = collections.NewPrefix(1)
+	SequencePrefix    = collections.NewPrefix(2)
+)
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			Config:          collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Credentials:     collections.NewMap(deps.SchemaBuilder, CredentialsPrefix, "credentials", collections.BytesKey, codec.CollValue[v1.Credential](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			addrCodec:       deps.AddressCodec,
+			signingHandlers: handlerMap,
+			hs:              deps.Environment.HeaderService,
+		}, nil
+	}
+}
+
+// Account implements an account authenticated by the WebAuthn assertions of
+// its registered passkey credentials, signed over secp256r1.
+type Account struct {
+	Config collections.Item[v1.Config]
+	// Credentials are the credentials registered to the account, by credential ID.
+	Credentials collections.Map[[]byte, v1.Credential]
+	Sequence    collections.Sequence
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.Config == nil || msg.Config.RelyingPartyId == "" {
+		return nil, errors.New("relying party ID must be set")
+	}
+	if len(msg.Credentials) == 0 {
+		return nil, errors.New("at least one credential is required")
+	}
+
+	if err := a.Config.Set(ctx, *msg.Config); err != nil {
+		return nil, err
+	}
+
+	for _, cred := range msg.Credentials {
+		if err := a.addCredential(ctx, cred); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.MsgInitResponse{}, nil
+}
+
+func (a Account) AddCredential(ctx context.Context, msg *v1.MsgAddCredential) (*v1.MsgAddCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgAddCredentialResponse{}, a.addCredential(ctx, msg.Credential)
+}
+
+func (a Account) RemoveCredential(ctx context.Context, msg *v1.MsgRemoveCredential) (*v1.MsgRemoveCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	// the account must keep at least one credential to be able to authenticate
+	count, err := a.countCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 1 {
+		return nil, errors.New("the last credential of the account can't be removed")
+	}
+
+	return &v1.MsgRemoveCredentialResponse{}, a.removeCredential(ctx, msg.CredentialId)
+}
+
+func (a Account) RotateCredential(ctx context.Context, msg *v1.MsgRotateCredential) (*v1.MsgRotateCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	// the new credential is validated before the old one is removed, so that the
+	// account is never left without a usable credential
+	if err := validateCredential(msg.NewCredential); err != nil {
+		return nil, err
+	}
+	if err := a.removeCredential(ctx, msg.CredentialId); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgRotateCredentialResponse{}, a.addCredential(ctx, msg.NewCredential)
+}
+
+func validateCredential(cred *v1.Credential) error {
+	if cred == nil || len(cred.Id) == 0 {
+		return errors.New("credential ID must be set")
+	}
+	if x, _ := elliptic.UnmarshalCompressed(elliptic.P256(), cred.PubKey); x == nil {
+		return fmt.Errorf("credential %X: invalid compressed secp256r1 public key", cred.Id)
+	}
+	return nil
+}
+
+func (a Account) addCredential(ctx context.Context, cred *v1.Credential) error {
+	if err := validateCredential(cred); err != nil {
+		return err
+	}
+
+	has, err := a.Credentials.Has(ctx, cred.Id)
+	if err != nil {
+		return err
+	}
+	if has {
+		return fmt.Errorf("credential %X already registered", cred.Id)
+	}
+
+	return a.Credentials.Set(ctx, cred.Id, *cred)
+}
+
+func (a Account) removeCredential(ctx context.Context, id []byte) error {
+	has, err := a.Credentials.Has(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return fmt.Errorf("credential %X not found", id)
+	}
+
+	return a.Credentials.Remove(ctx, id)
+}
+
+func (a Account) countCredentials(ctx context.Context) (int, error) {
+	count := 0
+	err := a.Credentials.Walk(ctx, nil, func(_ []byte, _ v1.Credential) (stop bool, err error) {
+		count++
+		return false, nil
+	})
+	return count, err
+}
+
+// Authenticate implements the authentication flow of an abstracted passkey account.
+// The signature of the account in the transaction is a v1.Assertion of one of its
+// credentials over the sign bytes of the transaction.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	assertion := new(v1.Assertion)
+	if err := assertion.Unmarshal(msg.Tx.Signatures[msg.SignerIndex]); err != nil {
+		return nil, fmt.Errorf("unable to decode assertion: %w", err)
+	}
+
+	cred, err := a.Credentials.Get(ctx, assertion.CredentialId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("credential %X not found", assertion.CredentialId)
+		}
+		return nil, err
+	}
+
+	signerData, err := a.computeSignerData(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := base.GetTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := base.ParseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signCount, err := verifyAssertion(config, cred, assertion, signBytes)
+	if err != nil {
+		return nil, fmt.Errorf("signature verification failed: %w", err)
+	}
+
+	cred.SignCount = signCount
+	if err := a.Credentials.Set(ctx, cred.Id, cred); err != nil {
+		return nil, err
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// computeSignerData will populate signer data for the given credential and also
+// increase the sequence.
+func (a Account) computeSignerData(ctx context.Context, cred v1.Credential) (signing.SignerData, error) {
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	pkAny, err := anypb.New(&secp256r1api.PubKey{Key: cred.PubKey})
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return base.NewSignerData(ctx, a.addrCodec, a.hs, wantSequence, pkAny)
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) QueryCredentials(ctx context.Context, _ *v1.QueryCredentials) (*v1.QueryCredentialsResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var creds []*v1.Credential
+	err = a.Credentials.Walk(ctx, nil, func(_ []byte, cred v1.Credential) (stop bool, err error) {
+		creds = append(creds, &cred)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryCredentialsResponse{Config: &config, Credentials: creds}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.AddCredential)
+	accountstd.RegisterExecuteHandler(builder, a.RemoveCredential)
+	accountstd.RegisterExecuteHandler(builder, a.RotateCredential)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryCredentials)
+}
@@ -0,0 +1,302 @@
+package passkey
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	secp256r1api "cosmossdk.io/api/cosmos/crypto/secp256r1"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var testBody, _ = (&tx.TxBody{Memo: "passkey"}).Marshal()
+
+// signBytes returns the sign bytes of the test tx for the credential at the
+// given sequence.
+func signBytes(t *testing.T, cred *v1.Credential, sequence uint64) []byte {
+	t.Helper()
+	pkAny, err := anypb.New(&secp256r1api.PubKey{Key: cred.PubKey})
+	require.NoError(t, err)
+	bz, err := signHandler{}.GetSignBytes(context.Background(), signing.SignerData{
+		Address:       testAccount,
+		ChainID:       testChainID,
+		AccountNumber: testAccNum,
+		Sequence:      sequence,
+		PubKey:        pkAny,
+	}, signing.TxData{BodyBytes: testBody})
+	require.NoError(t, err)
+	return bz
+}
+
+// authenticate runs the authentication of the test tx at the given sequence,
+// signed with the assertion.
+func authenticate(ctx context.Context, acc Account, assertion *v1.Assertion, sequence uint64) error {
+	sig, err := assertion.Marshal()
+	if err != nil {
+		return err
+	}
+	authInfo := &tx.AuthInfo{
+		SignerInfos: []*tx.SignerInfo{{
+			ModeInfo: &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signingtypes.SignMode_SIGN_MODE_DIRECT}}},
+			Sequence: sequence,
+		}},
+	}
+	authInfoBytes, err := authInfo.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	_, err = acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{
+		RawTx:       &tx.TxRaw{BodyBytes: testBody, AuthInfoBytes: authInfoBytes, Signatures: [][]byte{sig}},
+		Tx:          &tx.Tx{AuthInfo: authInfo, Signatures: [][]byte{sig}},
+		SignerIndex: 0,
+	})
+	return err
+}
+
+func initAccount(t *testing.T, ctx context.Context, acc Account, creds ...*v1.Credential) {
+	t.Helper()
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			RelyingPartyId:          testRPID,
+			Origins:                 []string{testOrigin},
+			RequireUserVerification: true,
+		},
+		Credentials: creds,
+	})
+	require.NoError(t, err)
+}
+
+func TestInit(t *testing.T) {
+	valid := newAuthenticator(t, "cred").credential()
+	config := &v1.Config{RelyingPartyId: testRPID}
+
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgInit
+		expErr string
+	}{
+		{
+			"success",
+			&v1.MsgInit{Config: config, Credentials: []*v1.Credential{valid}},
+			"",
+		},
+		{
+			"no relying party ID",
+			&v1.MsgInit{Config: &v1.Config{}, Credentials: []*v1.Credential{valid}},
+			"relying party ID must be set",
+		},
+		{
+			"no credentials",
+			&v1.MsgInit{Config: config},
+			"at least one credential is required",
+		},
+		{
+			"no credential ID",
+			&v1.MsgInit{Config: config, Credentials: []*v1.Credential{{PubKey: valid.PubKey}}},
+			"credential ID must be set",
+		},
+		{
+			"invalid public key",
+			&v1.MsgInit{Config: config, Credentials: []*v1.Credential{{Id: valid.Id, PubKey: []byte{2, 1}}}},
+			"invalid compressed secp256r1 public key",
+		},
+		{
+			"duplicate credential",
+			&v1.MsgInit{Config: config, Credentials: []*v1.Credential{valid, valid}},
+			"already registered",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setup(t, ss)
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setup(t, ss)
+	phone, laptop := newAuthenticator(t, "phone"), newAuthenticator(t, "laptop")
+	initAccount(t, ctx, acc, phone.credential(), laptop.credential())
+
+	// any registered credential can authenticate the account
+	require.NoError(t, authenticate(ctx, acc, phone.assert(t, signBytes(t, phone.credential(), 0), testRPID, testOrigin), 0))
+	require.NoError(t, authenticate(ctx, acc, laptop.assert(t, signBytes(t, laptop.credential(), 1), testRPID, testOrigin), 1))
+
+	seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), seq.Sequence)
+
+	// the sign count of the credentials is updated
+	cred, err := acc.Credentials.Get(ctx, phone.id)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), cred.SignCount)
+
+	testcases := []struct {
+		name   string
+		assert func(sequence uint64) *v1.Assertion
+		expErr string
+	}{
+		{
+			"unknown credential",
+			func(sequence uint64) *v1.Assertion {
+				other := newAuthenticator(t, "other")
+				return other.assert(t, signBytes(t, other.credential(), sequence), testRPID, testOrigin)
+			},
+			"credential 6F74686572 not found",
+		},
+		{
+			"wrong challenge",
+			func(sequence uint64) *v1.Assertion {
+				return phone.assert(t, signBytes(t, phone.credential(), sequence+1), testRPID, testOrigin)
+			},
+			"challenge doesn't match the sign bytes",
+		},
+		{
+			"wrong origin",
+			func(sequence uint64) *v1.Assertion {
+				return phone.assert(t, signBytes(t, phone.credential(), sequence), testRPID, "https://evil.example.com")
+			},
+			"origin not allowed",
+		},
+		{
+			"wrong relying party",
+			func(sequence uint64) *v1.Assertion {
+				return phone.assert(t, signBytes(t, phone.credential(), sequence), "evil.example.com", testOrigin)
+			},
+			"relying party ID hash doesn't match",
+		},
+		{
+			"user not verified",
+			func(sequence uint64) *v1.Assertion {
+				phone.flags = flagUserPresent
+				defer func() { phone.flags = flagUserPresent | flagUserVerified }()
+				return phone.assert(t, signBytes(t, phone.credential(), sequence), testRPID, testOrigin)
+			},
+			"user not verified",
+		},
+		{
+			"replayed sign count",
+			func(sequence uint64) *v1.Assertion {
+				phone.signCount = 0
+				return phone.assert(t, signBytes(t, phone.credential(), sequence), testRPID, testOrigin)
+			},
+			"signature counter didn't increase",
+		},
+		{
+			"signed by another key",
+			func(sequence uint64) *v1.Assertion {
+				other := newAuthenticator(t, "phone")
+				other.signCount = 10
+				return other.assert(t, signBytes(t, phone.credential(), sequence), testRPID, testOrigin)
+			},
+			"invalid signature",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+			require.NoError(t, err)
+			err = authenticate(ctx, acc, tc.assert(seq.Sequence), seq.Sequence)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+
+	// only the accounts module can authenticate the account
+	_, err = acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{})
+	require.ErrorContains(t, err, "unauthorized")
+}
+
+func TestAuthenticateNoSignCount(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setup(t, ss)
+	auth := newAuthenticator(t, "key")
+	initAccount(t, ctx, acc, auth.credential())
+
+	// authenticators which don't implement the counter always report 0
+	auth.noCounter = true
+	for seq := uint64(0); seq < 2; seq++ {
+		require.NoError(t, authenticate(ctx, acc, auth.assert(t, signBytes(t, auth.credential(), seq), testRPID, testOrigin), seq))
+	}
+
+	// but once an authenticator reported a counter, it must keep increasing
+	auth.noCounter = false
+	require.NoError(t, authenticate(ctx, acc, auth.assert(t, signBytes(t, auth.credential(), 2), testRPID, testOrigin), 2))
+	auth.noCounter, auth.signCount = true, 0
+	err := authenticate(ctx, acc, auth.assert(t, signBytes(t, auth.credential(), 3), testRPID, testOrigin), 3)
+	require.ErrorContains(t, err, "signature counter didn't increase")
+}
+
+func TestManageCredentials(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setup(t, ss)
+	phone, laptop, key := newAuthenticator(t, "phone"), newAuthenticator(t, "laptop"), newAuthenticator(t, "key")
+	initAccount(t, ctx, acc, phone.credential())
+
+	// only the account itself can manage its credentials
+	other := accountstd.SetSender(ctx, []byte("other"))
+	_, err := acc.AddCredential(other, &v1.MsgAddCredential{Credential: laptop.credential()})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.RemoveCredential(other, &v1.MsgRemoveCredential{CredentialId: phone.id})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.RotateCredential(other, &v1.MsgRotateCredential{CredentialId: phone.id, NewCredential: laptop.credential()})
+	require.ErrorContains(t, err, "unauthorized")
+
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: phone.id})
+	require.ErrorContains(t, err, "the last credential of the account can't be removed")
+
+	_, err = acc.AddCredential(ctx, &v1.MsgAddCredential{Credential: laptop.credential()})
+	require.NoError(t, err)
+	_, err = acc.AddCredential(ctx, &v1.MsgAddCredential{Credential: laptop.credential()})
+	require.ErrorContains(t, err, "already registered")
+
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: []byte("unknown")})
+	require.ErrorContains(t, err, "not found")
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: phone.id})
+	require.NoError(t, err)
+
+	// an invalid credential doesn't replace the rotated one
+	_, err = acc.RotateCredential(ctx, &v1.MsgRotateCredential{CredentialId: laptop.id, NewCredential: &v1.Credential{Id: key.id}})
+	require.ErrorContains(t, err, "invalid compressed secp256r1 public key")
+	_, err = acc.RotateCredential(ctx, &v1.MsgRotateCredential{CredentialId: laptop.id, NewCredential: key.credential()})
+	require.NoError(t, err)
+
+	res, err := acc.QueryCredentials(ctx, &v1.QueryCredentials{})
+	require.NoError(t, err)
+	require.Equal(t, testRPID, res.Config.RelyingPartyId)
+	require.Len(t, res.Credentials, 1)
+	require.Equal(t, key.id, res.Credentials[0].Id)
+
+	// removed credentials can no longer authenticate the account
+	err = authenticate(ctx, acc, laptop.assert(t, signBytes(t, laptop.credential(), 0), testRPID, testOrigin), 0)
+	require.ErrorContains(t, err, "not found")
+	require.NoError(t, authenticate(ctx, acc, key.assert(t, signBytes(t, key.credential(), 0), testRPID, testOrigin), 0))
+}
+
+func TestClientDataJSON(t *testing.T) {
+	// browsers may add fields to the client data, which are ignored
+	var cd clientData
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"webauthn.get","challenge":"YQ","origin":"https://a.b","crossOrigin":false}`), &cd))
+	require.Equal(t, clientData{Type: clientDataTypeGet, Challenge: "YQ", Origin: "https://a.b"}, cd)
+}
@@ -0,0 +1,173 @@
+package passkey
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+type ProtoMsg = protoiface.MessageV1
+
+const (
+	testAccNum   = 7
+	testRPID     = "wallet.example.com"
+	testOrigin   = "https://wallet.example.com"
+	testChainID  = "test-chain"
+	testAccount  = "mock_passkey_account"
+	testSignMode = signingv1beta1.SignMode_SIGN_MODE_DIRECT
+)
+
+// mock statecodec
+type mockStateCodec struct {
+	codec.Codec
+}
+
+func (c mockStateCodec) Marshal(m gogoproto.Message) ([]byte, error) {
+	// Size() check can catch the typed nil value.
+	if m == nil || gogoproto.Size(m) == 0 {
+		// return empty bytes instead of nil, because nil has special meaning in places like store.Set
+		return []byte{}, nil
+	}
+
+	return gogoproto.Marshal(m)
+}
+
+func (c mockStateCodec) Unmarshal(bz []byte, ptr gogoproto.Message) error {
+	return gogoproto.Unmarshal(bz, ptr)
+}
+
+// mock address codec
+type addressCodec struct{}
+
+func (a addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (a addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{}
+
+func (headerService) HeaderInfo(context.Context) header.Info {
+	return header.Info{ChainID: testChainID}
+}
+
+// signHandler returns the body bytes followed by the signer data as sign bytes.
+type signHandler struct{}
+
+func (signHandler) Mode() signingv1beta1.SignMode { return testSignMode }
+
+func (signHandler) GetSignBytes(_ context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	signerDataBz, err := json.Marshal(signerData)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, txData.BodyBytes...), signerDataBz...), nil
+}
+
+func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
+	t.Helper()
+	return accountstd.NewMockContext(
+		testAccNum, []byte(testAccount), []byte(testAccount), nil, func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
+			return nil
+		}, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
+			return nil, nil
+		}, func(ctx context.Context, req, resp ProtoMsg) error {
+			_, ok := req.(*accountsv1.AccountNumberRequest)
+			require.True(t, ok)
+			gogoproto.Merge(resp.(gogoproto.Message), &accountsv1.AccountNumberResponse{Number: testAccNum})
+			return nil
+		},
+	)
+}
+
+func setup(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: mockStateCodec{},
+		Environment: appmodule.Environment{
+			HeaderService: headerService{},
+		},
+	}
+
+	_, acc, err := NewAccount("passkey", signing.NewHandlerMap(signHandler{}))(deps)
+	require.NoError(t, err)
+	return acc.(Account)
+}
+
+// authenticator emulates a WebAuthn authenticator holding a single credential.
+type authenticator struct {
+	id        []byte
+	key       *ecdsa.PrivateKey
+	signCount uint32
+	flags     byte
+	// noCounter emulates an authenticator which doesn't implement the signature
+	// counter and always reports 0.
+	noCounter bool
+}
+
+func newAuthenticator(t *testing.T, id string) *authenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &authenticator{id: []byte(id), key: key, flags: flagUserPresent | flagUserVerified}
+}
+
+func (a *authenticator) credential() *v1.Credential {
+	return &v1.Credential{
+		Id:     a.id,
+		PubKey: elliptic.MarshalCompressed(elliptic.P256(), a.key.X, a.key.Y),
+	}
+}
+
+// assert returns the assertion of the credential over the sign bytes, for the
+// given relying party ID and origin.
+func (a *authenticator) assert(t *testing.T, signBytes []byte, rpID, origin string) *v1.Assertion {
+	t.Helper()
+	if !a.noCounter {
+		a.signCount++
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(rpIDHash[:], a.flags)
+	authData = binary.BigEndian.AppendUint32(authData, a.signCount)
+
+	clientDataJSON, err := json.Marshal(clientData{
+		Type:      clientDataTypeGet,
+		Challenge: base64.RawURLEncoding.EncodeToString(signBytes),
+		Origin:    origin,
+	})
+	require.NoError(t, err)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	return &v1.Assertion{
+		CredentialId:      a.id,
+		AuthenticatorData: authData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         sig,
+	}
+}
@@ -0,0 +1,91 @@
+package passkey
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+)
+
+const (
+	// clientDataTypeGet is the type of the client data of WebAuthn assertions.
+	clientDataTypeGet = "webauthn.get"
+
+	// authenticatorDataMinLength is the length of the authenticator data without
+	// attested credential data nor extensions: the SHA-256 hash of the relying
+	// party ID, the flags byte and the 4 bytes signature counter.
+	authenticatorDataMinLength = 37
+
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+)
+
+// clientData is the subset of the WebAuthn client data checked by the account.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// verifyAssertion verifies the WebAuthn assertion of the credential over the sign
+// bytes, as specified in https://www.w3.org/TR/webauthn-2/#sctn-verifying-assertion,
+// and returns the new signature counter of the credential.
+func verifyAssertion(config v1.Config, cred v1.Credential, assertion *v1.Assertion, signBytes []byte) (uint32, error) {
+	var cd clientData
+	if err := json.Unmarshal(assertion.ClientDataJson, &cd); err != nil {
+		return 0, fmt.Errorf("invalid client data: %w", err)
+	}
+	if cd.Type != clientDataTypeGet {
+		return 0, fmt.Errorf("unexpected client data type: %s", cd.Type)
+	}
+	if cd.Challenge != base64.RawURLEncoding.EncodeToString(signBytes) {
+		return 0, errors.New("challenge doesn't match the sign bytes")
+	}
+	if len(config.Origins) > 0 && !slices.Contains(config.Origins, cd.Origin) {
+		return 0, fmt.Errorf("origin not allowed: %s", cd.Origin)
+	}
+
+	authData := assertion.AuthenticatorData
+	if len(authData) < authenticatorDataMinLength {
+		return 0, fmt.Errorf("authenticator data too short: %d bytes", len(authData))
+	}
+	rpIDHash := sha256.Sum256([]byte(config.RelyingPartyId))
+	if !bytes.Equal(authData[:32], rpIDHash[:]) {
+		return 0, errors.New("relying party ID hash doesn't match")
+	}
+	flags := authData[32]
+	if flags&flagUserPresent == 0 {
+		return 0, errors.New("user not present")
+	}
+	if config.RequireUserVerification && flags&flagUserVerified == 0 {
+		return 0, errors.New("user not verified")
+	}
+
+	// the counter must increase, unless the authenticator doesn't implement it
+	signCount := binary.BigEndian.Uint32(authData[33:37])
+	if (signCount != 0 || cred.SignCount != 0) && signCount <= cred.SignCount {
+		return 0, fmt.Errorf("signature counter didn't increase: stored %d, got %d", cred.SignCount, signCount)
+	}
+
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), cred.PubKey)
+	if x == nil {
+		return 0, errors.New("invalid credential public key")
+	}
+	pubKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJson)
+	digest := sha256.Sum256(append(slices.Clone(authData), clientDataHash[:]...))
+	if !ecdsa.VerifyASN1(pubKey, digest[:], assertion.Signature) {
+		return 0, errors.New("invalid signature")
+	}
+
+	return signCount, nil
+}
@@ -0,0 +1,397 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+// The prefixes of the base account are reserved: 0 for the pubkey and 1 for the
+// sequence.
+var (
+	GuardiansPrefix  = collections.NewPrefix(2)
+	ConfigPrefix     = collections.NewPrefix(3)
+	RecoveriesPrefix = collections.NewPrefix(4)
+)
+
+// MaxTimeLock is the maximum time lock of a recovery, in seconds: 10 years. It
+// keeps the time a recovery can be executed at from overflowing.
+const MaxTimeLock = 10 * 365 * 24 * 60 * 60
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+		return name, Account{
+			Account:       baseAcc.(base.Account),
+			Guardians:     collections.NewKeySet(deps.SchemaBuilder, GuardiansPrefix, "guardians", collections.BytesKey),
+			Config:        collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Recoveries:    collections.NewMap(deps.SchemaBuilder, RecoveriesPrefix, "recoveries", collections.BytesKey, codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+			addrCodec:     deps.AddressCodec,
+			headerService: deps.Environment.HeaderService,
+			eventService:  deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Account implements a base account which can be recovered by its guardians if
+// its key is lost. Once a threshold of guardians approved the rotation of the
+// pubkey, it can be executed after a time lock during which the owner can veto it.
+type Account struct {
+	base.Account
+
+	Guardians collections.KeySet[[]byte]
+	Config    collections.Item[v1.Config]
+	// Recoveries are the pending recoveries of the account, by new pubkey.
+	Recoveries collections.Map[[]byte, v1.Recovery]
+
+	addrCodec     address.Codec
+	headerService header.Service
+	eventService  event.Service
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if _, err := a.Account.Init(ctx, &basev1.MsgInit{PubKey: msg.PubKey}); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, a.setGuardians(ctx, msg.Guardians, msg.Config)
+}
+
+// UpdateGuardians replaces the guardians and the config of the account, and
+// cancels the pending recoveries.
+func (a Account) UpdateGuardians(ctx context.Context, msg *v1.MsgUpdateGuardians) (*v1.MsgUpdateGuardiansResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if err := a.Guardians.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+	if err := a.Recoveries.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateGuardiansResponse{}, a.setGuardians(ctx, msg.Guardians, msg.Config)
+}
+
+func (a Account) setGuardians(ctx context.Context, guardians []string, config *v1.Config) error {
+	if len(guardians) == 0 {
+		return errors.New("guardians must be specified")
+	}
+	if config == nil {
+		return errors.New("config must be specified")
+	}
+	if config.Threshold == 0 || int(config.Threshold) > len(guardians) {
+		return fmt.Errorf("threshold must be between 1 and the number of guardians, got %d", config.Threshold)
+	}
+	if config.TimeLock < 0 || config.TimeLock > MaxTimeLock {
+		return fmt.Errorf("time lock must be between 0 and %d seconds, got %d", MaxTimeLock, config.TimeLock)
+	}
+
+	for _, guardian := range guardians {
+		addr, err := a.addrCodec.StringToBytes(guardian)
+		if err != nil {
+			return err
+		}
+		if slices.Equal(addr, accountstd.Whoami(ctx)) {
+			return errors.New("the account can't be its own guardian")
+		}
+		has, err := a.Guardians.Has(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if has {
+			return fmt.Errorf("duplicate guardian %s", guardian)
+		}
+		if err := a.Guardians.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	return a.Config.Set(ctx, *config)
+}
+
+// InitiateRecovery starts the recovery of the account to a new pubkey, approved
+// by the guardian sending it.
+func (a Account) InitiateRecovery(ctx context.Context, msg *v1.MsgInitiateRecovery) (*v1.MsgInitiateRecoveryResponse, error) {
+	if _, err := dcrd_secp256k1.ParsePubKey(msg.NewPubKey); err != nil {
+		return nil, err
+	}
+
+	has, err := a.Recoveries.Has(ctx, msg.NewPubKey)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.New("recovery already initiated, approve it instead")
+	}
+
+	guardian, err := a.checkGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery := v1.Recovery{NewPubKey: msg.NewPubKey}
+	if err := a.approve(ctx, &recovery, guardian); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_initiated",
+		event.NewAttribute("guardian", guardian),
+		event.NewAttribute("new_pub_key", fmt.Sprintf("%X", msg.NewPubKey)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitiateRecoveryResponse{}, nil
+}
+
+// ApproveRecovery approves a pending recovery of the account. Once approved by
+// the threshold of guardians, the recovery can be executed after the time lock.
+func (a Account) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	recovery, err := a.Recoveries.Get(ctx, msg.NewPubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errors.New("recovery not found")
+		}
+		return nil, err
+	}
+
+	guardian, err := a.checkGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.approve(ctx, &recovery, guardian); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgApproveRecoveryResponse{ExecutableAt: recovery.ExecutableAt}, nil
+}
+
+// WithdrawApproval withdraws the approval of a pending recovery by the guardian
+// sending it, which can then approve another recovery. The time lock of the
+// recovery is reset if it falls below the threshold, and the recovery is
+// cancelled once all its approvals are withdrawn.
+func (a Account) WithdrawApproval(ctx context.Context, msg *v1.MsgWithdrawApproval) (*v1.MsgWithdrawApprovalResponse, error) {
+	recovery, err := a.Recoveries.Get(ctx, msg.NewPubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errors.New("recovery not found")
+		}
+		return nil, err
+	}
+
+	guardian, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.Index(recovery.Approvals, guardian)
+	if i == -1 {
+		return nil, errors.New("sender didn't approve the recovery")
+	}
+	recovery.Approvals = slices.Delete(recovery.Approvals, i, i+1)
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_approval_withdrawn",
+		event.NewAttribute("guardian", guardian),
+		event.NewAttribute("new_pub_key", fmt.Sprintf("%X", msg.NewPubKey)),
+	); err != nil {
+		return nil, err
+	}
+
+	if len(recovery.Approvals) == 0 {
+		return &v1.MsgWithdrawApprovalResponse{}, a.Recoveries.Remove(ctx, msg.NewPubKey)
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(recovery.Approvals) < int(config.Threshold) {
+		recovery.ExecutableAt = 0
+	}
+
+	return &v1.MsgWithdrawApprovalResponse{}, a.Recoveries.Set(ctx, msg.NewPubKey, recovery)
+}
+
+// checkGuardian checks that the sender is a guardian which didn't approve any of
+// the pending recoveries yet, and returns its address.
+func (a Account) checkGuardian(ctx context.Context) (string, error) {
+	sender := accountstd.Sender(ctx)
+	isGuardian, err := a.Guardians.Has(ctx, sender)
+	if err != nil {
+		return "", err
+	}
+	if !isGuardian {
+		return "", errors.New("unauthorized: sender is not a guardian")
+	}
+
+	guardian, err := a.addrCodec.BytesToString(sender)
+	if err != nil {
+		return "", err
+	}
+
+	approved := false
+	err = a.Recoveries.Walk(ctx, nil, func(_ []byte, recovery v1.Recovery) (stop bool, err error) {
+		approved = slices.Contains(recovery.Approvals, guardian)
+		return approved, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if approved {
+		return "", errors.New("guardian already approved a pending recovery")
+	}
+
+	return guardian, nil
+}
+
+// approve records the approval of the recovery by the guardian, and starts the
+// time lock of the recovery if the threshold is reached.
+func (a Account) approve(ctx context.Context, recovery *v1.Recovery, guardian string) error {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	if recovery.ExecutableAt == 0 && len(recovery.Approvals) >= int(config.Threshold) {
+		recovery.ExecutableAt = a.headerService.HeaderInfo(ctx).Time.Add(time.Duration(config.TimeLock) * time.Second).Unix()
+
+		if err := a.eventService.EventManager(ctx).EmitKV("recovery_approved",
+			event.NewAttribute("new_pub_key", fmt.Sprintf("%X", recovery.NewPubKey)),
+			event.NewAttribute("executable_at", fmt.Sprint(recovery.ExecutableAt)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return a.Recoveries.Set(ctx, recovery.NewPubKey, *recovery)
+}
+
+// ExecuteRecovery swaps the pubkey of the account to the pubkey of a recovery
+// whose time lock elapsed, and cancels the other pending recoveries.
+func (a Account) ExecuteRecovery(ctx context.Context, msg *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	recovery, err := a.Recoveries.Get(ctx, msg.NewPubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errors.New("recovery not found")
+		}
+		return nil, err
+	}
+
+	if recovery.ExecutableAt == 0 {
+		return nil, errors.New("recovery not approved by the threshold of guardians")
+	}
+	if a.headerService.HeaderInfo(ctx).Time.Unix() < recovery.ExecutableAt {
+		return nil, fmt.Errorf("recovery time lock not elapsed, executable at %d", recovery.ExecutableAt)
+	}
+
+	if err := a.Recoveries.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+	if err := a.PubKey.Set(ctx, secp256k1.PubKey{Key: recovery.NewPubKey}); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_executed",
+		event.NewAttribute("new_pub_key", fmt.Sprintf("%X", recovery.NewPubKey)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteRecoveryResponse{}, nil
+}
+
+// VetoRecovery cancels the pending recoveries of the account.
+func (a Account) VetoRecovery(ctx context.Context, _ *v1.MsgVetoRecovery) (*v1.MsgVetoRecoveryResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if err := a.Recoveries.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_vetoed"); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVetoRecoveryResponse{}, nil
+}
+
+func (a Account) QueryGuardians(ctx context.Context, _ *v1.QueryGuardians) (*v1.QueryGuardiansResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var guardians []string
+	err = a.Guardians.Walk(ctx, nil, func(addr []byte) (stop bool, err error) {
+		guardian, err := a.addrCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		guardians = append(guardians, guardian)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGuardiansResponse{Guardians: guardians, Config: &config}, nil
+}
+
+func (a Account) QueryRecoveries(ctx context.Context, _ *v1.QueryRecoveries) (*v1.QueryRecoveriesResponse, error) {
+	var recoveries []*v1.Recovery
+	err := a.Recoveries.Walk(ctx, nil, func(_ []byte, recovery v1.Recovery) (stop bool, err error) {
+		recoveries = append(recoveries, &recovery)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryRecoveriesResponse{Recoveries: recoveries}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateGuardians)
+	accountstd.RegisterExecuteHandler(builder, a.InitiateRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.WithdrawApproval)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.VetoRecovery)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryGuardians)
+	accountstd.RegisterQueryHandler(builder, a.QueryRecoveries)
+}
@@ -0,0 +1,285 @@
+package recovery
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+const self = "mock_recovery_account"
+
+func setup(t *testing.T, ss store.KVStoreService, timefn func() time.Time) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss, timefn)
+
+	_, acc, err := NewAccount("recovery", nil)(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func newPubKey() []byte {
+	return secp256k1.GenPrivKey().PubKey().Bytes()
+}
+
+func TestInit(t *testing.T) {
+	pubKey := newPubKey()
+
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgInit
+		expErr string
+	}{
+		{
+			"success",
+			&v1.MsgInit{PubKey: pubKey, Guardians: []string{"alice", "bob"}, Config: &v1.Config{Threshold: 2, TimeLock: 60}},
+			"",
+		},
+		{
+			"invalid pubkey",
+			&v1.MsgInit{PubKey: []byte{1}, Guardians: []string{"alice"}, Config: &v1.Config{Threshold: 1}},
+			"malformed public key",
+		},
+		{
+			"no guardians",
+			&v1.MsgInit{PubKey: pubKey, Config: &v1.Config{Threshold: 1}},
+			"guardians must be specified",
+		},
+		{
+			"no config",
+			&v1.MsgInit{PubKey: pubKey, Guardians: []string{"alice"}},
+			"config must be specified",
+		},
+		{
+			"threshold above the number of guardians",
+			&v1.MsgInit{PubKey: pubKey, Guardians: []string{"alice"}, Config: &v1.Config{Threshold: 2}},
+			"threshold must be between 1 and the number of guardians",
+		},
+		{
+			"zero threshold",
+			&v1.MsgInit{PubKey: pubKey, Guardians: []string{"alice"}, Config: &v1.Config{}},
+			"threshold must be between 1 and the number of guardians",
+		},
+		{
+			"negative time lock",
+			&v1.MsgInit{PubKey: pubKey, Guardians: []string{"alice"}, Config: &v1.Config{Threshold: 1, TimeLock: -1}},
+			"time lock must be between 0 and 315360000 seconds, got -1",
+		},
+		{
+			"time lock overflow",
+			&v1.MsgInit{PubKey: pubKey, Guardians: []string{"alice"}, Config: &v1.Config{Threshold: 1, TimeLock: math.MaxInt64}},
+			"time lock must be between 0 and 315360000 seconds, got 9223372036854775807",
+		},
+		{
+			"duplicate guardian",
+			&v1.MsgInit{PubKey: pubKey, Guardians: []string{"alice", "alice"}, Config: &v1.Config{Threshold: 1}},
+			"duplicate guardian",
+		},
+		{
+			"self guardian",
+			&v1.MsgInit{PubKey: pubKey, Guardians: []string{self}, Config: &v1.Config{Threshold: 1}},
+			"the account can't be its own guardian",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setup(t, ss, time.Now)
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := acc.QueryGuardians(ctx, &v1.QueryGuardians{})
+			require.NoError(t, err)
+			require.Equal(t, []string{"alice", "bob"}, res.Guardians)
+			require.Equal(t, uint32(2), res.Config.Threshold)
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	now := time.Unix(1000, 0)
+	acc := setup(t, ss, func() time.Time { return now })
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    newPubKey(),
+		Guardians: []string{"alice", "bob", "carol"},
+		Config:    &v1.Config{Threshold: 2, TimeLock: 60},
+	})
+	require.NoError(t, err)
+
+	as := func(sender string) context.Context { return accountstd.SetSender(ctx, []byte(sender)) }
+	newKey, otherKey := newPubKey(), newPubKey()
+
+	// only guardians can initiate and approve recoveries
+	_, err = acc.InitiateRecovery(as("mallory"), &v1.MsgInitiateRecovery{NewPubKey: newKey})
+	require.ErrorContains(t, err, "sender is not a guardian")
+	_, err = acc.InitiateRecovery(as("alice"), &v1.MsgInitiateRecovery{NewPubKey: []byte{1}})
+	require.ErrorContains(t, err, "malformed public key")
+
+	_, err = acc.InitiateRecovery(as("alice"), &v1.MsgInitiateRecovery{NewPubKey: newKey})
+	require.NoError(t, err)
+	_, err = acc.InitiateRecovery(as("bob"), &v1.MsgInitiateRecovery{NewPubKey: newKey})
+	require.ErrorContains(t, err, "recovery already initiated")
+
+	// a competing recovery can be pending, but each guardian approves only one
+	_, err = acc.InitiateRecovery(as("carol"), &v1.MsgInitiateRecovery{NewPubKey: otherKey})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(as("alice"), &v1.MsgApproveRecovery{NewPubKey: otherKey})
+	require.ErrorContains(t, err, "guardian already approved a pending recovery")
+	_, err = acc.ApproveRecovery(as("bob"), &v1.MsgApproveRecovery{NewPubKey: newPubKey()})
+	require.ErrorContains(t, err, "recovery not found")
+
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newKey})
+	require.ErrorContains(t, err, "recovery not approved by the threshold of guardians")
+
+	res, err := acc.ApproveRecovery(as("bob"), &v1.MsgApproveRecovery{NewPubKey: newKey})
+	require.NoError(t, err)
+	require.Equal(t, now.Unix()+60, res.ExecutableAt)
+
+	// the recovery can't be executed before the end of the time lock
+	now = now.Add(59 * time.Second)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newKey})
+	require.ErrorContains(t, err, "recovery time lock not elapsed")
+
+	// anyone can execute the recovery once the time lock elapsed
+	now = now.Add(time.Second)
+	_, err = acc.ExecuteRecovery(as("mallory"), &v1.MsgExecuteRecovery{NewPubKey: newKey})
+	require.NoError(t, err)
+	pk, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newKey, pk.Key)
+
+	// the competing recovery is cancelled
+	recoveries, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, recoveries.Recoveries)
+}
+
+func TestVetoRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	now := time.Unix(1000, 0)
+	acc := setup(t, ss, func() time.Time { return now })
+	pubKey := newPubKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    pubKey,
+		Guardians: []string{"alice"},
+		Config:    &v1.Config{Threshold: 1, TimeLock: 60},
+	})
+	require.NoError(t, err)
+	newKey := newPubKey()
+
+	_, err = acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("alice")), &v1.MsgInitiateRecovery{NewPubKey: newKey})
+	require.NoError(t, err)
+	recoveries, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.Recovery{{NewPubKey: newKey, Approvals: []string{"alice"}, ExecutableAt: now.Unix() + 60}}, recoveries.Recoveries)
+
+	// only the owner can veto the recovery
+	_, err = acc.VetoRecovery(accountstd.SetSender(ctx, []byte("alice")), &v1.MsgVetoRecovery{})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.VetoRecovery(accountstd.SetSender(ctx, []byte(self)), &v1.MsgVetoRecovery{})
+	require.NoError(t, err)
+
+	now = now.Add(time.Hour)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newKey})
+	require.ErrorContains(t, err, "recovery not found")
+	pk, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, pk.Key)
+}
+
+func TestWithdrawApproval(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	now := time.Unix(1000, 0)
+	acc := setup(t, ss, func() time.Time { return now })
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    newPubKey(),
+		Guardians: []string{"alice", "bob", "carol"},
+		Config:    &v1.Config{Threshold: 2, TimeLock: 60},
+	})
+	require.NoError(t, err)
+
+	as := func(sender string) context.Context { return accountstd.SetSender(ctx, []byte(sender)) }
+	newKey, otherKey := newPubKey(), newPubKey()
+
+	_, err = acc.InitiateRecovery(as("alice"), &v1.MsgInitiateRecovery{NewPubKey: newKey})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(as("bob"), &v1.MsgApproveRecovery{NewPubKey: newKey})
+	require.NoError(t, err)
+	_, err = acc.InitiateRecovery(as("carol"), &v1.MsgInitiateRecovery{NewPubKey: otherKey})
+	require.NoError(t, err)
+
+	_, err = acc.WithdrawApproval(as("carol"), &v1.MsgWithdrawApproval{NewPubKey: newKey})
+	require.ErrorContains(t, err, "sender didn't approve the recovery")
+	_, err = acc.WithdrawApproval(as("carol"), &v1.MsgWithdrawApproval{NewPubKey: newPubKey()})
+	require.ErrorContains(t, err, "recovery not found")
+
+	// the time lock is reset once the recovery falls below the threshold
+	_, err = acc.WithdrawApproval(as("bob"), &v1.MsgWithdrawApproval{NewPubKey: newKey})
+	require.NoError(t, err)
+	now = now.Add(time.Hour)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newKey})
+	require.ErrorContains(t, err, "recovery not approved by the threshold of guardians")
+
+	// the guardian can approve another recovery once its approval is withdrawn
+	res, err := acc.ApproveRecovery(as("bob"), &v1.MsgApproveRecovery{NewPubKey: otherKey})
+	require.NoError(t, err)
+	require.Equal(t, now.Unix()+60, res.ExecutableAt)
+
+	// the recovery is cancelled once all its approvals are withdrawn
+	_, err = acc.WithdrawApproval(as("alice"), &v1.MsgWithdrawApproval{NewPubKey: newKey})
+	require.NoError(t, err)
+	recoveries, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.Recovery{{NewPubKey: otherKey, Approvals: []string{"carol", "bob"}, ExecutableAt: now.Unix() + 60}}, recoveries.Recoveries)
+}
+
+func TestUpdateGuardians(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setup(t, ss, time.Now)
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		PubKey:    newPubKey(),
+		Guardians: []string{"alice", "bob"},
+		Config:    &v1.Config{Threshold: 2, TimeLock: 60},
+	})
+	require.NoError(t, err)
+	_, err = acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("alice")), &v1.MsgInitiateRecovery{NewPubKey: newPubKey()})
+	require.NoError(t, err)
+
+	msg := &v1.MsgUpdateGuardians{Guardians: []string{"carol"}, Config: &v1.Config{Threshold: 1}}
+	_, err = acc.UpdateGuardians(accountstd.SetSender(ctx, []byte("alice")), msg)
+	require.ErrorContains(t, err, "unauthorized")
+
+	selfCtx := accountstd.SetSender(ctx, []byte(self))
+	_, err = acc.UpdateGuardians(selfCtx, msg)
+	require.NoError(t, err)
+
+	res, err := acc.QueryGuardians(ctx, &v1.QueryGuardians{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"carol"}, res.Guardians)
+	require.Equal(t, &v1.Config{Threshold: 1}, res.Config)
+
+	// the pending recoveries are cancelled
+	recoveries, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, recoveries.Recoveries)
+
+	// the base account handlers are still available
+	_, err = acc.SwapPubKey(selfCtx, &basev1.MsgSwapPubKey{NewPubKey: newPubKey()})
+	require.NoError(t, err)
+}
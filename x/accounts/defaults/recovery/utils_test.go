@@ -0,0 +1,100 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type ProtoMsg = protoiface.MessageV1
+
+var TestFunds = sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))
+
+// mock statecodec
+type mockStateCodec struct {
+	codec.Codec
+}
+
+var _ codec.Codec = mockStateCodec{}
+
+func (c mockStateCodec) Marshal(m gogoproto.Message) ([]byte, error) {
+	// Size() check can catch the typed nil value.
+	if m == nil || gogoproto.Size(m) == 0 {
+		// return empty bytes instead of nil, because nil has special meaning in places like store.Set
+		return []byte{}, nil
+	}
+
+	return gogoproto.Marshal(m)
+}
+
+func (c mockStateCodec) Unmarshal(bz []byte, ptr gogoproto.Message) error {
+	err := gogoproto.Unmarshal(bz, ptr)
+
+	return err
+}
+
+// mock address codec
+type addressCodec struct{}
+
+func (a addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (a addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
+	t.Helper()
+	return accountstd.NewMockContext(
+		0, []byte("mock_recovery_account"), []byte("sender"), TestFunds, func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
+			return nil
+		}, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
+			return nil, nil
+		}, func(ctx context.Context, req, resp ProtoMsg) error {
+			return errors.New("unexpected query")
+		},
+	)
+}
+
+func makeMockDependencies(storeservice store.KVStoreService, timefn func() time.Time) accountstd.Dependencies {
+	sb := collections.NewSchemaBuilder(storeservice)
+
+	return accountstd.Dependencies{
+		SchemaBuilder:    sb,
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: mockStateCodec{},
+		Environment: appmodule.Environment{
+			HeaderService: headerService{timefn},
+			EventService:  eventService{},
+		},
+	}
+}
+
+type headerService struct {
+	timefn func() time.Time
+}
+
+func (h headerService) HeaderInfo(context.Context) header.Info {
+	return header.Info{
+		Time: h.timefn(),
+	}
+}
+
+type eventService struct{}
+
+// EventManager implements event.Service.
+func (eventService) EventManager(context.Context) event.Manager {
+	return runtime.EventService{Events: runtime.Events{EventManagerI: sdk.NewEventManager()}}
+}
@@ -0,0 +1,303 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/session/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// The prefixes of the base account are reserved: 0 for the pubkey and 1 for the
+// sequence.
+var SessionKeysPrefix = collections.NewPrefix(2)
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+		return name, Account{
+			Account:     baseAcc.(base.Account),
+			SessionKeys: collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
+			addrCodec:   deps.AddressCodec,
+			hs:          deps.Environment.HeaderService,
+		}, nil
+	}
+}
+
+// Account implements a base account authenticated by its main key, which can
+// register session keys allowed to sign a restricted set of transactions until
+// they expire.
+type Account struct {
+	base.Account
+
+	// SessionKeys are the session keys of the account, by pubkey.
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
+
+	addrCodec address.Codec
+	hs        header.Service
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if _, err := a.Account.Init(ctx, &basev1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence}); err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitResponse{}, nil
+}
+
+// MigrateLegacyAccount carries over the pubkey, unless the init message sets a new one, and the
+// sequence of a legacy x/auth account, as base.Account does.
+func (a Account) MigrateLegacyAccount(ctx context.Context, legacyAcc sdk.AccountI, initMsg protoiface.MessageV1) (sdk.Coins, error) {
+	msg, ok := initMsg.(*v1.MsgInit)
+	if !ok {
+		return nil, fmt.Errorf("invalid init message for a session account: %T", initMsg)
+	}
+
+	baseMsg := &basev1.MsgInit{PubKey: msg.PubKey}
+	funds, err := a.Account.MigrateLegacyAccount(ctx, legacyAcc, baseMsg)
+	if err != nil {
+		return nil, err
+	}
+	msg.PubKey, msg.InitSequence = baseMsg.PubKey, baseMsg.InitSequence
+	return funds, nil
+}
+
+// SwapPubKey swaps the main pubkey of the account, which can't be one of its
+// session keys.
+func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	has, err := a.SessionKeys.Has(ctx, msg.NewPubKey)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.New("pubkey is registered as a session key")
+	}
+
+	if _, err := a.Account.SwapPubKey(ctx, &basev1.MsgSwapPubKey{NewPubKey: msg.NewPubKey}); err != nil {
+		return nil, err
+	}
+	return &v1.MsgSwapPubKeyResponse{}, nil
+}
+
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if _, err := dcrd_secp256k1.ParsePubKey(msg.PubKey); err != nil {
+		return nil, err
+	}
+	pk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(pk.Key, msg.PubKey) {
+		return nil, errors.New("the main pubkey of the account can't be a session key")
+	}
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.New("session key already registered")
+	}
+
+	if !a.hs.HeaderInfo(ctx).Time.Before(msg.ExpiresAt) {
+		return nil, errors.New("session key expiry must be in the future")
+	}
+	if err := validatePermissions(msg.Permissions); err != nil {
+		return nil, err
+	}
+	if err := msg.MaxFee.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid max fee: %w", err)
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.SessionKeys.Set(ctx, msg.PubKey, v1.SessionKey{
+		PubKey:      msg.PubKey,
+		ExpiresAt:   msg.ExpiresAt,
+		Permissions: msg.Permissions,
+		MaxUses:     msg.MaxUses,
+		MaxFee:      msg.MaxFee,
+	})
+}
+
+func (a Account) RevokeSessionKey(ctx context.Context, msg *v1.MsgRevokeSessionKey) (*v1.MsgRevokeSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("session key not found")
+	}
+
+	return &v1.MsgRevokeSessionKeyResponse{}, a.SessionKeys.Remove(ctx, msg.PubKey)
+}
+
+// Authenticate implements the authentication flow of an abstracted session account.
+// The transaction is signed by the main key of the account, or by the session key
+// set as public key of the signer info, within the limits of the session key.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+
+	pubKey, sessionKey, err := a.getSigner(ctx, signerInfo.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if sessionKey != nil {
+		if err := a.checkSessionKey(ctx, sessionKey, msg.Tx); err != nil {
+			return nil, fmt.Errorf("session key not allowed to sign the transaction: %w", err)
+		}
+	}
+
+	if err := a.VerifySignature(ctx, msg, pubKey); err != nil {
+		return nil, err
+	}
+
+	if sessionKey != nil {
+		sessionKey.Uses++
+		if err := a.SessionKeys.Set(ctx, sessionKey.PubKey, *sessionKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// getSigner returns the pubkey which signed the transaction given the pubkey of
+// the signer info, which defaults to the main pubkey of the account, and the
+// session key if it's not the main pubkey.
+func (a Account) getSigner(ctx context.Context, signerPubKey *codectypes.Any) (*secp256k1.PubKey, *v1.SessionKey, error) {
+	pk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if signerPubKey == nil {
+		return &pk, nil, nil
+	}
+
+	if signerPubKey.TypeUrl != codectypes.MsgTypeURL(&pk) {
+		return nil, nil, fmt.Errorf("unsupported signer pubkey type: %s", signerPubKey.TypeUrl)
+	}
+	signerPk := new(secp256k1.PubKey)
+	if err := signerPk.Unmarshal(signerPubKey.Value); err != nil {
+		return nil, nil, err
+	}
+	if bytes.Equal(signerPk.Key, pk.Key) {
+		return &pk, nil, nil
+	}
+
+	sessionKey, err := a.SessionKeys.Get(ctx, signerPk.Key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil, errors.New("signer pubkey is neither the account pubkey nor a session key")
+		}
+		return nil, nil, err
+	}
+	return signerPk, &sessionKey, nil
+}
+
+// checkSessionKey checks that the session key is allowed to sign the given
+// transaction, its messages and its fee.
+func (a Account) checkSessionKey(ctx context.Context, sessionKey *v1.SessionKey, transaction *tx.Tx) error {
+	if !a.hs.HeaderInfo(ctx).Time.Before(sessionKey.ExpiresAt) {
+		return errors.New("session key expired")
+	}
+	if sessionKey.MaxUses != 0 && sessionKey.Uses >= sessionKey.MaxUses {
+		return errors.New("session key has no uses left")
+	}
+	body := transaction.Body
+	if body == nil || len(body.Messages) == 0 {
+		return errors.New("no messages")
+	}
+
+	// the fee isn't bounded by the spend limits of the messages
+	if fee := transaction.AuthInfo.Fee; fee != nil && !fee.Amount.IsAllLTE(sessionKey.MaxFee) {
+		return fmt.Errorf("fee %s exceeds the max fee of %s", fee.Amount, sessionKey.MaxFee)
+	}
+
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range body.Messages {
+		permission, ok := findPermission(sessionKey.Permissions, msg.TypeUrl)
+		if !ok {
+			return fmt.Errorf("message not allowed: %s", msg.TypeUrl)
+		}
+		if err := checkMsg(self, permission, msg); err != nil {
+			return fmt.Errorf("%s: %w", msg.TypeUrl, err)
+		}
+	}
+
+	return nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	res, err := a.Account.QuerySequence(ctx, &basev1.QuerySequence{})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: res.Sequence}, nil
+}
+
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	var sessionKeys []v1.SessionKey
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, sessionKey v1.SessionKey) (stop bool, err error) {
+		sessionKeys = append(sessionKeys, sessionKey)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RevokeSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
+}
@@ -0,0 +1,357 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/session/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var (
+	now           = time.Unix(1000, 0)
+	sendTypeURL   = codectypes.MsgTypeURL(&banktypes.MsgSend{})
+	voteTypeURL   = "/cosmos.gov.v1.MsgVote"
+	atoms         = func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(amount))) }
+	errNotAllowed = "session key not allowed to sign the transaction"
+)
+
+func mustAny(t *testing.T, msg gogoproto.Message) *codectypes.Any {
+	t.Helper()
+	a, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	return a
+}
+
+// authenticate runs the authentication of a tx with the given messages at the
+// given sequence, signed by the key. The key is set in the signer info if
+// setPubKey is true.
+func authenticate(t *testing.T, ctx context.Context, acc Account, key *secp256k1.PrivKey, setPubKey bool, sequence uint64, msgs ...*codectypes.Any) error {
+	t.Helper()
+	return authenticateWithFee(t, ctx, acc, key, setPubKey, sequence, nil, msgs...)
+}
+
+// authenticateWithFee runs the authentication of a tx with the given fee, see
+// authenticate.
+func authenticateWithFee(t *testing.T, ctx context.Context, acc Account, key *secp256k1.PrivKey, setPubKey bool, sequence uint64, fee sdk.Coins, msgs ...*codectypes.Any) error {
+	t.Helper()
+	body := &tx.TxBody{Messages: msgs}
+	bodyBytes, err := body.Marshal()
+	require.NoError(t, err)
+
+	signerInfo := &tx.SignerInfo{
+		ModeInfo: &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signingtypes.SignMode_SIGN_MODE_DIRECT}}},
+		Sequence: sequence,
+	}
+	if setPubKey {
+		signerInfo.PublicKey = mustAny(t, key.PubKey().(*secp256k1.PubKey))
+	}
+	authInfo := &tx.AuthInfo{SignerInfos: []*tx.SignerInfo{signerInfo}, Fee: &tx.Fee{Amount: fee}}
+	authInfoBytes, err := authInfo.Marshal()
+	require.NoError(t, err)
+
+	pkAny := mustAny(t, key.PubKey().(*secp256k1.PubKey))
+	signBytes, err := signHandler{}.GetSignBytes(ctx, signing.SignerData{
+		Address:       testAccount,
+		ChainID:       testChainID,
+		AccountNumber: testAccNum,
+		Sequence:      sequence,
+		PubKey:        &anypb.Any{TypeUrl: pkAny.TypeUrl, Value: pkAny.Value},
+	}, signing.TxData{BodyBytes: bodyBytes})
+	require.NoError(t, err)
+	sig, err := key.Sign(signBytes)
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	_, err = acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{
+		RawTx:       &tx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: [][]byte{sig}},
+		Tx:          &tx.Tx{Body: body, AuthInfo: authInfo, Signatures: [][]byte{sig}},
+		SignerIndex: 0,
+	})
+	return err
+}
+
+func setupAccount(t *testing.T, timefn func() time.Time) (context.Context, Account, *secp256k1.PrivKey) {
+	t.Helper()
+	ctx, ss := newMockContext(t)
+	acc := setup(t, ss, timefn)
+	mainKey := secp256k1.GenPrivKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: mainKey.PubKey().Bytes()})
+	require.NoError(t, err)
+	return ctx, acc, mainKey
+}
+
+func sequence(t *testing.T, ctx context.Context, acc Account) uint64 {
+	t.Helper()
+	res, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+	require.NoError(t, err)
+	return res.Sequence
+}
+
+func TestAddSessionKey(t *testing.T) {
+	ctx, acc, mainKey := setupAccount(t, func() time.Time { return now })
+	sessionKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	expiresAt := now.Add(time.Hour)
+
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgAddSessionKey
+		expErr string
+	}{
+		{
+			"invalid pubkey",
+			&v1.MsgAddSessionKey{PubKey: []byte{1}, ExpiresAt: expiresAt, Permissions: []v1.Permission{{MsgTypeUrl: voteTypeURL}}},
+			"malformed public key",
+		},
+		{
+			"main pubkey",
+			&v1.MsgAddSessionKey{PubKey: mainKey.PubKey().Bytes(), ExpiresAt: expiresAt, Permissions: []v1.Permission{{MsgTypeUrl: voteTypeURL}}},
+			"the main pubkey of the account can't be a session key",
+		},
+		{
+			"expired",
+			&v1.MsgAddSessionKey{PubKey: sessionKey, ExpiresAt: now, Permissions: []v1.Permission{{MsgTypeUrl: voteTypeURL}}},
+			"session key expiry must be in the future",
+		},
+		{
+			"no permissions",
+			&v1.MsgAddSessionKey{PubKey: sessionKey, ExpiresAt: expiresAt},
+			"at least one permission is required",
+		},
+		{
+			"duplicate permission",
+			&v1.MsgAddSessionKey{PubKey: sessionKey, ExpiresAt: expiresAt, Permissions: []v1.Permission{{MsgTypeUrl: voteTypeURL}, {MsgTypeUrl: voteTypeURL}}},
+			"duplicate permission",
+		},
+		{
+			"unsupported spend limit",
+			&v1.MsgAddSessionKey{PubKey: sessionKey, ExpiresAt: expiresAt, Permissions: []v1.Permission{{MsgTypeUrl: voteTypeURL, SpendLimit: atoms(1)}}},
+			"spend limit not supported",
+		},
+		{
+			"invalid max fee",
+			&v1.MsgAddSessionKey{PubKey: sessionKey, ExpiresAt: expiresAt, Permissions: []v1.Permission{{MsgTypeUrl: voteTypeURL}}, MaxFee: sdk.Coins{{Denom: "atom", Amount: math.NewInt(-1)}}},
+			"invalid max fee",
+		},
+		{
+			"success",
+			&v1.MsgAddSessionKey{PubKey: sessionKey, ExpiresAt: expiresAt, Permissions: []v1.Permission{{MsgTypeUrl: sendTypeURL, SpendLimit: atoms(1)}}, MaxFee: atoms(1)},
+			"",
+		},
+		{
+			"already registered",
+			&v1.MsgAddSessionKey{PubKey: sessionKey, ExpiresAt: expiresAt, Permissions: []v1.Permission{{MsgTypeUrl: voteTypeURL}}},
+			"session key already registered",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.AddSessionKey(ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// only the account itself can manage its session keys
+	other := accountstd.SetSender(ctx, []byte("other"))
+	_, err := acc.AddSessionKey(other, &v1.MsgAddSessionKey{})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.RevokeSessionKey(other, &v1.MsgRevokeSessionKey{PubKey: sessionKey})
+	require.ErrorContains(t, err, "unauthorized")
+
+	// a session key can't become the main pubkey
+	_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: sessionKey})
+	require.ErrorContains(t, err, "pubkey is registered as a session key")
+
+	res, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, res.SessionKeys, 1)
+	require.Equal(t, sessionKey, res.SessionKeys[0].PubKey)
+	require.Equal(t, atoms(1), res.SessionKeys[0].MaxFee)
+
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionKey})
+	require.NoError(t, err)
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionKey})
+	require.ErrorContains(t, err, "session key not found")
+}
+
+func TestAuthenticate(t *testing.T) {
+	blockTime := now
+	ctx, acc, mainKey := setupAccount(t, func() time.Time { return blockTime })
+	sessionKey := secp256k1.GenPrivKey()
+	_, err := acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{
+		PubKey:    sessionKey.PubKey().Bytes(),
+		ExpiresAt: now.Add(time.Hour),
+		Permissions: []v1.Permission{
+			{MsgTypeUrl: sendTypeURL, SpendLimit: atoms(10)},
+			{MsgTypeUrl: codectypes.MsgTypeURL(&accountsv1.MsgExecute{})},
+		},
+		MaxUses: 3,
+	})
+	require.NoError(t, err)
+
+	send := func(amount sdk.Coins) *codectypes.Any {
+		return mustAny(t, &banktypes.MsgSend{FromAddress: testAccount, ToAddress: "bob", Amount: amount})
+	}
+	vote := &codectypes.Any{TypeUrl: voteTypeURL}
+
+	// the main key signs anything, with or without its pubkey in the signer info
+	require.NoError(t, authenticate(t, ctx, acc, mainKey, false, 0, vote))
+	require.NoError(t, authenticate(t, ctx, acc, mainKey, true, 1, send(atoms(100))))
+
+	// the session key signs the allowed messages within the spend limit
+	require.NoError(t, authenticate(t, ctx, acc, sessionKey, true, 2, send(atoms(10)), send(atoms(5))))
+
+	testcases := []struct {
+		name   string
+		msgs   []*codectypes.Any
+		expErr string
+	}{
+		{"message not allowed", []*codectypes.Any{send(atoms(1)), vote}, "message not allowed: " + voteTypeURL},
+		{"spend limit exceeded", []*codectypes.Any{send(atoms(11))}, "exceeds the spend limit"},
+		{"denom not in spend limit", []*codectypes.Any{send(sdk.NewCoins(sdk.NewCoin("btc", math.NewInt(1))))}, "exceeds the spend limit"},
+		{"no messages", nil, "no messages"},
+		{
+			"execute on the account itself",
+			[]*codectypes.Any{mustAny(t, &accountsv1.MsgExecute{Sender: testAccount, Target: testAccount})},
+			"session keys can't execute messages on the account itself",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := authenticate(t, ctx, acc, sessionKey, true, sequence(t, ctx, acc), tc.msgs...)
+			require.ErrorContains(t, err, errNotAllowed)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+
+	// without its pubkey in the signer info, the session key is checked against
+	// the main pubkey
+	err = authenticate(t, ctx, acc, sessionKey, false, 3, send(atoms(1)))
+	require.ErrorContains(t, err, "signature verification failed")
+
+	// unknown keys are rejected
+	err = authenticate(t, ctx, acc, secp256k1.GenPrivKey(), true, 4, send(atoms(1)))
+	require.ErrorContains(t, err, "signer pubkey is neither the account pubkey nor a session key")
+
+	// the session key can be used up to its max uses
+	require.NoError(t, authenticate(t, ctx, acc, sessionKey, true, 4, mustAny(t, &accountsv1.MsgExecute{Sender: testAccount, Target: "other"})))
+	require.NoError(t, authenticate(t, ctx, acc, sessionKey, true, 5, send(atoms(1))))
+	err = authenticate(t, ctx, acc, sessionKey, true, 6, send(atoms(1)))
+	require.ErrorContains(t, err, "session key has no uses left")
+
+	res, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.SessionKeys[0].Uses)
+}
+
+func TestAuthenticateExpired(t *testing.T) {
+	blockTime := now
+	ctx, acc, _ := setupAccount(t, func() time.Time { return blockTime })
+	sessionKey := secp256k1.GenPrivKey()
+	_, err := acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{
+		PubKey:      sessionKey.PubKey().Bytes(),
+		ExpiresAt:   now.Add(time.Hour),
+		Permissions: []v1.Permission{{MsgTypeUrl: voteTypeURL}},
+	})
+	require.NoError(t, err)
+	vote := &codectypes.Any{TypeUrl: voteTypeURL}
+
+	require.NoError(t, authenticate(t, ctx, acc, sessionKey, true, 0, vote))
+
+	blockTime = now.Add(time.Hour)
+	err = authenticate(t, ctx, acc, sessionKey, true, 1, vote)
+	require.ErrorContains(t, err, "session key expired")
+
+	// revoked session keys are rejected
+	blockTime = now
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionKey.PubKey().Bytes()})
+	require.NoError(t, err)
+	err = authenticate(t, ctx, acc, sessionKey, true, 1, vote)
+	require.ErrorContains(t, err, "signer pubkey is neither the account pubkey nor a session key")
+}
+
+func TestAuthenticateFee(t *testing.T) {
+	ctx, acc, mainKey := setupAccount(t, func() time.Time { return now })
+	vote := &codectypes.Any{TypeUrl: voteTypeURL}
+
+	limitedKey, noFeeKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	for key, maxFee := range map[*secp256k1.PrivKey]sdk.Coins{limitedKey: atoms(5), noFeeKey: nil} {
+		_, err := acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{
+			PubKey:      key.PubKey().Bytes(),
+			ExpiresAt:   now.Add(time.Hour),
+			Permissions: []v1.Permission{{MsgTypeUrl: voteTypeURL}},
+			MaxFee:      maxFee,
+		})
+		require.NoError(t, err)
+	}
+
+	// the main key pays any fee
+	require.NoError(t, authenticateWithFee(t, ctx, acc, mainKey, true, 0, atoms(1000), vote))
+
+	// a session key pays at most its max fee, even if its messages don't spend
+	// any coins
+	require.NoError(t, authenticateWithFee(t, ctx, acc, limitedKey, true, 1, atoms(5), vote))
+	err := authenticateWithFee(t, ctx, acc, limitedKey, true, 2, atoms(6), vote)
+	require.ErrorContains(t, err, errNotAllowed)
+	require.ErrorContains(t, err, "exceeds the max fee")
+	err = authenticateWithFee(t, ctx, acc, limitedKey, true, 2, sdk.NewCoins(sdk.NewCoin("btc", math.NewInt(1))), vote)
+	require.ErrorContains(t, err, "exceeds the max fee")
+
+	// a session key without max fee can't pay any fee
+	require.NoError(t, authenticateWithFee(t, ctx, acc, noFeeKey, true, 2, nil, vote))
+	err = authenticateWithFee(t, ctx, acc, noFeeKey, true, 3, atoms(1), vote)
+	require.ErrorContains(t, err, "exceeds the max fee")
+}
+
+// legacyAccount is a legacy x/auth account with a pubkey and a sequence.
+type legacyAccount struct {
+	sdk.AccountI
+	pubKey   *secp256k1.PubKey
+	sequence uint64
+}
+
+func (a legacyAccount) GetPubKey() cryptotypes.PubKey { return a.pubKey }
+func (a legacyAccount) GetSequence() uint64           { return a.sequence }
+
+func TestMigrateLegacyAccount(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setup(t, ss, func() time.Time { return now })
+	legacyKey := secp256k1.GenPrivKey().PubKey().(*secp256k1.PubKey)
+
+	_, err := acc.MigrateLegacyAccount(ctx, legacyAccount{pubKey: legacyKey}, &v1.MsgAddSessionKey{})
+	require.ErrorContains(t, err, "invalid init message for a session account")
+
+	// the pubkey and the sequence of the legacy account are carried over
+	msg := &v1.MsgInit{}
+	funds, err := acc.MigrateLegacyAccount(ctx, legacyAccount{pubKey: legacyKey, sequence: 5}, msg)
+	require.NoError(t, err)
+	require.Nil(t, funds)
+	require.Equal(t, &v1.MsgInit{PubKey: legacyKey.Bytes(), InitSequence: 5}, msg)
+
+	_, err = acc.Init(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), sequence(t, ctx, acc))
+}
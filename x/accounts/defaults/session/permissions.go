@@ -0,0 +1,115 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	v1 "cosmossdk.io/x/accounts/defaults/session/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var msgExecuteTypeURL = codectypes.MsgTypeURL(&accountsv1.MsgExecute{})
+
+// spendFuncs return the coins spent by a message, by message type URL. Spend
+// limits can only be set on these messages.
+var spendFuncs = map[string]func(bz []byte) (sdk.Coins, error){
+	codectypes.MsgTypeURL(&banktypes.MsgSend{}): func(bz []byte) (sdk.Coins, error) {
+		msg := new(banktypes.MsgSend)
+		if err := gogoproto.Unmarshal(bz, msg); err != nil {
+			return nil, err
+		}
+		return msg.Amount, nil
+	},
+	codectypes.MsgTypeURL(&banktypes.MsgMultiSend{}): func(bz []byte) (sdk.Coins, error) {
+		msg := new(banktypes.MsgMultiSend)
+		if err := gogoproto.Unmarshal(bz, msg); err != nil {
+			return nil, err
+		}
+		spent := sdk.NewCoins()
+		for _, input := range msg.Inputs {
+			spent = spent.Add(input.Coins...)
+		}
+		return spent, nil
+	},
+	msgExecuteTypeURL: func(bz []byte) (sdk.Coins, error) {
+		msg := new(accountsv1.MsgExecute)
+		if err := gogoproto.Unmarshal(bz, msg); err != nil {
+			return nil, err
+		}
+		return msg.Funds, nil
+	},
+}
+
+// validatePermissions validates the permissions of a new session key.
+func validatePermissions(permissions []v1.Permission) error {
+	if len(permissions) == 0 {
+		return errors.New("at least one permission is required")
+	}
+
+	seen := make(map[string]bool, len(permissions))
+	for _, p := range permissions {
+		if p.MsgTypeUrl == "" {
+			return errors.New("permission message type URL must be set")
+		}
+		if seen[p.MsgTypeUrl] {
+			return fmt.Errorf("duplicate permission for %s", p.MsgTypeUrl)
+		}
+		seen[p.MsgTypeUrl] = true
+
+		if p.SpendLimit.Empty() {
+			continue
+		}
+		if _, ok := spendFuncs[p.MsgTypeUrl]; !ok {
+			return fmt.Errorf("spend limit not supported for %s", p.MsgTypeUrl)
+		}
+		if err := p.SpendLimit.Validate(); err != nil {
+			return fmt.Errorf("invalid spend limit for %s: %w", p.MsgTypeUrl, err)
+		}
+	}
+
+	return nil
+}
+
+func findPermission(permissions []v1.Permission, msgTypeURL string) (v1.Permission, bool) {
+	for _, p := range permissions {
+		if p.MsgTypeUrl == msgTypeURL {
+			return p, true
+		}
+	}
+	return v1.Permission{}, false
+}
+
+// checkMsg checks that the message of the transaction is within the limits of
+// the permission, given the address of the account.
+func checkMsg(self string, permission v1.Permission, msg *codectypes.Any) error {
+	// session keys must not be able to manage the account, e.g. to register
+	// other session keys or to swap the main pubkey
+	if msg.TypeUrl == msgExecuteTypeURL {
+		execute := new(accountsv1.MsgExecute)
+		if err := gogoproto.Unmarshal(msg.Value, execute); err != nil {
+			return err
+		}
+		if execute.Target == self {
+			return errors.New("session keys can't execute messages on the account itself")
+		}
+	}
+
+	if permission.SpendLimit.Empty() {
+		return nil
+	}
+	spent, err := spendFuncs[msg.TypeUrl](msg.Value)
+	if err != nil {
+		return err
+	}
+	if !spent.IsAllLTE(permission.SpendLimit) {
+		return fmt.Errorf("spent %s exceeds the spend limit of %s", spent, permission.SpendLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+type ProtoMsg = protoiface.MessageV1
+
+const (
+	testAccNum   = 7
+	testChainID  = "test-chain"
+	testAccount  = "mock_session_account"
+	testSignMode = signingv1beta1.SignMode_SIGN_MODE_DIRECT
+)
+
+// mock statecodec
+type mockStateCodec struct {
+	codec.Codec
+}
+
+func (c mockStateCodec) Marshal(m gogoproto.Message) ([]byte, error) {
+	// Size() check can catch the typed nil value.
+	if m == nil || gogoproto.Size(m) == 0 {
+		// return empty bytes instead of nil, because nil has special meaning in places like store.Set
+		return []byte{}, nil
+	}
+
+	return gogoproto.Marshal(m)
+}
+
+func (c mockStateCodec) Unmarshal(bz []byte, ptr gogoproto.Message) error {
+	return gogoproto.Unmarshal(bz, ptr)
+}
+
+// mock address codec
+type addressCodec struct{}
+
+func (a addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (a addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct {
+	timefn func() time.Time
+}
+
+func (h headerService) HeaderInfo(context.Context) header.Info {
+	return header.Info{ChainID: testChainID, Time: h.timefn()}
+}
+
+// signHandler returns the body bytes followed by the signer data as sign bytes.
+type signHandler struct{}
+
+func (signHandler) Mode() signingv1beta1.SignMode { return testSignMode }
+
+func (signHandler) GetSignBytes(_ context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	signerDataBz, err := json.Marshal(signerData)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, txData.BodyBytes...), signerDataBz...), nil
+}
+
+func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
+	t.Helper()
+	return accountstd.NewMockContext(
+		testAccNum, []byte(testAccount), []byte(testAccount), nil, func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
+			return nil
+		}, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
+			return nil, nil
+		}, func(ctx context.Context, req, resp ProtoMsg) error {
+			_, ok := req.(*accountsv1.AccountNumberRequest)
+			require.True(t, ok)
+			gogoproto.Merge(resp.(gogoproto.Message), &accountsv1.AccountNumberResponse{Number: testAccNum})
+			return nil
+		},
+	)
+}
+
+func setup(t *testing.T, ss store.KVStoreService, timefn func() time.Time) Account {
+	t.Helper()
+	deps := accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: mockStateCodec{},
+		Environment: appmodule.Environment{
+			HeaderService: headerService{timefn},
+		},
+	}
+
+	_, acc, err := NewAccount("session", signing.NewHandlerMap(signHandler{}))(deps)
+	require.NoError(t, err)
+	return acc.(Account)
+}
@@ -13,6 +13,9 @@ import (
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	"cosmossdk.io/x/accounts/defaults/lockup"
 	"cosmossdk.io/x/accounts/defaults/multisig"
+	"cosmossdk.io/x/accounts/defaults/passkey"
+	"cosmossdk.io/x/accounts/defaults/recovery"
+	"cosmossdk.io/x/accounts/defaults/session"
 	"cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -65,8 +68,11 @@ func (s directHandler) GetSignBytes(_ context.Context, _ signing.SignerData, _ s
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	handler := directHandler{}
 	account := baseaccount.NewAccount("base", signing.NewHandlerMap(handler))
+	passkeyAccount := passkey.NewAccount("passkey", signing.NewHandlerMap(handler))
+	sessionAccount := session.NewAccount("session", signing.NewHandlerMap(handler))
+	recoveryAccount := recovery.NewAccount("recovery", signing.NewHandlerMap(handler))
 	accountskeeper, err := NewKeeper(
-		in.Cdc, in.Environment, in.AddressCodec, in.Registry, account,
+		in.Cdc, in.Environment, in.AddressCodec, in.Registry, account, passkeyAccount, sessionAccount, recoveryAccount,
 		accountstd.AddAccount(lockup.CONTINUOUS_LOCKING_ACCOUNT, lockup.NewContinuousLockingAccount),
 		accountstd.AddAccount(lockup.PERIODIC_LOCKING_ACCOUNT, lockup.NewPeriodicLockingAccount),
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
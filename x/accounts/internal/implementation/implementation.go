@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Dependencies are passed to the constructor of a smart account.
@@ -92,7 +93,7 @@ func newImplementation(schemaBuilder *collections.SchemaBuilder, account Account
 	if err != nil {
 		return Implementation{}, err
 	}
-	return Implementation{
+	impl := Implementation{
 		Init:                  initHandler,
 		Execute:               executeHandler,
 		Query:                 queryHandler,
@@ -100,7 +101,11 @@ func newImplementation(schemaBuilder *collections.SchemaBuilder, account Account
 		InitHandlerSchema:     ir.schema,
 		QueryHandlersSchema:   qr.er.handlersSchema,
 		ExecuteHandlersSchema: er.handlersSchema,
-	}, nil
+	}
+	if migrator, ok := account.(LegacyAccountMigrator); ok {
+		impl.MigrateLegacyAccount = migrator.MigrateLegacyAccount
+	}
+	return impl, nil
 }
 
 // Implementation wraps an Account implementer in order to provide a concrete
@@ -112,6 +117,9 @@ type Implementation struct {
 	Execute func(ctx context.Context, msg ProtoMsg) (resp ProtoMsg, err error)
 	// Query defines the query handler for the smart account.
 	Query func(ctx context.Context, msg ProtoMsg) (resp ProtoMsg, err error)
+	// MigrateLegacyAccount defines how a legacy x/auth account is migrated to the smart account,
+	// it is nil if the smart account doesn't implement LegacyAccountMigrator.
+	MigrateLegacyAccount func(ctx context.Context, legacyAcc sdk.AccountI, initMsg ProtoMsg) (sdk.Coins, error)
 	// CollectionsSchema represents the state schema.
 	CollectionsSchema collections.Schema
 	// InitHandlerSchema represents the init handler schema.
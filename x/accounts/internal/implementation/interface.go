@@ -1,5 +1,11 @@
 package implementation
 
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Account defines a smart account interface.
 type Account interface {
 	// RegisterInitHandler allows the smart account to register an initialisation handler, using
@@ -15,3 +21,12 @@ type Account interface {
 	// might also decide to not register any query handler.
 	RegisterQueryHandlers(builder *QueryBuilder)
 }
+
+// LegacyAccountMigrator is optionally implemented by the smart accounts which legacy x/auth
+// accounts can be migrated to.
+type LegacyAccountMigrator interface {
+	// MigrateLegacyAccount carries over the state of the legacy account to the init message of
+	// the smart account, and returns the coins held by the legacy account the smart account must
+	// be initialized with. It errors if the legacy account can't be migrated to the smart account.
+	MigrateLegacyAccount(ctx context.Context, legacyAcc sdk.AccountI, initMsg ProtoMsg) (sdk.Coins, error)
+}
@@ -143,8 +143,7 @@ func (k Keeper) init(
 	initRequest implementation.ProtoMsg,
 	funds sdk.Coins,
 ) (implementation.ProtoMsg, error) {
-	impl, ok := k.accounts[accountType]
-	if !ok {
+	if _, ok := k.accounts[accountType]; !ok {
 		return nil, fmt.Errorf("%w: not found %s", errAccountTypeNotFound, accountType)
 	}
 
@@ -153,6 +152,25 @@ func (k Keeper) init(
 	if err != nil {
 		return nil, fmt.Errorf("unable to transfer funds: %w", err)
 	}
+	return k.initAccount(ctx, accountType, creator, accountNum, accountAddr, initRequest, funds)
+}
+
+// initAccount inits the account implementation and maps the account address to its type and number.
+// The funds are only exposed to the account, they must have been sent to it beforehand.
+func (k Keeper) initAccount(
+	ctx context.Context,
+	accountType string,
+	creator []byte,
+	accountNum uint64,
+	accountAddr []byte,
+	initRequest implementation.ProtoMsg,
+	funds sdk.Coins,
+) (implementation.ProtoMsg, error) {
+	impl, ok := k.accounts[accountType]
+	if !ok {
+		return nil, fmt.Errorf("%w: not found %s", errAccountTypeNotFound, accountType)
+	}
+
 	// make the context and init the account
 	ctx = k.makeAccountContext(ctx, accountNum, accountAddr, creator, funds, false)
 	resp, err := impl.Init(ctx, initRequest)
@@ -175,6 +193,9 @@ func (k Keeper) init(
 // Concretely speaking this works like Init, but with a custom account number provided,
 // Where the creator is the account itself. This can be used by the x/auth module to
 // gradually migrate base accounts to x/accounts.
+// The account type must implement accountstd.LegacyAccountMigrator, which carries over the
+// state of the legacy account to the init message, and returns the coins already held by the
+// account which it must be initialized with, e.g. the original locking of a vesting account.
 // NOTE: this assumes the calling module checks for account overrides.
 func (k Keeper) MigrateLegacyAccount(
 	ctx context.Context,
@@ -182,8 +203,22 @@ func (k Keeper) MigrateLegacyAccount(
 	accNum uint64, // The current account number
 	accType string, // The account type to migrate to
 	msg implementation.ProtoMsg, // The init msg of the account type we're migrating to
+	legacyAcc sdk.AccountI, // The legacy account being migrated
 ) (implementation.ProtoMsg, error) {
-	return k.init(ctx, accType, addr, accNum, addr, msg, nil)
+	impl, ok := k.accounts[accType]
+	if !ok {
+		return nil, fmt.Errorf("%w: not found %s", errAccountTypeNotFound, accType)
+	}
+	if impl.MigrateLegacyAccount == nil {
+		return nil, fmt.Errorf("legacy accounts can't be migrated to account type %s", accType)
+	}
+
+	funds, err := impl.MigrateLegacyAccount(ctx, legacyAcc, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.initAccount(ctx, accType, addr, accNum, addr, msg, funds)
 }
 
 // Execute executes a state transition on the given account.
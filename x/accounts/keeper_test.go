@@ -39,6 +39,40 @@ func TestKeeper_Init(t *testing.T) {
 	})
 }
 
+func TestKeeper_MigrateLegacyAccount(t *testing.T) {
+	m, ctx := newKeeper(t,
+		accountstd.AddAccount("test", NewTestAccount),
+		accountstd.AddAccount("legacy", NewLegacyTestAccount),
+	)
+
+	t.Run("ok", func(t *testing.T) {
+		addr := []byte("legacy")
+
+		resp, err := m.MigrateLegacyAccount(ctx, addr, 5, "legacy", &types.Empty{}, nil)
+		require.NoError(t, err)
+		require.Equal(t, &types.Empty{}, resp)
+
+		// the legacy account keeps its address and account number
+		accType, err := m.AccountsByType.Get(ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, "legacy", accType)
+		num, err := m.AccountByNumber.Get(ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), num)
+		require.True(t, m.IsAccountsModuleAccount(ctx, addr))
+	})
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := m.MigrateLegacyAccount(ctx, []byte("legacy2"), 6, "unknown", &types.Empty{}, nil)
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+
+	t.Run("account type without legacy migration", func(t *testing.T) {
+		_, err := m.MigrateLegacyAccount(ctx, []byte("legacy3"), 7, "test", &types.Empty{}, nil)
+		require.ErrorContains(t, err, "legacy accounts can't be migrated")
+	})
+}
+
 func TestKeeper_Execute(t *testing.T) {
 	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
 
@@ -31,3 +31,15 @@ type FeegrantKeeper interface {
 type ConsensusKeeper interface {
 	Params(context.Context, *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error)
 }
+
+// FeeMarketKeeper defines the contract needed to read the fee market parameters and state.
+type FeeMarketKeeper interface {
+	GetFeeMarketParams(ctx context.Context) (types.FeeMarketParams, error)
+	GetFeeMarketState(ctx context.Context) (types.FeeMarketState, error)
+}
+
+// FeeMarketBankKeeper defines the contract needed to burn or route the base portion of the fees.
+type FeeMarketBankKeeper interface {
+	BurnCoins(ctx context.Context, address []byte, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
@@ -0,0 +1,135 @@
+package ante
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeMarketDecorator implements an EIP-1559 like fee market: every tx must pay at least
+// the base fee per unit of gas in the fee market denomination, and the base fee of a
+// block is adjusted from the gas used by the previous one, see types.FeeMarketParams.
+// The base portion of the fees is burned or sent to the configured module account,
+// and the tx priority is set to the tip per unit of gas paid above the base fee.
+// The fee market parameters are governed by x/auth, the decorator is a no-op while
+// the fee market is disabled.
+// CONTRACT: Tx must implement FeeTx interface to use FeeMarketDecorator
+// CONTRACT: FeeMarketDecorator must be placed after DeductFeeDecorator, which moves the fees to the fee collector.
+type FeeMarketDecorator struct {
+	accountKeeper   AccountKeeper
+	bankKeeper      FeeMarketBankKeeper
+	feeMarketKeeper FeeMarketKeeper
+}
+
+func NewFeeMarketDecorator(ak AccountKeeper, bk FeeMarketBankKeeper, fmk FeeMarketKeeper) FeeMarketDecorator {
+	return FeeMarketDecorator{
+		accountKeeper:   ak,
+		bankKeeper:      bk,
+		feeMarketKeeper: fmk,
+	}
+}
+
+func (fmd FeeMarketDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params, err := fmd.feeMarketKeeper.GetFeeMarketParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	if !params.Enabled {
+		return next(ctx, tx, simulate)
+	}
+
+	state, err := fmd.feeMarketKeeper.GetFeeMarketState(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	baseFee := state.BaseFeeAt(params, fmd.blockHeight(ctx))
+
+	gas := feeTx.GetGas()
+	paid := feeTx.GetFee().AmountOf(params.Denom)
+	baseFees := baseFee.MulInt(sdkmath.NewIntFromUint64(gas)).Ceil().TruncateInt()
+
+	execMode := fmd.accountKeeper.GetEnvironment().TransactionService.ExecMode(ctx)
+	if execMode != transaction.ExecModeSimulate && paid.LT(baseFees) {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s%s required: %s%s (base fee: %s)",
+			paid, params.Denom, baseFees, params.Denom, baseFee,
+		)
+	}
+
+	// zero fees are accepted in simulation mode, only the fees paid can be collected.
+	if collected := sdkmath.MinInt(paid, baseFees); collected.IsPositive() {
+		if err := fmd.collectBaseFees(ctx, params, sdk.NewCoins(sdk.NewCoin(params.Denom, collected))); err != nil {
+			return ctx, err
+		}
+	}
+
+	var priority int64
+	if gas > 0 && paid.GT(baseFees) {
+		priority = math.MaxInt64
+		tip := paid.Sub(baseFees).Quo(sdkmath.NewIntFromUint64(gas))
+		if tip.IsInt64() {
+			priority = tip.Int64()
+		}
+	}
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// BaseFee returns the base fee per unit of gas to be paid by txs executed with ctx,
+// it is zero while the fee market is disabled. It can be used by the mempool to
+// reject or evict the txs paying less than the base fee.
+func (fmd FeeMarketDecorator) BaseFee(ctx context.Context) (sdkmath.LegacyDec, error) {
+	params, err := fmd.feeMarketKeeper.GetFeeMarketParams(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if !params.Enabled {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+
+	state, err := fmd.feeMarketKeeper.GetFeeMarketState(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	return state.BaseFeeAt(params, fmd.blockHeight(ctx)), nil
+}
+
+// blockHeight returns the height of the block the txs executed with ctx are included in.
+func (fmd FeeMarketDecorator) blockHeight(ctx context.Context) uint64 {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	switch fmd.accountKeeper.GetEnvironment().TransactionService.ExecMode(ctx) {
+	case transaction.ExecModeCheck, transaction.ExecModeReCheck, transaction.ExecModeSimulate:
+		// the context holds the header of the last committed block, txs are included in the next one.
+		height++
+	}
+
+	return height
+}
+
+// collectBaseFees burns the base portion of the fees, or sends it to the base fee recipient.
+func (fmd FeeMarketDecorator) collectBaseFees(ctx sdk.Context, params types.FeeMarketParams, baseFees sdk.Coins) error {
+	if params.BaseFeeRecipient != "" {
+		return fmd.bankKeeper.SendCoinsFromModuleToModule(ctx, types.FeeCollectorName, params.BaseFeeRecipient, baseFees)
+	}
+
+	feeCollector := fmd.accountKeeper.GetModuleAddress(types.FeeCollectorName)
+	if feeCollector == nil {
+		return fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
+	}
+
+	return fmd.bankKeeper.BurnCoins(ctx, feeCollector, baseFees)
+}
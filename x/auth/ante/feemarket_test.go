@@ -0,0 +1,121 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+	antetestutil "cosmossdk.io/x/auth/ante/testutil"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestFeeMarketDecorator(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	fmBankKeeper := antetestutil.NewMockFeeMarketBankKeeper(gomock.NewController(t))
+
+	params := authtypes.FeeMarketParams{
+		Enabled:                  true,
+		Denom:                    "atom",
+		MinBaseFee:               math.LegacyOneDec(),
+		TargetBlockGas:           100,
+		BaseFeeChangeDenominator: 8,
+	}
+	require.NoError(t, s.accountKeeper.FeeMarketParams.Set(s.ctx, params))
+	fmd := ante.NewFeeMarketDecorator(s.accountKeeper, fmBankKeeper, s.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil),
+		fmd,
+	)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), authtypes.FeeCollectorName, gomock.Any()).Return(nil).AnyTimes()
+	feeCollector := s.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	accs := s.CreateTestAccounts(1)
+	newTx := func(fee, gas int64) sdk.Tx {
+		s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+		s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", fee)))
+		s.txBuilder.SetGasLimit(uint64(gas))
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+
+	// endBlock records the gas consumed by the block at height.
+	endBlock := func(height int64, gasUsed uint64) {
+		blockGasMeter := storetypes.NewInfiniteGasMeter()
+		blockGasMeter.ConsumeGas(gasUsed, "block")
+		ctx := s.ctx.WithBlockHeight(height).WithHeaderInfo(header.Info{Height: height}).WithBlockGasMeter(blockGasMeter)
+		require.NoError(t, s.accountKeeper.EndBlocker(ctx))
+	}
+
+	// the base portion of the fees is burned, the priority is the tip per unit of gas.
+	ctx := s.ctx.WithExecMode(sdk.ExecModeFinalize).WithBlockHeight(1)
+	fmBankKeeper.EXPECT().BurnCoins(gomock.Any(), feeCollector, sdk.NewCoins(sdk.NewInt64Coin("atom", 150))).Return(nil)
+	newCtx, err := antehandler(ctx, newTx(450, 150), false)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), newCtx.Priority())
+
+	// the state is updated from the gas consumed by the block, not the gas limits of its txs.
+	state, err := s.accountKeeper.GetFeeMarketState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, authtypes.FeeMarketState{}, state)
+	endBlock(1, 150)
+	state, err = s.accountKeeper.GetFeeMarketState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, authtypes.FeeMarketState{BaseFee: math.LegacyOneDec(), Height: 1, GasUsed: 150}, state)
+
+	// block 1 was above target, the base fee of block 2 increased.
+	checkCtx := s.ctx.WithExecMode(sdk.ExecModeCheck).WithBlockHeight(1)
+	baseFee, err := fmd.BaseFee(checkCtx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.0625"), baseFee)
+
+	_, err = antehandler(checkCtx, newTx(100, 100), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	_, err = antehandler(ctx.WithBlockHeight(2), newTx(100, 100), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// fees are not checked in simulation mode.
+	_, err = antehandler(s.ctx.WithExecMode(sdk.ExecModeSimulate).WithBlockHeight(1), newTx(0, 100), true)
+	require.NoError(t, err)
+
+	// the base portion of the fees can be sent to a module account instead.
+	params.BaseFeeRecipient = "distribution"
+	require.NoError(t, s.accountKeeper.FeeMarketParams.Set(s.ctx, params))
+	fmBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, "distribution", sdk.NewCoins(sdk.NewInt64Coin("atom", 107))).Return(nil)
+	newCtx, err = sdk.ChainAnteDecorators(fmd)(ctx.WithBlockHeight(2), newTx(200, 100), false)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), newCtx.Priority())
+
+	endBlock(2, 80)
+	state, err = s.accountKeeper.GetFeeMarketState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, authtypes.FeeMarketState{BaseFee: math.LegacyMustNewDecFromStr("1.0625"), Height: 2, GasUsed: 80}, state)
+
+	// no base fee is charged while the fee market is disabled, and its state is left untouched.
+	params.Enabled = false
+	require.NoError(t, s.accountKeeper.FeeMarketParams.Set(s.ctx, params))
+	baseFee, err = fmd.BaseFee(checkCtx)
+	require.NoError(t, err)
+	require.True(t, baseFee.IsZero())
+	newCtx, err = sdk.ChainAnteDecorators(fmd)(ctx.WithBlockHeight(3).WithPriority(0), newTx(0, 100), false)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), newCtx.Priority())
+
+	endBlock(3, 1000)
+	newState, err := s.accountKeeper.GetFeeMarketState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, state, newState)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: x/auth/ante/expected_keepers.go
+// Source: ante/expected_keepers.go
 
 // Package testutil is a generated GoMock package.
 package testutil
@@ -209,3 +209,107 @@ func (mr *MockConsensusKeeperMockRecorder) Params(arg0, arg1 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockConsensusKeeper)(nil).Params), arg0, arg1)
 }
+
+// MockFeeMarketKeeper is a mock of FeeMarketKeeper interface.
+type MockFeeMarketKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeMarketKeeperMockRecorder
+}
+
+// MockFeeMarketKeeperMockRecorder is the mock recorder for MockFeeMarketKeeper.
+type MockFeeMarketKeeperMockRecorder struct {
+	mock *MockFeeMarketKeeper
+}
+
+// NewMockFeeMarketKeeper creates a new mock instance.
+func NewMockFeeMarketKeeper(ctrl *gomock.Controller) *MockFeeMarketKeeper {
+	mock := &MockFeeMarketKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeeMarketKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeMarketKeeper) EXPECT() *MockFeeMarketKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetFeeMarketParams mocks base method.
+func (m *MockFeeMarketKeeper) GetFeeMarketParams(ctx context.Context) (types.FeeMarketParams, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeMarketParams", ctx)
+	ret0, _ := ret[0].(types.FeeMarketParams)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeMarketParams indicates an expected call of GetFeeMarketParams.
+func (mr *MockFeeMarketKeeperMockRecorder) GetFeeMarketParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeMarketParams", reflect.TypeOf((*MockFeeMarketKeeper)(nil).GetFeeMarketParams), ctx)
+}
+
+// GetFeeMarketState mocks base method.
+func (m *MockFeeMarketKeeper) GetFeeMarketState(ctx context.Context) (types.FeeMarketState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeMarketState", ctx)
+	ret0, _ := ret[0].(types.FeeMarketState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeMarketState indicates an expected call of GetFeeMarketState.
+func (mr *MockFeeMarketKeeperMockRecorder) GetFeeMarketState(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeMarketState", reflect.TypeOf((*MockFeeMarketKeeper)(nil).GetFeeMarketState), ctx)
+}
+
+// MockFeeMarketBankKeeper is a mock of FeeMarketBankKeeper interface.
+type MockFeeMarketBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeMarketBankKeeperMockRecorder
+}
+
+// MockFeeMarketBankKeeperMockRecorder is the mock recorder for MockFeeMarketBankKeeper.
+type MockFeeMarketBankKeeperMockRecorder struct {
+	mock *MockFeeMarketBankKeeper
+}
+
+// NewMockFeeMarketBankKeeper creates a new mock instance.
+func NewMockFeeMarketBankKeeper(ctrl *gomock.Controller) *MockFeeMarketBankKeeper {
+	mock := &MockFeeMarketBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeeMarketBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeMarketBankKeeper) EXPECT() *MockFeeMarketBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// BurnCoins mocks base method.
+func (m *MockFeeMarketBankKeeper) BurnCoins(ctx context.Context, address []byte, amt types1.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", ctx, address, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockFeeMarketBankKeeperMockRecorder) BurnCoins(ctx, address, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockFeeMarketBankKeeper)(nil).BurnCoins), ctx, address, amt)
+}
+
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockFeeMarketBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types1.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockFeeMarketBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockFeeMarketBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
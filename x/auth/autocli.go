@@ -87,6 +87,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "UpdateFeeMarketParams",
+					Use:            "update-fee-market-params-proposal [params]",
+					Short:          "Submit a proposal to update the fee market params. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx auth update-fee-market-params-proposal '{ "enabled": true, "denom": "stake", "min_base_fee": "0.001", "target_block_gas": 15000000, "base_fee_change_denominator": 8 }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker records the gas consumed by the block in the fee market state, from
+// which the base fee of the next block is derived. It is a no-op while the fee
+// market is disabled.
+func (ak AccountKeeper) EndBlocker(ctx context.Context) error {
+	params, err := ak.GetFeeMarketParams(ctx)
+	if err != nil || !params.Enabled {
+		return err
+	}
+
+	state, err := ak.GetFeeMarketState(ctx)
+	if err != nil {
+		return err
+	}
+
+	state = state.Advance(params, uint64(ak.HeaderService.HeaderInfo(ctx).Height))
+	state.GasUsed = sdk.UnwrapSDKContext(ctx).BlockGasMeter().GasConsumed()
+
+	return ak.FeeMarket.Set(ctx, state)
+}
@@ -18,6 +18,16 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		return err
 	}
 
+	if err := ak.FeeMarketParams.Set(ctx, data.FeeMarketParams); err != nil {
+		return err
+	}
+
+	if !data.FeeMarketState.BaseFee.IsNil() && data.FeeMarketState.BaseFee.IsPositive() {
+		if err := ak.FeeMarket.Set(ctx, data.FeeMarketState); err != nil {
+			return err
+		}
+	}
+
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
@@ -52,5 +62,17 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState,
 		genAccounts = append(genAccounts, genAcc)
 		return false, nil
 	})
-	return types.NewGenesisState(params, genAccounts), err
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+	if genState.FeeMarketParams, err = ak.GetFeeMarketParams(ctx); err != nil {
+		return nil, err
+	}
+	if genState.FeeMarketState, err = ak.GetFeeMarketState(ctx); err != nil {
+		return nil, err
+	}
+
+	return genState, nil
 }
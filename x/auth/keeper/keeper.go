@@ -102,6 +102,10 @@ type AccountKeeper struct {
 	AccountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// FeeMarket is the state of the fee market, used by the ante.FeeMarketDecorator.
+	FeeMarket collections.Item[types.FeeMarketState]
+	// FeeMarketParams are the parameters of the fee market.
+	FeeMarketParams collections.Item[types.FeeMarketParams]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -135,6 +139,8 @@ func NewAccountKeeper(
 		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		AccountNumber:     collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:          collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		FeeMarket:         collections.NewItem(sb, types.FeeMarketStateKey, "fee_market", codec.CollValue[types.FeeMarketState](cdc)),
+		FeeMarketParams:   collections.NewItem(sb, types.FeeMarketParamsKey, "fee_market_params", codec.CollValue[types.FeeMarketParams](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -278,6 +284,29 @@ func (ak AccountKeeper) GetParams(ctx context.Context) (params types.Params) {
 	return params
 }
 
+// GetFeeMarketState returns the fee market state, or an empty state if the fee market was never used.
+func (ak AccountKeeper) GetFeeMarketState(ctx context.Context) (types.FeeMarketState, error) {
+	state, err := ak.FeeMarket.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.FeeMarketState{}, err
+	}
+	return state, nil
+}
+
+// SetFeeMarketState sets the fee market state.
+func (ak AccountKeeper) SetFeeMarketState(ctx context.Context, state types.FeeMarketState) error {
+	return ak.FeeMarket.Set(ctx, state)
+}
+
+// GetFeeMarketParams returns the fee market parameters, the fee market is disabled if they were never set.
+func (ak AccountKeeper) GetFeeMarketParams(ctx context.Context) (types.FeeMarketParams, error) {
+	params, err := ak.FeeMarketParams.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.FeeMarketParams{}, err
+	}
+	return params, nil
+}
+
 func (ak AccountKeeper) NonAtomicMsgsExec(ctx context.Context, signer sdk.AccAddress, msgs []sdk.Msg) ([]*types.NonAtomicExecResult, error) {
 	msgResponses := make([]*types.NonAtomicExecResult, 0, len(msgs))
 
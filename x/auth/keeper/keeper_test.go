@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth"
 	authcodec "cosmossdk.io/x/auth/codec"
@@ -105,6 +106,24 @@ func (suite *KeeperTestSuite) TestSupply_ValidatePermissions() {
 	suite.Require().Error(err)
 }
 
+func (suite *KeeperTestSuite) TestFeeMarketGenesis() {
+	suite.SetupTest() // reset
+
+	genState := types.DefaultGenesisState()
+	genState.FeeMarketParams = types.DefaultFeeMarketParams("stake")
+	genState.FeeMarketState = types.FeeMarketState{BaseFee: math.LegacyNewDec(2), Height: 10, GasUsed: 100}
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+	suite.Require().NoError(suite.accountKeeper.InitGenesis(suite.ctx, *genState))
+
+	exported, err := suite.accountKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.FeeMarketParams, exported.FeeMarketParams)
+	suite.Require().Equal(genState.FeeMarketState, exported.FeeMarketState)
+
+	genState.FeeMarketParams.TargetBlockGas = 0
+	suite.Require().Error(types.ValidateGenesis(*genState))
+}
+
 func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.SetupTest() // reset
 
@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/auth/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -69,3 +72,67 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) UpdateFeeMarketParams(ctx context.Context, msg *types.MsgUpdateFeeMarketParams) (*types.MsgUpdateFeeMarketParamsResponse, error) {
+	if ms.ak.authority != msg.Authority {
+		return nil, fmt.Errorf(
+			"expected authority account as only signer for proposal message; invalid authority; expected %s, got %s",
+			ms.ak.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.ak.FeeMarketParams.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeeMarketParamsResponse{}, nil
+}
+
+// MigrateAccount migrates the signer account to the given x/accounts account type, keeping its address,
+// account number and balances. The account is then removed from x/auth.
+func (ms msgServer) MigrateAccount(ctx context.Context, msg *types.MsgMigrateAccount) (*types.MsgMigrateAccountResponse, error) {
+	signer, err := ms.ak.AddressCodec().StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	acc := ms.ak.GetAccount(ctx, signer)
+	if acc == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", msg.Signer)
+	}
+
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("module account %s can't be migrated", msg.Signer)
+	}
+
+	if ms.ak.AccountsModKeeper.IsAccountsModuleAccount(ctx, signer) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s is already an x/accounts account", msg.Signer)
+	}
+
+	if msg.AccountInitMsg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("account init message must be specified")
+	}
+	initMsg, err := accountstd.UnpackAnyRaw(msg.AccountInitMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the account type carries over the state of the legacy account, e.g. its sequence or its vesting schedule
+	initResp, err := ms.ak.AccountsModKeeper.MigrateLegacyAccount(ctx, signer, acc.GetAccountNumber(), msg.AccountType, initMsg, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	// the account is now handled by x/accounts
+	ms.ak.RemoveAccount(ctx, acc)
+
+	initRespAny, err := codectypes.NewAnyWithValue(initResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateAccountResponse{InitResponse: initRespAny}, nil
+}
@@ -1,7 +1,16 @@
 package keeper_test
 
 import (
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
 	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -109,3 +118,113 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketParams() {
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateFeeMarketParams
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name: "set invalid authority",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: "foo",
+			},
+			expectErr: true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "set invalid denom",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    types.DefaultFeeMarketParams(""),
+			},
+			expectErr: true,
+			expErrMsg: "invalid denom",
+		},
+		{
+			name: "enable the fee market",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    types.DefaultFeeMarketParams("stake"),
+			},
+		},
+		{
+			name: "disable the fee market",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    types.FeeMarketParams{MinBaseFee: math.LegacyZeroDec()},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateFeeMarketParams(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				params, err := s.accountKeeper.GetFeeMarketParams(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.req.Params, params)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrateAccount() {
+	packAny := func(msg gogoproto.Message) *codectypes.Any {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		s.Require().NoError(err)
+		return anyMsg
+	}
+
+	s.Run("base account", func() {
+		pubKey := secp256k1.GenPrivKey().PubKey()
+		acc := s.accountKeeper.NewAccountWithAddress(s.ctx, sdk.AccAddress(pubKey.Address()))
+		s.accountKeeper.SetAccount(s.ctx, acc)
+
+		// the state of the legacy account is carried over by the account type
+		s.acctsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), acc.GetAddress().Bytes()).Return(false)
+		s.acctsModKeeper.EXPECT().MigrateLegacyAccount(
+			gomock.Any(), acc.GetAddress().Bytes(), acc.GetAccountNumber(), "base", &basev1.MsgInit{}, acc,
+		).Return(&basev1.MsgInitResponse{}, nil)
+
+		res, err := s.msgServer.MigrateAccount(s.ctx, &types.MsgMigrateAccount{
+			Signer:         acc.GetAddress().String(),
+			AccountType:    "base",
+			AccountInitMsg: packAny(&basev1.MsgInit{}),
+		})
+		s.Require().NoError(err)
+		s.Require().Equal("/cosmos.accounts.defaults.base.v1.MsgInitResponse", res.InitResponse.TypeUrl)
+
+		// the account is removed from x/auth
+		has, err := s.accountKeeper.Accounts.Has(s.ctx, acc.GetAddress())
+		s.Require().NoError(err)
+		s.Require().False(has)
+	})
+
+	s.Run("module account", func() {
+		acc := s.accountKeeper.GetModuleAccount(s.ctx, multiPerm)
+
+		_, err := s.msgServer.MigrateAccount(s.ctx, &types.MsgMigrateAccount{
+			Signer:         acc.GetAddress().String(),
+			AccountType:    "base",
+			AccountInitMsg: packAny(&basev1.MsgInit{}),
+		})
+		s.Require().ErrorContains(err, "can't be migrated")
+	})
+
+	s.Run("unknown account", func() {
+		_, err := s.msgServer.MigrateAccount(s.ctx, &types.MsgMigrateAccount{
+			Signer:         sdk.AccAddress("unknown").String(),
+			AccountType:    "base",
+			AccountInitMsg: packAny(&basev1.MsgInit{}),
+		})
+		s.Require().ErrorContains(err, "does not exist")
+	})
+}
@@ -34,6 +34,7 @@ var (
 	_ appmodule.AppModule     = AppModule{}
 	_ appmodule.HasServices   = AppModule{}
 	_ appmodule.HasMigrations = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // AppModule implements an application module for the auth module.
@@ -141,6 +142,11 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// EndBlock updates the fee market state from the gas consumed by the block.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.accountKeeper.EndBlocker(ctx)
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
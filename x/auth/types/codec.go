@@ -23,6 +23,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeeMarketParams{}, "cosmos-sdk/x/auth/MsgUpdateFeeMarket")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -60,5 +61,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgNonAtomicExec{},
+		&MsgMigrateAccount{},
+		&MsgUpdateFeeMarketParams{},
 	)
 }
@@ -19,4 +19,5 @@ type BankKeeper interface {
 type AccountsModKeeper interface {
 	SendModuleMessageUntyped(ctx context.Context, sender []byte, msg protoiface.MessageV1) (protoiface.MessageV1, error)
 	IsAccountsModuleAccount(ctx context.Context, accountAddr []byte) bool
+	MigrateLegacyAccount(ctx context.Context, addr []byte, accNum uint64, accType string, msg protoiface.MessageV1, legacyAcc sdk.AccountI) (protoiface.MessageV1, error)
 }
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default fee market parameter values
+const (
+	DefaultTargetBlockGas           uint64 = 15_000_000
+	DefaultBaseFeeChangeDenominator uint64 = 8
+)
+
+// DefaultMinBaseFee is the default minimum base fee.
+var DefaultMinBaseFee = math.LegacyNewDecWithPrec(1, 3)
+
+// DefaultFeeMarketParams returns the default parameters of a fee market enabled for denom.
+func DefaultFeeMarketParams(denom string) FeeMarketParams {
+	return FeeMarketParams{
+		Enabled:                  true,
+		Denom:                    denom,
+		MinBaseFee:               DefaultMinBaseFee,
+		TargetBlockGas:           DefaultTargetBlockGas,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+	}
+}
+
+// Validate performs basic validation of the fee market parameters, they are
+// only validated when the fee market is enabled.
+func (p FeeMarketParams) Validate() error {
+	if !p.Enabled {
+		return nil
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	if p.MinBaseFee.IsNil() || !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", p.MinBaseFee)
+	}
+	if p.TargetBlockGas == 0 {
+		return errors.New("target block gas must be positive")
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+	return nil
+}
+
+// NextBaseFee returns the base fee of the block following a block with the given
+// base fee and gas used.
+func (p FeeMarketParams) NextBaseFee(baseFee math.LegacyDec, gasUsed uint64) math.LegacyDec {
+	target := math.NewIntFromUint64(p.TargetBlockGas)
+	denominator := math.NewIntFromUint64(p.BaseFeeChangeDenominator)
+
+	var delta math.LegacyDec
+	switch {
+	case gasUsed > p.TargetBlockGas:
+		delta = baseFee.MulInt(math.NewIntFromUint64(gasUsed - p.TargetBlockGas)).QuoInt(target).QuoInt(denominator)
+		baseFee = baseFee.Add(delta)
+	case gasUsed < p.TargetBlockGas:
+		delta = baseFee.MulInt(math.NewIntFromUint64(p.TargetBlockGas - gasUsed)).QuoInt(target).QuoInt(denominator)
+		baseFee = baseFee.Sub(delta)
+	}
+
+	return math.LegacyMaxDec(baseFee, p.MinBaseFee)
+}
+
+// BaseFeeAt returns the base fee of the block at height. The state is updated at
+// the end of every block while the fee market is enabled, the base fee of the
+// blocks which followed the one recorded is derived from its gas used, and from
+// the blocks in between being empty.
+func (s FeeMarketState) BaseFeeAt(p FeeMarketParams, height uint64) math.LegacyDec {
+	if s.BaseFee.IsNil() || s.BaseFee.IsZero() {
+		return p.MinBaseFee
+	}
+
+	baseFee := math.LegacyMaxDec(s.BaseFee, p.MinBaseFee)
+	if height <= s.Height {
+		return baseFee
+	}
+
+	baseFee = p.NextBaseFee(baseFee, s.GasUsed)
+	for h := s.Height + 1; h < height; h++ {
+		next := p.NextBaseFee(baseFee, 0)
+		if next.Equal(baseFee) {
+			// the base fee reached its minimum, or its decrease is too small to be represented.
+			break
+		}
+		baseFee = next
+	}
+
+	return baseFee
+}
+
+// Advance returns the state of the block at height, with no gas used yet if
+// the state is not the one of that block already.
+func (s FeeMarketState) Advance(p FeeMarketParams, height uint64) FeeMarketState {
+	if height <= s.Height && !s.BaseFee.IsNil() {
+		return s
+	}
+
+	return FeeMarketState{
+		BaseFee: s.BaseFeeAt(p, height),
+		Height:  height,
+	}
+}
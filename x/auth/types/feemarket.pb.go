@@ -0,0 +1,802 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/feemarket.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeMarketParams defines the parameters of the fee market.
+type FeeMarketParams struct {
+	// enabled defines whether the fee market is enabled. When disabled, the base
+	// fee is not charged and the fee market state is not updated.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// denom is the denomination in which the base fee is paid.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_base_fee is the lowest value the base fee can reach, it must be positive
+	// for the base fee to be able to increase again.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// target_block_gas is the gas used by a block which leaves the base fee unchanged.
+	// Blocks using more gas than the target increase the base fee of the following
+	// block, blocks using less decrease it.
+	TargetBlockGas uint64 `protobuf:"varint,4,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the change of the base fee between two
+	// blocks to 1/base_fee_change_denominator of its value.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,5,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// base_fee_recipient is the name of the module account receiving the base
+	// portion of the fees. If empty, the base portion is burned from the fee
+	// collector, which must then be granted the burner permission.
+	BaseFeeRecipient string `protobuf:"bytes,6,opt,name=base_fee_recipient,json=baseFeeRecipient,proto3" json:"base_fee_recipient,omitempty"`
+}
+
+func (m *FeeMarketParams) Reset()         { *m = FeeMarketParams{} }
+func (m *FeeMarketParams) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketParams) ProtoMessage()    {}
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03e0ae999f9232fb, []int{0}
+}
+func (m *FeeMarketParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketParams.Merge(m, src)
+}
+func (m *FeeMarketParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketParams proto.InternalMessageInfo
+
+func (m *FeeMarketParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *FeeMarketParams) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeMarketParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetBaseFeeRecipient() string {
+	if m != nil {
+		return m.BaseFeeRecipient
+	}
+	return ""
+}
+
+// FeeMarketState defines the state of the fee market, it holds the base fee of
+// the last block executed while the fee market was enabled and the gas used in
+// that block.
+type FeeMarketState struct {
+	// base_fee is the minimum gas price of the transactions included in the block.
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+	// height is the height of the block.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// gas_used is the gas consumed by the block.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *FeeMarketState) Reset()         { *m = FeeMarketState{} }
+func (m *FeeMarketState) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketState) ProtoMessage()    {}
+func (*FeeMarketState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03e0ae999f9232fb, []int{1}
+}
+func (m *FeeMarketState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketState.Merge(m, src)
+}
+func (m *FeeMarketState) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketState) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketState proto.InternalMessageInfo
+
+func (m *FeeMarketState) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeMarketState) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FeeMarketParams)(nil), "cosmos.auth.v1beta1.FeeMarketParams")
+	proto.RegisterType((*FeeMarketState)(nil), "cosmos.auth.v1beta1.FeeMarketState")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/auth/v1beta1/feemarket.proto", fileDescriptor_03e0ae999f9232fb)
+}
+
+var fileDescriptor_03e0ae999f9232fb = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0xd4, 0x34, 0x89, 0x83, 0xd4, 0x3a, 0x16, 0xd9, 0xb4, 0xb0, 0x0d, 0xf5, 0x12, 0x44,
+	0x77, 0x29, 0x05, 0x6f, 0x5e, 0x62, 0xa8, 0x17, 0x05, 0x5d, 0x11, 0xc4, 0xcb, 0xf0, 0x76, 0xf7,
+	0x75, 0x76, 0x48, 0x67, 0x26, 0xec, 0x4c, 0xc5, 0x7e, 0x0b, 0x4f, 0x7e, 0x06, 0x8f, 0x22, 0x7e,
+	0x88, 0x1e, 0x8b, 0x27, 0xf1, 0x10, 0x24, 0x39, 0xf8, 0x35, 0x64, 0x67, 0x36, 0x11, 0xcf, 0x5e,
+	0x96, 0x7d, 0xbf, 0x3f, 0xf3, 0x7e, 0xef, 0xf1, 0xe8, 0xfd, 0xc2, 0x58, 0x65, 0x6c, 0x0a, 0x17,
+	0xae, 0x4a, 0xdf, 0x1f, 0xe7, 0xe8, 0xe0, 0x38, 0x3d, 0x43, 0x54, 0x50, 0xcf, 0xd0, 0x25, 0xf3,
+	0xda, 0x38, 0xc3, 0xee, 0x06, 0x51, 0xd2, 0x88, 0x92, 0x56, 0xb4, 0xbf, 0x27, 0x8c, 0x30, 0x9e,
+	0x4f, 0x9b, 0xbf, 0x20, 0xdd, 0x1f, 0x06, 0x29, 0x0f, 0x44, 0xeb, 0x0b, 0xd4, 0x1d, 0x50, 0x52,
+	0x9b, 0xd4, 0x7f, 0x03, 0x74, 0xf4, 0x75, 0x8b, 0xde, 0x3e, 0x45, 0x7c, 0xe1, 0x9b, 0xbd, 0x84,
+	0x1a, 0x94, 0x65, 0x11, 0xed, 0xa3, 0x86, 0xfc, 0x1c, 0xcb, 0x88, 0x8c, 0xc8, 0x78, 0x90, 0xad,
+	0x4b, 0xb6, 0x47, 0xb7, 0x4b, 0xd4, 0x46, 0x45, 0x5b, 0x23, 0x32, 0xbe, 0x99, 0x85, 0x82, 0xbd,
+	0xa5, 0xb7, 0x94, 0xd4, 0x3c, 0x07, 0x8b, 0xfc, 0x0c, 0x31, 0xba, 0xd1, 0x90, 0x93, 0xc7, 0x57,
+	0x8b, 0xc3, 0xce, 0xcf, 0xc5, 0xe1, 0x41, 0x88, 0x60, 0xcb, 0x59, 0x22, 0x4d, 0xaa, 0xc0, 0x55,
+	0xc9, 0x73, 0x14, 0x50, 0x5c, 0x4e, 0xb1, 0xf8, 0xfe, 0xed, 0x11, 0x6d, 0x13, 0x4e, 0xb1, 0xf8,
+	0xfc, 0xfb, 0xcb, 0x03, 0x92, 0x51, 0x25, 0xf5, 0x04, 0x2c, 0x9e, 0x22, 0xb2, 0x31, 0xdd, 0x75,
+	0x50, 0x0b, 0x74, 0x3c, 0x3f, 0x37, 0xc5, 0x8c, 0x0b, 0xb0, 0x51, 0x77, 0x44, 0xc6, 0xdd, 0x6c,
+	0x27, 0xe0, 0x93, 0x06, 0x7e, 0x06, 0x96, 0x3d, 0xa1, 0x07, 0xeb, 0xfe, 0xbc, 0xa8, 0x40, 0x0b,
+	0xe4, 0x3e, 0x9c, 0xd4, 0xe0, 0x4c, 0x1d, 0x6d, 0x7b, 0x53, 0x94, 0x87, 0x77, 0x9f, 0x7a, 0xc1,
+	0xf4, 0x2f, 0xcf, 0x1e, 0x52, 0xb6, 0xb1, 0xd7, 0x58, 0xc8, 0xb9, 0x44, 0xed, 0xa2, 0x9e, 0x9f,
+	0x72, 0xb7, 0x75, 0x65, 0x6b, 0xfc, 0xe8, 0x13, 0xa1, 0x3b, 0x9b, 0xa5, 0xbd, 0x76, 0xe0, 0x90,
+	0xbd, 0xa2, 0x83, 0xcd, 0xfc, 0xe4, 0xbf, 0xe6, 0xef, 0xb7, 0xed, 0xd8, 0x3d, 0xda, 0xab, 0x50,
+	0x8a, 0xca, 0xf9, 0x6d, 0x77, 0xb3, 0xb6, 0x62, 0x43, 0x3a, 0x10, 0x60, 0xf9, 0x85, 0xc5, 0xd2,
+	0xaf, 0xba, 0x9b, 0xf5, 0x05, 0xd8, 0x37, 0x16, 0xcb, 0xc9, 0xc9, 0xd5, 0x32, 0x26, 0xd7, 0xcb,
+	0x98, 0xfc, 0x5a, 0xc6, 0xe4, 0xe3, 0x2a, 0xee, 0x5c, 0xaf, 0xe2, 0xce, 0x8f, 0x55, 0xdc, 0x79,
+	0x37, 0xfc, 0x27, 0xc5, 0x87, 0x70, 0x6d, 0xee, 0x72, 0x8e, 0x36, 0xef, 0xf9, 0x4b, 0x38, 0xf9,
+	0x33, 0x00, 0x80, 0x9a, 0x7c, 0xe6, 0x89, 0x02, 0x00, 0x00,
+}
+
+func (m *FeeMarketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFeeRecipient) > 0 {
+		i -= len(m.BaseFeeRecipient)
+		copy(dAtA[i:], m.BaseFeeRecipient)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.BaseFeeRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarketState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeMarketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.TargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	l = len(m.BaseFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeMarketState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeMarketParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+)
+
+func TestFeeMarketParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultFeeMarketParams("stake").Validate())
+
+	p := types.DefaultFeeMarketParams("")
+	require.Error(t, p.Validate())
+
+	p = types.DefaultFeeMarketParams("stake")
+	p.MinBaseFee = math.LegacyZeroDec()
+	require.Error(t, p.Validate())
+
+	p = types.DefaultFeeMarketParams("stake")
+	p.TargetBlockGas = 0
+	require.Error(t, p.Validate())
+
+	p = types.DefaultFeeMarketParams("stake")
+	p.BaseFeeChangeDenominator = 0
+	require.Error(t, p.Validate())
+
+	// the params of a disabled fee market are not validated.
+	p.Enabled = false
+	require.NoError(t, p.Validate())
+}
+
+func TestNextBaseFee(t *testing.T) {
+	p := types.FeeMarketParams{
+		Denom:                    "stake",
+		MinBaseFee:               math.LegacyOneDec(),
+		TargetBlockGas:           100,
+		BaseFeeChangeDenominator: 8,
+	}
+
+	testCases := []struct {
+		name     string
+		baseFee  math.LegacyDec
+		gasUsed  uint64
+		expected math.LegacyDec
+	}{
+		{"on target", math.LegacyNewDec(8), 100, math.LegacyNewDec(8)},
+		{"full block", math.LegacyNewDec(8), 200, math.LegacyNewDec(9)},
+		{"above target", math.LegacyNewDec(8), 150, math.LegacyMustNewDecFromStr("8.5")},
+		{"empty block", math.LegacyNewDec(8), 0, math.LegacyNewDec(7)},
+		{"min base fee", math.LegacyOneDec(), 0, math.LegacyOneDec()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, p.NextBaseFee(tc.baseFee, tc.gasUsed))
+		})
+	}
+}
+
+func TestFeeMarketStateBaseFeeAt(t *testing.T) {
+	p := types.FeeMarketParams{
+		Denom:                    "stake",
+		MinBaseFee:               math.LegacyOneDec(),
+		TargetBlockGas:           100,
+		BaseFeeChangeDenominator: 8,
+	}
+
+	// the fee market starts at the min base fee.
+	require.Equal(t, p.MinBaseFee, types.FeeMarketState{}.BaseFeeAt(p, 5))
+	require.Equal(t, types.FeeMarketState{BaseFee: p.MinBaseFee, Height: 5}, types.FeeMarketState{}.Advance(p, 5))
+
+	state := types.FeeMarketState{BaseFee: math.LegacyNewDec(8), Height: 10, GasUsed: 200}
+	require.Equal(t, math.LegacyNewDec(8), state.BaseFeeAt(p, 10))
+	require.Equal(t, math.LegacyNewDec(9), state.BaseFeeAt(p, 11))
+	// the blocks between the recorded one and height were empty.
+	require.Equal(t, math.LegacyMustNewDecFromStr("7.875"), state.BaseFeeAt(p, 12))
+	require.Equal(t, p.MinBaseFee, state.BaseFeeAt(p, 1_000_000_000))
+
+	require.Equal(t, state, state.Advance(p, 10))
+	require.Equal(t, types.FeeMarketState{BaseFee: math.LegacyMustNewDecFromStr("7.875"), Height: 12}, state.Advance(p, 12))
+}
@@ -67,6 +67,14 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := data.FeeMarketParams.Validate(); err != nil {
+		return err
+	}
+
+	if !data.FeeMarketState.BaseFee.IsNil() && data.FeeMarketState.BaseFee.IsNegative() {
+		return fmt.Errorf("fee market base fee cannot be negative: %s", data.FeeMarketState.BaseFee)
+	}
+
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
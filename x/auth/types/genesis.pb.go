@@ -31,6 +31,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*any.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// fee_market_params defines the parameters of the fee market.
+	FeeMarketParams FeeMarketParams `protobuf:"bytes,3,opt,name=fee_market_params,json=feeMarketParams,proto3" json:"fee_market_params"`
+	// fee_market_state defines the state of the fee market.
+	FeeMarketState FeeMarketState `protobuf:"bytes,4,opt,name=fee_market_state,json=feeMarketState,proto3" json:"fee_market_state"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +84,20 @@ func (m *GenesisState) GetAccounts() []*any.Any {
 	return nil
 }
 
+func (m *GenesisState) GetFeeMarketParams() FeeMarketParams {
+	if m != nil {
+		return m.FeeMarketParams
+	}
+	return FeeMarketParams{}
+}
+
+func (m *GenesisState) GetFeeMarketState() FeeMarketState {
+	if m != nil {
+		return m.FeeMarketState
+	}
+	return FeeMarketState{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -87,24 +105,28 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0x64, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x92, 0x10, 0xab,
-	0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x20, 0xfe, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x1b, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x57, 0x9c, 0xf7, 0x5c, 0x01,
-	0x00, 0x00,
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc2, 0x40,
+	0x1c, 0xc6, 0x7b, 0x60, 0x88, 0x1e, 0x46, 0xa5, 0x32, 0x00, 0x26, 0x27, 0x8a, 0x03, 0x71, 0xb8,
+	0x13, 0xd8, 0x4d, 0x74, 0xd0, 0xc9, 0xc4, 0xe0, 0x62, 0x74, 0x20, 0x07, 0xfe, 0x5b, 0x09, 0xb6,
+	0x47, 0x7a, 0x57, 0x63, 0xdf, 0xc2, 0xc7, 0x70, 0x74, 0xf3, 0x15, 0x18, 0x19, 0x9d, 0x8c, 0x69,
+	0x07, 0x5f, 0xc3, 0xf4, 0xae, 0x1a, 0x88, 0x8d, 0x4b, 0x73, 0xb9, 0xef, 0xd7, 0xef, 0xfb, 0xfe,
+	0xff, 0xc3, 0x7b, 0x23, 0x21, 0x3d, 0x21, 0x19, 0x0f, 0xd5, 0x3d, 0x7b, 0xec, 0x0c, 0x41, 0xf1,
+	0x0e, 0x73, 0xc1, 0x07, 0x39, 0x96, 0x74, 0x1a, 0x08, 0x25, 0xec, 0x6d, 0x83, 0xd0, 0x14, 0xa1,
+	0x19, 0xd2, 0xa8, 0xbb, 0x42, 0xb8, 0x0f, 0xc0, 0x34, 0x32, 0x0c, 0x1d, 0xc6, 0xfd, 0xc8, 0xf0,
+	0x8d, 0xaa, 0x2b, 0x5c, 0xa1, 0x8f, 0x2c, 0x3d, 0x65, 0xb7, 0x24, 0x2f, 0x48, 0x5b, 0x1a, 0xbd,
+	0x95, 0xa7, 0x3b, 0x00, 0x1e, 0x0f, 0x26, 0xa0, 0x32, 0xa8, 0xc2, 0xbd, 0xb1, 0x2f, 0x98, 0xfe,
+	0x9a, 0xab, 0xfd, 0xb7, 0x02, 0x5e, 0x3f, 0x37, 0x7d, 0xaf, 0x14, 0x57, 0x60, 0x1f, 0xe3, 0xd2,
+	0x94, 0x07, 0xdc, 0x93, 0x35, 0xd4, 0x44, 0xed, 0x72, 0x77, 0x87, 0xe6, 0xf4, 0xa7, 0x97, 0x1a,
+	0x39, 0x5d, 0x9b, 0x7d, 0xec, 0x5a, 0x2f, 0x5f, 0xaf, 0x87, 0xa8, 0x9f, 0xfd, 0x65, 0x1f, 0xe1,
+	0x55, 0x3e, 0x1a, 0x89, 0xd0, 0x57, 0xb2, 0x56, 0x68, 0x16, 0xdb, 0xe5, 0x6e, 0x95, 0x9a, 0x61,
+	0xe9, 0xcf, 0xb0, 0xf4, 0xc4, 0x8f, 0xfa, 0xbf, 0x94, 0x7d, 0x8b, 0x2b, 0x0e, 0xc0, 0xc0, 0x34,
+	0x1d, 0x64, 0xe1, 0x45, 0x1d, 0x7e, 0x90, 0x1b, 0x7e, 0x06, 0x70, 0xa1, 0xe1, 0xbf, 0x2d, 0x36,
+	0x9d, 0x65, 0xcd, 0xbe, 0xc6, 0x5b, 0x0b, 0xe6, 0x32, 0x1d, 0xb1, 0xb6, 0xa2, 0xbd, 0x5b, 0xff,
+	0x7b, 0xeb, 0x6d, 0x2c, 0x5a, 0x6f, 0x38, 0xcb, 0x52, 0x6f, 0x16, 0x13, 0x34, 0x8f, 0x09, 0xfa,
+	0x8c, 0x09, 0x7a, 0x4e, 0x88, 0x35, 0x4f, 0x88, 0xf5, 0x9e, 0x10, 0xeb, 0xa6, 0x6e, 0x8c, 0xe5,
+	0xdd, 0x84, 0x8e, 0x05, 0x7b, 0x32, 0x6f, 0xa2, 0xa2, 0x29, 0xc8, 0x61, 0x49, 0xef, 0xa0, 0xf7,
+	0x3d, 0x00, 0x8e, 0xeb, 0xa9, 0xb9, 0x38, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +149,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeMarketState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.FeeMarketParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +221,10 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.FeeMarketParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeMarketState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -284,6 +330,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarketParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMarketParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarketState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMarketState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
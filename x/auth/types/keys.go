@@ -26,6 +26,12 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// FeeMarketStateKey is the prefix for the fee market state key
+	FeeMarketStateKey = collections.NewPrefix(3)
+
+	// FeeMarketParamsKey is the prefix for the fee market params key
+	FeeMarketParamsKey = collections.NewPrefix(4)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 )
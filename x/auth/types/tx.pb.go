@@ -279,52 +279,277 @@ func (m *MsgNonAtomicExecResponse) GetResults() []*NonAtomicExecResult {
 	return nil
 }
 
+// MsgMigrateAccount defines a message which allows users to migrate their x/auth
+// account to an x/accounts account type. It must be signed by the key of the
+// account.
+type MsgMigrateAccount struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// account_type is the x/accounts account type to migrate to.
+	AccountType string `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	// account_init_msg is the init message of the account type. The pubkey and
+	// sequence of a base account, and the schedule and original locking of a
+	// lockup account are set from the migrated account.
+	AccountInitMsg *any.Any `protobuf:"bytes,3,opt,name=account_init_msg,json=accountInitMsg,proto3" json:"account_init_msg,omitempty"`
+}
+
+func (m *MsgMigrateAccount) Reset()         { *m = MsgMigrateAccount{} }
+func (m *MsgMigrateAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAccount) ProtoMessage()    {}
+func (*MsgMigrateAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{5}
+}
+func (m *MsgMigrateAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAccount.Merge(m, src)
+}
+func (m *MsgMigrateAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateAccount proto.InternalMessageInfo
+
+func (m *MsgMigrateAccount) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgMigrateAccount) GetAccountType() string {
+	if m != nil {
+		return m.AccountType
+	}
+	return ""
+}
+
+func (m *MsgMigrateAccount) GetAccountInitMsg() *any.Any {
+	if m != nil {
+		return m.AccountInitMsg
+	}
+	return nil
+}
+
+// MsgMigrateAccountResponse defines the response of MsgMigrateAccount.
+type MsgMigrateAccountResponse struct {
+	// init_response is the response returned by the initialization of the
+	// x/accounts account.
+	InitResponse *any.Any `protobuf:"bytes,1,opt,name=init_response,json=initResponse,proto3" json:"init_response,omitempty"`
+}
+
+func (m *MsgMigrateAccountResponse) Reset()         { *m = MsgMigrateAccountResponse{} }
+func (m *MsgMigrateAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAccountResponse) ProtoMessage()    {}
+func (*MsgMigrateAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{6}
+}
+func (m *MsgMigrateAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAccountResponse.Merge(m, src)
+}
+func (m *MsgMigrateAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateAccountResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateAccountResponse) GetInitResponse() *any.Any {
+	if m != nil {
+		return m.InitResponse
+	}
+	return nil
+}
+
+// MsgUpdateFeeMarketParams is the Msg/UpdateFeeMarketParams request type.
+type MsgUpdateFeeMarketParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the fee market parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params FeeMarketParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateFeeMarketParams) Reset()         { *m = MsgUpdateFeeMarketParams{} }
+func (m *MsgUpdateFeeMarketParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeMarketParams) ProtoMessage()    {}
+func (*MsgUpdateFeeMarketParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{7}
+}
+func (m *MsgUpdateFeeMarketParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeMarketParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeMarketParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeMarketParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeMarketParams.Merge(m, src)
+}
+func (m *MsgUpdateFeeMarketParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeMarketParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeMarketParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeMarketParams proto.InternalMessageInfo
+
+func (m *MsgUpdateFeeMarketParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateFeeMarketParams) GetParams() FeeMarketParams {
+	if m != nil {
+		return m.Params
+	}
+	return FeeMarketParams{}
+}
+
+// MsgUpdateFeeMarketParamsResponse defines the response structure for executing a
+// MsgUpdateFeeMarketParams message.
+type MsgUpdateFeeMarketParamsResponse struct {
+}
+
+func (m *MsgUpdateFeeMarketParamsResponse) Reset()         { *m = MsgUpdateFeeMarketParamsResponse{} }
+func (m *MsgUpdateFeeMarketParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeMarketParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateFeeMarketParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{8}
+}
+func (m *MsgUpdateFeeMarketParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeMarketParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeMarketParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeMarketParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeMarketParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeeMarketParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeMarketParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeMarketParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeMarketParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.auth.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgNonAtomicExec)(nil), "cosmos.auth.v1beta1.MsgNonAtomicExec")
 	proto.RegisterType((*NonAtomicExecResult)(nil), "cosmos.auth.v1beta1.NonAtomicExecResult")
 	proto.RegisterType((*MsgNonAtomicExecResponse)(nil), "cosmos.auth.v1beta1.MsgNonAtomicExecResponse")
+	proto.RegisterType((*MsgMigrateAccount)(nil), "cosmos.auth.v1beta1.MsgMigrateAccount")
+	proto.RegisterType((*MsgMigrateAccountResponse)(nil), "cosmos.auth.v1beta1.MsgMigrateAccountResponse")
+	proto.RegisterType((*MsgUpdateFeeMarketParams)(nil), "cosmos.auth.v1beta1.MsgUpdateFeeMarketParams")
+	proto.RegisterType((*MsgUpdateFeeMarketParamsResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateFeeMarketParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/tx.proto", fileDescriptor_c2d62bd9c4c212e5) }
 
 var fileDescriptor_c2d62bd9c4c212e5 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x8f, 0xd2, 0x40,
-	0x14, 0x66, 0xdc, 0x5d, 0x0c, 0x83, 0x66, 0xb5, 0x90, 0x2c, 0xb2, 0xa6, 0x62, 0xa3, 0x09, 0x21,
-	0x32, 0x5d, 0x58, 0xa3, 0x09, 0x07, 0x13, 0x48, 0x36, 0x9e, 0x30, 0xa6, 0x66, 0x2f, 0x1e, 0x34,
-	0x05, 0xc6, 0xb1, 0xd9, 0x6d, 0xa7, 0xe9, 0x1b, 0x56, 0xb8, 0x19, 0x8f, 0x9e, 0x3c, 0xfa, 0x13,
-	0x3c, 0x72, 0xe0, 0x47, 0x6c, 0xf6, 0xb4, 0xe1, 0xe4, 0xc9, 0x18, 0x38, 0x70, 0xf1, 0x47, 0x98,
-	0xce, 0x4c, 0x25, 0x60, 0x8d, 0x7b, 0x69, 0x3b, 0xf3, 0x7d, 0xef, 0xbd, 0xef, 0xbd, 0xef, 0x15,
-	0xdf, 0xed, 0x73, 0xf0, 0x39, 0xd8, 0xee, 0x50, 0xbc, 0xb7, 0xcf, 0x1a, 0x3d, 0x2a, 0xdc, 0x86,
-	0x2d, 0x46, 0x24, 0x8c, 0xb8, 0xe0, 0x46, 0x41, 0xa1, 0x24, 0x46, 0x89, 0x46, 0xcb, 0x45, 0xc6,
-	0x19, 0x97, 0xb8, 0x1d, 0x7f, 0x29, 0x6a, 0xf9, 0x0e, 0xe3, 0x9c, 0x9d, 0x52, 0x5b, 0x9e, 0x7a,
-	0xc3, 0x77, 0xb6, 0x1b, 0x8c, 0x13, 0x48, 0x65, 0x79, 0xab, 0x62, 0x74, 0x4a, 0x05, 0xed, 0xe9,
-	0xf2, 0x3e, 0x30, 0xfb, 0xac, 0x11, 0xbf, 0x34, 0x70, 0xdb, 0xf5, 0xbd, 0x80, 0xdb, 0xf2, 0xa9,
-	0xaf, 0xcc, 0x34, 0xa9, 0x52, 0x99, 0xc4, 0xad, 0x19, 0xc2, 0xbb, 0x5d, 0x60, 0xc7, 0xe1, 0xc0,
-	0x15, 0xf4, 0xa5, 0x1b, 0xb9, 0x3e, 0x18, 0x4f, 0x70, 0x2e, 0x66, 0xf0, 0xc8, 0x13, 0xe3, 0x12,
-	0xaa, 0xa0, 0x6a, 0xae, 0x53, 0x9a, 0x4d, 0xeb, 0x45, 0x2d, 0xa2, 0x3d, 0x18, 0x44, 0x14, 0xe0,
-	0x95, 0x88, 0xbc, 0x80, 0x39, 0x2b, 0xaa, 0xf1, 0x0c, 0x67, 0x43, 0x99, 0xa1, 0x74, 0xad, 0x82,
-	0xaa, 0xf9, 0xe6, 0x3e, 0x49, 0x99, 0x04, 0x51, 0x45, 0x3a, 0xb9, 0xf3, 0x1f, 0xf7, 0x32, 0xdf,
-	0x96, 0x93, 0x1a, 0x72, 0x74, 0x54, 0xeb, 0xf9, 0x6c, 0x5a, 0xdf, 0x55, 0x21, 0x75, 0x18, 0x9c,
-	0x54, 0x0e, 0xc8, 0xe3, 0xa7, 0x9f, 0x96, 0x93, 0xda, 0xaa, 0xc4, 0xe7, 0xe5, 0xa4, 0x76, 0x7f,
-	0xc5, 0xb0, 0x47, 0xaa, 0xaf, 0x8d, 0x06, 0x2c, 0x82, 0xf7, 0x36, 0xae, 0x1c, 0x0a, 0x21, 0x0f,
-	0x80, 0xb6, 0x0a, 0x29, 0x35, 0xac, 0xaf, 0x08, 0xdf, 0xea, 0x02, 0x7b, 0xc1, 0x83, 0xb6, 0xe0,
-	0xbe, 0xd7, 0x3f, 0x1a, 0xd1, 0xbe, 0x71, 0x80, 0xb3, 0xe0, 0xb1, 0x80, 0x46, 0xff, 0x1d, 0x81,
-	0xe6, 0x19, 0x47, 0x78, 0xdb, 0x07, 0x16, 0x77, 0xbf, 0x55, 0xcd, 0x37, 0x8b, 0x44, 0x99, 0x4b,
-	0x12, 0x73, 0x49, 0x3b, 0x18, 0x77, 0xf6, 0x2f, 0xa6, 0x75, 0xed, 0x1f, 0xe9, 0xb9, 0x40, 0xff,
-	0x8c, 0xa5, 0x0b, 0xcc, 0x91, 0xe1, 0xad, 0x7c, 0xdc, 0xb3, 0xce, 0x69, 0x1d, 0xe3, 0xc2, 0x9a,
-	0x2c, 0x87, 0xc2, 0xf0, 0x54, 0x18, 0x45, 0xbc, 0x43, 0xa3, 0x88, 0x6b, 0x6d, 0x8e, 0x3a, 0x18,
-	0x55, 0xbc, 0x1d, 0x51, 0x08, 0xf5, 0xf8, 0x53, 0x05, 0x38, 0x92, 0x61, 0xbd, 0xc1, 0xa5, 0xcd,
-	0x86, 0x93, 0x11, 0x19, 0x1d, 0x7c, 0x3d, 0x92, 0x55, 0xa0, 0x84, 0x64, 0x27, 0xd5, 0x54, 0x1f,
-	0x53, 0x64, 0x39, 0x49, 0x60, 0xf3, 0x17, 0xc2, 0x5b, 0x5d, 0x60, 0xc6, 0x07, 0x7c, 0x63, 0x6d,
-	0xb5, 0x1e, 0xa4, 0xa6, 0xda, 0x30, 0xab, 0xfc, 0xe8, 0x2a, 0xac, 0x44, 0xaf, 0x55, 0xb8, 0xf8,
-	0xdb, 0x52, 0x83, 0xe2, 0x9b, 0xeb, 0x76, 0x3e, 0xfc, 0x57, 0xce, 0x35, 0x5a, 0xb9, 0x7e, 0x25,
-	0x5a, 0x52, 0xbb, 0xbc, 0xf3, 0x31, 0xde, 0xe0, 0xce, 0xe1, 0xf9, 0xdc, 0x44, 0x97, 0x73, 0x13,
-	0xfd, 0x9c, 0x9b, 0xe8, 0xcb, 0xc2, 0xcc, 0x5c, 0x2e, 0xcc, 0xcc, 0xf7, 0x85, 0x99, 0x79, 0xad,
-	0x7f, 0x63, 0x18, 0x9c, 0x10, 0x8f, 0x27, 0xfb, 0x2a, 0xc6, 0x21, 0x85, 0x5e, 0x56, 0xfa, 0x72,
-	0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x89, 0xaa, 0x66, 0x4e, 0x04, 0x00, 0x00,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0x8d, 0x5f, 0x80, 0xa7, 0x4c, 0xf8, 0x74, 0xf2, 0x84, 0x31, 0x4f, 0x6e, 0x70, 0x69, 0x15,
+	0xa1, 0xc6, 0xe6, 0xa3, 0x1f, 0x2a, 0x0b, 0xa4, 0x44, 0xa2, 0xa8, 0x0b, 0x57, 0x95, 0x5b, 0xba,
+	0xe8, 0xa2, 0xc8, 0x49, 0x86, 0xc1, 0x02, 0x7b, 0x2c, 0xcf, 0x84, 0x92, 0x45, 0xa5, 0xaa, 0xcb,
+	0xae, 0xba, 0xec, 0x4f, 0xe8, 0x92, 0x05, 0xfd, 0x0f, 0x88, 0x15, 0x42, 0xad, 0xd4, 0x55, 0x55,
+	0xc1, 0x82, 0xbf, 0x51, 0x79, 0x66, 0x6c, 0x14, 0x63, 0x52, 0xda, 0x6e, 0x92, 0x78, 0xee, 0xb9,
+	0xf7, 0xdc, 0x7b, 0xe6, 0x9e, 0x18, 0xfc, 0xdf, 0xc2, 0xc4, 0xc3, 0xc4, 0x74, 0x3a, 0x74, 0xcb,
+	0xdc, 0x5d, 0x68, 0x42, 0xea, 0x2c, 0x98, 0x74, 0xcf, 0x08, 0x42, 0x4c, 0xb1, 0x5c, 0xe2, 0x51,
+	0x23, 0x8a, 0x1a, 0x22, 0xaa, 0x96, 0x11, 0x46, 0x98, 0xc5, 0xcd, 0xe8, 0x17, 0x87, 0xaa, 0x53,
+	0x08, 0x63, 0xb4, 0x03, 0x4d, 0xf6, 0xd4, 0xec, 0x6c, 0x9a, 0x8e, 0xdf, 0x8d, 0x43, 0xbc, 0xca,
+	0x06, 0xcf, 0x11, 0x25, 0x79, 0x68, 0x52, 0xd0, 0x7b, 0x04, 0x99, 0xbb, 0x0b, 0xd1, 0x97, 0x08,
+	0x4c, 0x38, 0x9e, 0xeb, 0x63, 0x93, 0x7d, 0x8a, 0x23, 0x2d, 0xab, 0x55, 0xd6, 0x19, 0x8f, 0xdf,
+	0xcc, 0x8a, 0x6f, 0x42, 0xe8, 0x39, 0xe1, 0x36, 0xa4, 0x1c, 0xa4, 0x9f, 0x48, 0x60, 0xcc, 0x22,
+	0x68, 0x3d, 0x68, 0x3b, 0x14, 0x3e, 0x75, 0x42, 0xc7, 0x23, 0xf2, 0x7d, 0x50, 0x88, 0x72, 0x70,
+	0xe8, 0xd2, 0xae, 0x22, 0x55, 0xa4, 0x6a, 0xa1, 0xa1, 0x9c, 0x1c, 0xd4, 0xca, 0xa2, 0xd3, 0x7a,
+	0xbb, 0x1d, 0x42, 0x42, 0x9e, 0xd1, 0xd0, 0xf5, 0x91, 0x7d, 0x01, 0x95, 0x57, 0xc0, 0x50, 0xc0,
+	0x2a, 0x28, 0xff, 0x54, 0xa4, 0x6a, 0x71, 0x71, 0xda, 0xc8, 0x90, 0xcb, 0xe0, 0x24, 0x8d, 0xc2,
+	0xe1, 0xf7, 0x1b, 0xb9, 0x4f, 0xe7, 0xfb, 0x73, 0x92, 0x2d, 0xb2, 0x96, 0xd7, 0x4e, 0x0e, 0x6a,
+	0x63, 0x3c, 0xa5, 0x46, 0xda, 0xdb, 0x95, 0x79, 0xe3, 0xee, 0x83, 0x77, 0xe7, 0xfb, 0x73, 0x17,
+	0x14, 0xef, 0xcf, 0xf7, 0xe7, 0x66, 0x2e, 0x10, 0xe6, 0x1e, 0x1f, 0x2e, 0x35, 0x80, 0x6e, 0x80,
+	0xc9, 0xd4, 0x91, 0x0d, 0x49, 0x80, 0x7d, 0x02, 0x97, 0x4b, 0x19, 0x1c, 0xfa, 0x47, 0x09, 0x8c,
+	0x5b, 0x04, 0x3d, 0xc1, 0x7e, 0x9d, 0x62, 0xcf, 0x6d, 0xad, 0xee, 0xc1, 0x96, 0x3c, 0x0f, 0x86,
+	0x88, 0x8b, 0x7c, 0x18, 0xfe, 0x52, 0x02, 0x81, 0x93, 0x57, 0xc1, 0x80, 0x47, 0x50, 0x34, 0x7d,
+	0xbe, 0x5a, 0x5c, 0x2c, 0x1b, 0x7c, 0x03, 0x8c, 0x78, 0x03, 0x8c, 0xba, 0xdf, 0x6d, 0x4c, 0x1f,
+	0x1d, 0xd4, 0xc4, 0x25, 0x1b, 0x4d, 0x87, 0xc0, 0x44, 0x16, 0x8b, 0x20, 0x9b, 0xa5, 0x2f, 0x17,
+	0xa3, 0x99, 0x45, 0x4d, 0x7d, 0x1d, 0x94, 0x7a, 0xda, 0xb2, 0x21, 0xe9, 0xec, 0x50, 0xb9, 0x0c,
+	0x06, 0x61, 0x18, 0x62, 0xd1, 0x9b, 0xcd, 0x1f, 0xe4, 0x2a, 0x18, 0x08, 0x21, 0x09, 0x84, 0xfc,
+	0x99, 0x0d, 0xd8, 0x0c, 0xa1, 0xbf, 0x02, 0x4a, 0x7a, 0xe0, 0x58, 0x22, 0xb9, 0x01, 0xfe, 0x0d,
+	0x19, 0x0b, 0x51, 0x24, 0x36, 0x49, 0x35, 0xf3, 0x1e, 0x33, 0xda, 0xb2, 0xe3, 0x44, 0xfd, 0xb3,
+	0x04, 0x26, 0x2c, 0x82, 0x2c, 0x17, 0x85, 0x0e, 0x85, 0xf5, 0x56, 0x0b, 0x77, 0x7c, 0xfa, 0x07,
+	0x92, 0xce, 0x80, 0x61, 0x87, 0x27, 0x6f, 0xd0, 0x6e, 0x00, 0xd9, 0x64, 0x05, 0xbb, 0x28, 0xce,
+	0x9e, 0x77, 0x03, 0x28, 0xaf, 0x80, 0xf1, 0x18, 0xe2, 0xfa, 0x2e, 0xdd, 0xf0, 0x08, 0x52, 0xf2,
+	0x7d, 0x04, 0x18, 0x15, 0xe8, 0xc7, 0xbe, 0x4b, 0x2d, 0x82, 0x7a, 0xe5, 0x7e, 0x01, 0xa6, 0x2e,
+	0xb5, 0x9d, 0x08, 0xf3, 0x10, 0x8c, 0x30, 0x86, 0x50, 0x1c, 0x28, 0x52, 0x1f, 0x9a, 0xe1, 0x08,
+	0x1a, 0xa7, 0xea, 0x5f, 0x24, 0xa0, 0x24, 0x2b, 0xf9, 0x08, 0x42, 0x8b, 0x79, 0xf0, 0x2f, 0xfd,
+	0xb6, 0x96, 0xf2, 0xdb, 0x6c, 0xe6, 0x3d, 0xa5, 0xd8, 0xb2, 0x8c, 0x97, 0xe1, 0xb2, 0xd9, 0x3e,
+	0x2e, 0x4b, 0x2a, 0xea, 0x3a, 0xa8, 0x5c, 0x35, 0x55, 0x3c, 0xfa, 0xe2, 0xd7, 0x3c, 0xc8, 0x5b,
+	0x04, 0xc9, 0xaf, 0xc1, 0x70, 0xcf, 0xbf, 0x4c, 0x76, 0xb7, 0x29, 0xdf, 0xaa, 0x77, 0xae, 0x83,
+	0x4a, 0x64, 0x2e, 0x1d, 0x5d, 0x76, 0xb7, 0x0c, 0xc1, 0x48, 0xaf, 0xb3, 0x6f, 0x5d, 0x55, 0xb3,
+	0x07, 0xa6, 0xd6, 0xae, 0x05, 0x4b, 0xb6, 0x63, 0x0b, 0x8c, 0xa6, 0xd6, 0xfd, 0xf6, 0x55, 0x05,
+	0x7a, 0x71, 0xaa, 0x71, 0x3d, 0x5c, 0xc2, 0xf4, 0x06, 0xfc, 0x97, 0xbd, 0x48, 0xb5, 0xfe, 0x62,
+	0xa5, 0xe0, 0xea, 0xbd, 0xdf, 0x82, 0xc7, 0xf4, 0xea, 0xe0, 0xdb, 0x68, 0x79, 0x1a, 0x4b, 0x87,
+	0xa7, 0x9a, 0x74, 0x7c, 0xaa, 0x49, 0x3f, 0x4e, 0x35, 0xe9, 0xc3, 0x99, 0x96, 0x3b, 0x3e, 0xd3,
+	0x72, 0xdf, 0xce, 0xb4, 0xdc, 0x4b, 0xf1, 0x7e, 0x23, 0xed, 0x6d, 0xc3, 0xc5, 0xf1, 0xf6, 0x44,
+	0xf6, 0x25, 0xcd, 0x21, 0xe6, 0x91, 0xa5, 0x9f, 0x03, 0x00, 0xbb, 0x91, 0xe0, 0xd0, 0x67, 0x07,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -344,6 +569,12 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// NonAtomicExec allows users to submit multiple messages for non-atomic execution.
 	NonAtomicExec(ctx context.Context, in *MsgNonAtomicExec, opts ...grpc.CallOption) (*MsgNonAtomicExecResponse, error)
+	// MigrateAccount migrates a BaseAccount or a vesting account to an x/accounts
+	// account type, keeping its address, account number and balances.
+	MigrateAccount(ctx context.Context, in *MsgMigrateAccount, opts ...grpc.CallOption) (*MsgMigrateAccountResponse, error)
+	// UpdateFeeMarketParams defines a (governance) operation for updating the
+	// fee market parameters. The authority defaults to the x/gov module account.
+	UpdateFeeMarketParams(ctx context.Context, in *MsgUpdateFeeMarketParams, opts ...grpc.CallOption) (*MsgUpdateFeeMarketParamsResponse, error)
 }
 
 type msgClient struct {
@@ -372,6 +603,24 @@ func (c *msgClient) NonAtomicExec(ctx context.Context, in *MsgNonAtomicExec, opt
 	return out, nil
 }
 
+func (c *msgClient) MigrateAccount(ctx context.Context, in *MsgMigrateAccount, opts ...grpc.CallOption) (*MsgMigrateAccountResponse, error) {
+	out := new(MsgMigrateAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/MigrateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeMarketParams(ctx context.Context, in *MsgUpdateFeeMarketParams, opts ...grpc.CallOption) (*MsgUpdateFeeMarketParamsResponse, error) {
+	out := new(MsgUpdateFeeMarketParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/UpdateFeeMarketParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
@@ -379,6 +628,12 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// NonAtomicExec allows users to submit multiple messages for non-atomic execution.
 	NonAtomicExec(context.Context, *MsgNonAtomicExec) (*MsgNonAtomicExecResponse, error)
+	// MigrateAccount migrates a BaseAccount or a vesting account to an x/accounts
+	// account type, keeping its address, account number and balances.
+	MigrateAccount(context.Context, *MsgMigrateAccount) (*MsgMigrateAccountResponse, error)
+	// UpdateFeeMarketParams defines a (governance) operation for updating the
+	// fee market parameters. The authority defaults to the x/gov module account.
+	UpdateFeeMarketParams(context.Context, *MsgUpdateFeeMarketParams) (*MsgUpdateFeeMarketParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -391,6 +646,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) NonAtomicExec(ctx context.Context, req *MsgNonAtomicExec) (*MsgNonAtomicExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NonAtomicExec not implemented")
 }
+func (*UnimplementedMsgServer) MigrateAccount(ctx context.Context, req *MsgMigrateAccount) (*MsgMigrateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAccount not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFeeMarketParams(ctx context.Context, req *MsgUpdateFeeMarketParams) (*MsgUpdateFeeMarketParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeMarketParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -432,6 +693,42 @@ func _Msg_NonAtomicExec_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/MigrateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAccount(ctx, req.(*MsgMigrateAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeMarketParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeMarketParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeMarketParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/UpdateFeeMarketParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeMarketParams(ctx, req.(*MsgUpdateFeeMarketParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -444,6 +741,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NonAtomicExec",
 			Handler:    _Msg_NonAtomicExec_Handler,
 		},
+		{
+			MethodName: "MigrateAccount",
+			Handler:    _Msg_MigrateAccount_Handler,
+		},
+		{
+			MethodName: "UpdateFeeMarketParams",
+			Handler:    _Msg_UpdateFeeMarketParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -635,64 +940,211 @@ func (m *MsgNonAtomicExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgMigrateAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgMigrateAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AccountInitMsg != nil {
+		{
+			size, err := m.AccountInitMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.AccountType) > 0 {
+		i -= len(m.AccountType)
+		copy(dAtA[i:], m.AccountType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgNonAtomicExec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMigrateAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.InitResponse != nil {
+		{
+			size, err := m.InitResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NonAtomicExecResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateFeeMarketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeMarketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeMarketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeMarketParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeMarketParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeMarketParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgNonAtomicExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NonAtomicExecResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Error)
@@ -718,16 +1170,355 @@ func (m *MsgNonAtomicExecResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgMigrateAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccountInitMsg != nil {
+		l = m.AccountInitMsg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitResponse != nil {
+		l = m.InitResponse.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateFeeMarketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateFeeMarketParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNonAtomicExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNonAtomicExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNonAtomicExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &any.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *NonAtomicExecResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -750,15 +1541,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: NonAtomicExecResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NonAtomicExecResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -786,11 +1577,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -817,7 +1608,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Resp == nil {
+				m.Resp = &any.Any{}
+			}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -842,7 +1636,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgNonAtomicExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -865,12 +1659,46 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNonAtomicExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNonAtomicExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &NonAtomicExecResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -892,7 +1720,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNonAtomicExec) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -915,10 +1743,10 @@ func (m *MsgNonAtomicExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNonAtomicExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNonAtomicExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -955,7 +1783,39 @@ func (m *MsgNonAtomicExec) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountInitMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -982,8 +1842,10 @@ func (m *MsgNonAtomicExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msgs = append(m.Msgs, &any.Any{})
-			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AccountInitMsg == nil {
+				m.AccountInitMsg = &any.Any{}
+			}
+			if err := m.AccountInitMsg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1008,7 +1870,7 @@ func (m *MsgNonAtomicExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NonAtomicExecResult) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1031,47 +1893,15 @@ func (m *NonAtomicExecResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NonAtomicExecResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NonAtomicExecResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitResponse", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1098,10 +1928,10 @@ func (m *NonAtomicExecResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resp == nil {
-				m.Resp = &any.Any{}
+			if m.InitResponse == nil {
+				m.InitResponse = &any.Any{}
 			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1126,7 +1956,7 @@ func (m *NonAtomicExecResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNonAtomicExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeeMarketParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1149,15 +1979,47 @@ func (m *MsgNonAtomicExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNonAtomicExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeeMarketParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNonAtomicExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1184,8 +2046,7 @@ func (m *MsgNonAtomicExecResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, &NonAtomicExecResult{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1210,6 +2071,56 @@ func (m *MsgNonAtomicExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateFeeMarketParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeMarketParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeMarketParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
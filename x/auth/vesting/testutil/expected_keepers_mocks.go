@@ -120,6 +120,21 @@ func (mr *MockAccountsModKeeperMockRecorder) IsAccountsModuleAccount(ctx, accoun
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAccountsModuleAccount", reflect.TypeOf((*MockAccountsModKeeper)(nil).IsAccountsModuleAccount), ctx, accountAddr)
 }
 
+// MigrateLegacyAccount mocks base method.
+func (m *MockAccountsModKeeper) MigrateLegacyAccount(ctx context.Context, addr []byte, accNum uint64, accType string, msg protoiface.MessageV1, legacyAcc types.AccountI) (protoiface.MessageV1, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateLegacyAccount", ctx, addr, accNum, accType, msg, legacyAcc)
+	ret0, _ := ret[0].(protoiface.MessageV1)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateLegacyAccount indicates an expected call of MigrateLegacyAccount.
+func (mr *MockAccountsModKeeperMockRecorder) MigrateLegacyAccount(ctx, addr, accNum, accType, msg, legacyAcc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateLegacyAccount", reflect.TypeOf((*MockAccountsModKeeper)(nil).MigrateLegacyAccount), ctx, addr, accNum, accType, msg, legacyAcc)
+}
+
 // SendModuleMessageUntyped mocks base method.
 func (m *MockAccountsModKeeper) SendModuleMessageUntyped(ctx context.Context, sender []byte, msg protoiface.MessageV1) (protoiface.MessageV1, error) {
 	m.ctrl.T.Helper()
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
 	authtypes "cosmossdk.io/x/auth/types"
 	vestexported "cosmossdk.io/x/auth/vesting/exported"
 
@@ -18,6 +19,11 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+
+	_ accountstd.LegacyVestingAccount         = (*ContinuousVestingAccount)(nil)
+	_ accountstd.LegacyPeriodicVestingAccount = (*PeriodicVestingAccount)(nil)
+	_ accountstd.LegacyVestingAccount         = (*DelayedVestingAccount)(nil)
+	_ accountstd.LegacyVestingAccount         = (*PermanentLockedAccount)(nil)
 )
 
 // Base Vesting Account
@@ -355,6 +361,16 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// GetLegacyVestingPeriods returns the vesting periods of the account, carried over when it's
+// migrated to an x/accounts lockup account.
+func (pva PeriodicVestingAccount) GetLegacyVestingPeriods() []accountstd.LegacyVestingPeriod {
+	periods := make([]accountstd.LegacyVestingPeriod, len(pva.VestingPeriods))
+	for i, period := range pva.VestingPeriods {
+		periods[i] = accountstd.LegacyVestingPeriod{Length: period.Length, Amount: period.Amount}
+	}
+	return periods
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
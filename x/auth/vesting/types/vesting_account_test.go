@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/accounts/accountstd"
 	authcodec "cosmossdk.io/x/auth/codec"
 	"cosmossdk.io/x/auth/keeper"
 	authtypes "cosmossdk.io/x/auth/types"
@@ -547,6 +548,24 @@ func TestGetVestingCoinsPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, emptyCoins, vestingCoins)
 }
 
+func TestGetLegacyVestingPeriodsPeriodicVestingAcc(t *testing.T) {
+	now := time.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	pva, err := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+	require.NoError(t, err)
+
+	// require the periods read by x/accounts to match the vesting periods
+	require.Equal(t, []accountstd.LegacyVestingPeriod{
+		{Length: periods[0].Length, Amount: periods[0].Amount},
+		{Length: periods[1].Length, Amount: periods[1].Amount},
+	}, pva.GetLegacyVestingPeriods())
+}
+
 func TestSpendableCoinsPeriodicVestingAcc(t *testing.T) {
 	now := time.Now()
 	endTime := now.Add(24 * time.Hour)
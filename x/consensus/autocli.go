@@ -21,6 +21,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current consensus parameters",
 				},
+				{
+					RpcMethod: "GasSchedule",
+					Use:       "gas-schedule",
+					Short:     "Query the current gas costs of the module stores",
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"comet": cmtservice.CometBFTAutoCLIDescriptor,
@@ -42,6 +47,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "UpdateGasSchedule",
+					Use:       "update-gas-schedule-proposal [schedule]",
+					Short:     "Submit a proposal to update the gas costs of the module stores. Note: the entire schedule must be provided.",
+					Example:   fmt.Sprintf(`%s tx consensus update-gas-schedule-proposal '{"default_config":{...},"stores":[{"store":"acc","config":{...}}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "schedule"},
+					},
+					GovProposal: true,
+				},
 			},
 		},
 	}
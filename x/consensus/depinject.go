@@ -55,10 +55,16 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic(err)
 	}
 
-	k := keeper.NewKeeper(in.Cdc, in.Environment, authorityAddr)
+	// the stores are only known once the app is built.
+	var bapp *baseapp.BaseApp
+	hasStore := func(name string) bool { return bapp != nil && bapp.HasStore(name) }
+
+	k := keeper.NewKeeper(in.Cdc, in.Environment, authorityAddr, keeper.WithStoreLookup(hasStore))
 	m := NewAppModule(in.Cdc, k)
 	baseappOpt := func(app *baseapp.BaseApp) {
+		bapp = app
 		app.SetParamStore(k.ParamsStore)
+		app.SetGasConfigStore(k)
 	}
 
 	return ModuleOutputs{
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
@@ -12,6 +13,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
+	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/consensus/exported"
 	"cosmossdk.io/x/consensus/types"
 
@@ -25,23 +27,58 @@ type Keeper struct {
 
 	authority   string
 	ParamsStore collections.Item[cmtproto.ConsensusParams]
+	// GasScheduleStore holds the gas costs of the module stores, see GasConfigs.
+	GasScheduleStore collections.Item[types.GasSchedule]
+
+	// hasStore reports whether the app has a store of the given name, see
+	// WithStoreLookup.
+	hasStore func(name string) bool
+}
+
+// Option configures the Keeper.
+type Option func(*Keeper)
+
+// WithStoreLookup makes the keeper reject the gas schedules configuring the
+// costs of stores for which hasStore returns false, e.g. BaseApp.HasStore.
+func WithStoreLookup(hasStore func(name string) bool) Option {
+	return func(k *Keeper) {
+		k.hasStore = hasStore
+	}
 }
 
 var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
 
-func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, authority string) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, authority string, opts ...Option) Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
-	return Keeper{
-		Environment: env,
-		authority:   authority,
-		ParamsStore: collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+	k := Keeper{
+		Environment:      env,
+		authority:        authority,
+		ParamsStore:      collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		GasScheduleStore: collections.NewItem(sb, collections.NewPrefix("GasSchedule"), "gas_schedule", codec.CollValue[types.GasSchedule](cdc)),
 	}
+	for _, opt := range opts {
+		opt(&k)
+	}
+
+	return k
 }
 
 func (k *Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// GasConfigs returns the gas costs of the stores without costs of their own
+// and the gas costs of the stores by store name, as set by governance.
+func (k Keeper) GasConfigs(ctx context.Context) (storetypes.GasConfig, map[string]storetypes.GasConfig, error) {
+	schedule, err := k.GasScheduleStore.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return storetypes.GasConfig{}, nil, err
+	}
+
+	defaultConfig, stores := schedule.StoreGasConfigs()
+	return defaultConfig, stores, nil
+}
+
 // Querier
 
 var _ types.QueryServer = Keeper{}
@@ -56,6 +93,16 @@ func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types
 	return &types.QueryParamsResponse{Params: &params}, nil
 }
 
+// GasSchedule queries the gas schedule of consensus module
+func (k Keeper) GasSchedule(ctx context.Context, _ *types.QueryGasScheduleRequest) (*types.QueryGasScheduleResponse, error) {
+	schedule, err := k.GasScheduleStore.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGasScheduleResponse{Schedule: &schedule}, nil
+}
+
 // MsgServer
 
 var _ types.MsgServer = Keeper{}
@@ -99,3 +146,33 @@ func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k Keeper) UpdateGasSchedule(ctx context.Context, msg *types.MsgUpdateGasSchedule) (*types.MsgUpdateGasScheduleResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Schedule.Validate(); err != nil {
+		return nil, err
+	}
+	if k.hasStore != nil {
+		for _, store := range msg.Schedule.Stores {
+			if !k.hasStore(store.Store) {
+				return nil, fmt.Errorf("unknown store %s", store.Store)
+			}
+		}
+	}
+
+	if err := k.GasScheduleStore.Set(ctx, msg.Schedule); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		"update_gas_schedule",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("schedule", msg.Schedule.String())); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGasScheduleResponse{}, nil
+}
@@ -29,7 +29,7 @@ type KeeperTestSuite struct {
 	ctx                   sdk.Context
 	consensusParamsKeeper *consensusparamkeeper.Keeper
 
-	queryClient types.QueryClient
+	queryClient    types.QueryClient
 }
 
 func getDuration(d time.Duration) *time.Duration {
@@ -47,7 +47,8 @@ func (s *KeeperTestSuite) SetupTest(enabledFeatures bool) {
 	authority, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
 	s.Require().NoError(err)
 
-	keeper := consensusparamkeeper.NewKeeper(encCfg.Codec, env, authority)
+	hasStore := func(name string) bool { return name == "acc" || name == "bank" }
+	keeper := consensusparamkeeper.NewKeeper(encCfg.Codec, env, authority, consensusparamkeeper.WithStoreLookup(hasStore))
 
 	s.ctx = ctx
 	s.consensusParamsKeeper = &keeper
@@ -603,3 +604,119 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateGasSchedule() {
+	s.SetupTest(false)
+
+	// the default costs apply until a schedule is set.
+	defaultConfig, stores, err := s.consensusParamsKeeper.GasConfigs(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(storetypes.KVGasConfig(), defaultConfig)
+	s.Require().Empty(stores)
+
+	accConfig := storetypes.KVGasConfig()
+	accConfig.ReadCostFlat = 100
+	newDefaultConfig := storetypes.KVGasConfig()
+	newDefaultConfig.ReadCostFlat = 10
+	newDefault := types.NewGasConfig(newDefaultConfig)
+	zeroConfig := accConfig
+	zeroConfig.WriteCostPerByte = 0
+	overflowingConfig := accConfig
+	overflowingConfig.ReadCostPerByte = types.MaxGasCost + 1
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateGasSchedule
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: "invalid",
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "empty store name",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Schedule:  types.GasSchedule{Stores: []types.StoreGasConfig{{Config: types.NewGasConfig(accConfig)}}},
+			},
+			expErrMsg: "store name cannot be empty",
+		},
+		{
+			name: "duplicate store",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Schedule: types.GasSchedule{Stores: []types.StoreGasConfig{
+					{Store: "acc", Config: types.NewGasConfig(accConfig)},
+					{Store: "acc"},
+				}},
+			},
+			expErrMsg: "duplicate gas config for store acc",
+		},
+		{
+			name: "zero cost",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Schedule:  types.GasSchedule{Stores: []types.StoreGasConfig{{Store: "acc", Config: types.NewGasConfig(zeroConfig)}}},
+			},
+			expErrMsg: "invalid gas config for store acc: write cost per byte must be positive",
+		},
+		{
+			name: "invalid default config",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Schedule: types.GasSchedule{
+					DefaultConfig: &types.GasConfig{},
+				},
+			},
+			expErrMsg: "invalid default gas config: has cost must be positive",
+		},
+		{
+			name: "overflowing cost",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Schedule:  types.GasSchedule{Stores: []types.StoreGasConfig{{Store: "acc", Config: types.NewGasConfig(overflowingConfig)}}},
+			},
+			expErrMsg: "read cost per byte must be at most 4294967295",
+		},
+		{
+			name: "unknown store",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Schedule:  types.GasSchedule{Stores: []types.StoreGasConfig{{Store: "accounts", Config: types.NewGasConfig(accConfig)}}},
+			},
+			expErrMsg: "unknown store accounts",
+		},
+		{
+			name: "valid schedule",
+			input: &types.MsgUpdateGasSchedule{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Schedule: types.GasSchedule{
+					DefaultConfig: &newDefault,
+					Stores:        []types.StoreGasConfig{{Store: "acc", Config: types.NewGasConfig(accConfig)}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.consensusParamsKeeper.UpdateGasSchedule(s.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.queryClient.GasSchedule(s.ctx, &types.QueryGasScheduleRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.input.Schedule, *res.Schedule)
+
+			defaultConfig, stores, err := s.consensusParamsKeeper.GasConfigs(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(newDefaultConfig, defaultConfig)
+			s.Require().Equal(map[string]storetypes.GasConfig{"acc": accConfig}, stores)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// NewGasConfig returns the GasConfig holding the costs of a store gas config.
+func NewGasConfig(gc storetypes.GasConfig) GasConfig {
+	return GasConfig{
+		HasCost:          gc.HasCost,
+		DeleteCost:       gc.DeleteCost,
+		ReadCostFlat:     gc.ReadCostFlat,
+		ReadCostPerByte:  gc.ReadCostPerByte,
+		WriteCostFlat:    gc.WriteCostFlat,
+		WriteCostPerByte: gc.WriteCostPerByte,
+		IterNextCostFlat: gc.IterNextCostFlat,
+	}
+}
+
+// ToStoreGasConfig returns the store gas config holding the costs of the GasConfig.
+func (c GasConfig) ToStoreGasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{
+		HasCost:          c.HasCost,
+		DeleteCost:       c.DeleteCost,
+		ReadCostFlat:     c.ReadCostFlat,
+		ReadCostPerByte:  c.ReadCostPerByte,
+		WriteCostFlat:    c.WriteCostFlat,
+		WriteCostPerByte: c.WriteCostPerByte,
+		IterNextCostFlat: c.IterNextCostFlat,
+	}
+}
+
+// MaxGasCost is the maximum gas cost of a store operation, so that the cost of
+// an operation on up to 4 GiB of data can't overflow.
+const MaxGasCost = math.MaxUint32
+
+// Validate checks that every cost of the config is positive and at most
+// MaxGasCost.
+func (c GasConfig) Validate() error {
+	costs := []struct {
+		name string
+		cost uint64
+	}{
+		{"has cost", c.HasCost},
+		{"delete cost", c.DeleteCost},
+		{"read cost flat", c.ReadCostFlat},
+		{"read cost per byte", c.ReadCostPerByte},
+		{"write cost flat", c.WriteCostFlat},
+		{"write cost per byte", c.WriteCostPerByte},
+		{"iter next cost flat", c.IterNextCostFlat},
+	}
+	for _, c := range costs {
+		if c.cost == 0 {
+			return fmt.Errorf("%s must be positive", c.name)
+		}
+		if c.cost > MaxGasCost {
+			return fmt.Errorf("%s must be at most %d, got %d", c.name, uint64(MaxGasCost), c.cost)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the costs of the schedule are valid, and that every
+// store of the schedule has a name and is configured once.
+func (s GasSchedule) Validate() error {
+	if s.DefaultConfig != nil {
+		if err := s.DefaultConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid default gas config: %w", err)
+		}
+	}
+
+	seen := make(map[string]bool, len(s.Stores))
+	for _, store := range s.Stores {
+		if store.Store == "" {
+			return errors.New("store name cannot be empty")
+		}
+		if seen[store.Store] {
+			return fmt.Errorf("duplicate gas config for store %s", store.Store)
+		}
+		seen[store.Store] = true
+
+		if err := store.Config.Validate(); err != nil {
+			return fmt.Errorf("invalid gas config for store %s: %w", store.Store, err)
+		}
+	}
+
+	return nil
+}
+
+// StoreGasConfigs returns the gas costs of the stores without costs of their
+// own and the gas costs of the stores by store name.
+func (s GasSchedule) StoreGasConfigs() (storetypes.GasConfig, map[string]storetypes.GasConfig) {
+	defaultConfig := storetypes.KVGasConfig()
+	if s.DefaultConfig != nil {
+		defaultConfig = s.DefaultConfig.ToStoreGasConfig()
+	}
+
+	stores := make(map[string]storetypes.GasConfig, len(s.Stores))
+	for _, store := range s.Stores {
+		stores[store.Store] = store.Config.ToStoreGasConfig()
+	}
+
+	return defaultConfig, stores
+}
@@ -0,0 +1,998 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/consensus/v1/gas.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasConfig defines the gas costs of the operations on a store.
+type GasConfig struct {
+	HasCost          uint64 `protobuf:"varint,1,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty"`
+	DeleteCost       uint64 `protobuf:"varint,2,opt,name=delete_cost,json=deleteCost,proto3" json:"delete_cost,omitempty"`
+	ReadCostFlat     uint64 `protobuf:"varint,3,opt,name=read_cost_flat,json=readCostFlat,proto3" json:"read_cost_flat,omitempty"`
+	ReadCostPerByte  uint64 `protobuf:"varint,4,opt,name=read_cost_per_byte,json=readCostPerByte,proto3" json:"read_cost_per_byte,omitempty"`
+	WriteCostFlat    uint64 `protobuf:"varint,5,opt,name=write_cost_flat,json=writeCostFlat,proto3" json:"write_cost_flat,omitempty"`
+	WriteCostPerByte uint64 `protobuf:"varint,6,opt,name=write_cost_per_byte,json=writeCostPerByte,proto3" json:"write_cost_per_byte,omitempty"`
+	IterNextCostFlat uint64 `protobuf:"varint,7,opt,name=iter_next_cost_flat,json=iterNextCostFlat,proto3" json:"iter_next_cost_flat,omitempty"`
+}
+
+func (m *GasConfig) Reset()         { *m = GasConfig{} }
+func (m *GasConfig) String() string { return proto.CompactTextString(m) }
+func (*GasConfig) ProtoMessage()    {}
+func (*GasConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_984754f3fb373eeb, []int{0}
+}
+func (m *GasConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasConfig.Merge(m, src)
+}
+func (m *GasConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasConfig proto.InternalMessageInfo
+
+func (m *GasConfig) GetHasCost() uint64 {
+	if m != nil {
+		return m.HasCost
+	}
+	return 0
+}
+
+func (m *GasConfig) GetDeleteCost() uint64 {
+	if m != nil {
+		return m.DeleteCost
+	}
+	return 0
+}
+
+func (m *GasConfig) GetReadCostFlat() uint64 {
+	if m != nil {
+		return m.ReadCostFlat
+	}
+	return 0
+}
+
+func (m *GasConfig) GetReadCostPerByte() uint64 {
+	if m != nil {
+		return m.ReadCostPerByte
+	}
+	return 0
+}
+
+func (m *GasConfig) GetWriteCostFlat() uint64 {
+	if m != nil {
+		return m.WriteCostFlat
+	}
+	return 0
+}
+
+func (m *GasConfig) GetWriteCostPerByte() uint64 {
+	if m != nil {
+		return m.WriteCostPerByte
+	}
+	return 0
+}
+
+func (m *GasConfig) GetIterNextCostFlat() uint64 {
+	if m != nil {
+		return m.IterNextCostFlat
+	}
+	return 0
+}
+
+// StoreGasConfig defines the gas costs of the operations on a module store.
+type StoreGasConfig struct {
+	// store is the name of the store key of the module store.
+	Store  string    `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Config GasConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *StoreGasConfig) Reset()         { *m = StoreGasConfig{} }
+func (m *StoreGasConfig) String() string { return proto.CompactTextString(m) }
+func (*StoreGasConfig) ProtoMessage()    {}
+func (*StoreGasConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_984754f3fb373eeb, []int{1}
+}
+func (m *StoreGasConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreGasConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreGasConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreGasConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreGasConfig.Merge(m, src)
+}
+func (m *StoreGasConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreGasConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreGasConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreGasConfig proto.InternalMessageInfo
+
+func (m *StoreGasConfig) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *StoreGasConfig) GetConfig() GasConfig {
+	if m != nil {
+		return m.Config
+	}
+	return GasConfig{}
+}
+
+// GasSchedule defines the gas costs of the operations on the module stores.
+type GasSchedule struct {
+	// default_config defines the gas costs of the stores which have no costs of
+	// their own. When unset, the default costs of the SDK apply.
+	DefaultConfig *GasConfig `protobuf:"bytes,1,opt,name=default_config,json=defaultConfig,proto3" json:"default_config,omitempty"`
+	// stores defines the gas costs of individual stores.
+	Stores []StoreGasConfig `protobuf:"bytes,2,rep,name=stores,proto3" json:"stores"`
+}
+
+func (m *GasSchedule) Reset()         { *m = GasSchedule{} }
+func (m *GasSchedule) String() string { return proto.CompactTextString(m) }
+func (*GasSchedule) ProtoMessage()    {}
+func (*GasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_984754f3fb373eeb, []int{2}
+}
+func (m *GasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasSchedule.Merge(m, src)
+}
+func (m *GasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasSchedule proto.InternalMessageInfo
+
+func (m *GasSchedule) GetDefaultConfig() *GasConfig {
+	if m != nil {
+		return m.DefaultConfig
+	}
+	return nil
+}
+
+func (m *GasSchedule) GetStores() []StoreGasConfig {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GasConfig)(nil), "cosmos.consensus.v1.GasConfig")
+	proto.RegisterType((*StoreGasConfig)(nil), "cosmos.consensus.v1.StoreGasConfig")
+	proto.RegisterType((*GasSchedule)(nil), "cosmos.consensus.v1.GasSchedule")
+}
+
+func init() { proto.RegisterFile("cosmos/consensus/v1/gas.proto", fileDescriptor_984754f3fb373eeb) }
+
+var fileDescriptor_984754f3fb373eeb = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x69, 0xeb, 0x92, 0x67, 0x9a, 0xc2, 0xa5, 0x83, 0xa9, 0xc4, 0xb5, 0x0a, 0x08,
+	0x55, 0xa0, 0xda, 0x6a, 0x59, 0x58, 0x09, 0xa2, 0xdd, 0x10, 0x72, 0x37, 0x16, 0xeb, 0x6a, 0xbf,
+	0x38, 0x16, 0x8e, 0x2f, 0xf2, 0x5d, 0x42, 0xf2, 0x2d, 0xf8, 0x00, 0x0c, 0x8c, 0x8c, 0x7c, 0x8c,
+	0x8c, 0x19, 0x99, 0x10, 0x4a, 0x06, 0xbe, 0x06, 0xf2, 0x5d, 0xe2, 0x38, 0x52, 0x06, 0x16, 0xcb,
+	0x7e, 0xff, 0xdf, 0xfb, 0xe9, 0xf9, 0xdd, 0xc1, 0xd3, 0x48, 0xc8, 0x81, 0x90, 0x7e, 0x24, 0x72,
+	0x89, 0xb9, 0x1c, 0x49, 0x7f, 0x7c, 0xe5, 0x27, 0x5c, 0x7a, 0xc3, 0x42, 0x28, 0x41, 0xdb, 0x26,
+	0xf6, 0xaa, 0xd8, 0x1b, 0x5f, 0x9d, 0x9e, 0x24, 0x22, 0x11, 0x3a, 0xf7, 0xcb, 0x37, 0x83, 0x9e,
+	0x3e, 0xe6, 0x83, 0x34, 0x17, 0xbe, 0x7e, 0x9a, 0x52, 0xe7, 0x7b, 0x03, 0x9a, 0xb7, 0x5c, 0xbe,
+	0x13, 0x79, 0x2f, 0x4d, 0xe8, 0x13, 0x78, 0xd0, 0xe7, 0x32, 0x8c, 0x84, 0x54, 0x2e, 0x39, 0x27,
+	0x17, 0xfb, 0xc1, 0x61, 0xbf, 0x0c, 0xa5, 0xa2, 0x67, 0xe0, 0xc4, 0x98, 0xa1, 0x42, 0x93, 0x36,
+	0x74, 0x0a, 0xa6, 0xa4, 0x81, 0xe7, 0xd0, 0x2a, 0x90, 0xc7, 0x3a, 0x0e, 0x7b, 0x19, 0x57, 0xee,
+	0x9e, 0x66, 0x1e, 0x96, 0xd5, 0x92, 0xb8, 0xc9, 0xb8, 0xa2, 0xaf, 0x80, 0x6e, 0xa8, 0x21, 0x16,
+	0xe1, 0xfd, 0x54, 0xa1, 0xbb, 0xaf, 0xc9, 0xe3, 0x35, 0xf9, 0x11, 0x8b, 0xee, 0x54, 0x21, 0x7d,
+	0x01, 0xc7, 0x5f, 0x8a, 0x54, 0x61, 0xcd, 0x79, 0xa0, 0xc9, 0x23, 0x5d, 0xae, 0xa4, 0x97, 0xd0,
+	0xae, 0x71, 0x95, 0xd5, 0xd6, 0xec, 0xa3, 0x8a, 0x5d, 0x6b, 0x2f, 0xa1, 0x9d, 0x2a, 0x2c, 0xc2,
+	0x1c, 0x27, 0xaa, 0xa6, 0x3e, 0x34, 0x78, 0x19, 0x7d, 0xc0, 0x89, 0x5a, 0xdb, 0x3b, 0x29, 0xb4,
+	0xee, 0x94, 0x28, 0x70, 0xb3, 0xa6, 0x13, 0x38, 0x90, 0x65, 0x45, 0xef, 0xa8, 0x19, 0x98, 0x0f,
+	0xfa, 0x16, 0xec, 0x48, 0xe7, 0x7a, 0x39, 0xce, 0x35, 0xf3, 0x76, 0x9c, 0x8c, 0x57, 0x59, 0xba,
+	0xcd, 0xd9, 0xef, 0x33, 0xeb, 0xc7, 0xdf, 0x9f, 0x2f, 0x49, 0xb0, 0x6a, 0xec, 0x7c, 0x23, 0xe0,
+	0xdc, 0x72, 0x79, 0x17, 0xf5, 0x31, 0x1e, 0x65, 0x48, 0xdf, 0x43, 0x2b, 0xc6, 0x1e, 0x1f, 0x65,
+	0x2a, 0x34, 0x84, 0x4b, 0xfe, 0x47, 0x1d, 0x1c, 0xad, 0xba, 0x56, 0xf3, 0xde, 0x80, 0xad, 0x47,
+	0x94, 0x6e, 0xe3, 0x7c, 0xef, 0xc2, 0xb9, 0x7e, 0xb6, 0xb3, 0x7d, 0xfb, 0x27, 0xb7, 0xc6, 0x33,
+	0xdd, 0xdd, 0x37, 0xb3, 0x05, 0x23, 0xf3, 0x05, 0x23, 0x7f, 0x16, 0x8c, 0x7c, 0x5d, 0x32, 0x6b,
+	0xbe, 0x64, 0xd6, 0xaf, 0x25, 0xb3, 0x3e, 0x31, 0x23, 0x94, 0xf1, 0x67, 0x2f, 0x15, 0xfe, 0xa4,
+	0x76, 0x57, 0xd5, 0x74, 0x88, 0xf2, 0xde, 0xd6, 0xb7, 0xed, 0xf5, 0xbf, 0x01, 0x00, 0xbf, 0xce,
+	0x4f, 0xc7, 0xcc, 0x02, 0x00, 0x00,
+}
+
+func (m *GasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IterNextCostFlat != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.IterNextCostFlat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WriteCostPerByte != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.WriteCostPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteCostFlat != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.WriteCostFlat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReadCostPerByte != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.ReadCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadCostFlat != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.ReadCostFlat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeleteCost != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.DeleteCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasCost != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.HasCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreGasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreGasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGas(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintGas(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGas(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DefaultConfig != nil {
+		{
+			size, err := m.DefaultConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGas(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGas(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGas(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasCost != 0 {
+		n += 1 + sovGas(uint64(m.HasCost))
+	}
+	if m.DeleteCost != 0 {
+		n += 1 + sovGas(uint64(m.DeleteCost))
+	}
+	if m.ReadCostFlat != 0 {
+		n += 1 + sovGas(uint64(m.ReadCostFlat))
+	}
+	if m.ReadCostPerByte != 0 {
+		n += 1 + sovGas(uint64(m.ReadCostPerByte))
+	}
+	if m.WriteCostFlat != 0 {
+		n += 1 + sovGas(uint64(m.WriteCostFlat))
+	}
+	if m.WriteCostPerByte != 0 {
+		n += 1 + sovGas(uint64(m.WriteCostPerByte))
+	}
+	if m.IterNextCostFlat != 0 {
+		n += 1 + sovGas(uint64(m.IterNextCostFlat))
+	}
+	return n
+}
+
+func (m *StoreGasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovGas(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovGas(uint64(l))
+	return n
+}
+
+func (m *GasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultConfig != nil {
+		l = m.DefaultConfig.Size()
+		n += 1 + l + sovGas(uint64(l))
+	}
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovGas(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGas(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGas(x uint64) (n int) {
+	return sovGas(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GasConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCost", wireType)
+			}
+			m.HasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteCost", wireType)
+			}
+			m.DeleteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostFlat", wireType)
+			}
+			m.ReadCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostPerByte", wireType)
+			}
+			m.ReadCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostFlat", wireType)
+			}
+			m.WriteCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostPerByte", wireType)
+			}
+			m.WriteCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterNextCostFlat", wireType)
+			}
+			m.IterNextCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterNextCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreGasConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreGasConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGas
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGas
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGas
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultConfig == nil {
+				m.DefaultConfig = &GasConfig{}
+			}
+			if err := m.DefaultConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGas
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, StoreGasConfig{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGas(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGas
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGas
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGas
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGas        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGas          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGas = fmt.Errorf("proto: unexpected end of group")
+)
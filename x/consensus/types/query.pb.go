@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	v1 "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	_ "github.com/cosmos/cosmos-proto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -114,32 +115,124 @@ func (m *QueryParamsResponse) GetParams() *v1.ConsensusParams {
 	return nil
 }
 
+// QueryGasScheduleRequest defines the request type for querying the gas schedule.
+type QueryGasScheduleRequest struct {
+}
+
+func (m *QueryGasScheduleRequest) Reset()         { *m = QueryGasScheduleRequest{} }
+func (m *QueryGasScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasScheduleRequest) ProtoMessage()    {}
+func (*QueryGasScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{2}
+}
+func (m *QueryGasScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasScheduleRequest.Merge(m, src)
+}
+func (m *QueryGasScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasScheduleRequest proto.InternalMessageInfo
+
+// QueryGasScheduleResponse defines the response type for querying the gas schedule.
+type QueryGasScheduleResponse struct {
+	// schedule is the gas schedule stored in the consensus module.
+	Schedule *GasSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (m *QueryGasScheduleResponse) Reset()         { *m = QueryGasScheduleResponse{} }
+func (m *QueryGasScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasScheduleResponse) ProtoMessage()    {}
+func (*QueryGasScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{3}
+}
+func (m *QueryGasScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasScheduleResponse.Merge(m, src)
+}
+func (m *QueryGasScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryGasScheduleResponse) GetSchedule() *GasSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.consensus.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.consensus.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGasScheduleRequest)(nil), "cosmos.consensus.v1.QueryGasScheduleRequest")
+	proto.RegisterType((*QueryGasScheduleResponse)(nil), "cosmos.consensus.v1.QueryGasScheduleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/query.proto", fileDescriptor_bf54d1e5df04cee9) }
 
 var fileDescriptor_bf54d1e5df04cee9 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c,
-	0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0x68, 0x91,
-	0x92, 0x4b, 0xce, 0xcf, 0x4d, 0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0xa9, 0x2c, 0x48, 0x05, 0x9b,
-	0x58, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x95, 0x57, 0x12, 0xe1, 0x12, 0x0a, 0x04, 0xd9, 0x10, 0x00,
-	0x16, 0x0c, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe4, 0x12, 0x46, 0x11, 0x2d, 0x2e,
-	0x00, 0xd9, 0x28, 0x64, 0xc5, 0xc5, 0x06, 0xd1, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4,
-	0xa4, 0x07, 0x33, 0x5d, 0x0f, 0x6c, 0xba, 0x5e, 0x99, 0xa1, 0x9e, 0x33, 0xcc, 0x6d, 0x50, 0xbd,
-	0x50, 0x1d, 0x46, 0x5d, 0x8c, 0x5c, 0xac, 0x60, 0x33, 0x85, 0x1a, 0x18, 0xb9, 0xd8, 0x20, 0x92,
-	0x42, 0xea, 0x7a, 0x58, 0x7c, 0xa4, 0x87, 0xe9, 0x20, 0x29, 0x0d, 0xc2, 0x0a, 0x21, 0x6e, 0x54,
-	0x52, 0x6e, 0xba, 0xfc, 0x64, 0x32, 0x93, 0xac, 0x90, 0xb4, 0x3e, 0xb6, 0xd0, 0x84, 0x38, 0xc6,
-	0xc9, 0xe2, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xe4, 0x20, 0xba, 0x8a,
-	0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x90, 0x74, 0x83, 0x7d, 0x98, 0xc4, 0x06, 0x0e, 0x36,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x63, 0xcb, 0xf0, 0x2b, 0xac, 0x01, 0x00, 0x00,
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0x02, 0x41,
+	0x1c, 0xc6, 0x5d, 0x21, 0x89, 0xf1, 0x10, 0x8c, 0x41, 0xba, 0xe5, 0x64, 0xeb, 0x21, 0x0f, 0x39,
+	0x93, 0x46, 0x10, 0xe1, 0xa9, 0x0e, 0x5d, 0xb3, 0x2e, 0xd1, 0x45, 0xc6, 0x75, 0x32, 0x51, 0x77,
+	0x56, 0x67, 0x56, 0xf2, 0x16, 0x3d, 0x41, 0xd0, 0x4b, 0xf4, 0x00, 0xf5, 0x0e, 0xd1, 0x49, 0xe8,
+	0xd2, 0x31, 0xb4, 0x07, 0x09, 0x67, 0x66, 0xc5, 0x70, 0xa5, 0x8e, 0x33, 0xff, 0xff, 0xf7, 0x7d,
+	0x3f, 0x3e, 0xfe, 0x60, 0xdb, 0xe5, 0xa2, 0xcb, 0x05, 0x71, 0xb9, 0x27, 0x98, 0x27, 0x02, 0x41,
+	0x06, 0x25, 0xd2, 0x0b, 0x58, 0x7f, 0x88, 0xfd, 0x3e, 0x97, 0x1c, 0xa6, 0xf4, 0x02, 0x9e, 0x2d,
+	0xe0, 0x41, 0xc9, 0xde, 0x6a, 0x72, 0xde, 0xec, 0x30, 0x42, 0xfd, 0x16, 0xa1, 0x9e, 0xc7, 0x25,
+	0x95, 0x2d, 0xee, 0x09, 0x2d, 0xb1, 0x91, 0xcb, 0xbb, 0x4c, 0xd6, 0x6f, 0x24, 0x91, 0x43, 0x9f,
+	0x29, 0x47, 0x9f, 0xf6, 0x69, 0x37, 0x9c, 0x67, 0xb4, 0x65, 0x4d, 0xbd, 0x88, 0xf1, 0xd7, 0xa3,
+	0x6c, 0x14, 0x4e, 0x93, 0x9a, 0xb1, 0xb3, 0x0e, 0x60, 0x75, 0xca, 0x76, 0xae, 0xec, 0x2e, 0x58,
+	0x2f, 0x60, 0x42, 0x3a, 0x55, 0x90, 0xfa, 0xf5, 0x2b, 0xfc, 0xa9, 0x1a, 0x1e, 0x83, 0x84, 0x8e,
+	0x4d, 0x5b, 0x39, 0xab, 0x90, 0x2c, 0x3b, 0x38, 0xe4, 0xc2, 0x8a, 0x0b, 0x0f, 0x4a, 0xf8, 0x34,
+	0xcc, 0x31, 0x5a, 0xa3, 0x70, 0x32, 0x60, 0x43, 0x59, 0x9e, 0x51, 0x71, 0xe9, 0xde, 0xb2, 0x46,
+	0xd0, 0x61, 0x61, 0xda, 0x15, 0x48, 0x2f, 0x8e, 0x4c, 0x64, 0x05, 0xac, 0x0a, 0xf3, 0x67, 0x42,
+	0x73, 0x38, 0xa2, 0x3f, 0x3c, 0xaf, 0x9d, 0x29, 0xca, 0xaf, 0x71, 0xb0, 0xa2, 0xac, 0xe1, 0xbd,
+	0x05, 0x12, 0x9a, 0x08, 0xee, 0x46, 0x1a, 0x2c, 0xb6, 0x60, 0x17, 0xfe, 0x5e, 0xd4, 0x94, 0x4e,
+	0xfe, 0xe1, 0xe3, 0xfb, 0x29, 0x9e, 0x85, 0x9b, 0x24, 0xaa, 0x6d, 0xdd, 0x00, 0x7c, 0xb6, 0x40,
+	0x72, 0x0e, 0x13, 0xee, 0x2d, 0xb7, 0x5f, 0x2c, 0xc9, 0x2e, 0xfe, 0x73, 0xdb, 0x10, 0x55, 0xde,
+	0x5f, 0x8a, 0x6b, 0x5a, 0x51, 0x14, 0x8d, 0x76, 0x6e, 0x1f, 0x1f, 0x96, 0x15, 0x64, 0x1e, 0xee,
+	0x90, 0x25, 0x27, 0x51, 0x0b, 0x7b, 0x3b, 0x39, 0x7a, 0x1b, 0x23, 0x6b, 0x34, 0x46, 0xd6, 0xd7,
+	0x18, 0x59, 0x8f, 0x13, 0x14, 0x1b, 0x4d, 0x50, 0xec, 0x73, 0x82, 0x62, 0xd7, 0x48, 0x6b, 0x45,
+	0xa3, 0x8d, 0x5b, 0x9c, 0xdc, 0xcd, 0x79, 0xa8, 0x0b, 0xa8, 0x27, 0xd4, 0x59, 0x1d, 0xfc, 0x0c,
+	0x00, 0x1e, 0xe9, 0x35, 0xcb, 0x06, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -156,6 +249,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of x/consensus module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GasSchedule queries the gas costs of the module stores.
+	GasSchedule(ctx context.Context, in *QueryGasScheduleRequest, opts ...grpc.CallOption) (*QueryGasScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -175,10 +270,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) GasSchedule(ctx context.Context, in *QueryGasScheduleRequest, opts ...grpc.CallOption) (*QueryGasScheduleResponse, error) {
+	out := new(QueryGasScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Query/GasSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/consensus module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GasSchedule queries the gas costs of the module stores.
+	GasSchedule(context.Context, *QueryGasScheduleRequest) (*QueryGasScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -188,6 +294,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) GasSchedule(ctx context.Context, req *QueryGasScheduleRequest) (*QueryGasScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -211,6 +320,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Query/GasSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasSchedule(ctx, req.(*QueryGasScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -219,6 +346,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "GasSchedule",
+			Handler:    _Query_GasSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/query.proto",
@@ -282,6 +413,64 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGasScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -315,6 +504,28 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGasScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -457,6 +668,142 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGasScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &GasSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
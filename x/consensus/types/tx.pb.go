@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -170,47 +171,148 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateGasSchedule is the Msg/UpdateGasSchedule request type.
+type MsgUpdateGasSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// schedule defines the gas schedule replacing the current one.
+	Schedule GasSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *MsgUpdateGasSchedule) Reset()         { *m = MsgUpdateGasSchedule{} }
+func (m *MsgUpdateGasSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGasSchedule) ProtoMessage()    {}
+func (*MsgUpdateGasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{2}
+}
+func (m *MsgUpdateGasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGasSchedule.Merge(m, src)
+}
+func (m *MsgUpdateGasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGasSchedule proto.InternalMessageInfo
+
+func (m *MsgUpdateGasSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateGasSchedule) GetSchedule() GasSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return GasSchedule{}
+}
+
+// MsgUpdateGasScheduleResponse defines the response structure for executing a
+// MsgUpdateGasSchedule message.
+type MsgUpdateGasScheduleResponse struct {
+}
+
+func (m *MsgUpdateGasScheduleResponse) Reset()         { *m = MsgUpdateGasScheduleResponse{} }
+func (m *MsgUpdateGasScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGasScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateGasScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{3}
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGasScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGasScheduleResponse.Merge(m, src)
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGasScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGasScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.consensus.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.consensus.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateGasSchedule)(nil), "cosmos.consensus.v1.MsgUpdateGasSchedule")
+	proto.RegisterType((*MsgUpdateGasScheduleResponse)(nil), "cosmos.consensus.v1.MsgUpdateGasScheduleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/tx.proto", fileDescriptor_2135c60575ab504d) }
 
 var fileDescriptor_2135c60575ab504d = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x1b, 0xb7, 0xdd, 0xb5, 0xa3, 0xb0, 0x98, 0x2a, 0x3b, 0x5b, 0x74, 0xa8, 0x45, 0x64,
-	0x29, 0x76, 0xb2, 0xad, 0xeb, 0x5f, 0x10, 0xdc, 0x88, 0xa2, 0x87, 0x45, 0xc9, 0xb2, 0x1e, 0xbc,
-	0xc8, 0x34, 0x99, 0xcd, 0x86, 0x6e, 0x32, 0x21, 0x33, 0x8d, 0xdb, 0x9b, 0x08, 0x5e, 0x3c, 0xf9,
-	0x45, 0x84, 0x1e, 0xf6, 0x43, 0x88, 0xa7, 0xc5, 0x93, 0x78, 0x92, 0xf6, 0xd0, 0xaf, 0x21, 0x9d,
-	0x99, 0x6c, 0xdc, 0x9a, 0xc2, 0x5e, 0x02, 0xc9, 0xf3, 0xfc, 0x9e, 0x27, 0xf3, 0xe6, 0x0d, 0xb8,
-	0xee, 0x32, 0x1e, 0x32, 0x6e, 0xb9, 0x2c, 0xe2, 0x34, 0xe2, 0x03, 0x6e, 0xa5, 0x1d, 0x4b, 0x1c,
-	0xe1, 0x38, 0x61, 0x82, 0x99, 0x35, 0xa5, 0xe2, 0x53, 0x15, 0xa7, 0x9d, 0xfa, 0x15, 0x12, 0x06,
-	0x11, 0xb3, 0xe4, 0x55, 0xf9, 0xea, 0xeb, 0xca, 0xf7, 0x5e, 0xde, 0x59, 0x1a, 0x52, 0xd2, 0x9a,
-	0x2e, 0x08, 0xb9, 0x3f, 0x8b, 0x0e, 0xb9, 0xaf, 0x05, 0xe4, 0xb2, 0x90, 0x8a, 0xde, 0xbe, 0xb0,
-	0xc4, 0x30, 0xa6, 0xb2, 0x37, 0x26, 0x09, 0x09, 0x35, 0xd8, 0xfc, 0x56, 0x06, 0xab, 0x3b, 0xdc,
-	0xdf, 0x8b, 0x3d, 0x22, 0xe8, 0x1b, 0xa9, 0x98, 0xf7, 0x41, 0x95, 0x0c, 0xc4, 0x01, 0x4b, 0x02,
-	0x31, 0x84, 0x46, 0xc3, 0xd8, 0xa8, 0xda, 0xf0, 0xe7, 0x71, 0xfb, 0xaa, 0x6e, 0xdc, 0xf6, 0xbc,
-	0x84, 0x72, 0xbe, 0x2b, 0x92, 0x20, 0xf2, 0x9d, 0xdc, 0x6a, 0x6e, 0x81, 0x4a, 0xef, 0x90, 0xb9,
-	0x7d, 0x78, 0xa1, 0x61, 0x6c, 0x5c, 0xea, 0x22, 0x9c, 0x75, 0x63, 0xd9, 0x8d, 0xd3, 0x0e, 0xb6,
-	0x67, 0xba, 0xaa, 0x71, 0x94, 0xd9, 0x7c, 0x02, 0x2e, 0xd2, 0x34, 0xf0, 0x68, 0xe4, 0x52, 0xb8,
-	0x24, 0xc1, 0x9b, 0x05, 0xe0, 0x73, 0x6d, 0xd1, 0xec, 0x29, 0x62, 0x3e, 0x05, 0xd5, 0x94, 0x1c,
-	0x06, 0x1e, 0x11, 0x2c, 0x81, 0x65, 0xc9, 0x37, 0x0b, 0xf8, 0xb7, 0x99, 0x47, 0x07, 0xe4, 0x90,
-	0xf9, 0x12, 0x94, 0x49, 0xcf, 0x0d, 0x60, 0x45, 0xc2, 0x37, 0x0a, 0xe0, 0x6d, 0xfb, 0xd9, 0x2b,
-	0xc5, 0xd9, 0xd7, 0x7e, 0x1f, 0xb7, 0x57, 0xd5, 0x20, 0xda, 0xdc, 0xeb, 0x37, 0x36, 0xf1, 0xbd,
-	0x4d, 0x68, 0x38, 0x32, 0xc1, 0xdc, 0x03, 0x55, 0x3e, 0x8c, 0xdc, 0x83, 0x84, 0x45, 0x43, 0xb8,
-	0xbc, 0xf0, 0x5d, 0x76, 0x33, 0x8f, 0xce, 0xac, 0xfd, 0x9f, 0xd9, 0x71, 0xf2, 0x24, 0xf3, 0x35,
-	0x58, 0xd9, 0xa7, 0x44, 0x0c, 0x12, 0x0a, 0x57, 0x64, 0x68, 0xa3, 0x20, 0xf4, 0x85, 0x72, 0x2c,
-	0x8e, 0xec, 0x3a, 0x59, 0xca, 0xe3, 0x47, 0x9f, 0xa6, 0xa3, 0x56, 0xfe, 0xe1, 0xbe, 0x4c, 0x47,
-	0xad, 0xdb, 0xb9, 0xd9, 0x3a, 0xfa, 0x67, 0x4f, 0xe7, 0x76, 0xa3, 0xb9, 0x0e, 0xd6, 0xe6, 0x1e,
-	0x39, 0x94, 0xc7, 0x33, 0x7b, 0xf7, 0xb3, 0x01, 0x96, 0x76, 0xb8, 0x6f, 0x7e, 0x00, 0x97, 0xcf,
-	0xac, 0xd3, 0x2d, 0x5c, 0xb0, 0xdf, 0x78, 0x2e, 0xa5, 0x7e, 0xe7, 0x3c, 0xae, 0xac, 0xab, 0x59,
-	0xfb, 0x31, 0x7f, 0xbe, 0xad, 0x07, 0xf5, 0xca, 0xc7, 0xe9, 0xa8, 0x65, 0xd8, 0x0f, 0xbf, 0x8f,
-	0x91, 0x71, 0x32, 0x46, 0xc6, 0x9f, 0x31, 0x32, 0xbe, 0x4e, 0x50, 0xe9, 0x64, 0x82, 0x4a, 0xbf,
-	0x26, 0xa8, 0xf4, 0x0e, 0x29, 0x82, 0x7b, 0x7d, 0x1c, 0xb0, 0x33, 0xc7, 0x94, 0x63, 0xec, 0x2d,
-	0xcb, 0x7f, 0xe2, 0xee, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xb2, 0x37, 0x1a, 0xaf, 0x03,
-	0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0x12, 0x4f,
+	0x18, 0xc7, 0x59, 0x0a, 0x6d, 0x99, 0xdf, 0x2f, 0x69, 0xba, 0x60, 0xba, 0x25, 0xed, 0x8a, 0x68,
+	0x4c, 0x25, 0xb2, 0x5b, 0xb0, 0xad, 0xda, 0xc4, 0xc4, 0xae, 0x51, 0xf4, 0xd0, 0x68, 0x96, 0xd4,
+	0x83, 0x17, 0x33, 0xec, 0x4e, 0x97, 0x0d, 0xec, 0x0e, 0xd9, 0x59, 0xb0, 0xdc, 0x8c, 0x07, 0x0f,
+	0x1e, 0x8c, 0x2f, 0xc3, 0x8b, 0x09, 0x87, 0xbe, 0x88, 0xc6, 0x8b, 0x8d, 0x27, 0xe3, 0xc1, 0x18,
+	0x38, 0xf0, 0x36, 0x0c, 0x33, 0xb3, 0x0b, 0x85, 0x6d, 0x6d, 0xbc, 0x10, 0x98, 0xe7, 0xfb, 0xf9,
+	0x3e, 0x7f, 0xe6, 0x19, 0xc0, 0x9a, 0x81, 0x89, 0x83, 0x89, 0x6a, 0x60, 0x97, 0x20, 0x97, 0xb4,
+	0x89, 0xda, 0x29, 0xa9, 0xfe, 0x91, 0xd2, 0xf2, 0xb0, 0x8f, 0xc5, 0x34, 0x8b, 0x2a, 0x61, 0x54,
+	0xe9, 0x94, 0xb2, 0xcb, 0xd0, 0xb1, 0x5d, 0xac, 0xd2, 0x4f, 0xa6, 0xcb, 0xae, 0x32, 0xdd, 0x6b,
+	0xfa, 0x4b, 0xe5, 0x10, 0x0b, 0xad, 0xf0, 0x04, 0x0e, 0xb1, 0x46, 0xd6, 0x0e, 0xb1, 0x78, 0x40,
+	0x36, 0xb0, 0x83, 0xfc, 0xda, 0xa1, 0xaf, 0xfa, 0xdd, 0x16, 0xa2, 0x79, 0x5b, 0xd0, 0x83, 0x4e,
+	0x00, 0x66, 0x2c, 0x6c, 0x61, 0x66, 0x38, 0xfa, 0xc6, 0x4f, 0xd7, 0xa3, 0xea, 0xb5, 0x20, 0x87,
+	0xf2, 0x5f, 0x12, 0x60, 0x69, 0x9f, 0x58, 0x07, 0x2d, 0x13, 0xfa, 0xe8, 0x05, 0xb5, 0x13, 0x77,
+	0x40, 0x0a, 0xb6, 0xfd, 0x3a, 0xf6, 0x6c, 0xbf, 0x2b, 0x09, 0x39, 0x61, 0x23, 0xa5, 0x49, 0xdf,
+	0x8f, 0x8b, 0x19, 0x5e, 0xe6, 0x9e, 0x69, 0x7a, 0x88, 0x90, 0xaa, 0xef, 0xd9, 0xae, 0xa5, 0x8f,
+	0xa5, 0xe2, 0x16, 0x48, 0xd6, 0x9a, 0xd8, 0x68, 0x48, 0xf1, 0x9c, 0xb0, 0xf1, 0x5f, 0x59, 0x56,
+	0x82, 0x82, 0x15, 0x5a, 0xb0, 0xd2, 0x29, 0x29, 0xda, 0x28, 0xce, 0xd2, 0xe8, 0x4c, 0x2c, 0x3e,
+	0x00, 0x8b, 0xa8, 0x63, 0x9b, 0xc8, 0x35, 0x90, 0x34, 0x47, 0xc1, 0x6b, 0x11, 0xe0, 0x63, 0x2e,
+	0xe1, 0x6c, 0x88, 0x88, 0x0f, 0x41, 0xaa, 0x03, 0x9b, 0xb6, 0x09, 0x7d, 0xec, 0x49, 0x09, 0xca,
+	0xe7, 0x23, 0xf8, 0x97, 0x81, 0x86, 0x1b, 0x8c, 0x21, 0xf1, 0x29, 0x48, 0xc0, 0x9a, 0x61, 0x4b,
+	0x49, 0x0a, 0xaf, 0x47, 0xc0, 0x7b, 0xda, 0xa3, 0x67, 0x8c, 0xd3, 0xae, 0xfc, 0x3c, 0x2e, 0x2e,
+	0xb1, 0x41, 0x14, 0x89, 0xd9, 0xc8, 0x6d, 0x2a, 0xdb, 0x9b, 0x92, 0xa0, 0x53, 0x07, 0xf1, 0x00,
+	0xa4, 0x48, 0xd7, 0x35, 0xea, 0x1e, 0x76, 0xbb, 0xd2, 0xfc, 0xb9, 0xb5, 0x54, 0x03, 0x0d, 0xf7,
+	0x4c, 0xcf, 0x7a, 0x96, 0xf4, 0xb1, 0x93, 0xf8, 0x1c, 0x2c, 0x1c, 0x22, 0xe8, 0xb7, 0x3d, 0x24,
+	0x2d, 0x50, 0xd3, 0x5c, 0x84, 0xe9, 0x13, 0xa6, 0x38, 0xdf, 0xb2, 0xac, 0x07, 0x2e, 0xbb, 0xf7,
+	0xdf, 0x0d, 0x7b, 0x85, 0xf1, 0xc5, 0x7d, 0x18, 0xf6, 0x0a, 0x37, 0xc7, 0x62, 0xf5, 0x68, 0x62,
+	0x59, 0xa6, 0x76, 0x23, 0xbf, 0x0a, 0x56, 0xa6, 0x8e, 0x74, 0x44, 0x5a, 0x23, 0x79, 0xfe, 0x9b,
+	0x00, 0x32, 0x61, 0xac, 0x02, 0x49, 0xd5, 0xa8, 0x23, 0xb3, 0xdd, 0x44, 0xff, 0xbc, 0x4f, 0x15,
+	0xb0, 0x48, 0xb8, 0x87, 0x14, 0x0f, 0x1b, 0x9f, 0x79, 0x5f, 0xca, 0x44, 0x2e, 0x2d, 0x75, 0xf2,
+	0xeb, 0x6a, 0xec, 0xf3, 0xb0, 0x57, 0x10, 0xf4, 0x10, 0xde, 0xdd, 0x99, 0xed, 0xf7, 0xfa, 0xdf,
+	0xfa, 0xad, 0x40, 0x92, 0x97, 0xc1, 0x5a, 0x54, 0x43, 0x41, 0xc7, 0xe5, 0x8f, 0x71, 0x30, 0xb7,
+	0x4f, 0x2c, 0xf1, 0x0d, 0xf8, 0xff, 0xcc, 0x03, 0xba, 0x11, 0x59, 0xe6, 0xd4, 0xdc, 0xb2, 0xb7,
+	0x2f, 0xa3, 0x0a, 0xa7, 0x9b, 0xfe, 0x3a, 0x7d, 0xa3, 0x5b, 0x77, 0xc5, 0xf7, 0x02, 0x58, 0x9e,
+	0x9d, 0xf7, 0xad, 0x8b, 0x8d, 0x27, 0xa4, 0xd9, 0xd2, 0xa5, 0xa5, 0x17, 0x14, 0xb2, 0x5d, 0xce,
+	0x26, 0xdf, 0x8e, 0x46, 0xae, 0xdd, 0x3b, 0xe9, 0xcb, 0xc2, 0x69, 0x5f, 0x16, 0x7e, 0xf7, 0x65,
+	0xe1, 0xd3, 0x40, 0x8e, 0x9d, 0x0e, 0xe4, 0xd8, 0x8f, 0x81, 0x1c, 0x7b, 0x25, 0x33, 0x82, 0x98,
+	0x0d, 0xc5, 0xc6, 0x67, 0x26, 0x4e, 0x37, 0xb8, 0x36, 0x4f, 0xff, 0x8e, 0xee, 0xfc, 0x19, 0x00,
+	0xf1, 0x1b, 0x9f, 0x84, 0x5f, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -228,6 +330,9 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateGasSchedule defines a governance operation for updating the gas costs
+	// of the module stores. The authority is defined in the keeper.
+	UpdateGasSchedule(ctx context.Context, in *MsgUpdateGasSchedule, opts ...grpc.CallOption) (*MsgUpdateGasScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -247,11 +352,23 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateGasSchedule(ctx context.Context, in *MsgUpdateGasSchedule, opts ...grpc.CallOption) (*MsgUpdateGasScheduleResponse, error) {
+	out := new(MsgUpdateGasScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Msg/UpdateGasSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateGasSchedule defines a governance operation for updating the gas costs
+	// of the module stores. The authority is defined in the keeper.
+	UpdateGasSchedule(context.Context, *MsgUpdateGasSchedule) (*MsgUpdateGasScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -261,6 +378,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGasSchedule(ctx context.Context, req *MsgUpdateGasSchedule) (*MsgUpdateGasScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -284,6 +404,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGasSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Msg/UpdateGasSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGasSchedule(ctx, req.(*MsgUpdateGasSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -292,6 +430,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateGasSchedule",
+			Handler:    _Msg_UpdateGasSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
@@ -422,6 +564,69 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateGasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGasScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -479,6 +684,30 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateGasScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -833,6 +1062,171 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateGasSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGasScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
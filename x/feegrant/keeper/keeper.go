@@ -38,7 +38,7 @@ var _ ante.FeegrantKeeper = &Keeper{}
 func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak feegrant.AccountKeeper) Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
-	return Keeper{
+	k := Keeper{
 		Environment: env,
 		cdc:         cdc,
 		authKeeper:  ak,
@@ -57,6 +57,14 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak feegrant.Acc
 			collections.BoolValue,
 		),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // Logger returns a module-specific logger.